@@ -8,6 +8,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -28,15 +29,22 @@ func main() {
 	flag.StringVar(&source, "source", defaultSourceValue, "source dir")
 	var dest string
 	flag.StringVar(&dest, "dest", "", "destination dir")
+	var schema string
+	flag.StringVar(&schema, "schema", "", "destination file for the JSON schema of the components")
 	flag.Parse()
 
-	if err := run(source, dest); err != nil {
+	if dest == "" && schema == "" {
+		log.Print("-dest or -schema is required")
+		os.Exit(1)
+	}
+
+	if err := run(source, dest, schema); err != nil {
 		log.Printf("%v", err)
 		os.Exit(1)
 	}
 }
 
-func run(source, dest string) error {
+func run(source, dest, schema string) error {
 	tg, err := tsgen.NewTSGen()
 	if err != nil {
 		return fmt.Errorf("create typescript generator: %w", err)
@@ -52,8 +60,21 @@ func run(source, dest string) error {
 		return fmt.Errorf("run reflect: %w", err)
 	}
 
-	if err := tg.Stage(dest, m); err != nil {
-		return fmt.Errorf("stage typescript: %w", err)
+	if dest != "" {
+		if err := tg.Stage(dest, m); err != nil {
+			return fmt.Errorf("stage typescript: %w", err)
+		}
+	}
+
+	if schema != "" {
+		b, err := tg.Schema(m)
+		if err != nil {
+			return fmt.Errorf("generate schema: %w", err)
+		}
+
+		if err := ioutil.WriteFile(schema, b, 0644); err != nil {
+			return fmt.Errorf("write schema: %w", err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRun_schema regenerates the component JSON schema and fails if it differs from the
+// checked in schema, so changes to components can't leave the schema out of date.
+func TestRun_schema(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	// The generator runs from the root of the repository.
+	root := filepath.Join(wd, "..", "..")
+	require.NoError(t, os.Chdir(root))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	generated := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, run(filepath.Join(root, "pkg", "view", "component"), "", generated))
+
+	got, err := ioutil.ReadFile(generated)
+	require.NoError(t, err)
+	want, err := ioutil.ReadFile(filepath.Join(root, "pkg", "view", "component", "schema.json"))
+	require.NoError(t, err)
+
+	require.True(t, string(want) == string(got),
+		"pkg/view/component/schema.json is out of date; run `go run ./cmd/ts-component-gen -schema pkg/view/component/schema.json`")
+}
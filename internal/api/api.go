@@ -7,11 +7,11 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/vmware-tanzu/octant/internal/util/json"
 
@@ -19,6 +19,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/mime"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/pkg/api"
@@ -48,7 +49,11 @@ func AcceptedHosts() []string {
 	}
 
 	listenerAddr := getListenerAddr()
-	host, _, err := net.SplitHostPort(listenerAddr)
+	network, address := listenerNetwork(listenerAddr)
+	if network == "unix" {
+		return hosts
+	}
+	host, _, err := net.SplitHostPort(address)
 	if err != nil {
 		panic(fmt.Sprintf("Unable to parse OCTANT_LISTENER_ADDR: %s", listenerAddr))
 	}
@@ -58,14 +63,32 @@ func AcceptedHosts() []string {
 }
 
 // Listener returns the default listener if OCTANT_LISTENER_ADDR is not set.
+// OCTANT_LISTENER_ADDR is either a host:port or a unix socket path prefixed with unix://.
+// The listener serves TLS when a certificate is configured.
 func Listener() (net.Listener, error) {
-	listenerAddr := getListenerAddr()
-	conn, err := net.DialTimeout("tcp", listenerAddr, time.Millisecond*500)
+	network, address := listenerNetwork(getListenerAddr())
+
+	var host string
+	if network == "tcp" {
+		var err error
+		if host, _, err = net.SplitHostPort(address); err != nil {
+			return nil, err
+		}
+	}
+
+	tlsConfig, err := listenerTLSConfig(host)
 	if err != nil {
-		return net.Listen("tcp", listenerAddr)
+		return nil, err
+	}
+
+	listener, err := listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil {
+		return listener, nil
 	}
-	_ = conn.Close()
-	return nil, fmt.Errorf("tcp %s: dial: already in use", listenerAddr)
+	return &tlsListener{Listener: tls.NewListener(listener, tlsConfig)}, nil
 }
 
 func getListenerAddr() string {
@@ -123,6 +146,7 @@ type API struct {
 	dashConfig       config.Dash
 	logger           log.Logger
 	scManager        *api.StreamingConnectionManager
+	clientPool       *auth.ClientPool
 
 	modulePaths   map[string]module.Module
 	modules       []module.Module
@@ -143,6 +167,7 @@ func New(ctx context.Context, prefix string, actionDispatcher api.ActionDispatch
 		logger:           logger,
 		forceUpdateCh:    make(chan bool, 1),
 		scManager:        streamingConnectionManager,
+		clientPool:       auth.NewClientPool(),
 	}
 }
 
@@ -158,10 +183,12 @@ func (a *API) Handler(ctx context.Context) (http.Handler, error) {
 	}
 	router := mux.NewRouter()
 	router.Use(rebindHandler(ctx, AcceptedHosts()))
+	router.Use(impersonationHandler(a.ctx, a.dashConfig, a.clientPool))
 
 	s := router.PathPrefix(a.prefix).Subrouter()
 
 	s.Handle("/stream", streamService(a.scManager, a.dashConfig))
+	s.Handle(componentSchemaPath, componentSchemaService(a.logger)).Methods(http.MethodGet)
 
 	s.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		a.logger.Errorf("api handler not found: %s", r.URL.String())
@@ -47,6 +47,12 @@ func TestAPI_routes(t *testing.T) {
 			method:       http.MethodGet,
 			expectedCode: http.StatusNotFound,
 		},
+		{
+			path:            "/schema/components.json",
+			method:          http.MethodGet,
+			expectedCode:    http.StatusOK,
+			expectedContent: string(component.Schema()),
+		},
 	}
 
 	for _, tc := range cases {
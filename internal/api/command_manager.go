@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/vmware-tanzu/octant/internal/event"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/api"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+)
+
+// CommandLister lists commands for the command palette.
+type CommandLister interface {
+	Commands() []action.Command
+}
+
+// CommandManagerOption is an option for configuring CommandManager.
+type CommandManagerOption func(manager *CommandManager)
+
+// WithCommandPoller configures the poller.
+func WithCommandPoller(poller Poller) CommandManagerOption {
+	return func(manager *CommandManager) {
+		manager.poller = poller
+	}
+}
+
+// CommandManager sends the commands registered by Octant and plugins to the command palette.
+// Commands are dispatched with the perform action request.
+type CommandManager struct {
+	lister CommandLister
+	poller Poller
+}
+
+var _ StateManager = (*CommandManager)(nil)
+
+// NewCommandManager creates an instance of CommandManager.
+func NewCommandManager(lister CommandLister, options ...CommandManagerOption) *CommandManager {
+	cm := &CommandManager{
+		lister: lister,
+		poller: NewInterruptiblePoller("commands"),
+	}
+
+	for _, option := range options {
+		option(cm)
+	}
+
+	return cm
+}
+
+// Handlers returns nil.
+func (c *CommandManager) Handlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Start starts the manager. It sends the commands when they change.
+func (c *CommandManager) Start(ctx context.Context, state octant.State, client api.OctantClient) {
+	c.poller.Run(ctx, nil, c.runUpdate(client), event.DefaultScheduleDelay)
+}
+
+func (c *CommandManager) runUpdate(client api.OctantClient) PollerFunc {
+	var previous []byte
+
+	return func(ctx context.Context) bool {
+		logger := log.From(ctx)
+
+		commands := c.lister.Commands()
+
+		cur, err := json.Marshal(commands)
+		if err != nil {
+			logger.WithErr(err).Errorf("unable to marshal commands")
+			return false
+		}
+
+		if ctx.Err() == nil && !bytes.Equal(previous, cur) {
+			previous = cur
+			client.Send(CreateCommandsEvent(commands))
+		}
+
+		return false
+	}
+}
+
+// CreateCommandsEvent creates a commands event.
+func CreateCommandsEvent(commands []action.Command) oevent.Event {
+	if commands == nil {
+		commands = []action.Command{}
+	}
+
+	return oevent.Event{
+		Type: oevent.EventTypeCommands,
+		Data: map[string]interface{}{
+			"commands": commands,
+		},
+	}
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/api/fake"
+)
+
+type commandLister []action.Command
+
+func (c commandLister) Commands() []action.Command {
+	return c
+}
+
+func TestCommandManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	state := octantFake.NewMockState(controller)
+
+	commands := commandLister{
+		{Name: "action.octant.dev/apply", Title: "Apply YAML", Source: "overview"},
+	}
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(api.CreateCommandsEvent([]action.Command(commands)))
+
+	manager := api.NewCommandManager(commands, api.WithCommandPoller(api.NewSingleRunPoller()))
+	manager.Start(context.Background(), state, octantClient)
+}
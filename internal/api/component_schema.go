@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/vmware-tanzu/octant/internal/mime"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// componentSchemaPath is the path of the component JSON Schema below the API prefix.
+const componentSchemaPath = "/schema/components.json"
+
+// componentSchemaService serves the JSON Schema of the components, so plugin tooling can
+// check plugin output against the schema Octant validates it with.
+func componentSchemaService(logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", mime.JSONContentType)
+		if _, err := w.Write(component.Schema()); err != nil {
+			logger.WithErr(err).Errorf("write component schema")
+		}
+	}
+}
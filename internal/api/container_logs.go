@@ -16,6 +16,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/event"
 
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/vmware-tanzu/octant/internal/modules/overview/container"
@@ -152,6 +153,9 @@ func (s *podLogsStateManager) Start(ctx context.Context, _ octant.State, client
 }
 
 func (s *podLogsStateManager) streamEventsToClient(ctx context.Context, logEventType event.EventType, logCh <-chan container.LogEntry) {
+	metrics.LogStreamStarted()
+	defer metrics.LogStreamStopped()
+
 	done := false
 	for !done {
 		select {
@@ -11,7 +11,9 @@ import (
 	"errors"
 	"fmt"
 	"hash/fnv"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/vmware-tanzu/octant/internal/util/json"
@@ -24,6 +26,7 @@ import (
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
 	"github.com/vmware-tanzu/octant/internal/event"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -33,6 +36,11 @@ import (
 
 const (
 	RequestSetContentPath = "action.octant.dev/setContentPath"
+	// RequestSetTablePage requests a page of a paged table in the current content.
+	RequestSetTablePage = "action.octant.dev/setTablePage"
+	// RequestRefreshContent requests the full current content, e.g. if a client was
+	// unable to apply a content patch.
+	RequestRefreshContent = "action.octant.dev/refreshContent"
 )
 
 // ContentManagerOption is an option for configuring ContentManager.
@@ -85,6 +93,23 @@ type ContentManager struct {
 	contentGenerateFunc ContentGenerateFunc
 	poller              Poller
 	updateContentCh     chan struct{}
+
+	// tableMu guards the state of paged tables and of the client. The last generated
+	// content is kept with all rows of its paged tables, so pages can be sent without
+	// generating it again. The last sent content is kept so updates can be sent as patches.
+	tableMu      sync.Mutex
+	client       api.OctantClient
+	lastContent  *Content
+	sentContent  *sentContent
+	tableQueries map[string]component.TablePaging
+}
+
+// sentContent is the content last sent to the client.
+type sentContent struct {
+	path        string
+	queryParams map[string][]string
+	checksum    [sha256.Size]byte
+	response    *encodedResponse
 }
 
 // NewContentManager creates an instance of ContentManager.
@@ -95,6 +120,7 @@ func NewContentManager(moduleManager module.ManagerInterface, dashConfig config.
 		logger:          logger,
 		poller:          NewInterruptiblePoller("content"),
 		updateContentCh: make(chan struct{}, 1),
+		tableQueries:    make(map[string]component.TablePaging),
 	}
 	cm.contentGenerateFunc = cm.generateContent
 
@@ -120,7 +146,12 @@ func (cm *ContentManager) Start(ctx context.Context, state octant.State, s api.O
 
 	ctx, cancel := context.WithCancel(ctx)
 
+	cm.tableMu.Lock()
+	cm.client = s
+	cm.tableMu.Unlock()
+
 	updateCancel := state.OnContentPathUpdate(func(contentPath string) {
+		cm.resetTables()
 		cm.updateContentCh <- struct{}{}
 	})
 
@@ -134,8 +165,6 @@ func (cm *ContentManager) Start(ctx context.Context, state octant.State, s api.O
 }
 
 func (cm *ContentManager) runUpdate(state octant.State, s api.OctantClient) PollerFunc {
-	previousChecksum := ""
-
 	return func(ctx context.Context) bool {
 		contentPath := state.GetContentPath()
 		if contentPath == "" {
@@ -160,15 +189,12 @@ func (cm *ContentManager) runUpdate(state octant.State, s api.OctantClient) Poll
 			return false
 		}
 
-		checksum := content.Checksum()
-		if checksum == previousChecksum {
-			return false
-		}
-		previousChecksum = checksum
+		cm.setLastContent(content)
+		content = cm.pageTables(content)
 
 		if ctx.Err() == nil {
 			if content.Path == state.GetContentPath() {
-				s.Send(CreateContentEvent(content.Response, getNamespace(state, contentPath, cm), contentPath, state.GetQueryParams()))
+				cm.sendContent(ctx, state, s, content)
 			}
 
 		}
@@ -195,6 +221,10 @@ func (cm *ContentManager) generateContent(ctx context.Context, state octant.Stat
 		return content, false, nil
 	}
 	modulePath := strings.TrimPrefix(contentPath, m.Name())
+	defer func(started time.Time) {
+		metrics.ObserveContentGeneration(m.Name(), modulePath, time.Since(started))
+	}(time.Now())
+
 	options := module.ContentOptions{
 		LabelSet: FiltersToLabelSet(state.GetFilters()),
 	}
@@ -244,6 +274,14 @@ func (cm *ContentManager) Handlers() []octant.ClientRequestHandler {
 			RequestType: CheckLoading,
 			Handler:     cm.Loaded,
 		},
+		{
+			RequestType: RequestSetTablePage,
+			Handler:     cm.SetTablePage,
+		},
+		{
+			RequestType: RequestRefreshContent,
+			Handler:     cm.RefreshContent,
+		},
 	}
 }
 
@@ -287,6 +325,158 @@ func (cm *ContentManager) SetContentPath(state octant.State, payload action.Payl
 	return nil
 }
 
+// SetTablePage sets the page, sort order and filters of a paged table, and sends the
+// page from the last generated content.
+func (cm *ContentManager) SetTablePage(state octant.State, payload action.Payload) error {
+	query, err := tablePagingFromPayload(payload)
+	if err != nil {
+		return fmt.Errorf("extract table page from payload: %w", err)
+	}
+
+	cm.tableMu.Lock()
+	cm.tableQueries[query.Key] = query
+	content, client := cm.lastContent, cm.client
+	cm.tableMu.Unlock()
+
+	contentPath := state.GetContentPath()
+	if content == nil || client == nil || content.Path != contentPath {
+		return nil
+	}
+
+	cm.sendContent(cm.ctx, state, client, cm.pageTables(*content))
+	return nil
+}
+
+// RefreshContent sends the last generated content in full.
+func (cm *ContentManager) RefreshContent(state octant.State, _ action.Payload) error {
+	cm.tableMu.Lock()
+	cm.sentContent = nil
+	content, client := cm.lastContent, cm.client
+	cm.tableMu.Unlock()
+
+	if content == nil || client == nil || content.Path != state.GetContentPath() {
+		return nil
+	}
+
+	cm.sendContent(cm.ctx, state, client, cm.pageTables(*content))
+	return nil
+}
+
+func tablePagingFromPayload(payload action.Payload) (component.TablePaging, error) {
+	key, err := payload.String("key")
+	if err != nil {
+		return component.TablePaging{}, err
+	}
+
+	query := component.TablePaging{Key: key}
+
+	if page, err := payload.Int64("page"); err == nil {
+		query.Page = int(page)
+	}
+	if pageSize, err := payload.Int64("pageSize"); err == nil {
+		query.PageSize = int(pageSize)
+	}
+	if query.SortBy, err = payload.OptionalString("sortBy"); err != nil {
+		return component.TablePaging{}, err
+	}
+	if sortDescending, ok := payload["sortDescending"].(bool); ok {
+		query.SortDescending = sortDescending
+	}
+
+	if filters, ok := payload["filters"].(map[string]interface{}); ok {
+		query.Filters = make(map[string]string)
+		for column, value := range filters {
+			if s, ok := value.(string); ok && s != "" {
+				query.Filters[column] = s
+			}
+		}
+	}
+
+	return query, nil
+}
+
+func (cm *ContentManager) setLastContent(content Content) {
+	cm.tableMu.Lock()
+	defer cm.tableMu.Unlock()
+
+	cm.lastContent = &content
+}
+
+// resetTables forgets the state of paged tables when the content path changes.
+func (cm *ContentManager) resetTables() {
+	cm.tableMu.Lock()
+	defer cm.tableMu.Unlock()
+
+	cm.lastContent = nil
+	cm.sentContent = nil
+	cm.tableQueries = make(map[string]component.TablePaging)
+}
+
+// sendContent sends content to the client. Nothing is sent if the content is unchanged
+// since it was last sent. If only view components changed, patches of the changed
+// components are sent instead.
+func (cm *ContentManager) sendContent(ctx context.Context, state octant.State, client api.OctantClient, content Content) {
+	cm.tableMu.Lock()
+	defer cm.tableMu.Unlock()
+
+	previous := cm.sentContent
+	cm.sentContent = nil
+	namespace := getNamespace(state, content.Path, cm)
+	queryParams := state.GetQueryParams()
+
+	data, err := json.Marshal(content.Response)
+	if err != nil {
+		internalLog.From(ctx).With("err", err).Debugf("encode content")
+		client.Send(CreateContentEvent(content.Response, namespace, content.Path, queryParams))
+		return
+	}
+
+	checksum := sha256.Sum256(data)
+	samePage := previous != nil && previous.path == content.Path && reflect.DeepEqual(previous.queryParams, queryParams)
+	if samePage && previous.checksum == checksum {
+		cm.sentContent = previous
+		return
+	}
+
+	response, err := decodeResponse(data)
+	if err != nil {
+		internalLog.From(ctx).With("err", err).Debugf("decode content")
+		client.Send(CreateContentEvent(content.Response, namespace, content.Path, queryParams))
+		return
+	}
+	cm.sentContent = &sentContent{path: content.Path, queryParams: queryParams, checksum: checksum, response: response}
+
+	if samePage {
+		patches, ok, err := diffResponses(previous.response, response)
+		if err != nil {
+			internalLog.From(ctx).With("err", err).Debugf("diff content")
+		}
+		if ok {
+			if len(patches) > 0 {
+				client.Send(CreateContentPatchEvent(patches, namespace, content.Path, queryParams))
+			}
+			return
+		}
+	}
+
+	client.Send(CreateContentEvent(content.Response, namespace, content.Path, queryParams))
+}
+
+// pageTables returns a copy of content in which paged tables only contain their
+// current page.
+func (cm *ContentManager) pageTables(content Content) Content {
+	cm.tableMu.Lock()
+	defer cm.tableMu.Unlock()
+
+	query := func(key string) (component.TablePaging, bool) {
+		q, ok := cm.tableQueries[key]
+		return q, ok
+	}
+
+	content.Response.Components = component.PageTables(content.Response.Components, query)
+	return content
+}
+
 // Loaded is no-op once content is serving
 func (cm *ContentManager) Loaded(state octant.State, payload action.Payload) error {
 	return nil
@@ -310,6 +500,19 @@ func CreateContentEvent(contentResponse component.ContentResponse, namespace, co
 	}
 }
 
+// CreateContentPatchEvent creates a content patch event.
+func CreateContentPatchEvent(patches []ComponentPatch, namespace, contentPath string, queryParams map[string][]string) oevent.Event {
+	return oevent.Event{
+		Type: oevent.EventTypeContentPatch,
+		Data: map[string]interface{}{
+			"patches":     patches,
+			"namespace":   namespace,
+			"contentPath": contentPath,
+			"queryParams": queryParams,
+		},
+	}
+}
+
 func notFoundPage(contentPath string) component.ContentResponse {
 	title := component.TitleFromString("Not Found")
 	cr := component.NewContentResponse(title)
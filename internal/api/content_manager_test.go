@@ -7,7 +7,10 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -24,6 +27,7 @@ import (
 	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/api/fake"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -42,6 +46,8 @@ func TestContentManager_Handlers(t *testing.T) {
 		api.RequestSetContentPath,
 		action.RequestSetNamespace,
 		api.CheckLoading,
+		api.RequestSetTablePage,
+		api.RequestRefreshContent,
 	})
 }
 
@@ -197,6 +203,143 @@ func TestContentManager_SetNamespace(t *testing.T) {
 	require.NoError(t, manager.SetNamespace(state, payload))
 }
 
+func TestContentManager_SetTablePage(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	params := map[string][]string{}
+
+	dashConfig := configFake.NewMockDash(controller)
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	fakeModule := moduleFake.NewMockModule(controller)
+	state := octantFake.NewMockState(controller)
+
+	dashConfig.EXPECT().CurrentContext().Return("foo-context")
+	state.EXPECT().GetClientID().Return("foo-client")
+	state.EXPECT().GetFilters().Return(nil).AnyTimes()
+	state.EXPECT().GetNamespace().Return("foo-namespace").AnyTimes()
+	state.EXPECT().GetQueryParams().Return(params).AnyTimes()
+	state.EXPECT().GetContentPath().Return(".").AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).DoAndReturn(func(fn octant.ContentPathUpdateFunc) octant.UpdateCancelFunc {
+		fn("foo")
+		return func() {}
+	})
+
+	table := component.NewTable("Pods", "placeholder", component.NewTableCols("Name"))
+	for _, name := range []string{"c", "a", "b"} {
+		table.Add(component.TableRow{"Name": component.NewText(name)})
+	}
+	table.EnablePaging()
+	contentResponse := component.ContentResponse{Components: []component.Component{table}}
+
+	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(fakeModule, true).AnyTimes()
+	moduleManager.EXPECT().Navigation(gomock.Any(), "foo-namespace", "foo-module").Return([]navigation.Navigation{}, nil)
+	fakeModule.EXPECT().Name().Return("foo-module").AnyTimes()
+	fakeModule.EXPECT().Content(gomock.Any(), ".", gomock.Any()).Return(contentResponse, nil)
+
+	query := component.TablePaging{Key: "table-0", Page: 1, PageSize: 2, SortBy: "Name"}
+	expected := component.ContentResponse{Components: []component.Component{table.Page(query)}}
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().StopCh().Return(make(chan struct{}, 1)).AnyTimes()
+	octantClient.EXPECT().Send(api.CreateContentEvent(expected, "foo-namespace", ".", params))
+	octantClient.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	manager := api.NewContentManager(moduleManager, dashConfig, log.NopLogger(),
+		api.WithContentGeneratorPoller(api.NewSingleRunPoller()))
+	manager.Start(context.Background(), state, octantClient)
+
+	payload := action.Payload{
+		"key":      "table-0",
+		"page":     float64(1),
+		"pageSize": float64(2),
+		"sortBy":   "Name",
+	}
+	require.NoError(t, manager.SetTablePage(state, payload))
+
+	require.Error(t, manager.SetTablePage(state, action.Payload{}))
+}
+
+func TestContentManager_patches(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	fakeModule := moduleFake.NewMockModule(controller)
+	state := octantFake.NewMockState(controller)
+
+	dashConfig.EXPECT().CurrentContext().Return("foo-context")
+	state.EXPECT().GetClientID().Return("foo-client")
+	state.EXPECT().GetFilters().Return(nil).AnyTimes()
+	state.EXPECT().GetNamespace().Return("foo-namespace").AnyTimes()
+	queryParams := map[string][]string{"filter": {"app:a"}}
+	state.EXPECT().GetQueryParams().DoAndReturn(func() map[string][]string {
+		return queryParams
+	}).AnyTimes()
+	state.EXPECT().GetContentPath().Return(".").AnyTimes()
+	state.EXPECT().OnContentPathUpdate(gomock.Any()).DoAndReturn(func(fn octant.ContentPathUpdateFunc) octant.UpdateCancelFunc {
+		fn("foo")
+		return func() {}
+	})
+
+	description := strings.Repeat("description ", 20)
+	table := component.NewTable("Pods", "placeholder", component.NewTableCols("Name", "Description"))
+	for i := 0; i < 40; i++ {
+		table.Add(component.TableRow{
+			"Name":        component.NewText(fmt.Sprintf("pod-%d", i)),
+			"Description": component.NewText(description),
+		})
+	}
+	table.EnablePaging()
+	contentResponse := component.ContentResponse{Components: []component.Component{table}}
+
+	moduleManager.EXPECT().ModuleForContentPath(gomock.Any()).Return(fakeModule, true).AnyTimes()
+	moduleManager.EXPECT().Navigation(gomock.Any(), "foo-namespace", "foo-module").Return([]navigation.Navigation{}, nil)
+	fakeModule.EXPECT().Name().Return("foo-module").AnyTimes()
+	fakeModule.EXPECT().Content(gomock.Any(), ".", gomock.Any()).Return(contentResponse, nil)
+
+	var events []oevent.Event
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().StopCh().Return(make(chan struct{}, 1)).AnyTimes()
+	octantClient.EXPECT().Send(gomock.Any()).Do(func(e oevent.Event) {
+		events = append(events, e)
+	}).AnyTimes()
+
+	manager := api.NewContentManager(moduleManager, dashConfig, log.NopLogger(),
+		api.WithContentGeneratorPoller(api.NewSingleRunPoller()))
+	manager.Start(context.Background(), state, octantClient)
+
+	require.NoError(t, manager.SetTablePage(state, action.Payload{"key": "table-0", "page": float64(1)}))
+
+	// nothing is sent if the content didn't change
+	require.NoError(t, manager.SetTablePage(state, action.Payload{"key": "table-0", "page": float64(1)}))
+
+	// content is sent in full if the query params changed
+	queryParams = map[string][]string{"filter": {"app:b"}}
+	require.NoError(t, manager.SetTablePage(state, action.Payload{"key": "table-0", "page": float64(0)}))
+
+	require.NoError(t, manager.RefreshContent(state, action.Payload{}))
+
+	require.Len(t, events, 4)
+	assert.Equal(t, oevent.EventTypeContent, events[0].Type)
+	assert.Equal(t, oevent.EventTypeContentPatch, events[1].Type)
+	assert.Equal(t, oevent.EventTypeContent, events[2].Type)
+	assert.Equal(t, oevent.EventTypeContent, events[3].Type)
+
+	data, ok := events[1].Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, map[string][]string{"filter": {"app:a"}}, data["queryParams"])
+	patches, ok := data["patches"].([]api.ComponentPatch)
+	require.True(t, ok)
+	require.Len(t, patches, 1)
+	assert.Contains(t, patches[0].Operations, api.PatchOperation{
+		Op:    "replace",
+		Path:  "/config/rows/0/Name/config/value",
+		Value: json.RawMessage(`"pod-20"`),
+	})
+}
+
 func TestContentManager_SetQueryParams(t *testing.T) {
 	tests := []struct {
 		name    string
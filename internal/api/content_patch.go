@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// maxPatchRatio is the largest size of a content patch relative to the size of the
+	// content. Full content is sent if the patch is larger.
+	maxPatchRatio = 0.5
+)
+
+// PatchOperation is a JSON Patch (RFC 6902) operation.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ComponentPatch is a list of operations which update a view component of content. The
+// component is identified by its index and accessor, and paths are relative to it.
+type ComponentPatch struct {
+	Index      int              `json:"index"`
+	Accessor   string           `json:"accessor"`
+	Operations []PatchOperation `json:"operations"`
+}
+
+// encodedResponse is the generic JSON representation of a content response and its
+// encoded size. It is kept for the last sent content, so content is only encoded once.
+type encodedResponse struct {
+	value map[string]interface{}
+	size  int
+}
+
+func encodeResponse(response component.ContentResponse) (*encodedResponse, error) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("marshal content: %w", err)
+	}
+	return decodeResponse(data)
+}
+
+// decodeResponse converts the JSON of a content response to its generic representation.
+func decodeResponse(data []byte) (*encodedResponse, error) {
+	var value map[string]interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("unmarshal content: %w", err)
+	}
+	return &encodedResponse{value: value, size: len(data)}, nil
+}
+
+// components returns the generic JSON representation of the view components.
+func (r *encodedResponse) components() []interface{} {
+	components, _ := r.value["viewComponents"].([]interface{})
+	return components
+}
+
+// DiffContent returns the patches which update the view components of previous to
+// current. It returns false if the content can't be patched, i.e. if anything but the
+// view components changed, if components were added, removed or reordered, or if the
+// patches are not much smaller than the content.
+func DiffContent(previous, current component.ContentResponse) ([]ComponentPatch, bool, error) {
+	a, err := encodeResponse(previous)
+	if err != nil {
+		return nil, false, err
+	}
+	b, err := encodeResponse(current)
+	if err != nil {
+		return nil, false, err
+	}
+	return diffResponses(a, b)
+}
+
+func diffResponses(previous, current *encodedResponse) ([]ComponentPatch, bool, error) {
+	previousComponents, currentComponents := previous.components(), current.components()
+	if len(previousComponents) != len(currentComponents) {
+		return nil, false, nil
+	}
+
+	for i := range previousComponents {
+		if accessor(previousComponents[i]) != accessor(currentComponents[i]) {
+			return nil, false, nil
+		}
+	}
+
+	for _, m := range []map[string]interface{}{previous.value, current.value} {
+		for key := range m {
+			if key != "viewComponents" && !reflect.DeepEqual(previous.value[key], current.value[key]) {
+				return nil, false, nil
+			}
+		}
+	}
+
+	var patches []ComponentPatch
+	for i := range currentComponents {
+		var d differ
+		if err := d.diff("", previousComponents[i], currentComponents[i]); err != nil {
+			return nil, false, err
+		}
+
+		if len(d.operations) > 0 {
+			patches = append(patches, ComponentPatch{
+				Index:      i,
+				Accessor:   accessor(currentComponents[i]),
+				Operations: d.operations,
+			})
+		}
+	}
+
+	patchSize, err := jsonSize(patches)
+	if err != nil {
+		return nil, false, err
+	}
+	if float64(patchSize) > float64(current.size)*maxPatchRatio {
+		return nil, false, nil
+	}
+
+	return patches, true, nil
+}
+
+// differ creates the operations which convert one JSON value to another.
+type differ struct {
+	operations []PatchOperation
+}
+
+func (d *differ) diff(path string, a, b interface{}) error {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		if bv, ok := b.(map[string]interface{}); ok {
+			return d.diffObjects(path, av, bv)
+		}
+	case []interface{}:
+		if bv, ok := b.([]interface{}); ok {
+			return d.diffArrays(path, av, bv)
+		}
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return d.add("replace", path, b)
+}
+
+func (d *differ) diffObjects(path string, a, b map[string]interface{}) error {
+	for _, key := range sortedKeys(a) {
+		if _, ok := b[key]; !ok {
+			d.operations = append(d.operations, PatchOperation{Op: "remove", Path: path + "/" + escapePointer(key)})
+		}
+	}
+
+	for _, key := range sortedKeys(b) {
+		p := path + "/" + escapePointer(key)
+		previous, ok := a[key]
+		if !ok {
+			if err := d.add("add", p, b[key]); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := d.diff(p, previous, b[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffArrays compares items at the same index. Items are appended or removed at the
+// end, so inserting an item in the middle of an array replaces the items after it.
+func (d *differ) diffArrays(path string, a, b []interface{}) error {
+	common := len(a)
+	if len(b) < common {
+		common = len(b)
+	}
+
+	for i := 0; i < common; i++ {
+		if err := d.diff(path+"/"+strconv.Itoa(i), a[i], b[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := len(a) - 1; i >= common; i-- {
+		d.operations = append(d.operations, PatchOperation{Op: "remove", Path: path + "/" + strconv.Itoa(i)})
+	}
+
+	for i := common; i < len(b); i++ {
+		if err := d.add("add", path+"/"+strconv.Itoa(i), b[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *differ) add(op, path string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshal value of %s: %w", path, err)
+	}
+
+	d.operations = append(d.operations, PatchOperation{Op: op, Path: path, Value: data})
+	return nil
+}
+
+// accessor returns the accessor of the generic JSON representation of a view component.
+func accessor(v interface{}) string {
+	c, _ := v.(map[string]interface{})
+	metadata, _ := c["metadata"].(map[string]interface{})
+	value, _ := metadata["accessor"].(string)
+	return value
+}
+
+func jsonSize(v interface{}) (int, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// escapePointer escapes a key for use in a JSON pointer.
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
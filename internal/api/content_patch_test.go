@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ojson "github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestDiffContent(t *testing.T) {
+	newTable := func(names ...string) *component.Table {
+		table := component.NewTable("Pods", "placeholder", component.NewTableCols("Name", "Status"))
+		for _, name := range names {
+			table.Add(component.TableRow{"Name": component.NewText(name), "Status": component.NewText("Running")})
+		}
+		table.SetAccessor("pods")
+		return table
+	}
+
+	manyNames := make([]string, 20)
+	for i := range manyNames {
+		manyNames[i] = fmt.Sprintf("pod-%d", i)
+	}
+	updatedNames := append([]string{}, manyNames...)
+	updatedNames[3] = "renamed"
+
+	tests := []struct {
+		name     string
+		previous component.ContentResponse
+		current  component.ContentResponse
+		expected []ComponentPatch
+		isPatch  bool
+	}{
+		{
+			name:     "unchanged",
+			previous: component.ContentResponse{Components: []component.Component{newTable(manyNames...)}},
+			current:  component.ContentResponse{Components: []component.Component{newTable(manyNames...)}},
+			isPatch:  true,
+		},
+		{
+			name:     "changed cell",
+			previous: component.ContentResponse{Components: []component.Component{newTable(manyNames...)}},
+			current:  component.ContentResponse{Components: []component.Component{newTable(updatedNames...)}},
+			expected: []ComponentPatch{
+				{
+					Index:    0,
+					Accessor: "pods",
+					Operations: []PatchOperation{
+						{Op: "replace", Path: "/config/rows/3/Name/config/value", Value: json.RawMessage(`"renamed"`)},
+					},
+				},
+			},
+			isPatch: true,
+		},
+		{
+			name:     "added and removed rows",
+			previous: component.ContentResponse{Components: []component.Component{newTable(manyNames...)}},
+			current:  component.ContentResponse{Components: []component.Component{newTable(manyNames[:18]...)}},
+			expected: []ComponentPatch{
+				{
+					Index:    0,
+					Accessor: "pods",
+					Operations: []PatchOperation{
+						{Op: "remove", Path: "/config/rows/19"},
+						{Op: "remove", Path: "/config/rows/18"},
+					},
+				},
+			},
+			isPatch: true,
+		},
+		{
+			name:     "changed title",
+			previous: component.ContentResponse{Title: component.TitleFromString("a"), Components: []component.Component{newTable("a")}},
+			current:  component.ContentResponse{Title: component.TitleFromString("b"), Components: []component.Component{newTable("a")}},
+		},
+		{
+			name:     "added component",
+			previous: component.ContentResponse{Components: []component.Component{newTable("a")}},
+			current:  component.ContentResponse{Components: []component.Component{newTable("a"), component.NewText("text")}},
+		},
+		{
+			name:     "patch too large",
+			previous: component.ContentResponse{Components: []component.Component{newTable("a")}},
+			current:  component.ContentResponse{Components: []component.Component{newTable(manyNames...)}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patches, isPatch, err := DiffContent(test.previous, test.current)
+			require.NoError(t, err)
+			require.Equal(t, test.isPatch, isPatch)
+			assert.Equal(t, test.expected, patches)
+		})
+	}
+}
+
+func TestPatchOperation_JSON(t *testing.T) {
+	operations := []PatchOperation{
+		{Op: "replace", Path: "/config/value", Value: json.RawMessage(`false`)},
+		{Op: "remove", Path: "/config/rows/1"},
+	}
+
+	data, err := ojson.Marshal(operations)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"op":"replace","path":"/config/value","value":false},{"op":"remove","path":"/config/rows/1"}]`, string(data))
+}
+
+func Test_escapePointer(t *testing.T) {
+	assert.Equal(t, "a~1b~0c", escapePointer("a/b~c"))
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/event"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -78,12 +79,23 @@ func (c *ContextManager) Handlers() []octant.ClientRequestHandler {
 	}
 }
 
-// SetContext sets the current context.
+// SetContext sets the current context. The current context is shared by all clients, so it
+// can't be changed by authenticated users when Octant serves several users.
 func (c *ContextManager) SetContext(state octant.State, payload action.Payload) error {
 	requestedContext, err := payload.String("requestedContext")
 	if err != nil {
 		return errors.Wrap(err, "extract requested context from payload")
 	}
+
+	if c.ctx != nil {
+		if _, ok := auth.UserFrom(c.ctx); ok {
+			state.SendAlert(action.CreateAlert(
+				action.AlertTypeWarning,
+				"The context can't be changed while Octant serves several users",
+				action.DefaultAlertExpiration))
+			return nil
+		}
+	}
 	state.SetContext(requestedContext)
 	state.Dispatch(c.ctx, action.RequestSetContext, action.Payload{"contextName": requestedContext})
 	return nil
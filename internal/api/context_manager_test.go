@@ -15,6 +15,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/octant"
@@ -74,3 +75,28 @@ func TestContext_SetContext(t *testing.T) {
 
 	manager.SetContext(state, action.Payload{"requestedContext": "foo"})
 }
+
+func TestContext_SetContext_authenticated_user(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	state := octantFake.NewMockState(controller)
+	octantClient := fake.NewMockOctantClient(controller)
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+
+	generatorFunc := func(ctx context.Context, state octant.State) ([]event.Event, error) {
+		return nil, nil
+	}
+	manager := api.NewContextManager(dashConfig,
+		api.WithContextGenerator(generatorFunc),
+		api.WithContextGeneratorPoll(api.NewSingleRunPoller()))
+
+	ctx := auth.WithUser(context.Background(), auth.User{Name: "jane"})
+	manager.Start(ctx, state, octantClient)
+
+	// The context isn't changed, and the user is told why.
+	state.EXPECT().SendAlert(gomock.Any())
+
+	manager.SetContext(state, action.Payload{"requestedContext": "foo"})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/event"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/api"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// credentialsAlertExpiration is how long the credentials expired alert is shown. It is sent
+// again while the credentials are still expired.
+const credentialsAlertExpiration = time.Minute
+
+// CredentialsReporter reports the status of the credentials of the current context.
+type CredentialsReporter interface {
+	CurrentContext() string
+	CredentialStatus() kubeconfig.CredentialStatus
+}
+
+// CredentialsManagerOption is an option for configuring CredentialsManager.
+type CredentialsManagerOption func(manager *CredentialsManager)
+
+// WithCredentialsPoller configures the poller.
+func WithCredentialsPoller(poller Poller) CredentialsManagerOption {
+	return func(manager *CredentialsManager) {
+		manager.poller = poller
+	}
+}
+
+// CredentialsManager alerts the frontend when the API server rejects the credentials of the
+// current context. The alert has a button for refreshing the credentials.
+type CredentialsManager struct {
+	reporter CredentialsReporter
+	poller   Poller
+}
+
+var _ StateManager = (*CredentialsManager)(nil)
+
+// NewCredentialsManager creates an instance of CredentialsManager.
+func NewCredentialsManager(reporter CredentialsReporter, options ...CredentialsManagerOption) *CredentialsManager {
+	cm := &CredentialsManager{
+		reporter: reporter,
+		poller:   NewInterruptiblePoller("credentials"),
+	}
+
+	for _, option := range options {
+		option(cm)
+	}
+
+	return cm
+}
+
+// Handlers returns nil.
+func (c *CredentialsManager) Handlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Start starts the manager.
+func (c *CredentialsManager) Start(ctx context.Context, state octant.State, client api.OctantClient) {
+	c.poller.Run(ctx, nil, c.runUpdate(client), event.DefaultScheduleDelay)
+}
+
+func (c *CredentialsManager) runUpdate(client api.OctantClient) PollerFunc {
+	var sentAt time.Time
+
+	return func(ctx context.Context) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		contextName := c.reporter.CurrentContext()
+		expired := c.reporter.CredentialStatus() == kubeconfig.CredentialsExpired
+
+		switch {
+		case expired && time.Since(sentAt) >= credentialsAlertExpiration:
+			sentAt = time.Now()
+			client.Send(CreateCredentialsExpiredEvent(contextName, sentAt.Add(credentialsAlertExpiration)))
+		case !expired && !sentAt.IsZero():
+			sentAt = time.Time{}
+			alert := action.CreateAlert(action.AlertTypeSuccess,
+				fmt.Sprintf("Credentials for context %s were refreshed", contextName),
+				action.DefaultAlertExpiration)
+			client.Send(oevent.CreateEvent(oevent.EventTypeAlert, action.Payload{
+				"type":       alert.Type,
+				"message":    alert.Message,
+				"expiration": alert.Expiration,
+			}))
+		}
+
+		return false
+	}
+}
+
+// CreateCredentialsExpiredEvent creates an alert event telling the user the credentials of
+// contextName have expired. The alert has a button for refreshing the credentials.
+func CreateCredentialsExpiredEvent(contextName string, expiration time.Time) oevent.Event {
+	buttonGroup := component.NewButtonGroup()
+	buttonGroup.AddButton(component.NewButton("Retry", action.Payload{
+		"action": octant.ActionRefreshCredentials,
+	}))
+
+	return oevent.CreateEvent(oevent.EventTypeAlert, action.Payload{
+		"type": action.AlertTypeError,
+		"message": fmt.Sprintf(
+			"Credentials for context %s have expired. Log in again, then retry.", contextName),
+		"expiration":  &expiration,
+		"buttonGroup": buttonGroup,
+	})
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/api/fake"
+	"github.com/vmware-tanzu/octant/pkg/event"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+type credentialsReporter struct {
+	status kubeconfig.CredentialStatus
+}
+
+func (r credentialsReporter) CurrentContext() string {
+	return "context"
+}
+
+func (r credentialsReporter) CredentialStatus() kubeconfig.CredentialStatus {
+	return r.status
+}
+
+func TestCredentialsManager_Start(t *testing.T) {
+	tests := []struct {
+		name   string
+		status kubeconfig.CredentialStatus
+		sent   bool
+	}{
+		{name: "valid", status: kubeconfig.CredentialsValid},
+		{name: "rejected", status: kubeconfig.CredentialsRejected},
+		{name: "expired", status: kubeconfig.CredentialsExpired, sent: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			state := octantFake.NewMockState(controller)
+
+			octantClient := fake.NewMockOctantClient(controller)
+			if test.sent {
+				octantClient.EXPECT().Send(gomock.Any()).Do(func(ev event.Event) {
+					assert.Equal(t, event.EventTypeAlert, ev.Type)
+				})
+			}
+
+			manager := api.NewCredentialsManager(credentialsReporter{status: test.status},
+				api.WithCredentialsPoller(api.NewSingleRunPoller()))
+			manager.Start(context.Background(), state, octantClient)
+		})
+	}
+}
+
+func TestCreateCredentialsExpiredEvent(t *testing.T) {
+	ev := api.CreateCredentialsExpiredEvent("context", time.Unix(0, 0))
+
+	data, ok := ev.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, action.AlertTypeError, data["type"])
+	assert.Equal(t, "Credentials for context context have expired. Log in again, then retry.", data["message"])
+
+	buttonGroup, ok := data["buttonGroup"].(*component.ButtonGroup)
+	assert.True(t, ok)
+	assert.Equal(t, "action.octant.dev/refreshCredentials", buttonGroup.Config.Buttons[0].Config.Payload["action"])
+}
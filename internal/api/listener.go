@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+const (
+	// ListenerSocketModeKey is the file mode of a unix socket listener.
+	ListenerSocketModeKey = "listener-socket-mode"
+	// TLSCertFileKey is the certificate file the listener serves.
+	TLSCertFileKey = "tls-cert-file"
+	// TLSKeyFileKey is the private key file for TLSCertFileKey.
+	TLSKeyFileKey = "tls-key-file"
+	// TLSSelfSignedKey enables TLS with a generated self-signed certificate.
+	TLSSelfSignedKey = "tls-self-signed"
+	// TLSClientCAFileKey is the CA bundle used to verify client certificates.
+	TLSClientCAFileKey = "tls-client-ca-file"
+
+	unixListenerPrefix       = "unix:"
+	defaultSocketMode        = 0600
+	selfSignedCertValidity   = 365 * 24 * time.Hour
+	selfSignedCertRenewAfter = 30 * 24 * time.Hour
+)
+
+// tlsListener is a listener which serves TLS.
+type tlsListener struct {
+	net.Listener
+}
+
+// ListenerURL returns the URL the dashboard is reachable at for listener.
+func ListenerURL(listener net.Listener) string {
+	addr := listener.Addr()
+	if addr.Network() == "unix" {
+		return unixListenerPrefix + "//" + addr.String()
+	}
+	if _, ok := listener.(*tlsListener); ok {
+		return fmt.Sprintf("https://%s", addr)
+	}
+	return fmt.Sprintf("http://%s", addr)
+}
+
+// listenerNetwork splits a listener address into a network and an address.
+// Addresses prefixed with unix: are unix socket paths.
+func listenerNetwork(listenerAddr string) (string, string) {
+	if strings.HasPrefix(listenerAddr, unixListenerPrefix) {
+		return "unix", strings.TrimPrefix(strings.TrimPrefix(listenerAddr, unixListenerPrefix), "//")
+	}
+	return "tcp", listenerAddr
+}
+
+// listen listens on address if nothing else is serving it.
+func listen(network, address string) (net.Listener, error) {
+	conn, err := net.DialTimeout(network, address, time.Millisecond*500)
+	if err == nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%s %s: dial: already in use", network, address)
+	}
+
+	if network != "unix" {
+		return net.Listen(network, address)
+	}
+
+	// Nothing is serving the socket, so it was left behind by an earlier run.
+	if fi, err := os.Stat(address); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(address); err != nil {
+			return nil, fmt.Errorf("remove stale socket %s: %w", address, err)
+		}
+	}
+
+	mode, err := socketMode()
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(address, mode); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("set socket mode: %w", err)
+	}
+	return listener, nil
+}
+
+func socketMode() (os.FileMode, error) {
+	s := viper.GetString(ListenerSocketModeKey)
+	if s == "" {
+		return defaultSocketMode, nil
+	}
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf("invalid socket mode %q: must be octal permissions like 0600", s)
+	}
+	return os.FileMode(mode), nil
+}
+
+// listenerTLSConfig returns the TLS configuration for the listener, or nil if TLS is not configured.
+func listenerTLSConfig(host string) (*tls.Config, error) {
+	certFile := viper.GetString(TLSCertFileKey)
+	keyFile := viper.GetString(TLSKeyFileKey)
+	selfSigned := viper.GetBool(TLSSelfSignedKey)
+	clientCAFile := viper.GetString(TLSClientCAFileKey)
+
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("--%s and --%s must be set together", TLSCertFileKey, TLSKeyFileKey)
+	}
+	if certFile != "" && selfSigned {
+		return nil, fmt.Errorf("--%s can't be used with --%s", TLSSelfSignedKey, TLSCertFileKey)
+	}
+	if certFile == "" && !selfSigned {
+		if clientCAFile != "" {
+			return nil, fmt.Errorf("--%s requires --%s or --%s", TLSClientCAFileKey, TLSCertFileKey, TLSSelfSignedKey)
+		}
+		return nil, nil
+	}
+
+	if selfSigned {
+		dir := plugin.ConfigDir(plugin.DefaultConfig)
+		if dir == "" {
+			return nil, fmt.Errorf("unable to store self-signed certificate: home directory is unknown")
+		}
+		dir = filepath.Join(dir, "tls")
+		certFile = filepath.Join(dir, "octant.crt")
+		keyFile = filepath.Join(dir, "octant.key")
+		if err := ensureSelfSignedCert(certFile, keyFile, selfSignedHosts(host), time.Now()); err != nil {
+			return nil, fmt.Errorf("create self-signed certificate: %w", err)
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// Websockets are served over HTTP/1.1.
+		NextProtos: []string{"http/1.1"},
+	}
+
+	if clientCAFile != "" {
+		data, err := ioutil.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in client CA file %s", clientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// selfSignedHosts returns the host names a self-signed certificate is valid for.
+func selfSignedHosts(host string) []string {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if customHosts := viper.GetString(AcceptedHostsKey); customHosts != "" {
+		hosts = append(hosts, strings.Split(customHosts, ",")...)
+	}
+	if ip := net.ParseIP(host); host != "" && (ip == nil || !ip.IsUnspecified()) {
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
+// ensureSelfSignedCert writes a self-signed certificate for hosts unless a usable one already exists.
+func ensureSelfSignedCert(certFile, keyFile string, hosts []string, now time.Time) error {
+	if cert, err := loadCertificate(certFile); err == nil && cert.NotAfter.Sub(now) > selfSignedCertRenewAfter {
+		covered := true
+		for _, host := range hosts {
+			if cert.VerifyHostname(host) != nil {
+				covered = false
+				break
+			}
+		}
+		if _, err := os.Stat(keyFile); covered && err == nil {
+			return nil
+		}
+	}
+
+	certPEM, keyPEM, err := generateSelfSignedCert(hosts, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(certFile), 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(certFile, certPEM, 0644)
+}
+
+func loadCertificate(certFile string) (*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no certificate found in %s", certFile)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func generateSelfSignedCert(hosts []string, now time.Time) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Octant"}, CommonName: "octant"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedCertValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
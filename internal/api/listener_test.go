@@ -0,0 +1,258 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listenerNetwork(t *testing.T) {
+	tests := []struct {
+		addr            string
+		expectedNetwork string
+		expectedAddress string
+	}{
+		{addr: "127.0.0.1:7777", expectedNetwork: "tcp", expectedAddress: "127.0.0.1:7777"},
+		{addr: "unix:///tmp/octant.sock", expectedNetwork: "unix", expectedAddress: "/tmp/octant.sock"},
+		{addr: "unix:octant.sock", expectedNetwork: "unix", expectedAddress: "octant.sock"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.addr, func(t *testing.T) {
+			network, address := listenerNetwork(test.addr)
+			assert.Equal(t, test.expectedNetwork, network)
+			assert.Equal(t, test.expectedAddress, address)
+		})
+	}
+}
+
+func TestListener_unixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-listener")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "octant.sock")
+	setViper(t, map[string]interface{}{
+		ListenerAddrKey:       "unix://" + socket,
+		ListenerSocketModeKey: "0660",
+	})
+
+	listener, err := Listener()
+	require.NoError(t, err)
+
+	fi, err := os.Stat(socket)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0660), fi.Mode().Perm())
+	assert.Equal(t, "unix://"+socket, ListenerURL(listener))
+	assert.Equal(t, []string{"localhost", "127.0.0.1"}, AcceptedHosts())
+
+	_, err = Listener()
+	require.Error(t, err, "socket is in use")
+
+	require.NoError(t, listener.Close())
+}
+
+func TestListener_staleUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-listener")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "octant.sock")
+	stale, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	setViper(t, map[string]interface{}{ListenerAddrKey: "unix://" + socket})
+
+	listener, err := Listener()
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+}
+
+func TestListener_selfSignedTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-listener")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	setViper(t, map[string]interface{}{
+		ListenerAddrKey:   "127.0.0.1:0",
+		TLSSelfSignedKey:  true,
+		"xdg-config-home": dir,
+	})
+
+	listener, err := Listener()
+	require.NoError(t, err)
+	defer listener.Close()
+
+	certFile := filepath.Join(dir, "octant", "tls", "octant.crt")
+	cert, err := loadCertificate(certFile)
+	require.NoError(t, err)
+	require.NoError(t, cert.VerifyHostname("localhost"))
+
+	assert.Equal(t, "https://"+listener.Addr().String(), ListenerURL(listener))
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	client := serveTLS(t, listener, &tls.Config{RootCAs: pool})
+
+	res, err := client.Get(ListenerURL(listener))
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestListener_clientCertificates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-listener")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	serverCert, serverKey := writeCert(t, dir, "server", []string{"127.0.0.1"}, now)
+	clientCert, clientKey := writeCert(t, dir, "client", []string{"client"}, now)
+
+	setViper(t, map[string]interface{}{
+		ListenerAddrKey:    "127.0.0.1:0",
+		TLSCertFileKey:     serverCert,
+		TLSKeyFileKey:      serverKey,
+		TLSClientCAFileKey: clientCert,
+	})
+
+	listener, err := Listener()
+	require.NoError(t, err)
+	defer listener.Close()
+
+	serverCA, err := loadCertificate(serverCert)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(serverCA)
+
+	withoutCert := serveTLS(t, listener, &tls.Config{RootCAs: pool})
+	_, err = withoutCert.Get(ListenerURL(listener))
+	require.Error(t, err)
+
+	keyPair, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	require.NoError(t, err)
+	withCert := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: pool, Certificates: []tls.Certificate{keyPair}},
+	}}
+	res, err := withCert.Get(ListenerURL(listener))
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func Test_listenerTLSConfig_invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+	}{
+		{name: "cert without key", config: map[string]interface{}{TLSCertFileKey: "cert.pem"}},
+		{name: "cert and self-signed", config: map[string]interface{}{TLSCertFileKey: "cert.pem", TLSKeyFileKey: "key.pem", TLSSelfSignedKey: true}},
+		{name: "client CA without TLS", config: map[string]interface{}{TLSClientCAFileKey: "ca.pem"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setViper(t, test.config)
+			_, err := listenerTLSConfig("127.0.0.1")
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_ensureSelfSignedCert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-listener")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	certFile := filepath.Join(dir, "octant.crt")
+	keyFile := filepath.Join(dir, "octant.key")
+	now := time.Now()
+
+	require.NoError(t, ensureSelfSignedCert(certFile, keyFile, []string{"localhost"}, now))
+	first, err := loadCertificate(certFile)
+	require.NoError(t, err)
+
+	fi, err := os.Stat(keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	require.NoError(t, ensureSelfSignedCert(certFile, keyFile, []string{"localhost"}, now))
+	reused, err := loadCertificate(certFile)
+	require.NoError(t, err)
+	assert.Equal(t, first.SerialNumber, reused.SerialNumber)
+
+	require.NoError(t, ensureSelfSignedCert(certFile, keyFile, []string{"localhost", "octant.example.com"}, now))
+	newHost, err := loadCertificate(certFile)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.SerialNumber, newHost.SerialNumber)
+	assert.NoError(t, newHost.VerifyHostname("octant.example.com"))
+
+	later := now.Add(selfSignedCertValidity - selfSignedCertRenewAfter/2)
+	require.NoError(t, ensureSelfSignedCert(certFile, keyFile, []string{"localhost", "octant.example.com"}, later))
+	renewed, err := loadCertificate(certFile)
+	require.NoError(t, err)
+	assert.NotEqual(t, newHost.SerialNumber, renewed.SerialNumber)
+}
+
+func setViper(t *testing.T, values map[string]interface{}) {
+	for k, v := range values {
+		viper.Set(k, v)
+	}
+	t.Cleanup(func() {
+		for k := range values {
+			viper.Set(k, nil)
+		}
+	})
+}
+
+func serveTLS(t *testing.T, listener net.Listener, config *tls.Config) *http.Client {
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+}
+
+func writeCert(t *testing.T, dir, name string, hosts []string, now time.Time) (string, string) {
+	certPEM, keyPEM, err := generateSelfSignedCert(hosts, now)
+	require.NoError(t, err)
+
+	// Client certificates need the client auth usage.
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	template, err := x509.ParseCertificate(keyPair.Certificate[0])
+	require.NoError(t, err)
+	template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+	der, err := x509.CreateCertificate(rand.Reader, template, template, template.PublicKey, keyPair.PrivateKey)
+	require.NoError(t, err)
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+	return certFile, keyFile
+}
@@ -17,8 +17,10 @@ import (
 
 	"github.com/gorilla/mux"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/log"
 	dashstrings "github.com/vmware-tanzu/octant/internal/util/strings"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 )
 
 var defaultPorts = map[string]string{"http": "80", "https": "443"}
@@ -128,3 +130,32 @@ func rebindHandler(ctx context.Context, acceptedHosts []string) mux.MiddlewareFu
 		})
 	}
 }
+
+// ClusterClientProvider provides the cluster client for the current context.
+type ClusterClientProvider interface {
+	ClusterClient() cluster.ClientInterface
+}
+
+// impersonationHandler is a middleware that stores a cluster client impersonating the authenticated
+// user in the request's context. Requests without an authenticated user are not changed.
+func impersonationHandler(ctx context.Context, provider ClusterClientProvider, pool *auth.ClientPool) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, ok := auth.UserFrom(r.Context())
+			if !ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			client, err := pool.ClientFor(ctx, provider.ClusterClient(), user)
+			if err != nil {
+				logger := log.From(ctx)
+				logger.WithErr(err).With("user", user.Name).Errorf("create impersonating cluster client")
+				http.Error(w, "unable to create cluster client", http.StatusInternalServerError)
+				return
+			}
+
+			h.ServeHTTP(w, r.WithContext(cluster.WithClient(r.Context(), client)))
+		})
+	}
+}
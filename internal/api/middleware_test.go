@@ -13,8 +13,14 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 )
 
 func Test_rebindHandler(t *testing.T) {
@@ -177,3 +183,47 @@ func Test_checkSameOrigin(t *testing.T) {
 		})
 	}
 }
+
+type impersonatingClient struct {
+	*clusterFake.MockClientInterface
+	impersonated cluster.ClientInterface
+}
+
+func (c *impersonatingClient) Impersonate(_ context.Context, _ rest.ImpersonationConfig) (cluster.ClientInterface, error) {
+	return c.impersonated, nil
+}
+
+type staticClusterClient struct {
+	client cluster.ClientInterface
+}
+
+func (s staticClusterClient) ClusterClient() cluster.ClientInterface {
+	return s.client
+}
+
+func Test_impersonationHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	userClient := clusterFake.NewMockClientInterface(controller)
+	base := &impersonatingClient{
+		MockClientInterface: clusterFake.NewMockClientInterface(controller),
+		impersonated:        userClient,
+	}
+
+	var got cluster.ClientInterface
+	handler := impersonationHandler(context.Background(), staticClusterClient{client: base}, auth.NewClientPool())(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, _ = cluster.ClientFromContext(r.Context())
+		}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Nil(t, got)
+
+	req = req.WithContext(auth.WithUser(req.Context(), auth.User{Name: "alice"}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.True(t, got == userClient)
+}
@@ -120,7 +120,7 @@ func NamespacesGenerator(ctx context.Context, config NamespaceManagerConfig) ([]
 		return nil, errors.New("namespaces manager config is nil")
 	}
 
-	clusterClient := config.ClusterClient()
+	clusterClient := cluster.ClientOrDefault(ctx, config.ClusterClient())
 	namespaceClient, err := clusterClient.NamespaceClient()
 	if err != nil {
 		return nil, errors.Wrap(err, "retrieve namespaces client")
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/api"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+)
+
+// NotificationManager sends notifications about watched objects to the frontend, which
+// shows them as browser notifications.
+type NotificationManager struct {
+	notifications *notification.Manager
+}
+
+var _ StateManager = (*NotificationManager)(nil)
+
+// NewNotificationManager creates an instance of NotificationManager.
+func NewNotificationManager(notifications *notification.Manager) *NotificationManager {
+	return &NotificationManager{
+		notifications: notifications,
+	}
+}
+
+// Handlers returns nil.
+func (n *NotificationManager) Handlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Start sends notifications to the client until the context is canceled.
+func (n *NotificationManager) Start(ctx context.Context, state octant.State, client api.OctantClient) {
+	ch, cancel := n.notifications.Subscribe(ctx)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-ch:
+			if !ok {
+				return
+			}
+			client.Send(CreateNotificationEvent(message))
+		}
+	}
+}
+
+// CreateNotificationEvent creates a notification event.
+func CreateNotificationEvent(n notification.Notification) oevent.Event {
+	return oevent.Event{
+		Type: oevent.EventTypeNotification,
+		Data: n,
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/pkg/api/fake"
+)
+
+func TestNotificationManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state := octantFake.NewMockState(controller)
+
+	n := notification.Notification{
+		Time:    time.Unix(1547211430, 0),
+		Level:   notification.LevelSuccess,
+		Title:   "Job migrate completed",
+		Context: "dev",
+	}
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().
+		Send(api.CreateNotificationEvent(n)).
+		Do(func(interface{}) { cancel() }).
+		MinTimes(1)
+
+	notifications := notification.NewManager()
+	manager := api.NewNotificationManager(notifications)
+
+	done := make(chan struct{})
+	go func() {
+		manager.Start(ctx, state, octantClient)
+		close(done)
+	}()
+
+	// publish until the manager has subscribed and sent the notification
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			notifications.Publish(n)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+
+	"github.com/vmware-tanzu/octant/internal/event"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/api"
+	oevent "github.com/vmware-tanzu/octant/pkg/event"
+)
+
+// ReadOnlyReporter reports whether mutating actions are refused.
+type ReadOnlyReporter interface {
+	ReadOnly() bool
+	MutatingActions() []string
+}
+
+// ReadOnlyManagerOption is an option for configuring ReadOnlyManager.
+type ReadOnlyManagerOption func(manager *ReadOnlyManager)
+
+// WithReadOnlyPoller configures the poller.
+func WithReadOnlyPoller(poller Poller) ReadOnlyManagerOption {
+	return func(manager *ReadOnlyManager) {
+		manager.poller = poller
+	}
+}
+
+// ReadOnlyManager tells the frontend when Octant is read-only, so it can hide the controls
+// for mutating actions. Read-only mode can change with the current context.
+type ReadOnlyManager struct {
+	reporter ReadOnlyReporter
+	poller   Poller
+}
+
+var _ StateManager = (*ReadOnlyManager)(nil)
+
+// NewReadOnlyManager creates an instance of ReadOnlyManager.
+func NewReadOnlyManager(reporter ReadOnlyReporter, options ...ReadOnlyManagerOption) *ReadOnlyManager {
+	rm := &ReadOnlyManager{
+		reporter: reporter,
+		poller:   NewInterruptiblePoller("readOnly"),
+	}
+
+	for _, option := range options {
+		option(rm)
+	}
+
+	return rm
+}
+
+// Handlers returns nil.
+func (r *ReadOnlyManager) Handlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Start starts the manager. It sends the read-only mode when it changes.
+func (r *ReadOnlyManager) Start(ctx context.Context, state octant.State, client api.OctantClient) {
+	r.poller.Run(ctx, nil, r.runUpdate(client), event.DefaultScheduleDelay)
+}
+
+func (r *ReadOnlyManager) runUpdate(client api.OctantClient) PollerFunc {
+	sent := false
+	var previous bool
+
+	return func(ctx context.Context) bool {
+		readOnly := r.reporter.ReadOnly()
+
+		if ctx.Err() == nil && (!sent || readOnly != previous) {
+			sent = true
+			previous = readOnly
+			client.Send(CreateReadOnlyEvent(readOnly, r.reporter.MutatingActions()))
+		}
+
+		return false
+	}
+}
+
+// CreateReadOnlyEvent creates a read-only mode event.
+func CreateReadOnlyEvent(readOnly bool, mutatingActions []string) oevent.Event {
+	if mutatingActions == nil {
+		mutatingActions = []string{}
+	}
+
+	return oevent.Event{
+		Type: oevent.EventTypeReadOnly,
+		Data: map[string]interface{}{
+			"readOnly":        readOnly,
+			"mutatingActions": mutatingActions,
+		},
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/vmware-tanzu/octant/internal/api"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/pkg/api/fake"
+)
+
+type readOnlyReporter struct {
+	readOnly bool
+	actions  []string
+}
+
+func (r readOnlyReporter) ReadOnly() bool {
+	return r.readOnly
+}
+
+func (r readOnlyReporter) MutatingActions() []string {
+	return r.actions
+}
+
+func TestReadOnlyManager_Start(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	state := octantFake.NewMockState(controller)
+
+	reporter := readOnlyReporter{readOnly: true, actions: []string{"action.octant.dev/apply"}}
+
+	octantClient := fake.NewMockOctantClient(controller)
+	octantClient.EXPECT().Send(api.CreateReadOnlyEvent(true, []string{"action.octant.dev/apply"}))
+
+	manager := api.NewReadOnlyManager(reporter, api.WithReadOnlyPoller(api.NewSingleRunPoller()))
+	manager.Start(context.Background(), state, octantClient)
+}
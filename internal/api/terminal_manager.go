@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -20,11 +21,13 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/vmware-tanzu/octant/internal/audit"
 	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/terminal"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
@@ -34,6 +37,9 @@ const (
 	RequestTerminalCommand = "action.octant.dev/sendTerminalCommand"
 	RequestTerminalResize  = "action.octant.dev/sendTerminalResize"
 	RequestActiveTerminal  = "action.octant.dev/setActiveTerminal"
+
+	auditTerminalOpen    = "terminal/open"
+	auditTerminalCommand = "terminal/command"
 )
 
 type terminalStateManager struct {
@@ -45,6 +51,8 @@ type terminalStateManager struct {
 	chanInstance          chan terminal.Instance
 	terminalSubscriptions sync.Map
 	existingInstance      bool
+	// input is the terminal input since the last line was entered.
+	input []byte
 }
 
 type terminalOutput struct {
@@ -82,7 +90,16 @@ func (s *terminalStateManager) Handlers() []octant.ClientRequestHandler {
 	}
 }
 
-func (s *terminalStateManager) SetActiveTerminal(state octant.State, payload action.Payload) error {
+func (s *terminalStateManager) SetActiveTerminal(state octant.State, payload action.Payload) (err error) {
+	defer func() {
+		containerName, _ := payload.OptionalString("containerName")
+		s.audit(auditTerminalOpen, audit.KeyFromPayload(podPayload(payload)), containerName, payload, err)
+	}()
+
+	if err := s.checkWritable(state); err != nil {
+		return err
+	}
+
 	namespace, err := payload.String("namespace")
 	if err != nil {
 		return fmt.Errorf("getting namespace from payload: %w", err)
@@ -160,7 +177,7 @@ func (s *terminalStateManager) startStream(pod *corev1.Pod, key store.Key, conta
 	}
 
 	for _, command := range commands {
-		validInstance, err := terminal.NewTerminalInstance(ctx, s.config.ClusterClient(), logger, key, container, command, s.chanInstance)
+		validInstance, err := terminal.NewTerminalInstance(ctx, cluster.ClientOrDefault(ctx, s.config.ClusterClient()), logger, key, container, command, s.chanInstance)
 		if err != nil {
 			logger.Debugf("streaming: %+v", err)
 			continue
@@ -205,12 +222,75 @@ func (s *terminalStateManager) SendTerminalCommand(state octant.State, payload a
 		return errors.New("terminal instance not found")
 	}
 
+	if err := s.checkWritable(state); err != nil {
+		return err
+	}
+
 	key, err := payload.String("key")
 	if err != nil {
 		return errors.Wrap(err, "extract key from payload")
 	}
 
-	return s.instance.Write([]byte(key))
+	err = s.instance.Write([]byte(key))
+	s.auditInput(key, err)
+	return err
+}
+
+// auditInput records each line entered in the terminal. Only a digest of the line is
+// recorded, since terminal input can contain secrets.
+func (s *terminalStateManager) auditInput(input string, err error) {
+	s.input = append(s.input, input...)
+	for {
+		i := bytes.IndexAny(s.input, "\r\n")
+		if i < 0 {
+			break
+		}
+
+		line := string(s.input[:i])
+		s.input = s.input[i+1:]
+		if line == "" && err == nil {
+			continue
+		}
+
+		instanceKey := s.instance.Key()
+		s.audit(auditTerminalCommand, &instanceKey, s.instance.Container(), line, err)
+	}
+}
+
+// audit records terminal access in the audit log.
+func (s *terminalStateManager) audit(actionName string, key *store.Key, container string, payload interface{}, err error) {
+	auditLog := s.config.AuditLog()
+	if auditLog == nil {
+		return
+	}
+
+	entry := audit.Entry{
+		Source:        audit.SourceTerminal,
+		Action:        actionName,
+		Target:        key,
+		Container:     container,
+		PayloadDigest: audit.Digest(payload),
+	}
+	entry.SetResult(err)
+	auditLog.Record(s.ctx, entry)
+}
+
+// podPayload returns the pod a terminal payload targets as an object payload.
+func podPayload(payload action.Payload) action.Payload {
+	namespace, _ := payload.OptionalString("namespace")
+	podName, _ := payload.OptionalString("podName")
+	return action.Payload{"apiVersion": "v1", "kind": "Pod", "namespace": namespace, "name": podName}
+}
+
+// checkWritable refuses terminal access in read-only mode, since commands run in the container.
+func (s *terminalStateManager) checkWritable(state octant.State) error {
+	if !s.config.ReadOnly() {
+		return nil
+	}
+
+	err := &action.ReadOnlyError{Action: "terminal"}
+	state.SendAlert(action.CreateAlert(action.AlertTypeError, err.Error(), action.DefaultAlertExpiration))
+	return err
 }
 
 func (s *terminalStateManager) Start(ctx context.Context, state octant.State, client api.OctantClient) {
@@ -5,16 +5,21 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 
 	"github.com/golang/mock/gomock"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/api/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
 func Test_TerminalStateManager(t *testing.T) {
@@ -32,6 +37,42 @@ func Test_TerminalStateManager(t *testing.T) {
 	tsm.Start(ctx, state, octantClient)
 }
 
+func Test_TerminalStateManager_readOnly(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	auditLog, err := audit.New(log.NopLogger())
+	require.NoError(t, err)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ReadOnly().Return(true)
+	dashConfig.EXPECT().AuditLog().Return(auditLog)
+
+	state := octantFake.NewMockState(controller)
+	state.EXPECT().SendAlert(gomock.Any())
+	octantClient := fake.NewMockOctantClient(controller)
+
+	tsm := api.NewTerminalStateManager(dashConfig)
+	tsm.Start(context.Background(), state, octantClient)
+
+	payload := action.Payload{"namespace": "default", "podName": "pod", "containerName": "container"}
+	for _, handler := range tsm.Handlers() {
+		if handler.RequestType != api.RequestActiveTerminal {
+			continue
+		}
+
+		var readOnlyErr *action.ReadOnlyError
+		assert.ErrorAs(t, handler.Handler(state, payload), &readOnlyErr)
+	}
+
+	entries := auditLog.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, audit.SourceTerminal, entries[0].Source)
+	assert.Equal(t, audit.ResultRefused, entries[0].Result)
+	assert.Equal(t, "container", entries[0].Container)
+	assert.Equal(t, &store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"}, entries[0].Target)
+}
+
 func Test_isWindowsContainer(t *testing.T) {
 	windowsPod := testutil.CreatePod("pod")
 	windowsPod.Spec.Tolerations = []corev1.Toleration{
@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apidiscovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+)
+
+// documentTTL is how long OpenAPI documents are cached. Installing a CRD changes them.
+const documentTTL = 5 * time.Minute
+
+type cachedDocument struct {
+	document *document
+	loadedAt time.Time
+}
+
+// apiIndex is the index of OpenAPI documents.
+type apiIndex struct {
+	// v3Paths maps group version paths to the URLs of their OpenAPI v3 documents. It is
+	// nil if the API server does not serve OpenAPI v3.
+	v3Paths  map[string]string
+	loadedAt time.Time
+}
+
+// SchemaLoader loads OpenAPI schemas from the API server. It uses OpenAPI v3 if the API
+// server serves it, and OpenAPI v2 otherwise. Documents are cached.
+type SchemaLoader struct {
+	client cluster.ClientInterface
+	now    func() time.Time
+
+	mu        sync.Mutex
+	index     *apiIndex
+	documents map[string]cachedDocument
+}
+
+// NewSchemaLoader creates an instance of SchemaLoader.
+func NewSchemaLoader(client cluster.ClientInterface) *SchemaLoader {
+	return &SchemaLoader{
+		client:    client,
+		now:       time.Now,
+		documents: make(map[string]cachedDocument),
+	}
+}
+
+// Explain explains a kind, or a field of a kind if path is not empty.
+func (l *SchemaLoader) Explain(ctx context.Context, gvk schema.GroupVersionKind, path []string) (*Explanation, error) {
+	d, err := l.document(ctx, gvk.GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return d.explain(gvk, path)
+}
+
+// FieldDocs documents the fields set in an object. The docs are markdown keyed by the
+// dotted path of the field, without list indexes.
+func (l *SchemaLoader) FieldDocs(ctx context.Context, object *unstructured.Unstructured) (map[string]string, error) {
+	gvk := object.GroupVersionKind()
+
+	d, err := l.document(ctx, gvk.GroupVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return d.fieldDocs(gvk, object.Object), nil
+}
+
+// document returns the document with the schemas of a group version.
+func (l *SchemaLoader) document(ctx context.Context, gv schema.GroupVersion) (*document, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	index, err := l.loadIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uri := "/openapi/v2"
+	if index.v3Paths != nil {
+		var ok bool
+		if uri, ok = index.v3Paths[groupVersionPath(gv)]; !ok {
+			return nil, fmt.Errorf("API server has no OpenAPI schema for %s", gv)
+		}
+	}
+
+	if cached, ok := l.documents[uri]; ok && l.now().Sub(cached.loadedAt) < documentTTL {
+		return cached.document, nil
+	}
+
+	data, err := l.get(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("load OpenAPI schema for %s: %w", gv, err)
+	}
+
+	d, err := parseDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	l.documents[uri] = cachedDocument{document: d, loadedAt: l.now()}
+	return d, nil
+}
+
+// loadIndex loads the index of OpenAPI v3 documents.
+func (l *SchemaLoader) loadIndex(ctx context.Context) (*apiIndex, error) {
+	if l.index != nil && l.now().Sub(l.index.loadedAt) < documentTTL {
+		return l.index, nil
+	}
+
+	index := &apiIndex{loadedAt: l.now()}
+
+	data, err := l.get(ctx, "/openapi/v3")
+	switch {
+	case kerrors.IsNotFound(err):
+		// the API server only serves OpenAPI v2
+	case err != nil:
+		return nil, fmt.Errorf("load OpenAPI v3 index: %w", err)
+	default:
+		var raw struct {
+			Paths map[string]struct {
+				ServerRelativeURL string `json:"serverRelativeURL"`
+			} `json:"paths"`
+		}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("parse OpenAPI v3 index: %w", err)
+		}
+
+		index.v3Paths = make(map[string]string)
+		for p, entry := range raw.Paths {
+			index.v3Paths[p] = entry.ServerRelativeURL
+		}
+	}
+
+	l.index = index
+	return index, nil
+}
+
+func (l *SchemaLoader) get(ctx context.Context, uri string) ([]byte, error) {
+	discoveryClient, err := l.client.DiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return discoveryClient.RESTClient().Get().
+		RequestURI(uri).
+		SetHeader("Accept", "application/json").
+		Do(ctx).
+		Raw()
+}
+
+// groupVersionPath returns the path of a group version in the OpenAPI v3 index.
+func groupVersionPath(gv schema.GroupVersion) string {
+	if gv.Group == "" {
+		return "api/" + gv.Version
+	}
+	return "apis/" + gv.Group + "/" + gv.Version
+}
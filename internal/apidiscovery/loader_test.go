@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apidiscovery
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+)
+
+func TestSchemaLoader_Explain(t *testing.T) {
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	tests := []struct {
+		name     string
+		path     []string
+		expected *Explanation
+		isErr    bool
+	}{
+		{
+			name: "kind",
+			expected: &Explanation{
+				GroupVersionKind: deployment,
+				Type:             "object",
+				Description:      "Deployment enables declarative updates for Pods and ReplicaSets.",
+				Fields: []Field{
+					{Name: "apiVersion", Type: "string", Description: "APIVersion defines the versioned schema of this representation of an object."},
+					{Name: "kind", Type: "string", Description: "Kind is a string value representing the REST resource this object represents."},
+					{Name: "metadata", Type: "ObjectMeta", Description: "Standard object's metadata.", HasFields: true},
+					{Name: "spec", Type: "DeploymentSpec", Description: "Specification of the desired behavior of the Deployment.", HasFields: true},
+				},
+			},
+		},
+		{
+			name: "field",
+			path: []string{"spec"},
+			expected: &Explanation{
+				GroupVersionKind: deployment,
+				Path:             []string{"spec"},
+				Type:             "DeploymentSpec",
+				Description:      "Specification of the desired behavior of the Deployment.",
+				Fields: []Field{
+					{Name: "containers", Type: "[]Container", Description: "List of containers.", HasFields: true},
+					{Name: "replicas", Type: "integer", Description: "Number of desired pods."},
+					{Name: "selector", Type: "map[string]string", Description: "Label selector for pods.", Required: true},
+					{Name: "strategy", Type: "DeploymentStrategy", Description: "The deployment strategy to use to replace existing pods with new ones.", HasFields: true},
+					{Name: "template", Type: "object", Description: "Template describes the pods that will be created.", Required: true},
+				},
+			},
+		},
+		{
+			name: "fields of list elements",
+			path: []string{"spec", "containers"},
+			expected: &Explanation{
+				GroupVersionKind: deployment,
+				Path:             []string{"spec", "containers"},
+				Type:             "[]Container",
+				Description:      "List of containers.",
+				Fields: []Field{
+					{Name: "image", Type: "string", Description: "Container image name."},
+					{Name: "name", Type: "string", Description: "Name of the container.", Required: true},
+					{Name: "port", Type: "int-or-string", Description: "Port to expose."},
+				},
+			},
+		},
+		{
+			name: "enum",
+			path: []string{"spec", "strategy", "type"},
+			expected: &Explanation{
+				GroupVersionKind: deployment,
+				Path:             []string{"spec", "strategy", "type"},
+				Type:             "string",
+				Description:      "Type of deployment.",
+				Enum:             []string{"Recreate", "RollingUpdate"},
+			},
+		},
+		{
+			name:  "missing field",
+			path:  []string{"spec", "missing"},
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			loader, requests := newTestLoader(t, true)
+
+			got, err := loader.Explain(context.Background(), deployment, test.path)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+
+			_, err = loader.Explain(context.Background(), deployment, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"/openapi/v3", "/openapi/v3/apis/apps/v1"}, *requests, "documents are cached")
+		})
+	}
+}
+
+func TestSchemaLoader_Explain_v2(t *testing.T) {
+	loader, requests := newTestLoader(t, false)
+
+	got, err := loader.Explain(context.Background(), schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, []string{"metadata"})
+	require.NoError(t, err)
+	assert.Equal(t, "ObjectMeta", got.Type)
+	assert.Equal(t, "Standard object's metadata.", got.Description)
+	assert.Equal(t, []Field{
+		{Name: "name", Type: "string", Description: "Name must be unique within a namespace."},
+	}, got.Fields)
+
+	_, err = loader.Explain(context.Background(), schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, nil)
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"/openapi/v3", "/openapi/v2"}, *requests)
+}
+
+func TestSchemaLoader_FieldDocs(t *testing.T) {
+	loader, _ := newTestLoader(t, true)
+
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":   "app",
+			"labels": map[string]interface{}{"app": "app"},
+		},
+		"spec": map[string]interface{}{
+			"strategy": map[string]interface{}{"type": "Recreate"},
+			"containers": []interface{}{
+				map[string]interface{}{"name": "a", "unknown": true},
+				map[string]interface{}{"name": "b", "image": "nginx"},
+			},
+		},
+	}}
+
+	docs, err := loader.FieldDocs(context.Background(), object)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"apiVersion":            "**apiVersion** `string`\n\nAPIVersion defines the versioned schema of this representation of an object.",
+		"kind":                  "**kind** `string`\n\nKind is a string value representing the REST resource this object represents.",
+		"metadata":              "**metadata** `ObjectMeta`\n\nStandard object's metadata.",
+		"metadata.name":         "**name** `string`\n\nName must be unique within a namespace.",
+		"metadata.labels":       "**labels** `map[string]string`\n\nMap of string keys and values.",
+		"spec":                  "**spec** `DeploymentSpec`\n\nSpecification of the desired behavior of the Deployment.",
+		"spec.strategy":         "**strategy** `DeploymentStrategy`\n\nThe deployment strategy to use to replace existing pods with new ones.",
+		"spec.strategy.type":    "**type** `string`\n\nType of deployment.\n\nPossible values: `Recreate`, `RollingUpdate`",
+		"spec.containers":       "**containers** `[]Container`\n\nList of containers.",
+		"spec.containers.name":  "**name** `string`\n\nName of the container.",
+		"spec.containers.image": "**image** `string`\n\nContainer image name.",
+	}, docs)
+}
+
+// newTestLoader creates a SchemaLoader for a test API server which serves OpenAPI v3 if
+// v3 is true. It returns the paths requested from the server.
+func newTestLoader(t *testing.T, v3 bool) (*SchemaLoader, *[]string) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+
+		var file string
+		switch {
+		case r.URL.Path == "/openapi/v3" && v3:
+			_, _ = w.Write([]byte(`{"paths": {"apis/apps/v1": {"serverRelativeURL": "/openapi/v3/apis/apps/v1?hash=1"}}}`))
+			return
+		case r.URL.Path == "/openapi/v3/apis/apps/v1" && v3:
+			file = "openapi-v3-apps-v1.json"
+		case r.URL.Path == "/openapi/v2" && !v3:
+			file = "openapi-v2.json"
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join("testdata", file))
+		require.NoError(t, err)
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	controller := gomock.NewController(t)
+	t.Cleanup(controller.Finish)
+
+	discoveryClient := discovery.NewDiscoveryClientForConfigOrDie(&rest.Config{Host: server.URL})
+
+	client := clusterFake.NewMockClientInterface(controller)
+	client.EXPECT().DiscoveryClient().Return(discoveryClient, nil).AnyTimes()
+
+	return NewSchemaLoader(client), &requests
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package apidiscovery lists the API resources served by a cluster and explains their
+// OpenAPI schemas.
+package apidiscovery
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// Resource describes an API resource served by a cluster.
+type Resource struct {
+	Group      string
+	Version    string
+	Name       string
+	Kind       string
+	Namespaced bool
+	Verbs      []string
+	ShortNames []string
+	// Preferred is true if Version is the preferred version of Group.
+	Preferred bool
+}
+
+// GroupVersionKind returns the group, version and kind of the resource.
+func (r Resource) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: r.Kind}
+}
+
+// Resources lists the resources served by the cluster, sorted by group, name and version.
+// Subresources are skipped. If discovery fails for some groups, the resources of the
+// other groups are returned with the error.
+func Resources(client discovery.DiscoveryInterface) ([]Resource, error) {
+	groups, resourceLists, err := client.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	preferred := make(map[string]string)
+	for _, group := range groups {
+		preferred[group.Name] = group.PreferredVersion.Version
+	}
+
+	var list []Resource
+	for _, resourceList := range resourceLists {
+		gv, parseErr := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if parseErr != nil {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+
+			list = append(list, Resource{
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Name:       resource.Name,
+				Kind:       resource.Kind,
+				Namespaced: resource.Namespaced,
+				Verbs:      resource.Verbs,
+				ShortNames: resource.ShortNames,
+				Preferred:  preferred[gv.Group] == gv.Version,
+			})
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Group != list[j].Group {
+			return list[i].Group < list[j].Group
+		}
+		if list[i].Name != list[j].Name {
+			return list[i].Name < list[j].Name
+		}
+		return list[i].Version < list[j].Version
+	})
+
+	return list, err
+}
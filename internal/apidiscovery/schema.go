@@ -0,0 +1,352 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apidiscovery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// maxFieldDocs limits the number of fields documented for an object.
+const maxFieldDocs = 2000
+
+// Field describes a field of an object.
+type Field struct {
+	Name        string
+	Type        string
+	Description string
+	Required    bool
+	Enum        []string
+	// HasFields is true if the field is an object with fields, or a list or map of them.
+	HasFields bool
+}
+
+// Explanation describes a kind or one of its fields, like kubectl explain.
+type Explanation struct {
+	GroupVersionKind schema.GroupVersionKind
+	// Path is the path of the field. It is empty for the kind.
+	Path        []string
+	Type        string
+	Description string
+	Enum        []string
+	Fields      []Field
+}
+
+// jsonSchema is the subset of an OpenAPI v2 or v3 schema used for explaining fields.
+type jsonSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AdditionalProperties *schemaOrBool          `json:"additionalProperties,omitempty"`
+	IntOrString          bool                   `json:"x-kubernetes-int-or-string,omitempty"`
+	PreserveUnknown      bool                   `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+	GroupVersionKinds    []groupVersionKind     `json:"x-kubernetes-group-version-kind,omitempty"`
+}
+
+// schemaOrBool is a schema, or a boolean in place of a schema.
+type schemaOrBool struct {
+	schema *jsonSchema
+	// allows is true if any value is allowed.
+	allows bool
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *schemaOrBool) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '{' {
+		s.allows = string(data) == "true"
+		return nil
+	}
+
+	s.schema = &jsonSchema{}
+	return json.Unmarshal(data, s.schema)
+}
+
+type groupVersionKind struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+// document is an OpenAPI v2 or v3 document.
+type document struct {
+	schemas map[string]*jsonSchema
+}
+
+// parseDocument parses an OpenAPI v3 document, or an OpenAPI v2 document in JSON format.
+func parseDocument(data []byte) (*document, error) {
+	var raw struct {
+		Definitions map[string]*jsonSchema `json:"definitions"`
+		Components  struct {
+			Schemas map[string]*jsonSchema `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse OpenAPI document: %w", err)
+	}
+
+	schemas := raw.Components.Schemas
+	if schemas == nil {
+		schemas = raw.Definitions
+	}
+
+	return &document{schemas: schemas}, nil
+}
+
+// kindSchema returns the schema of a kind.
+func (d *document) kindSchema(gvk schema.GroupVersionKind) (*jsonSchema, bool) {
+	for _, s := range d.schemas {
+		for _, candidate := range s.GroupVersionKinds {
+			if candidate.Group == gvk.Group && candidate.Version == gvk.Version && candidate.Kind == gvk.Kind {
+				return s, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// resolve follows references. Schemas with a single allOf entry are used for references
+// with descriptions in OpenAPI v3.
+func (d *document) resolve(s *jsonSchema) *jsonSchema {
+	for i := 0; s != nil && i < 10; i++ {
+		switch {
+		case s.Ref != "":
+			s = d.schemas[refName(s.Ref)]
+		case len(s.AllOf) == 1 && s.Type == "" && s.Properties == nil:
+			s = s.AllOf[0]
+		default:
+			return s
+		}
+	}
+	return s
+}
+
+// elements returns the schema of the elements of a list or map, or s for other schemas.
+func (d *document) elements(s *jsonSchema) *jsonSchema {
+	s = d.resolve(s)
+	if s == nil {
+		return nil
+	}
+
+	if s.Type == "array" && s.Items != nil {
+		return d.elements(s.Items)
+	}
+	if additional := s.additionalProperties(); additional != nil && len(s.Properties) == 0 {
+		return d.elements(additional)
+	}
+	return s
+}
+
+// typeName returns a type name for a schema like kubectl explain does.
+func (d *document) typeName(s *jsonSchema) string {
+	if s == nil {
+		return ""
+	}
+
+	if ref := s.reference(); ref != "" {
+		name := refName(ref)
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+		return name
+	}
+
+	switch {
+	case s.IntOrString:
+		return "int-or-string"
+	case s.Type == "array":
+		return "[]" + d.typeName(s.Items)
+	case s.Type == "object" && s.additionalProperties() != nil:
+		return "map[string]" + d.typeName(s.additionalProperties())
+	case s.Type == "":
+		return "object"
+	default:
+		return s.Type
+	}
+}
+
+// field returns the schema of a field of s. Fields of lists and maps are fields of their elements.
+func (d *document) field(s *jsonSchema, name string) (*jsonSchema, bool) {
+	s = d.elements(s)
+	if s == nil {
+		return nil, false
+	}
+
+	f, ok := s.Properties[name]
+	return f, ok
+}
+
+// fields lists the fields of s.
+func (d *document) fields(s *jsonSchema) []Field {
+	s = d.elements(s)
+	if s == nil {
+		return nil
+	}
+
+	required := make(map[string]bool)
+	for _, name := range s.Required {
+		required[name] = true
+	}
+
+	var list []Field
+	for name, f := range s.Properties {
+		elements := d.elements(f)
+		list = append(list, Field{
+			Name:        name,
+			Type:        d.typeName(f),
+			Description: d.description(f),
+			Required:    required[name],
+			Enum:        enumValues(d.resolve(f)),
+			HasFields:   elements != nil && len(elements.Properties) > 0,
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// description returns the description of a field. The description of the field is
+// preferred to the description of the referenced type.
+func (d *document) description(s *jsonSchema) string {
+	if s == nil {
+		return ""
+	}
+	if s.Description != "" {
+		return s.Description
+	}
+	if resolved := d.resolve(s); resolved != nil {
+		return resolved.Description
+	}
+	return ""
+}
+
+// explain explains a field of a kind.
+func (d *document) explain(gvk schema.GroupVersionKind, path []string) (*Explanation, error) {
+	s, ok := d.kindSchema(gvk)
+	if !ok {
+		return nil, fmt.Errorf("no schema found for %s", gvk)
+	}
+
+	for i, name := range path {
+		if s, ok = d.field(s, name); !ok {
+			return nil, fmt.Errorf("field %s does not exist in %s", strings.Join(path[:i+1], "."), gvk.Kind)
+		}
+	}
+
+	return &Explanation{
+		GroupVersionKind: gvk,
+		Path:             path,
+		Type:             d.typeName(s),
+		Description:      d.description(s),
+		Enum:             enumValues(d.resolve(s)),
+		Fields:           d.fields(s),
+	}, nil
+}
+
+// fieldDocs documents the fields set in an object. The docs are keyed by the dotted path
+// of the field. List indexes are left out of the path.
+func (d *document) fieldDocs(gvk schema.GroupVersionKind, object map[string]interface{}) map[string]string {
+	s, ok := d.kindSchema(gvk)
+	if !ok {
+		return nil
+	}
+
+	docs := make(map[string]string)
+	d.addFieldDocs(docs, "", s, object)
+	return docs
+}
+
+func (d *document) addFieldDocs(docs map[string]string, prefix string, s *jsonSchema, value interface{}) {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			d.addFieldDocs(docs, prefix, s, item)
+		}
+	case map[string]interface{}:
+		for name, fieldValue := range v {
+			if len(docs) >= maxFieldDocs {
+				return
+			}
+
+			f, ok := d.field(s, name)
+			if !ok {
+				continue
+			}
+
+			p := name
+			if prefix != "" {
+				p = prefix + "." + name
+			}
+
+			if _, ok := docs[p]; !ok {
+				docs[p] = fieldDoc(name, d.typeName(f), d.description(f), enumValues(d.resolve(f)))
+			}
+			d.addFieldDocs(docs, p, f, fieldValue)
+		}
+	}
+}
+
+// fieldDoc formats the documentation of a field as markdown.
+func fieldDoc(name, typeName, description string, enum []string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**%s** `%s`", name, typeName)
+	if description != "" {
+		fmt.Fprintf(&sb, "\n\n%s", description)
+	}
+	if len(enum) > 0 {
+		fmt.Fprintf(&sb, "\n\nPossible values: `%s`", strings.Join(enum, "`, `"))
+	}
+	return sb.String()
+}
+
+func (s *jsonSchema) reference() string {
+	if s.Ref != "" {
+		return s.Ref
+	}
+	if len(s.AllOf) == 1 && s.Type == "" {
+		return s.AllOf[0].Ref
+	}
+	return ""
+}
+
+// additionalProperties returns the schema of additional properties. It is nil if the
+// schema has none, or if additionalProperties is a boolean.
+func (s *jsonSchema) additionalProperties() *jsonSchema {
+	if s.AdditionalProperties == nil {
+		return nil
+	}
+	return s.AdditionalProperties.schema
+}
+
+func enumValues(s *jsonSchema) []string {
+	if s == nil {
+		return nil
+	}
+
+	var values []string
+	for _, v := range s.Enum {
+		values = append(values, fmt.Sprint(v))
+	}
+	return values
+}
+
+// refName returns the name of the schema a reference points to.
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
@@ -0,0 +1,328 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apidiscovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ValidationError is a violation of the schema of a kind in a YAML document. Lines and
+// columns start at 1.
+type ValidationError struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// Error implements error.
+func (e ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Field, e.Message)
+}
+
+// yamlLineError matches the line number in YAML syntax errors.
+var yamlLineError = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// Validate validates a YAML object against the OpenAPI schema of its kind. Fields which
+// are not in the schema are reported since the API server prunes them silently. An error
+// is returned if the object can't be validated, e.g. if its kind has no schema.
+func (l *SchemaLoader) Validate(ctx context.Context, data []byte) ([]ValidationError, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return []ValidationError{syntaxError(err)}, nil
+	}
+	if len(root.Content) == 0 {
+		return []ValidationError{{Line: 1, Column: 1, Message: "document is empty"}}, nil
+	}
+
+	object := root.Content[0]
+	if object.Kind != yaml.MappingNode {
+		return []ValidationError{nodeError(object, "", "document is not an object")}, nil
+	}
+
+	apiVersion, kind := mappingValue(object, "apiVersion"), mappingValue(object, "kind")
+	if apiVersion == nil || kind == nil {
+		return []ValidationError{nodeError(object, "", "apiVersion and kind are required")}, nil
+	}
+
+	gv, err := schema.ParseGroupVersion(apiVersion.Value)
+	if err != nil {
+		return []ValidationError{nodeError(apiVersion, "apiVersion", err.Error())}, nil
+	}
+	gvk := gv.WithKind(kind.Value)
+
+	d, err := l.document(ctx, gv)
+	if err != nil {
+		return nil, err
+	}
+
+	s, ok := d.kindSchema(gvk)
+	if !ok {
+		return nil, fmt.Errorf("no schema found for %s", gvk)
+	}
+
+	v := validator{document: d}
+	v.validate("", s, object)
+
+	sort.SliceStable(v.errs, func(i, j int) bool {
+		if v.errs[i].Line != v.errs[j].Line {
+			return v.errs[i].Line < v.errs[j].Line
+		}
+		return v.errs[i].Column < v.errs[j].Column
+	})
+
+	return v.errs, nil
+}
+
+// validator validates YAML nodes against schemas of a document.
+type validator struct {
+	document *document
+	errs     []ValidationError
+}
+
+func (v *validator) validate(fieldPath string, s *jsonSchema, node *yaml.Node) {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+
+	quantity := isQuantity(s)
+	s = v.document.resolve(s)
+	if s == nil || node.Tag == "!!null" {
+		return
+	}
+
+	switch {
+	case s.IntOrString || s.Format == "int-or-string":
+		v.expectTags(fieldPath, node, "int-or-string", "!!int", "!!str")
+		return
+	case quantity:
+		v.expectTags(fieldPath, node, "quantity", "!!int", "!!float", "!!str")
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		v.validateMapping(fieldPath, s, node)
+	case "array":
+		if node.Kind != yaml.SequenceNode {
+			v.add(node, fieldPath, fmt.Sprintf("expected array, got %s", nodeType(node)))
+			return
+		}
+		for i, item := range node.Content {
+			v.validate(fmt.Sprintf("%s[%d]", fieldPath, i), s.Items, item)
+		}
+	case "string":
+		v.expectTags(fieldPath, node, "string", "!!str", "!!timestamp")
+	case "integer":
+		v.expectTags(fieldPath, node, "integer", "!!int")
+	case "number":
+		v.expectTags(fieldPath, node, "number", "!!int", "!!float")
+	case "boolean":
+		v.expectTags(fieldPath, node, "boolean", "!!bool")
+	case "":
+		if node.Kind == yaml.MappingNode && len(s.Properties) > 0 {
+			v.validateMapping(fieldPath, s, node)
+		}
+	}
+
+	if values := enumValues(s); len(values) > 0 && node.Kind == yaml.ScalarNode {
+		for _, value := range values {
+			if node.Value == value {
+				return
+			}
+		}
+		v.add(node, fieldPath, fmt.Sprintf("unsupported value %q, expected one of: %s", node.Value, strings.Join(values, ", ")))
+	}
+}
+
+func (v *validator) validateMapping(fieldPath string, s *jsonSchema, node *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		v.add(node, fieldPath, fmt.Sprintf("expected object, got %s", nodeType(node)))
+		return
+	}
+
+	present := make(map[string]bool)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Tag == "!!merge" {
+			continue
+		}
+
+		name := key.Value
+		present[name] = true
+		p := name
+		if fieldPath != "" {
+			p = fieldPath + "." + name
+		}
+
+		if f, ok := s.Properties[name]; ok {
+			v.validate(p, f, value)
+			continue
+		}
+
+		if additional := s.AdditionalProperties; additional != nil && (additional.allows || additional.schema != nil) {
+			if additional.schema != nil {
+				v.validate(p, additional.schema, value)
+			}
+			continue
+		}
+
+		if s.PreserveUnknown || len(s.Properties) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("unknown field %q", name)
+		if suggestion := closestField(name, s.Properties); suggestion != "" {
+			message = fmt.Sprintf("%s, did you mean %q?", message, suggestion)
+		}
+		v.add(key, p, message)
+	}
+
+	for _, name := range s.Required {
+		if !present[name] {
+			v.add(node, fieldPath, fmt.Sprintf("missing required field %q", name))
+		}
+	}
+}
+
+// expectTags reports an error if node is not a scalar with one of the tags.
+func (v *validator) expectTags(fieldPath string, node *yaml.Node, typeName string, tags ...string) {
+	if node.Kind == yaml.ScalarNode {
+		for _, tag := range tags {
+			if node.ShortTag() == tag {
+				return
+			}
+		}
+	}
+
+	v.add(node, fieldPath, fmt.Sprintf("expected %s, got %s", typeName, nodeType(node)))
+}
+
+func (v *validator) add(node *yaml.Node, fieldPath, message string) {
+	v.errs = append(v.errs, nodeError(node, fieldPath, message))
+}
+
+func nodeError(node *yaml.Node, fieldPath, message string) ValidationError {
+	return ValidationError{
+		Line:    node.Line,
+		Column:  node.Column,
+		Field:   fieldPath,
+		Message: message,
+	}
+}
+
+// syntaxError converts a YAML parse error to a validation error.
+func syntaxError(err error) ValidationError {
+	var typeErr *yaml.TypeError
+	message := err.Error()
+	if errors.As(err, &typeErr) && len(typeErr.Errors) > 0 {
+		message = typeErr.Errors[0]
+	}
+
+	line := 1
+	if match := yamlLineError.FindStringSubmatch(message); match != nil {
+		line, _ = strconv.Atoi(match[1])
+		message = match[2]
+	}
+
+	return ValidationError{Line: line, Column: 1, Message: message}
+}
+
+// mappingValue returns the value of a key in a mapping node, or nil if the key is not set.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// nodeType describes the type of a node for error messages.
+func nodeType(node *yaml.Node) string {
+	switch node.ShortTag() {
+	case "!!map":
+		return "object"
+	case "!!seq":
+		return "array"
+	case "!!str", "!!timestamp":
+		return "string"
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	default:
+		return strings.TrimPrefix(node.ShortTag(), "!!")
+	}
+}
+
+// isQuantity returns true if s refers to resource.Quantity, which is published as a
+// string, but accepts numbers as well.
+func isQuantity(s *jsonSchema) bool {
+	if s == nil {
+		return false
+	}
+	return strings.HasSuffix(refName(s.reference()), "resource.Quantity")
+}
+
+// closestField returns the property closest to name, if it is close enough to be a typo.
+func closestField(name string, properties map[string]*jsonSchema) string {
+	best, bestDistance := "", 3
+	for property := range properties {
+		distance := editDistance(strings.ToLower(name), strings.ToLower(property))
+		if distance < bestDistance || (distance == bestDistance && best != "" && property < best) {
+			best, bestDistance = property, distance
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package apidiscovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaLoader_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		expected []ValidationError
+		isErr    bool
+	}{
+		{
+			name: "valid",
+			yaml: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  labels:
+    app: app
+spec:
+  replicas: 1
+  selector:
+    app: app
+  template:
+    anything: goes
+  strategy:
+    type: Recreate
+  containers:
+  - name: app
+    port: http
+  - name: sidecar
+    port: 8080
+`,
+		},
+		{
+			name: "schema violations",
+			yaml: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replica: 1
+  selector:
+    app: 1
+  template: {}
+  strategy:
+    type: Sometimes
+  containers:
+  - image: nginx
+    port: true
+`,
+			expected: []ValidationError{
+				{Line: 6, Column: 3, Field: "spec.replica", Message: `unknown field "replica", did you mean "replicas"?`},
+				{Line: 8, Column: 10, Field: "spec.selector.app", Message: "expected string, got integer"},
+				{Line: 11, Column: 11, Field: "spec.strategy.type", Message: `unsupported value "Sometimes", expected one of: Recreate, RollingUpdate`},
+				{Line: 13, Column: 5, Field: "spec.containers[0]", Message: `missing required field "name"`},
+				{Line: 14, Column: 11, Field: "spec.containers[0].port", Message: "expected int-or-string, got boolean"},
+			},
+		},
+		{
+			name: "wrong structure",
+			yaml: `apiVersion: apps/v1
+kind: Deployment
+spec:
+  selector: app
+  template: {}
+  containers:
+    name: app
+`,
+			expected: []ValidationError{
+				{Line: 4, Column: 13, Field: "spec.selector", Message: "expected object, got string"},
+				{Line: 7, Column: 5, Field: "spec.containers", Message: "expected array, got object"},
+			},
+		},
+		{
+			name: "syntax error",
+			yaml: "apiVersion: apps/v1\nkind: Deployment\nspec:\n  replicas: 1\n bad: indent\n",
+			expected: []ValidationError{
+				{Line: 4, Column: 1, Message: "did not find expected key"},
+			},
+		},
+		{
+			name: "missing kind",
+			yaml: "apiVersion: apps/v1\n",
+			expected: []ValidationError{
+				{Line: 1, Column: 1, Message: "apiVersion and kind are required"},
+			},
+		},
+		{
+			name:  "unknown kind",
+			yaml:  "apiVersion: apps/v1\nkind: Unknown\n",
+			isErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			loader, _ := newTestLoader(t, true)
+
+			got, err := loader.Validate(context.Background(), []byte(test.yaml))
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
@@ -0,0 +1,385 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package audit records the mutating actions performed through Octant.
+package audit
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// SourceAction is the source of entries for actions dispatched by the action manager.
+	SourceAction = "action"
+	// SourcePlugin is the source of entries for plugin API writes.
+	SourcePlugin = "plugin"
+	// SourceTerminal is the source of entries for terminal input.
+	SourceTerminal = "terminal"
+
+	// ResultSuccess is the result of a successful action.
+	ResultSuccess = "success"
+	// ResultError is the result of an action which failed.
+	ResultError = "error"
+	// ResultRefused is the result of an action refused in read-only mode.
+	ResultRefused = "refused"
+
+	// maxRecentEntries is the number of entries kept in memory for browsing.
+	maxRecentEntries = 1000
+)
+
+// Entry is an audit log entry.
+type Entry struct {
+	Time          time.Time  `json:"time"`
+	User          string     `json:"user,omitempty"`
+	Groups        []string   `json:"groups,omitempty"`
+	Context       string     `json:"context,omitempty"`
+	Source        string     `json:"source"`
+	Action        string     `json:"action"`
+	Target        *store.Key `json:"target,omitempty"`
+	Container     string     `json:"container,omitempty"`
+	PayloadDigest string     `json:"payloadDigest,omitempty"`
+	Result        string     `json:"result"`
+	Error         string     `json:"error,omitempty"`
+}
+
+// SetResult sets the entry's result from the error an action returned.
+func (e *Entry) SetResult(err error) {
+	var readOnlyErr *action.ReadOnlyError
+	switch {
+	case err == nil:
+		e.Result = ResultSuccess
+	case errors.As(err, &readOnlyErr):
+		e.Result = ResultRefused
+		e.Error = err.Error()
+	default:
+		e.Result = ResultError
+		e.Error = err.Error()
+	}
+}
+
+// Option is an option for configuring Log.
+type Option func(l *Log)
+
+// WithFile writes the log to path as JSON lines. If maxSizeMB is greater than zero, the
+// file is rotated when it grows past that size and maxBackups rotated files are kept.
+func WithFile(path string, maxSizeMB int, maxBackups int) Option {
+	return func(l *Log) {
+		l.path = path
+		l.maxSize = int64(maxSizeMB) * 1024 * 1024
+		l.maxBackups = maxBackups
+	}
+}
+
+// Log records audit entries. It keeps the most recent entries in memory so they can be
+// browsed in Octant, and writes every entry to a file if one is configured.
+type Log struct {
+	logger log.Logger
+
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu          sync.Mutex
+	file        *os.File
+	size        int64
+	closed      bool
+	recent      []Entry
+	contextName func() string
+	localUser   string
+	now         func() time.Time
+}
+
+var _ action.Auditor = (*Log)(nil)
+
+// New creates an instance of Log.
+func New(logger log.Logger, options ...Option) (*Log, error) {
+	l := &Log{
+		logger:    logger.With("component", "audit"),
+		localUser: localUserName(),
+		now:       time.Now,
+	}
+
+	for _, option := range options {
+		option(l)
+	}
+
+	if l.path == "" {
+		return l, nil
+	}
+
+	if err := l.loadRecent(); err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	if err := l.open(); err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+
+	return l, nil
+}
+
+// SetContextName sets the function returning the current kube context. It is used for
+// entries whose context doesn't carry the client's kube context.
+func (l *Log) SetContextName(fn func() string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.contextName = fn
+}
+
+// LocalUser returns the name of the user running Octant. Entries without a user are
+// attributed to it, except plugin entries.
+func (l *Log) LocalUser() string {
+	return l.localUser
+}
+
+// AuditAction records an action dispatched by the action manager.
+func (l *Log) AuditAction(ctx context.Context, actionPath string, payload action.Payload, err error) {
+	entry := Entry{
+		Source:        SourceAction,
+		Action:        actionPath,
+		Target:        KeyFromPayload(payload),
+		PayloadDigest: Digest(payload),
+	}
+	entry.SetResult(err)
+
+	l.Record(ctx, entry)
+}
+
+// Record records an entry. The time, user and kube context are filled in from ctx if
+// they are not set.
+func (l *Log) Record(ctx context.Context, entry Entry) {
+	if entry.Time.IsZero() {
+		entry.Time = l.now().UTC()
+	}
+
+	if entry.User == "" {
+		if u, ok := auth.UserFrom(ctx); ok {
+			entry.User = u.Name
+			entry.Groups = u.Groups
+		} else if entry.Source != SourcePlugin {
+			entry.User = l.localUser
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if entry.Context == "" {
+		entry.Context = ocontext.ClientStateFrom(ctx).ContextName
+		if entry.Context == "" && l.contextName != nil {
+			entry.Context = l.contextName()
+		}
+	}
+
+	l.recent = append(l.recent, entry)
+	if len(l.recent) > maxRecentEntries {
+		l.recent = l.recent[len(l.recent)-maxRecentEntries:]
+	}
+
+	if l.path != "" && !l.closed {
+		if err := l.write(entry); err != nil {
+			l.logger.WithErr(err).Errorf("unable to write audit log entry")
+		}
+	}
+}
+
+// Entries returns the recent entries, newest first.
+func (l *Log) Entries() []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := make([]Entry, len(l.recent))
+	for i := range l.recent {
+		entries[len(l.recent)-1-i] = l.recent[i]
+	}
+	return entries
+}
+
+// Path returns the path of the log file. It is empty if entries are only kept in memory.
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closed = true
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+func (l *Log) write(entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if l.maxSize > 0 && l.size > 0 && l.size+int64(len(data)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			// The entry is still written to the current file and rotation is retried
+			// on the next write.
+			l.logger.WithErr(err).Errorf("unable to rotate audit log")
+		}
+	}
+
+	if l.file == nil {
+		if err := l.open(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(data)
+	l.size += int64(n)
+	return err
+}
+
+func (l *Log) open() error {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	l.file = f
+	l.size = fi.Size()
+	return nil
+}
+
+// rotate moves the current file to path.1, shifting older backups, and opens a new file.
+// If the files can't be moved, the current file is opened again to append to it.
+func (l *Log) rotate() error {
+	err := l.file.Close()
+	l.file = nil
+	if err != nil {
+		return err
+	}
+
+	if err := l.shiftBackups(); err != nil {
+		if openErr := l.open(); openErr != nil {
+			return openErr
+		}
+		return err
+	}
+
+	return l.open()
+}
+
+// shiftBackups moves the current file to path.1 and shifts older backups, removing the
+// oldest one. The current file is removed if no backups are kept.
+func (l *Log) shiftBackups() error {
+	if l.maxBackups < 1 {
+		if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	oldest := backupPath(l.path, l.maxBackups)
+	if err := os.Remove(oldest); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := l.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(backupPath(l.path, i), backupPath(l.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(l.path, backupPath(l.path, 1))
+}
+
+// loadRecent reads the most recent entries from an existing log file.
+func (l *Log) loadRecent() error {
+	f, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		l.recent = append(l.recent, entry)
+		if len(l.recent) > maxRecentEntries {
+			l.recent = l.recent[1:]
+		}
+	}
+
+	return scanner.Err()
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+// KeyFromPayload returns the object an action payload targets, or nil if the payload
+// doesn't name one.
+func KeyFromPayload(payload action.Payload) *store.Key {
+	key := store.Key{}
+	key.APIVersion, _ = payload.OptionalString("apiVersion")
+	key.Kind, _ = payload.OptionalString("kind")
+	key.Name, _ = payload.OptionalString("name")
+	key.Namespace, _ = payload.OptionalString("namespace")
+
+	if key.APIVersion == "" && key.Kind == "" && key.Name == "" && key.Namespace == "" {
+		return nil
+	}
+	return &key
+}
+
+// Digest returns a SHA-256 digest of v's JSON representation, so entries can be matched
+// with payloads without storing their contents.
+func Digest(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+}
+
+func localUserName() string {
+	u, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	return u.Username
+}
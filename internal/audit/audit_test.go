@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestLog_AuditAction(t *testing.T) {
+	l, err := New(log.NopLogger())
+	require.NoError(t, err)
+	l.localUser = "local"
+	l.now = func() time.Time { return time.Unix(1600000000, 0) }
+	l.SetContextName(func() string { return "default-context" })
+
+	payload := action.Payload{"apiVersion": "v1", "kind": "Pod", "namespace": "default", "name": "pod"}
+
+	ctx := context.Background()
+	l.AuditAction(ctx, "action.octant.dev/deleteObject", payload, nil)
+
+	ctx = auth.WithUser(ctx, auth.User{Name: "alice", Groups: []string{"admins"}})
+	ctx = ocontext.WithClientState(ctx, ocontext.ClientState{ContextName: "prod"})
+	l.AuditAction(ctx, "action.octant.dev/deleteObject", payload, &action.ReadOnlyError{Action: "action.octant.dev/deleteObject"})
+	l.AuditAction(ctx, "action.octant.dev/deleteObject", payload, errors.New("not found"))
+
+	entries := l.Entries()
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, ResultError, entries[0].Result)
+	assert.Equal(t, "not found", entries[0].Error)
+
+	assert.Equal(t, ResultRefused, entries[1].Result)
+	assert.Equal(t, "alice", entries[1].User)
+	assert.Equal(t, []string{"admins"}, entries[1].Groups)
+	assert.Equal(t, "prod", entries[1].Context)
+
+	expected := Entry{
+		Time:          time.Unix(1600000000, 0).UTC(),
+		User:          "local",
+		Context:       "default-context",
+		Source:        SourceAction,
+		Action:        "action.octant.dev/deleteObject",
+		Target:        &store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"},
+		PayloadDigest: Digest(payload),
+		Result:        ResultSuccess,
+	}
+	assert.Equal(t, expected, entries[2])
+}
+
+func TestLog_Record_plugin(t *testing.T) {
+	l, err := New(log.NopLogger())
+	require.NoError(t, err)
+	l.localUser = "local"
+
+	l.Record(context.Background(), Entry{Source: SourcePlugin, Action: "delete", Result: ResultSuccess})
+
+	entries := l.Entries()
+	require.Len(t, entries, 1)
+	assert.Empty(t, entries[0].User, "plugin writes are not attributed to the local user")
+}
+
+func TestLog_file(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit", "audit.log")
+
+	l, err := New(log.NopLogger(), WithFile(path, 0, 0))
+	require.NoError(t, err)
+	l.Record(context.Background(), Entry{Source: SourceAction, Action: "first", Result: ResultSuccess})
+	l.Record(context.Background(), Entry{Source: SourceAction, Action: "second", Result: ResultSuccess})
+	require.NoError(t, l.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"action":"first"`)
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	reopened, err := New(log.NopLogger(), WithFile(path, 0, 0))
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	entries := reopened.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "second", entries[0].Action)
+	assert.Equal(t, "first", entries[1].Action)
+}
+
+func TestLog_rotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+
+	l, err := New(log.NopLogger(), WithFile(path, 1, 2))
+	require.NoError(t, err)
+	defer l.Close()
+
+	// Rotate after every entry.
+	l.maxSize = 1
+	for _, name := range []string{"first", "second", "third", "fourth"} {
+		l.Record(context.Background(), Entry{Source: SourceAction, Action: name, Result: ResultSuccess})
+	}
+
+	for file, expected := range map[string]string{
+		path:                "fourth",
+		backupPath(path, 1): "third",
+		backupPath(path, 2): "second",
+	} {
+		data, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"action":"`+expected+`"`)
+	}
+
+	_, err = os.Stat(backupPath(path, 3))
+	assert.True(t, os.IsNotExist(err))
+
+	assert.Len(t, l.Entries(), 4)
+}
+
+func TestLog_rotate_failure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "octant-audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+
+	l, err := New(log.NopLogger(), WithFile(path, 1, 1))
+	require.NoError(t, err)
+	defer l.Close()
+
+	// A non-empty directory in place of the backup makes rotation fail.
+	require.NoError(t, os.MkdirAll(filepath.Join(backupPath(path, 1), "blocked"), 0700))
+
+	l.maxSize = 1
+	for _, name := range []string{"first", "second", "third"} {
+		l.Record(context.Background(), Entry{Source: SourceAction, Action: name, Result: ResultSuccess})
+	}
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	for _, name := range []string{"first", "second", "third"} {
+		assert.Contains(t, string(data), `"action":"`+name+`"`)
+	}
+
+	// Rotation is retried on the next write once the backup can be moved.
+	require.NoError(t, os.RemoveAll(backupPath(path, 1)))
+	l.Record(context.Background(), Entry{Source: SourceAction, Action: "fourth", Result: ResultSuccess})
+
+	data, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"action":"fourth"`)
+	assert.NotContains(t, string(data), `"action":"third"`)
+
+	data, err = ioutil.ReadFile(backupPath(path, 1))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"action":"third"`)
+}
+
+func TestKeyFromPayload(t *testing.T) {
+	assert.Nil(t, KeyFromPayload(action.Payload{"update": "value"}))
+	assert.Equal(t,
+		&store.Key{Namespace: "default", Kind: "Deployment", Name: "app"},
+		KeyFromPayload(action.Payload{"namespace": "default", "kind": "Deployment", "name": "app"}))
+}
+
+func TestDigest(t *testing.T) {
+	a := Digest(action.Payload{"a": 1, "b": 2})
+	b := Digest(action.Payload{"b": 2, "a": 1})
+	assert.Equal(t, a, b)
+	assert.True(t, strings.HasPrefix(a, "sha256:"))
+	assert.NotEqual(t, a, Digest(action.Payload{"a": 2}))
+}
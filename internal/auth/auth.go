@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package auth authenticates users when Octant is run as a shared server. Each authenticated
+// user's requests are made to the cluster using Kubernetes impersonation.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+const (
+	// PathPrefix is the path prefix for authentication routes such as login and logout.
+	PathPrefix = "/auth"
+	// SessionCookieName is the name of the cookie that holds a user's credential after they log in.
+	SessionCookieName = "octant-session"
+)
+
+// User is an authenticated user.
+type User struct {
+	Name   string
+	UID    string
+	Groups []string
+}
+
+// ImpersonationConfig returns the configuration used to make cluster requests as the user. The
+// user's UID is not impersonated since client-go does not support it.
+func (u User) ImpersonationConfig() rest.ImpersonationConfig {
+	return rest.ImpersonationConfig{
+		UserName: u.Name,
+		Groups:   append([]string(nil), u.Groups...),
+	}
+}
+
+// Authenticator authenticates HTTP requests.
+type Authenticator interface {
+	// Authenticate returns the user making the request. It returns false if the request
+	// does not contain credentials, and an error if the credentials are not valid.
+	Authenticate(r *http.Request) (*User, bool, error)
+	// Challenge responds to a request that does not have valid credentials.
+	Challenge(w http.ResponseWriter, r *http.Request)
+}
+
+// LoginHandler is an Authenticator that serves its own login routes under PathPrefix.
+type LoginHandler interface {
+	RegisterRoutes(router *mux.Router)
+}
+
+type userContextKey struct{}
+
+// WithUser returns a copy of ctx that carries user.
+func WithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFrom returns the user stored in ctx by WithUser.
+func UserFrom(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(User)
+	return user, ok
+}
+
+// Options configures the authenticator used in server mode. At most one authenticator can be configured.
+type Options struct {
+	// TokenFile is the path to a static bearer token file.
+	TokenFile string
+	// HtpasswdFile is the path to an htpasswd file with bcrypt password hashes.
+	HtpasswdFile string
+	// OIDC configures OpenID Connect authentication.
+	OIDC OIDCOptions
+}
+
+// New creates the authenticator configured in options. It returns nil if no authenticator is
+// configured, which means Octant runs in single user mode.
+func New(ctx context.Context, options Options) (Authenticator, error) {
+	var configured []string
+	if options.TokenFile != "" {
+		configured = append(configured, "token file")
+	}
+	if options.HtpasswdFile != "" {
+		configured = append(configured, "htpasswd")
+	}
+	if options.OIDC.IssuerURL != "" {
+		configured = append(configured, "oidc")
+	}
+
+	switch {
+	case len(configured) == 0:
+		return nil, nil
+	case len(configured) > 1:
+		return nil, fmt.Errorf("only one authenticator can be configured, found %s", strings.Join(configured, ", "))
+	case options.TokenFile != "":
+		return NewTokenFile(options.TokenFile)
+	case options.HtpasswdFile != "":
+		return NewHtpasswd(options.HtpasswdFile)
+	default:
+		return NewOIDC(ctx, options.OIDC)
+	}
+}
+
+// Middleware is a middleware that only accepts requests from authenticated users. The user is
+// stored in the request's context. Requests for routes under PathPrefix are not authenticated.
+func Middleware(ctx context.Context, authenticator Authenticator) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == PathPrefix || strings.HasPrefix(r.URL.Path, PathPrefix+"/") {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			user, ok, err := authenticator.Authenticate(r)
+			if err != nil {
+				logger := log.From(ctx)
+				logger.WithErr(err).Debugf("unable to authenticate request for %s", r.URL.Path)
+			}
+			if err != nil || !ok {
+				authenticator.Challenge(w, r)
+				return
+			}
+
+			h.ServeHTTP(w, r.WithContext(WithUser(r.Context(), *user)))
+		})
+	}
+}
+
+// RegisterRoutes registers the authentication routes for authenticator on router. A logout route
+// which removes the session cookie is always registered.
+func RegisterRoutes(router *mux.Router, authenticator Authenticator) {
+	s := router.PathPrefix(PathPrefix).Subrouter()
+
+	s.HandleFunc("/logout", func(w http.ResponseWriter, r *http.Request) {
+		setSessionCookie(w, r, "", time.Unix(0, 0))
+		http.Redirect(w, r, "/", http.StatusFound)
+	})
+
+	if loginHandler, ok := authenticator.(LoginHandler); ok {
+		loginHandler.RegisterRoutes(s)
+	}
+}
+
+// bearerToken returns the bearer token in the request's Authorization header or its session cookie.
+func bearerToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		parts := strings.SplitN(header, " ", 2)
+		if len(parts) == 2 && strings.EqualFold(parts[0], "bearer") {
+			return strings.TrimSpace(parts[1])
+		}
+		return ""
+	}
+
+	if cookie, err := r.Cookie(SessionCookieName); err == nil {
+		return cookie.Value
+	}
+
+	return ""
+}
+
+func setSessionCookie(w http.ResponseWriter, r *http.Request, value string, expires time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// challengeLogin redirects browsers to the login page. Other clients receive an unauthorized response.
+func challengeLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet && strings.Contains(r.Header.Get("Accept"), "text/html") {
+		loginURL := PathPrefix + "/login?then=" + url.QueryEscape(r.URL.RequestURI())
+		http.Redirect(w, r, loginURL, http.StatusFound)
+		return
+	}
+
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+}
+
+// redirectTarget returns a local path to redirect to after logging in.
+func redirectTarget(then string) string {
+	if !strings.HasPrefix(then, "/") || strings.HasPrefix(then, "//") || strings.HasPrefix(then, "/\\") {
+		return "/"
+	}
+	return then
+}
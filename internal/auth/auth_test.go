@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuthenticator struct {
+	user *User
+	ok   bool
+	err  error
+}
+
+func (f fakeAuthenticator) Authenticate(r *http.Request) (*User, bool, error) {
+	return f.user, f.ok, f.err
+}
+
+func (f fakeAuthenticator) Challenge(w http.ResponseWriter, r *http.Request) {
+	challengeLogin(w, r)
+}
+
+func TestMiddleware(t *testing.T) {
+	cases := []struct {
+		name          string
+		path          string
+		accept        string
+		authenticator fakeAuthenticator
+		expectedCode  int
+		expectedUser  string
+		location      string
+	}{
+		{
+			name:          "authenticated",
+			path:          "/api/v1/stream",
+			authenticator: fakeAuthenticator{user: &User{Name: "alice"}, ok: true},
+			expectedCode:  http.StatusOK,
+			expectedUser:  "alice",
+		},
+		{
+			name:          "no credentials",
+			path:          "/api/v1/stream",
+			authenticator: fakeAuthenticator{},
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "invalid credentials",
+			path:          "/api/v1/stream",
+			authenticator: fakeAuthenticator{err: errors.New("invalid")},
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "browser is redirected to login",
+			path:          "/overview?x=1",
+			accept:        "text/html,application/xhtml+xml",
+			authenticator: fakeAuthenticator{},
+			expectedCode:  http.StatusFound,
+			location:      "/auth/login?then=%2Foverview%3Fx%3D1",
+		},
+		{
+			name:          "auth routes are not authenticated",
+			path:          "/auth/login",
+			authenticator: fakeAuthenticator{},
+			expectedCode:  http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotUser string
+			handler := Middleware(context.Background(), tc.authenticator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, ok := UserFrom(r.Context()); ok {
+					gotUser = user.Name
+				}
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.Equal(t, tc.expectedUser, gotUser)
+			assert.Equal(t, tc.location, w.Header().Get("Location"))
+		})
+	}
+}
+
+func TestRegisterRoutes_logout(t *testing.T) {
+	router := mux.NewRouter()
+	RegisterRoutes(router, fakeAuthenticator{})
+
+	req := httptest.NewRequest(http.MethodGet, "/auth/logout", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusFound, w.Code)
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, SessionCookieName, cookies[0].Name)
+	assert.Equal(t, "", cookies[0].Value)
+}
+
+func TestNew(t *testing.T) {
+	authenticator, err := New(context.Background(), Options{})
+	require.NoError(t, err)
+	assert.Nil(t, authenticator)
+
+	_, err = New(context.Background(), Options{TokenFile: "tokens.csv", HtpasswdFile: "htpasswd"})
+	require.Error(t, err)
+}
+
+func Test_bearerToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Equal(t, "", bearerToken(req))
+
+	req.AddCookie(&http.Cookie{Name: SessionCookieName, Value: "cookie"})
+	assert.Equal(t, "cookie", bearerToken(req))
+
+	req.Header.Set("Authorization", "Bearer header")
+	assert.Equal(t, "header", bearerToken(req))
+
+	req.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	assert.Equal(t, "", bearerToken(req))
+}
+
+func Test_redirectTarget(t *testing.T) {
+	assert.Equal(t, "/overview", redirectTarget("/overview"))
+	assert.Equal(t, "/", redirectTarget(""))
+	assert.Equal(t, "/", redirectTarget("https://example.com"))
+	assert.Equal(t, "/", redirectTarget("//example.com"))
+	assert.Equal(t, "/", redirectTarget(`/\example.com`))
+}
+
+func TestUser_ImpersonationConfig(t *testing.T) {
+	user := User{Name: "alice", UID: "1", Groups: []string{"dev"}}
+	config := user.ImpersonationConfig()
+	assert.Equal(t, "alice", config.UserName)
+	assert.Equal(t, []string{"dev"}, config.Groups)
+}
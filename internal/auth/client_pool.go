@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+)
+
+type pooledClient struct {
+	base   cluster.ClientInterface
+	client cluster.ClientInterface
+}
+
+// ClientPool creates and reuses cluster clients that impersonate authenticated users.
+type ClientPool struct {
+	mu      sync.Mutex
+	clients map[string]pooledClient
+}
+
+// NewClientPool creates an instance of ClientPool.
+func NewClientPool() *ClientPool {
+	return &ClientPool{
+		clients: map[string]pooledClient{},
+	}
+}
+
+// ClientFor returns a client for user created from base. The client is reused until base changes,
+// e.g. when the current context changes. ctx must live as long as the pool, since the client's
+// resources are released when ctx is done.
+func (p *ClientPool) ClientFor(ctx context.Context, base cluster.ClientInterface, user User) (cluster.ClientInterface, error) {
+	impersonator, ok := base.(cluster.Impersonator)
+	if !ok {
+		return nil, fmt.Errorf("cluster client does not support impersonation")
+	}
+
+	key := poolKey(user)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pc, ok := p.clients[key]; ok {
+		if pc.base == base {
+			return pc.client, nil
+		}
+		pc.client.Close()
+		delete(p.clients, key)
+	}
+
+	client, err := impersonator.Impersonate(ctx, user.ImpersonationConfig())
+	if err != nil {
+		return nil, fmt.Errorf("create client for user %q: %w", user.Name, err)
+	}
+
+	p.clients[key] = pooledClient{base: base, client: client}
+	return client, nil
+}
+
+// Close closes all clients in the pool.
+func (p *ClientPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, pc := range p.clients {
+		pc.client.Close()
+		delete(p.clients, key)
+	}
+}
+
+func poolKey(user User) string {
+	groups := append([]string(nil), user.Groups...)
+	sort.Strings(groups)
+	return user.Name + "\x00" + strings.Join(groups, "\x00")
+}
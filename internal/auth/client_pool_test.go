@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+)
+
+type fakeImpersonator struct {
+	*clusterFake.MockClientInterface
+	impersonated []rest.ImpersonationConfig
+	newClient    func() cluster.ClientInterface
+}
+
+func (f *fakeImpersonator) Impersonate(_ context.Context, config rest.ImpersonationConfig) (cluster.ClientInterface, error) {
+	f.impersonated = append(f.impersonated, config)
+	return f.newClient(), nil
+}
+
+func TestClientPool_ClientFor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	newClient := func() cluster.ClientInterface {
+		client := clusterFake.NewMockClientInterface(controller)
+		client.EXPECT().Close().AnyTimes()
+		return client
+	}
+
+	base := &fakeImpersonator{MockClientInterface: clusterFake.NewMockClientInterface(controller), newClient: newClient}
+	pool := NewClientPool()
+	ctx := context.Background()
+
+	alice := User{Name: "alice", Groups: []string{"ops", "dev"}}
+
+	client1, err := pool.ClientFor(ctx, base, alice)
+	require.NoError(t, err)
+
+	client2, err := pool.ClientFor(ctx, base, User{Name: "alice", Groups: []string{"dev", "ops"}})
+	require.NoError(t, err)
+	assert.True(t, client1 == client2, "expected client to be reused")
+
+	bob, err := pool.ClientFor(ctx, base, User{Name: "bob"})
+	require.NoError(t, err)
+	assert.False(t, client1 == bob)
+
+	require.Len(t, base.impersonated, 2)
+	assert.Equal(t, rest.ImpersonationConfig{UserName: "alice", Groups: []string{"ops", "dev"}}, base.impersonated[0])
+
+	// a new base client, e.g. after the context changes, creates a new client
+	other := &fakeImpersonator{MockClientInterface: clusterFake.NewMockClientInterface(controller), newClient: newClient}
+	client3, err := pool.ClientFor(ctx, other, alice)
+	require.NoError(t, err)
+	assert.False(t, client1 == client3)
+
+	pool.Close()
+}
+
+func TestClientPool_ClientFor_notImpersonator(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pool := NewClientPool()
+	_, err := pool.ClientFor(context.Background(), clusterFake.NewMockClientInterface(controller), User{Name: "alice"})
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Htpasswd authenticates requests using HTTP basic authentication. Passwords are checked against
+// an htpasswd file. Only bcrypt hashes (htpasswd -B) are supported.
+type Htpasswd struct {
+	hashes map[string][]byte
+}
+
+var _ Authenticator = (*Htpasswd)(nil)
+
+// NewHtpasswd creates an instance of Htpasswd using the entries in path.
+func NewHtpasswd(path string) (*Htpasswd, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open htpasswd file: %w", err)
+	}
+	defer f.Close()
+
+	hashes, err := readHtpasswd(f)
+	if err != nil {
+		return nil, fmt.Errorf("read htpasswd file %s: %w", path, err)
+	}
+
+	return &Htpasswd{hashes: hashes}, nil
+}
+
+func readHtpasswd(r io.Reader) (map[string][]byte, error) {
+	hashes := map[string][]byte{}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(text, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("line %d: expected user:hash", line)
+		}
+
+		hash := parts[1]
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, fmt.Errorf("line %d: only bcrypt hashes are supported: %w", line, err)
+		}
+
+		hashes[parts[0]] = []byte(hash)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("no users found")
+	}
+
+	return hashes, nil
+}
+
+// Authenticate authenticates a request using its basic authentication credentials.
+func (h *Htpasswd) Authenticate(r *http.Request) (*User, bool, error) {
+	name, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, false, nil
+	}
+
+	hash, ok := h.hashes[name]
+	if !ok {
+		return nil, false, fmt.Errorf("unknown user %q", name)
+	}
+
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil {
+		return nil, false, fmt.Errorf("invalid password for user %q", name)
+	}
+
+	return &User{Name: name}, true, nil
+}
+
+// Challenge asks the client for basic authentication credentials.
+func (h *Htpasswd) Challenge(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="Octant", charset="UTF-8"`)
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+}
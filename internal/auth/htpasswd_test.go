@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHtpasswd_Authenticate(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "htpasswd")
+	require.NoError(t, ioutil.WriteFile(path, []byte(fmt.Sprintf("# users\nalice:%s\n", hash)), 0600))
+
+	htpasswd, err := NewHtpasswd(path)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		user     string
+		password string
+		noAuth   bool
+		ok       bool
+		isErr    bool
+	}{
+		{name: "valid password", user: "alice", password: "secret", ok: true},
+		{name: "invalid password", user: "alice", password: "wrong", isErr: true},
+		{name: "unknown user", user: "bob", password: "secret", isErr: true},
+		{name: "no credentials", noAuth: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if !tc.noAuth {
+				req.SetBasicAuth(tc.user, tc.password)
+			}
+
+			user, ok, err := htpasswd.Authenticate(req)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, &User{Name: tc.user}, user)
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	htpasswd.Challenge(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Header().Get("WWW-Authenticate"), "Basic")
+}
+
+func Test_readHtpasswd(t *testing.T) {
+	_, err := readHtpasswd(strings.NewReader("alice:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\n"))
+	require.Error(t, err)
+
+	_, err = readHtpasswd(strings.NewReader("alice\n"))
+	require.Error(t, err)
+
+	_, err = readHtpasswd(strings.NewReader(""))
+	require.Error(t, err)
+}
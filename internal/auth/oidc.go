@@ -0,0 +1,422 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/gorilla/mux"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+const (
+	// oidcStateCookieName is the cookie that holds the state of a login in progress.
+	oidcStateCookieName = "octant-oidc-state"
+	// oidcKeyRefreshInterval is the minimum time between fetches of the provider's signing keys.
+	oidcKeyRefreshInterval = time.Minute
+)
+
+// OIDCOptions configures OpenID Connect authentication.
+type OIDCOptions struct {
+	// IssuerURL is the URL of the provider. Its discovery document is served at
+	// IssuerURL/.well-known/openid-configuration.
+	IssuerURL string
+	// ClientID is the client ID Octant is registered with. ID tokens must have it as their audience.
+	ClientID string
+	// ClientSecret is the client secret used to exchange authorization codes for tokens.
+	ClientSecret string
+	// RedirectURL is the URL the provider redirects to after logging in. Defaults to
+	// /auth/callback on the host the login was started from.
+	RedirectURL string
+	// UsernameClaim is the claim used as the user name. Defaults to sub.
+	UsernameClaim string
+	// GroupsClaim is the claim used as the user's groups. Defaults to groups.
+	GroupsClaim string
+	// Scopes are the scopes requested when logging in. Defaults to openid, profile and email.
+	Scopes []string
+	// HTTPClient is the client used to talk to the provider. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+type oidcProvider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// OIDC authenticates requests using ID tokens issued by an OpenID Connect provider. Browsers log in
+// using the authorization code flow and the ID token is stored in the session cookie. Other clients
+// can send the ID token as a bearer token.
+type OIDC struct {
+	options  OIDCOptions
+	provider oidcProvider
+
+	mu          sync.RWMutex
+	keys        map[string]*rsa.PublicKey
+	keysFetched time.Time
+}
+
+var _ Authenticator = (*OIDC)(nil)
+var _ LoginHandler = (*OIDC)(nil)
+
+// NewOIDC creates an instance of OIDC. The provider's discovery document and signing keys are fetched.
+func NewOIDC(ctx context.Context, options OIDCOptions) (*OIDC, error) {
+	if options.IssuerURL == "" {
+		return nil, fmt.Errorf("oidc issuer url is blank")
+	}
+	if options.ClientID == "" {
+		return nil, fmt.Errorf("oidc client id is blank")
+	}
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = "sub"
+	}
+	if options.GroupsClaim == "" {
+		options.GroupsClaim = "groups"
+	}
+	if len(options.Scopes) == 0 {
+		options.Scopes = []string{"openid", "profile", "email"}
+	}
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+
+	o := &OIDC{options: options}
+
+	discoveryURL := strings.TrimSuffix(options.IssuerURL, "/") + "/.well-known/openid-configuration"
+	if err := o.getJSON(ctx, discoveryURL, &o.provider); err != nil {
+		return nil, fmt.Errorf("fetch oidc discovery document: %w", err)
+	}
+	if o.provider.Issuer != options.IssuerURL {
+		return nil, fmt.Errorf("oidc issuer %q does not match issuer url %q", o.provider.Issuer, options.IssuerURL)
+	}
+
+	if err := o.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// Authenticate authenticates a request using the ID token in its Authorization header or session cookie.
+func (o *OIDC) Authenticate(r *http.Request) (*User, bool, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, false, nil
+	}
+
+	user, _, err := o.verify(r.Context(), token)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return user, true, nil
+}
+
+// Challenge redirects browsers to the login page.
+func (o *OIDC) Challenge(w http.ResponseWriter, r *http.Request) {
+	challengeLogin(w, r)
+}
+
+// RegisterRoutes registers the routes for the authorization code flow.
+func (o *OIDC) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/login", o.handleLogin).Methods(http.MethodGet)
+	router.HandleFunc("/callback", o.handleCallback).Methods(http.MethodGet)
+}
+
+func (o *OIDC) handleLogin(w http.ResponseWriter, r *http.Request) {
+	state, err := randomString()
+	if err != nil {
+		http.Error(w, "unable to start login", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    state + "|" + redirectTarget(r.URL.Query().Get("then")),
+		Path:     PathPrefix,
+		Expires:  time.Now().Add(10 * time.Minute),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	values := url.Values{}
+	values.Set("response_type", "code")
+	values.Set("client_id", o.options.ClientID)
+	values.Set("redirect_uri", o.redirectURL(r))
+	values.Set("scope", strings.Join(o.options.Scopes, " "))
+	values.Set("state", state)
+
+	http.Redirect(w, r, o.provider.AuthorizationEndpoint+"?"+values.Encode(), http.StatusFound)
+}
+
+func (o *OIDC) handleCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if errorCode := query.Get("error"); errorCode != "" {
+		http.Error(w, fmt.Sprintf("login failed: %s", errorCode), http.StatusUnauthorized)
+		return
+	}
+
+	cookie, err := r.Cookie(oidcStateCookieName)
+	if err != nil {
+		http.Error(w, "login state is missing", http.StatusBadRequest)
+		return
+	}
+	parts := strings.SplitN(cookie.Value, "|", 2)
+	state := query.Get("state")
+	if len(parts) != 2 || state == "" || subtle.ConstantTimeCompare([]byte(parts[0]), []byte(state)) != 1 {
+		http.Error(w, "login state does not match", http.StatusBadRequest)
+		return
+	}
+
+	idToken, err := o.exchange(r, query.Get("code"))
+	if err != nil {
+		http.Error(w, "unable to exchange authorization code", http.StatusUnauthorized)
+		return
+	}
+
+	_, expires, err := o.verify(r.Context(), idToken)
+	if err != nil {
+		http.Error(w, "invalid id token", http.StatusUnauthorized)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:    oidcStateCookieName,
+		Path:    PathPrefix,
+		Expires: time.Unix(0, 0),
+	})
+	setSessionCookie(w, r, idToken, expires)
+	http.Redirect(w, r, redirectTarget(parts[1]), http.StatusFound)
+}
+
+func (o *OIDC) redirectURL(r *http.Request) string {
+	if o.options.RedirectURL != "" {
+		return o.options.RedirectURL
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s%s/callback", scheme, r.Host, PathPrefix)
+}
+
+// exchange exchanges an authorization code for an ID token.
+func (o *OIDC) exchange(r *http.Request, code string) (string, error) {
+	if code == "" {
+		return "", fmt.Errorf("authorization code is blank")
+	}
+
+	values := url.Values{}
+	values.Set("grant_type", "authorization_code")
+	values.Set("code", code)
+	values.Set("redirect_uri", o.redirectURL(r))
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, o.provider.TokenEndpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(o.options.ClientID), url.QueryEscape(o.options.ClientSecret))
+
+	resp, err := o.options.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+
+	var tokenResponse struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("decode token response: %w", err)
+	}
+	if tokenResponse.IDToken == "" {
+		return "", fmt.Errorf("token response does not contain an id token")
+	}
+
+	return tokenResponse.IDToken, nil
+}
+
+// verify verifies an ID token and returns its user and expiry.
+func (o *OIDC) verify(ctx context.Context, rawToken string) (*User, time.Time, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unsupported signing method %s", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return o.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("verify id token: %w", err)
+	}
+
+	if !claims.VerifyIssuer(o.provider.Issuer, true) {
+		return nil, time.Time{}, fmt.Errorf("id token issuer does not match")
+	}
+	if !hasAudience(claims["aud"], o.options.ClientID) {
+		return nil, time.Time{}, fmt.Errorf("id token audience does not include %q", o.options.ClientID)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("id token does not expire")
+	}
+
+	name, _ := claims[o.options.UsernameClaim].(string)
+	if name == "" {
+		return nil, time.Time{}, fmt.Errorf("id token claim %q is blank", o.options.UsernameClaim)
+	}
+
+	user := &User{Name: name}
+	user.UID, _ = claims["sub"].(string)
+
+	switch groups := claims[o.options.GroupsClaim].(type) {
+	case string:
+		user.Groups = []string{groups}
+	case []interface{}:
+		for _, group := range groups {
+			if s, ok := group.(string); ok {
+				user.Groups = append(user.Groups, s)
+			}
+		}
+	}
+
+	return user, time.Unix(int64(exp), 0), nil
+}
+
+func hasAudience(aud interface{}, clientID string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == clientID
+	case []interface{}:
+		for _, a := range aud {
+			if a == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// key returns the signing key with id kid. The provider's keys are fetched again if the key
+// is unknown, since providers rotate their keys.
+func (o *OIDC) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	o.mu.RLock()
+	key, ok := o.keys[kid]
+	fetched := o.keysFetched
+	o.mu.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	if time.Since(fetched) > oidcKeyRefreshInterval {
+		if err := o.refreshKeys(ctx); err != nil {
+			return nil, err
+		}
+
+		o.mu.RLock()
+		key, ok = o.keys[kid]
+		o.mu.RUnlock()
+		if ok {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (o *OIDC) refreshKeys(ctx context.Context) error {
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := o.getJSON(ctx, o.provider.JWKSURI, &keySet); err != nil {
+		return fmt.Errorf("fetch oidc signing keys: %w", err)
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range keySet.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return fmt.Errorf("decode modulus of key %q: %w", jwk.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return fmt.Errorf("decode exponent of key %q: %w", jwk.Kid, err)
+		}
+
+		keys[jwk.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.keys = keys
+	o.keysFetched = time.Now()
+
+	return nil
+}
+
+func (o *OIDC) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.options.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", u, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// testIdP is a stand-in OpenID Connect provider.
+type testIdP struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+	codes  map[string]string
+}
+
+func newTestIdP(t *testing.T) *testIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &testIdP{key: key, kid: "key-1", codes: map[string]string{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kid": idp.kid,
+					"kty": "RSA",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, _ := r.BasicAuth()
+		idToken, ok := idp.codes[r.PostFormValue("code")]
+		if !ok || clientID != "octant" || clientSecret != "secret" {
+			http.Error(w, "invalid grant", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+	})
+
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (idp *testIdP) token(t *testing.T, claims jwt.MapClaims) string {
+	base := jwt.MapClaims{
+		"iss":    idp.server.URL,
+		"aud":    "octant",
+		"sub":    "1234",
+		"email":  "alice@example.com",
+		"groups": []string{"dev"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(base, k)
+			continue
+		}
+		base[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, base)
+	token.Header["kid"] = idp.kid
+
+	signed, err := token.SignedString(idp.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func newTestOIDC(t *testing.T, idp *testIdP) *OIDC {
+	o, err := NewOIDC(context.Background(), OIDCOptions{
+		IssuerURL:     idp.server.URL,
+		ClientID:      "octant",
+		ClientSecret:  "secret",
+		UsernameClaim: "email",
+	})
+	require.NoError(t, err)
+	return o
+}
+
+func TestOIDC_Authenticate(t *testing.T) {
+	idp := newTestIdP(t)
+	o := newTestOIDC(t, idp)
+
+	cases := []struct {
+		name     string
+		claims   jwt.MapClaims
+		expected *User
+		isErr    bool
+	}{
+		{
+			name:     "valid token",
+			expected: &User{Name: "alice@example.com", UID: "1234", Groups: []string{"dev"}},
+		},
+		{
+			name:     "audience list",
+			claims:   jwt.MapClaims{"aud": []string{"other", "octant"}},
+			expected: &User{Name: "alice@example.com", UID: "1234", Groups: []string{"dev"}},
+		},
+		{
+			name:   "expired",
+			claims: jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()},
+			isErr:  true,
+		},
+		{
+			name:   "no expiry",
+			claims: jwt.MapClaims{"exp": nil},
+			isErr:  true,
+		},
+		{
+			name:   "wrong audience",
+			claims: jwt.MapClaims{"aud": "other"},
+			isErr:  true,
+		},
+		{
+			name:   "wrong issuer",
+			claims: jwt.MapClaims{"iss": "https://example.com"},
+			isErr:  true,
+		},
+		{
+			name:   "missing user name",
+			claims: jwt.MapClaims{"email": nil},
+			isErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+idp.token(t, tc.claims))
+
+			user, ok, err := o.Authenticate(req)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, user)
+		})
+	}
+}
+
+func TestOIDC_Authenticate_unknownKey(t *testing.T) {
+	idp := newTestIdP(t)
+	o := newTestOIDC(t, idp)
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   idp.server.URL,
+		"aud":   "octant",
+		"email": "mallory@example.com",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "key-2"
+	signed, err := token.SignedString(other)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+signed)
+
+	_, _, err = o.Authenticate(req)
+	require.Error(t, err)
+}
+
+func TestOIDC_login(t *testing.T) {
+	idp := newTestIdP(t)
+	o := newTestOIDC(t, idp)
+
+	router := mux.NewRouter()
+	RegisterRoutes(router, o)
+
+	req := httptest.NewRequest(http.MethodGet, "http://octant.example.com/auth/login?then=/overview", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusFound, w.Code)
+
+	location, err := url.Parse(w.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(location.String(), idp.server.URL+"/authorize"))
+	assert.Equal(t, "octant", location.Query().Get("client_id"))
+	assert.Equal(t, "http://octant.example.com/auth/callback", location.Query().Get("redirect_uri"))
+
+	state := location.Query().Get("state")
+	require.NotEmpty(t, state)
+	stateCookies := w.Result().Cookies()
+	require.Len(t, stateCookies, 1)
+
+	idToken := idp.token(t, nil)
+	idp.codes["code-1"] = idToken
+
+	t.Run("state mismatch", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://octant.example.com/auth/callback?code=code-1&state=other", nil)
+		req.AddCookie(stateCookies[0])
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://octant.example.com/auth/callback?code=code-2&state="+state, nil)
+		req.AddCookie(stateCookies[0])
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("logged in", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://octant.example.com/auth/callback?code=code-1&state="+state, nil)
+		req.AddCookie(stateCookies[0])
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "/overview", w.Header().Get("Location"))
+
+		var session *http.Cookie
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == SessionCookieName {
+				session = cookie
+			}
+		}
+		require.NotNil(t, session)
+		assert.Equal(t, idToken, session.Value)
+
+		req = httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(session)
+		user, ok, err := o.Authenticate(req)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "alice@example.com", user.Name)
+	})
+}
+
+func TestNewOIDC_issuerMismatch(t *testing.T) {
+	idp := newTestIdP(t)
+
+	_, err := NewOIDC(context.Background(), OIDCOptions{
+		IssuerURL: idp.server.URL + "/",
+		ClientID:  "octant",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"crypto/subtle"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// tokenSessionDuration is how long a token entered on the login page is kept in the session cookie.
+const tokenSessionDuration = 12 * time.Hour
+
+// TokenFile authenticates requests using static bearer tokens. The file uses the format of the
+// Kubernetes API server's --token-auth-file flag: token,user,uid,"group1,group2".
+type TokenFile struct {
+	tokens map[string]User
+}
+
+var _ Authenticator = (*TokenFile)(nil)
+var _ LoginHandler = (*TokenFile)(nil)
+
+// NewTokenFile creates an instance of TokenFile using the tokens in path.
+func NewTokenFile(path string) (*TokenFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open token file: %w", err)
+	}
+	defer f.Close()
+
+	tokens, err := readTokens(f)
+	if err != nil {
+		return nil, fmt.Errorf("read token file %s: %w", path, err)
+	}
+
+	return &TokenFile{tokens: tokens}, nil
+}
+
+func readTokens(r io.Reader) (map[string]User, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	tokens := map[string]User{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) < 3 {
+			return nil, fmt.Errorf("line %d: expected at least 3 columns, found %d", line, len(record))
+		}
+
+		token := strings.TrimSpace(record[0])
+		if token == "" {
+			return nil, fmt.Errorf("line %d: token is blank", line)
+		}
+		if _, ok := tokens[token]; ok {
+			return nil, fmt.Errorf("line %d: token is listed more than once", line)
+		}
+
+		user := User{
+			Name: strings.TrimSpace(record[1]),
+			UID:  strings.TrimSpace(record[2]),
+		}
+		if user.Name == "" {
+			return nil, fmt.Errorf("line %d: user name is blank", line)
+		}
+		if len(record) > 3 {
+			for _, group := range strings.Split(record[3], ",") {
+				if group = strings.TrimSpace(group); group != "" {
+					user.Groups = append(user.Groups, group)
+				}
+			}
+		}
+
+		tokens[token] = user
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no tokens found")
+	}
+
+	return tokens, nil
+}
+
+// Authenticate authenticates a request using the bearer token in its Authorization header or session cookie.
+func (t *TokenFile) Authenticate(r *http.Request) (*User, bool, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, false, nil
+	}
+
+	user, ok := t.lookup(token)
+	if !ok {
+		return nil, false, fmt.Errorf("invalid bearer token")
+	}
+
+	return &user, true, nil
+}
+
+func (t *TokenFile) lookup(token string) (User, bool) {
+	for candidate, user := range t.tokens {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			return user, true
+		}
+	}
+	return User{}, false
+}
+
+// Challenge redirects browsers to the token login page.
+func (t *TokenFile) Challenge(w http.ResponseWriter, r *http.Request) {
+	challengeLogin(w, r)
+}
+
+var tokenLoginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Octant</title></head>
+<body>
+<form method="post" action="login">
+  <input type="hidden" name="then" value="{{ .Then }}">
+  <label for="token">Token</label>
+  <input type="password" id="token" name="token" autofocus>
+  <button type="submit">Log in</button>
+  {{ if .Failed }}<p>The token is not valid.</p>{{ end }}
+</form>
+</body>
+</html>
+`))
+
+// RegisterRoutes registers a login page where a token can be entered. The token is stored in
+// the session cookie.
+func (t *TokenFile) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		then := redirectTarget(r.FormValue("then"))
+
+		if r.Method == http.MethodPost {
+			if _, ok := t.lookup(r.PostFormValue("token")); ok {
+				setSessionCookie(w, r, r.PostFormValue("token"), time.Now().Add(tokenSessionDuration))
+				http.Redirect(w, r, then, http.StatusFound)
+				return
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = tokenLoginTemplate.Execute(w, struct {
+			Then   string
+			Failed bool
+		}{then, r.Method == http.MethodPost})
+	}).Methods(http.MethodGet, http.MethodPost)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package auth
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTokens = `# token,user,uid,groups
+token1,alice,1,"dev,ops"
+token2,bob,2
+`
+
+func newTestTokenFile(t *testing.T) *TokenFile {
+	path := filepath.Join(t.TempDir(), "tokens.csv")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testTokens), 0600))
+
+	tokenFile, err := NewTokenFile(path)
+	require.NoError(t, err)
+	return tokenFile
+}
+
+func TestTokenFile_Authenticate(t *testing.T) {
+	tokenFile := newTestTokenFile(t)
+
+	cases := []struct {
+		name          string
+		authorization string
+		expected      *User
+		ok            bool
+		isErr         bool
+	}{
+		{
+			name:          "user with groups",
+			authorization: "Bearer token1",
+			expected:      &User{Name: "alice", UID: "1", Groups: []string{"dev", "ops"}},
+			ok:            true,
+		},
+		{
+			name:          "user without groups",
+			authorization: "Bearer token2",
+			expected:      &User{Name: "bob", UID: "2"},
+			ok:            true,
+		},
+		{
+			name:          "unknown token",
+			authorization: "Bearer token3",
+			isErr:         true,
+		},
+		{
+			name: "no token",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			user, ok, err := tokenFile.Authenticate(req)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, user)
+		})
+	}
+}
+
+func Test_readTokens(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{name: "missing columns", input: "token1,alice\n"},
+		{name: "blank user", input: "token1,,1\n"},
+		{name: "duplicate token", input: "token1,alice,1\ntoken1,bob,2\n"},
+		{name: "empty", input: "# no tokens\n"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := readTokens(strings.NewReader(tc.input))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestTokenFile_login(t *testing.T) {
+	tokenFile := newTestTokenFile(t)
+	router := mux.NewRouter()
+	RegisterRoutes(router, tokenFile)
+
+	form := url.Values{"token": {"token1"}, "then": {"/overview"}}
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "/overview", w.Header().Get("Location"))
+
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "token1", cookies[0].Value)
+
+	form.Set("token", "invalid")
+	req = httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Empty(t, w.Result().Cookies())
+}
@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/transport"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
@@ -69,6 +70,7 @@ type Cluster struct {
 }
 
 var _ clusterTypes.ClientInterface = (*Cluster)(nil)
+var _ clusterTypes.Impersonator = (*Cluster)(nil)
 
 func newCluster(ctx context.Context, clientConfig clientcmd.ClientConfig, restClient *rest.Config, defaultNamespace string, providedNamespaces []string) (*Cluster, error) {
 	logger := internalLog.From(ctx).With("component", "cluster client")
@@ -223,10 +225,25 @@ func (c *Cluster) Version() (string, error) {
 	return fmt.Sprint(serverVersion), nil
 }
 
+// Impersonate creates a client that makes requests as the user in config. The client shares the
+// rest configuration of c, so it is authorized by the cluster using the impersonated user's RBAC.
+func (c *Cluster) Impersonate(ctx context.Context, config rest.ImpersonationConfig) (clusterTypes.ClientInterface, error) {
+	if config.UserName == "" {
+		return nil, errors.New("impersonated user name is blank")
+	}
+
+	restConfig := rest.CopyConfig(c.restConfig)
+	restConfig.Impersonate = config
+
+	return newCluster(ctx, c.clientConfig, restConfig, c.defaultNamespace, c.providedNamespaces)
+}
+
 type clusterOptions struct {
 	InitialNamespace   string
 	ProvidedNamespaces []string
 	RESTConfigOptions  clusterTypes.RESTConfigOptions
+	// UnauthorizedHandler is called when the API server responds with 401 Unauthorized.
+	UnauthorizedHandler func()
 }
 
 type ClusterOption func(*clusterOptions)
@@ -300,6 +317,9 @@ func FromClientConfig(
 		Debugf("initializing REST client configuration")
 
 	restConfig = withConfigDefaults(restConfig, options.RESTConfigOptions)
+	if options.UnauthorizedHandler != nil {
+		restConfig.WrapTransport = transport.Wrappers(restConfig.WrapTransport, unauthorizedWrapper(options.UnauthorizedHandler))
+	}
 
 	return newCluster(ctx, clientConfig, restConfig, defaultNamespace, options.ProvidedNamespaces)
 }
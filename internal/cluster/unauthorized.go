@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"net/http"
+
+	"k8s.io/client-go/transport"
+)
+
+// WithUnauthorizedHandler sets a function which is called when the API server responds
+// with 401 Unauthorized. It is used to find out when credentials have expired.
+func WithUnauthorizedHandler(fn func()) ClusterOption {
+	return func(clusterOptions *clusterOptions) {
+		clusterOptions.UnauthorizedHandler = fn
+	}
+}
+
+// unauthorizedRoundTripper calls onUnauthorized for responses with status 401.
+type unauthorizedRoundTripper struct {
+	delegate       http.RoundTripper
+	onUnauthorized func()
+}
+
+var _ http.RoundTripper = (*unauthorizedRoundTripper)(nil)
+
+func (rt *unauthorizedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.delegate.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		rt.onUnauthorized()
+	}
+	return resp, err
+}
+
+// WrappedRoundTripper returns the wrapped round tripper.
+func (rt *unauthorizedRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.delegate
+}
+
+func unauthorizedWrapper(onUnauthorized func()) transport.WrapperFunc {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &unauthorizedRoundTripper{delegate: rt, onUnauthorized: onUnauthorized}
+	}
+}
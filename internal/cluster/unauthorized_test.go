@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_unauthorizedRoundTripper(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	calls := 0
+	client := &http.Client{
+		Transport: unauthorizedWrapper(func() { calls++ })(http.DefaultTransport),
+	}
+
+	for _, status = range []int{http.StatusOK, http.StatusForbidden, http.StatusUnauthorized} {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, status, resp.StatusCode)
+	}
+
+	assert.Equal(t, 1, calls)
+}
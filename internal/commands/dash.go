@@ -20,6 +20,7 @@ import (
 	"k8s.io/klog"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/dash"
@@ -102,6 +103,28 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 				if file := viper.GetString("memstats"); file != "" {
 					options = append(options, dash.WithMemStats())
 				}
+				if viper.GetBool("read-only") {
+					options = append(options, dash.WithReadOnly())
+				}
+				if contexts := viper.GetStringSlice("read-only-contexts"); len(contexts) > 0 {
+					options = append(options, dash.WithReadOnlyContexts(contexts))
+				}
+				if contexts := viper.GetStringSlice("fleet-contexts"); len(contexts) > 0 {
+					options = append(options, dash.WithFleetContexts(contexts))
+				}
+				if auditLogFile := viper.GetString("audit-log-file"); auditLogFile != "" {
+					options = append(options, dash.WithAuditLog(auditLogFile, viper.GetInt("audit-log-max-size"), viper.GetInt("audit-log-max-backups")))
+				}
+
+				authenticator, err := auth.New(ctx, authOptions())
+				if err != nil {
+					golog.Printf("unable to configure authentication: %v", err)
+					os.Exit(1)
+				}
+				if authenticator != nil {
+					logger.Infof("running in server mode, users are authenticated and impersonated")
+					options = append(options, dash.WithAuthenticator(authenticator))
+				}
 
 				klogVerbosity := viper.GetString("klog-verbosity")
 				var klogOpts []string
@@ -160,17 +183,38 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	octantCmd.Flags().StringP("namespace", "n", "", "initial namespace")
 	octantCmd.Flags().StringSlice("namespace-list", []string{}, "a list of namespaces to use on start")
 	octantCmd.Flags().StringP("plugin-path", "", "", "plugin path")
+	octantCmd.Flags().StringSlice("plugin-fetch-allow-list", []string{}, "hosts JavaScript plugins are allowed to request with fetch (globs, fetch is refused if empty); httpClient is also restricted to these hosts when set")
+	octantCmd.Flags().Bool("read-only", false, "refuse every action which changes the cluster")
+	octantCmd.Flags().StringSlice("read-only-contexts", []string{}, "refuse actions which change the cluster while using a kube context matching one of these globs")
+	octantCmd.Flags().StringSlice("fleet-contexts", []string{}, "load kube contexts matching one of these globs into the fleet overview at startup")
+	octantCmd.Flags().String("audit-log-file", "", "write an audit log of actions which change the cluster to this file as JSON lines")
+	octantCmd.Flags().Int("audit-log-max-size", 0, "rotate the audit log file when it grows past this size in megabytes (0 disables rotation)")
+	octantCmd.Flags().Int("audit-log-max-backups", 5, "number of rotated audit log files to keep")
 	octantCmd.Flags().BoolP("verbose", "v", false, "turn on debug logging")
+	octantCmd.Flags().String("auth-token-file", "", "server mode: authenticate users with the static bearer tokens in this file (token,user,uid,\"group1,group2\")")
+	octantCmd.Flags().String("auth-htpasswd-file", "", "server mode: authenticate users with basic authentication using this htpasswd file (bcrypt only)")
+	octantCmd.Flags().String("auth-oidc-issuer-url", "", "server mode: authenticate users with this OpenID Connect issuer")
+	octantCmd.Flags().String("auth-oidc-client-id", "", "OpenID Connect client ID")
+	octantCmd.Flags().String("auth-oidc-client-secret", "", "OpenID Connect client secret")
+	octantCmd.Flags().String("auth-oidc-redirect-url", "", "OpenID Connect redirect URL (defaults to /auth/callback on the requested host)")
+	octantCmd.Flags().String("auth-oidc-username-claim", "sub", "OpenID Connect claim used as the user name")
+	octantCmd.Flags().String("auth-oidc-groups-claim", "groups", "OpenID Connect claim used as the user's groups")
+	octantCmd.Flags().StringP("listener-addr", "", "", "listener address for the octant frontend, host:port or unix:///path/to/socket")
+	octantCmd.Flags().String("listener-socket-mode", "0600", "file mode of the unix socket listener")
+	octantCmd.Flags().String("tls-cert-file", "", "serve the dashboard over TLS with this certificate file")
+	octantCmd.Flags().String("tls-key-file", "", "private key file for --tls-cert-file")
+	octantCmd.Flags().Bool("tls-self-signed", false, "serve the dashboard over TLS with a self-signed certificate stored in the octant config directory")
+	octantCmd.Flags().String("tls-client-ca-file", "", "require client certificates signed by a CA in this file (mutual TLS)")
 	octantCmd.Flags().IntP("client-max-recv-msg-size", "", papi.MaxMessageSize, "client max receiver message size")
 
 	octantCmd.Flags().StringP("accepted-hosts", "", "", "accepted hosts list [DEV]")
 	octantCmd.Flags().Float32P("client-qps", "", 200, "maximum QPS for client [DEV]")
 	octantCmd.Flags().IntP("client-burst", "", 400, "maximum burst for client throttle [DEV]")
+	octantCmd.Flags().Bool("dev-mode", false, "reject plugin content which doesn't match the component schema instead of logging a warning [DEV]")
 	octantCmd.Flags().BoolP("disable-open-browser", "", false, "disable automatic launching of the browser [DEV]")
 	octantCmd.Flags().BoolP("disable-origin-check", "", false, "disable cross origin resource check")
 	octantCmd.Flags().BoolP("enable-opencensus", "c", false, "enable open census [DEV]")
 	octantCmd.Flags().IntP("klog-verbosity", "", 0, "klog verbosity level [DEV]")
-	octantCmd.Flags().StringP("listener-addr", "", "", "listener address for the octant frontend [DEV]")
 	octantCmd.Flags().StringP("local-content", "", "", "local content path [DEV]")
 	octantCmd.Flags().StringP("proxy-frontend", "", "", "url to send frontend request to [DEV]")
 	octantCmd.Flags().String("ui-url", "", "dashboard url [DEV]")
@@ -181,6 +225,22 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	return octantCmd
 }
 
+// authOptions returns the server mode authentication options set by flags.
+func authOptions() auth.Options {
+	return auth.Options{
+		TokenFile:    viper.GetString("auth-token-file"),
+		HtpasswdFile: viper.GetString("auth-htpasswd-file"),
+		OIDC: auth.OIDCOptions{
+			IssuerURL:     viper.GetString("auth-oidc-issuer-url"),
+			ClientID:      viper.GetString("auth-oidc-client-id"),
+			ClientSecret:  viper.GetString("auth-oidc-client-secret"),
+			RedirectURL:   viper.GetString("auth-oidc-redirect-url"),
+			UsernameClaim: viper.GetString("auth-oidc-username-claim"),
+			GroupsClaim:   viper.GetString("auth-oidc-groups-claim"),
+		},
+	}
+}
+
 func bindViper(cmd *cobra.Command) error {
 	replacer := strings.NewReplacer("-", "_")
 	viper.SetEnvKeyReplacer(replacer)
@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/harness"
+	"github.com/vmware-tanzu/octant/pkg/plugin/scaffold"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func newPluginCmd() *cobra.Command {
+	pluginCmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Create and test plugins",
+		Long:  "Tools for creating Octant plugins and testing them without a cluster",
+	}
+
+	pluginCmd.AddCommand(newPluginInitCmd())
+	pluginCmd.AddCommand(newPluginValidateCmd())
+	pluginCmd.AddCommand(newPluginRenderCmd())
+
+	return pluginCmd
+}
+
+func newPluginInitCmd() *cobra.Command {
+	var language, dir, description string
+
+	initCmd := &cobra.Command{
+		Use:   "init NAME",
+		Short: "Create a new plugin",
+		Long:  "Create the source for a new Go or TypeScript plugin with print, tab and module handlers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			lang, err := scaffold.ParseLanguage(language)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			if dir == "" {
+				dir = name
+			}
+
+			files, err := scaffold.Generate(scaffold.Options{
+				Name:        name,
+				Description: description,
+				Language:    lang,
+				Dir:         dir,
+			})
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			for _, file := range files {
+				fmt.Fprintln(out, "created", file)
+			}
+			return nil
+		},
+	}
+
+	initCmd.Flags().StringVarP(&language, "lang", "l", string(scaffold.Go), "plugin language (go or ts)")
+	initCmd.Flags().StringVarP(&dir, "dir", "d", "", "directory to create the plugin in (defaults to NAME)")
+	initCmd.Flags().StringVar(&description, "description", "", "plugin description")
+
+	return initCmd
+}
+
+func newPluginValidateCmd() *cobra.Command {
+	validateCmd := &cobra.Command{
+		Use:   "validate PLUGIN",
+		Short: "Check a plugin's metadata",
+		Long:  "Load a plugin binary or JavaScript file, register it and check the capabilities it reports",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			h, err := harness.Load(ctx, pluginPath(args[0]))
+			if err != nil {
+				return err
+			}
+			defer h.Close()
+
+			metadata := h.Metadata()
+			if err := h.Validate(ctx); err != nil {
+				return fmt.Errorf("plugin %q is not valid: %w", metadata.Name, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "plugin %q is valid\n", metadata.Name)
+			return nil
+		},
+	}
+
+	return validateCmd
+}
+
+// pluginRenderObject is the output of a plugin's handlers for an object.
+type pluginRenderObject struct {
+	Object       string                       `json:"object"`
+	Print        *plugin.PrintResponse        `json:"print,omitempty"`
+	Tabs         []plugin.TabResponse         `json:"tabs,omitempty"`
+	ObjectStatus *plugin.ObjectStatusResponse `json:"objectStatus,omitempty"`
+	ListColumns  *plugin.ListColumnsResponse  `json:"listColumns,omitempty"`
+}
+
+// pluginRenderOutput is the output of the plugin render command.
+type pluginRenderOutput struct {
+	Metadata   plugin.Metadata            `json:"metadata"`
+	Objects    []pluginRenderObject       `json:"objects,omitempty"`
+	Navigation *navigation.Navigation     `json:"navigation,omitempty"`
+	Content    *component.ContentResponse `json:"content,omitempty"`
+}
+
+func newPluginRenderCmd() *cobra.Command {
+	var filenames, storeFilenames []string
+	var contentPath string
+
+	renderCmd := &cobra.Command{
+		Use:   "render PLUGIN",
+		Short: "Render a plugin's output without a cluster",
+		Long: `Run a plugin's print, tab, object status and list column handlers against objects read from YAML, or
+its content handler against a content path, and write the resulting components as JSON.
+Objects passed with --store are available to the plugin but are not rendered.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if len(filenames) == 0 && !cmd.Flags().Changed("content-path") {
+				return fmt.Errorf("at least one of --filename or --content-path is required")
+			}
+
+			objects, err := readObjectFiles(filenames)
+			if err != nil {
+				return err
+			}
+			storeObjects, err := readObjectFiles(storeFilenames)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			s := harness.NewMemoryStore(append(storeObjects, objects...)...)
+			h, err := harness.Load(ctx, pluginPath(args[0]), harness.WithStore(s))
+			if err != nil {
+				return err
+			}
+			defer h.Close()
+
+			output, err := renderPlugin(ctx, h, objects, contentPath, cmd.Flags().Changed("content-path"))
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(output)
+		},
+	}
+
+	renderCmd.Flags().StringSliceVarP(&filenames, "filename", "f", nil, "YAML file containing objects to render")
+	renderCmd.Flags().StringSliceVar(&storeFilenames, "store", nil, "YAML file containing additional objects the plugin can read")
+	renderCmd.Flags().StringVar(&contentPath, "content-path", "", "content path to render for module plugins")
+
+	return renderCmd
+}
+
+// renderPlugin calls the handlers a plugin reports support for in its capabilities.
+func renderPlugin(ctx context.Context, h *harness.Harness, objects []*unstructured.Unstructured, contentPath string, renderContent bool) (pluginRenderOutput, error) {
+	metadata := h.Metadata()
+	capabilities := metadata.Capabilities
+	output := pluginRenderOutput{Metadata: metadata}
+
+	for _, object := range objects {
+		gvk := object.GroupVersionKind()
+		result := pluginRenderObject{
+			Object: fmt.Sprintf("%s %s", gvk.Kind, object.GetName()),
+		}
+		if ns := object.GetNamespace(); ns != "" {
+			result.Object = fmt.Sprintf("%s %s/%s", gvk.Kind, ns, object.GetName())
+		}
+
+		if capabilities.HasPrinterSupport(gvk) {
+			printResponse, err := h.Print(ctx, object)
+			if err != nil {
+				return output, fmt.Errorf("print %s: %w", result.Object, err)
+			}
+			result.Print = &printResponse
+		}
+
+		if capabilities.HasTabSupport(gvk) {
+			tabs, err := h.PrintTabs(ctx, object)
+			if err != nil {
+				return output, fmt.Errorf("print tabs %s: %w", result.Object, err)
+			}
+			result.Tabs = tabs
+		}
+
+		if capabilities.HasObjectStatusSupport(gvk) {
+			status, err := h.ObjectStatus(ctx, object)
+			if err != nil {
+				return output, fmt.Errorf("object status %s: %w", result.Object, err)
+			}
+			result.ObjectStatus = &status
+		}
+
+		if capabilities.HasListColumnsSupport(gvk) {
+			listColumns, err := h.ListColumns(ctx, object)
+			if err != nil {
+				return output, fmt.Errorf("list columns %s: %w", result.Object, err)
+			}
+			result.ListColumns = &listColumns
+		}
+
+		output.Objects = append(output.Objects, result)
+	}
+
+	if renderContent {
+		nav, err := h.Navigation(ctx)
+		if err != nil {
+			return output, fmt.Errorf("navigation: %w", err)
+		}
+		output.Navigation = &nav
+
+		content, err := h.Content(ctx, contentPath)
+		if err != nil {
+			return output, fmt.Errorf("content %q: %w", contentPath, err)
+		}
+		output.Content = &content
+	}
+
+	return output, nil
+}
+
+func readObjectFiles(filenames []string) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	for _, filename := range filenames {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := harness.ReadObjects(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", filename, err)
+		}
+		objects = append(objects, list...)
+	}
+	return objects, nil
+}
+
+// pluginPath makes relative plugin paths absolute so Go plugins are not looked up in PATH.
+func pluginPath(p string) string {
+	if abs, err := filepath.Abs(p); err == nil {
+		return abs
+	}
+	return p
+}
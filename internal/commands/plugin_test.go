@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package commands
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+func Test_pluginRender(t *testing.T) {
+	testdata := filepath.Join("..", "..", "pkg", "plugin", "harness", "testdata")
+
+	var out bytes.Buffer
+	cmd := newPluginCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{
+		"render", filepath.Join(testdata, "plugin.js"),
+		"-f", filepath.Join(testdata, "objects.yaml"),
+		"--content-path", "/nested",
+	})
+	require.NoError(t, cmd.Execute())
+
+	var got struct {
+		Objects []struct {
+			Object string
+			Print  map[string]interface{}
+			Tabs   []interface{}
+		}
+		Navigation map[string]interface{}
+		Content    map[string]interface{}
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+
+	require.Len(t, got.Objects, 2)
+	require.Equal(t, "ConfigMap default/owner", got.Objects[0].Object)
+	require.Nil(t, got.Objects[0].Print)
+	require.Equal(t, "Pod default/pod", got.Objects[1].Object)
+	require.NotNil(t, got.Objects[1].Print)
+	require.Len(t, got.Objects[1].Tabs, 1)
+	require.Equal(t, "Test Plugin", got.Navigation["title"])
+	require.NotNil(t, got.Content)
+}
+
+func Test_pluginValidate(t *testing.T) {
+	var out bytes.Buffer
+	cmd := newPluginCmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"validate", filepath.Join("..", "..", "pkg", "plugin", "harness", "testdata", "plugin.js")})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "plugin \"test-plugin\" is valid\n", out.String())
+}
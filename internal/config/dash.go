@@ -9,8 +9,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
+	"github.com/vmware-tanzu/octant/internal/apidiscovery"
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/fleet"
 	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/metricshistory"
+	"github.com/vmware-tanzu/octant/internal/notification"
 	"github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
@@ -31,6 +37,10 @@ type KubeContextDecorator interface {
 	ClusterClient() cluster.ClientInterface
 	CurrentContext() string
 	Contexts() []kubeconfig.Context
+	KubeConfigEditor() *kubeconfig.Editor
+	Reload() (string, bool, error)
+	RefreshCredentials(context.Context) error
+	CredentialStatus() kubeconfig.CredentialStatus
 }
 
 func StaticClusterClient(client cluster.ClientInterface) *staticClusterClient {
@@ -56,6 +66,18 @@ func (scc *staticClusterClient) CurrentContext() string {
 func (scc *staticClusterClient) Contexts() []kubeconfig.Context {
 	return nil
 }
+func (scc *staticClusterClient) KubeConfigEditor() *kubeconfig.Editor {
+	return nil
+}
+func (scc *staticClusterClient) Reload() (string, bool, error) {
+	return "", false, nil
+}
+func (scc *staticClusterClient) RefreshCredentials(ctx context.Context) error {
+	return nil
+}
+func (scc *staticClusterClient) CredentialStatus() kubeconfig.CredentialStatus {
+	return kubeconfig.CredentialsValid
+}
 
 // UseFSContext is used to indicate a context switch to the file system Kubeconfig context
 const UseFSContext = ""
@@ -74,6 +96,15 @@ type Live struct {
 	buildInfo            config.BuildInfo
 	kubeConfigPath       string
 	contextChosenInUI    bool
+	readOnlyPolicy       *ReadOnlyPolicy
+	auditLog             *audit.Log
+	metricsHistory       *metricshistory.History
+	notifications        *notification.Manager
+	fleet                *fleet.Fleet
+
+	schemaLoaderMu     sync.Mutex
+	schemaLoader       *apidiscovery.SchemaLoader
+	schemaLoaderClient cluster.ClientInterface
 }
 
 var _ config.Dash = (*Live)(nil)
@@ -92,6 +123,9 @@ func NewLiveConfig(
 	buildInfo config.BuildInfo,
 	kubeConfigPath string,
 	contextChosenInUI bool,
+	readOnlyPolicy *ReadOnlyPolicy,
+	auditLog *audit.Log,
+	clusterFleet *fleet.Fleet,
 ) *Live {
 	l := &Live{
 		kubeContextDecorator: kubeContextDecorator,
@@ -106,6 +140,11 @@ func NewLiveConfig(
 		buildInfo:            buildInfo,
 		kubeConfigPath:       kubeConfigPath,
 		contextChosenInUI:    contextChosenInUI,
+		readOnlyPolicy:       readOnlyPolicy,
+		auditLog:             auditLog,
+		metricsHistory:       metricshistory.NewHistory(metricshistory.DefaultInterval, metricshistory.DefaultRetention),
+		notifications:        notification.NewManager(),
+		fleet:                clusterFleet,
 	}
 
 	return l
@@ -170,6 +209,47 @@ func (l *Live) UseContext(ctx context.Context, contextName string) error {
 		return err
 	}
 
+	return l.updateClusterClient(ctx, contextName)
+}
+
+// ReloadKubeConfig reloads the kube config files after they changed. The cluster client is
+// only recreated if the current context changed in the files and was not chosen in the UI,
+// or if the cluster or user of the current context changed. Otherwise only the list of
+// contexts is updated, so the current view is kept.
+func (l *Live) ReloadKubeConfig(ctx context.Context) error {
+	fsContext, changed, err := l.kubeContextDecorator.Reload()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !l.contextChosenInUI && fsContext != l.CurrentContext():
+		return l.UseFSContext(ctx)
+	case changed:
+		return l.UseContext(ctx, l.CurrentContext())
+	default:
+		return nil
+	}
+}
+
+// RefreshCredentials recreates the cluster client for the current context, so exec and auth
+// provider plugins are invoked again.
+func (l *Live) RefreshCredentials(ctx context.Context) error {
+	if err := l.kubeContextDecorator.RefreshCredentials(ctx); err != nil {
+		return err
+	}
+
+	return l.updateClusterClient(ctx, l.CurrentContext())
+}
+
+// CredentialStatus returns the status of the credentials of the current context.
+func (l *Live) CredentialStatus() kubeconfig.CredentialStatus {
+	return l.kubeContextDecorator.CredentialStatus()
+}
+
+// updateClusterClient updates the object store, modules and plugins after the cluster
+// client changed.
+func (l *Live) updateClusterClient(ctx context.Context, contextName string) error {
 	client := l.kubeContextDecorator.ClusterClient()
 	if err := l.objectStore.UpdateClusterClient(ctx, client); err != nil {
 		return err
@@ -197,11 +277,56 @@ func (l *Live) CurrentContext() string {
 	return l.kubeContextDecorator.CurrentContext()
 }
 
+// ReadOnly returns true if mutating actions are refused for the current context.
+func (l *Live) ReadOnly() bool {
+	return l.readOnlyPolicy.IsReadOnly(l.CurrentContext())
+}
+
+// AuditLog returns the audit log.
+func (l *Live) AuditLog() *audit.Log {
+	return l.auditLog
+}
+
 // Contexts returns the set of all contexts
 func (l *Live) Contexts() []kubeconfig.Context {
 	return l.kubeContextDecorator.Contexts()
 }
 
+// KubeConfigEditor returns an editor for the kube config files in use.
+func (l *Live) KubeConfigEditor() *kubeconfig.Editor {
+	return l.kubeContextDecorator.KubeConfigEditor()
+}
+
+// SchemaLoader returns a loader for the OpenAPI schemas of the current cluster. A new
+// loader is created when the cluster client changes.
+func (l *Live) SchemaLoader() *apidiscovery.SchemaLoader {
+	l.schemaLoaderMu.Lock()
+	defer l.schemaLoaderMu.Unlock()
+
+	client := l.ClusterClient()
+	if l.schemaLoader == nil || l.schemaLoaderClient != client {
+		l.schemaLoader = apidiscovery.NewSchemaLoader(client)
+		l.schemaLoaderClient = client
+	}
+
+	return l.schemaLoader
+}
+
+// MetricsHistory returns the recent resource usage of pods in the current cluster.
+func (l *Live) MetricsHistory() *metricshistory.History {
+	return l.metricsHistory
+}
+
+// Notifications returns the watched objects and the notifications about them.
+func (l *Live) Notifications() *notification.Manager {
+	return l.notifications
+}
+
+// Fleet returns the kube contexts loaded into the fleet overview.
+func (l *Live) Fleet() *fleet.Fleet {
+	return l.fleet
+}
+
 // DefaultNamespace returns the default namespace for the current cluster..
 func (l *Live) DefaultNamespace() string {
 	return l.ClusterClient().DefaultNamespace()
@@ -107,6 +107,9 @@ func TestLiveConfig(t *testing.T) {
 		buildInfo,
 		"",
 		false,
+		nil,
+		nil,
+		nil,
 	)
 
 	assert.NoError(t, config.Validate())
@@ -156,6 +159,9 @@ func TestLiveConfig_UseContext_WithContextChosenByUISetToTrue(t *testing.T) {
 		buildInfo,
 		"",
 		true, // contextChosenInUI
+		nil,
+		nil,
+		nil,
 	)
 
 	objectStore.EXPECT().UpdateClusterClient(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -214,6 +220,9 @@ func TestLiveConfig_UseContext_WithContextChosenByUISetToFalse(t *testing.T) {
 		buildInfo,
 		"",
 		false, // contextChosenInUI
+		nil,
+		nil,
+		nil,
 	)
 
 	objectStore.EXPECT().UpdateClusterClient(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -238,6 +247,95 @@ func TestLiveConfig_UseContext_WithContextChosenByUISetToFalse(t *testing.T) {
 	config.UseContext(context.TODO(), newContext)
 }
 
+func TestLiveConfig_ReloadKubeConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		contextChosenInUI bool
+		fsContext         string
+		changed           bool
+		switchTo          *string
+	}{
+		{
+			name:      "nothing changed",
+			fsContext: "current",
+		},
+		{
+			name:              "file context changed, but context was chosen in UI",
+			contextChosenInUI: true,
+			fsContext:         "other",
+		},
+		{
+			name:      "file context changed",
+			fsContext: "other",
+			switchTo:  stringPtr(UseFSContext),
+		},
+		{
+			name:      "credentials changed",
+			fsContext: "current",
+			changed:   true,
+			switchTo:  stringPtr("current"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			moduleManager := moduleFake.NewMockManagerInterface(controller)
+			objectStore := objectStoreFake.NewMockStore(controller)
+			pluginManager := pluginFake.NewMockManagerInterface(controller)
+			contextDecorator := configFake.NewMockKubeContextDecorator(controller)
+
+			config := NewLiveConfig(contextDecorator, stubCRDWatcher{}, log.NopLogger(), moduleManager,
+				objectStore, nil, pluginManager, nil, cluster.RESTConfigOptions{}, config.BuildInfo{},
+				"", test.contextChosenInUI, nil, nil, nil)
+
+			contextDecorator.EXPECT().Reload().Return(test.fsContext, test.changed, nil)
+			contextDecorator.EXPECT().CurrentContext().Return("current").AnyTimes()
+
+			if test.switchTo != nil {
+				contextDecorator.EXPECT().SwitchContext(gomock.Any(), *test.switchTo).Return(nil)
+				contextDecorator.EXPECT().ClusterClient()
+				objectStore.EXPECT().UpdateClusterClient(gomock.Any(), gomock.Any()).Return(nil)
+				moduleManager.EXPECT().UpdateContext(gomock.Any(), *test.switchTo).Return(nil)
+				moduleManager.EXPECT().Modules().Return(nil)
+				pluginManager.EXPECT().SetOctantClient(config)
+			}
+
+			require.NoError(t, config.ReloadKubeConfig(context.TODO()))
+		})
+	}
+}
+
+func TestLiveConfig_RefreshCredentials(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	moduleManager := moduleFake.NewMockManagerInterface(controller)
+	objectStore := objectStoreFake.NewMockStore(controller)
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	contextDecorator := configFake.NewMockKubeContextDecorator(controller)
+
+	config := NewLiveConfig(contextDecorator, stubCRDWatcher{}, log.NopLogger(), moduleManager,
+		objectStore, nil, pluginManager, nil, cluster.RESTConfigOptions{}, config.BuildInfo{},
+		"", false, nil, nil, nil)
+
+	contextDecorator.EXPECT().RefreshCredentials(gomock.Any()).Return(nil)
+	contextDecorator.EXPECT().CurrentContext().Return("current")
+	contextDecorator.EXPECT().ClusterClient()
+	objectStore.EXPECT().UpdateClusterClient(gomock.Any(), gomock.Any()).Return(nil)
+	moduleManager.EXPECT().UpdateContext(gomock.Any(), "current").Return(nil)
+	moduleManager.EXPECT().Modules().Return(nil)
+	pluginManager.EXPECT().SetOctantClient(config)
+
+	require.NoError(t, config.RefreshCredentials(context.TODO()))
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
 type stubCRDWatcher struct{}
 
 var _ config.CRDWatcher = (*stubCRDWatcher)(nil)
@@ -249,3 +347,21 @@ func (w stubCRDWatcher) AddConfig(config *config.CRDWatchConfig) error {
 func (stubCRDWatcher) Watch(_ context.Context) error {
 	return nil
 }
+
+func TestReadOnlyPolicy(t *testing.T) {
+	var nilPolicy *ReadOnlyPolicy
+	assert.False(t, nilPolicy.IsReadOnly("prod"))
+
+	all, err := NewReadOnlyPolicy(true, nil)
+	require.NoError(t, err)
+	assert.True(t, all.IsReadOnly("dev"))
+
+	contexts, err := NewReadOnlyPolicy(false, []string{"prod-*", "staging"})
+	require.NoError(t, err)
+	assert.True(t, contexts.IsReadOnly("prod-us-east"))
+	assert.True(t, contexts.IsReadOnly("staging"))
+	assert.False(t, contexts.IsReadOnly("dev"))
+
+	_, err = NewReadOnlyPolicy(false, []string{"prod-["})
+	require.Error(t, err)
+}
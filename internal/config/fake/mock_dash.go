@@ -10,8 +10,13 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 
+	apidiscovery "github.com/vmware-tanzu/octant/internal/apidiscovery"
+	audit "github.com/vmware-tanzu/octant/internal/audit"
+	fleet "github.com/vmware-tanzu/octant/internal/fleet"
 	kubeconfig "github.com/vmware-tanzu/octant/internal/kubeconfig"
+	metricshistory "github.com/vmware-tanzu/octant/internal/metricshistory"
 	module "github.com/vmware-tanzu/octant/internal/module"
+	notification "github.com/vmware-tanzu/octant/internal/notification"
 	portforward "github.com/vmware-tanzu/octant/internal/portforward"
 	cluster "github.com/vmware-tanzu/octant/pkg/cluster"
 	config "github.com/vmware-tanzu/octant/pkg/config"
@@ -44,6 +49,20 @@ func (m *MockDash) EXPECT() *MockDashMockRecorder {
 	return m.recorder
 }
 
+// AuditLog mocks base method.
+func (m *MockDash) AuditLog() *audit.Log {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditLog")
+	ret0, _ := ret[0].(*audit.Log)
+	return ret0
+}
+
+// AuditLog indicates an expected call of AuditLog.
+func (mr *MockDashMockRecorder) AuditLog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditLog", reflect.TypeOf((*MockDash)(nil).AuditLog))
+}
+
 // BuildInfo mocks base method.
 func (m *MockDash) BuildInfo() (string, string, string) {
 	m.ctrl.T.Helper()
@@ -102,6 +121,20 @@ func (mr *MockDashMockRecorder) Contexts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contexts", reflect.TypeOf((*MockDash)(nil).Contexts))
 }
 
+// CredentialStatus mocks base method.
+func (m *MockDash) CredentialStatus() kubeconfig.CredentialStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CredentialStatus")
+	ret0, _ := ret[0].(kubeconfig.CredentialStatus)
+	return ret0
+}
+
+// CredentialStatus indicates an expected call of CredentialStatus.
+func (mr *MockDashMockRecorder) CredentialStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CredentialStatus", reflect.TypeOf((*MockDash)(nil).CredentialStatus))
+}
+
 // CurrentContext mocks base method.
 func (m *MockDash) CurrentContext() string {
 	m.ctrl.T.Helper()
@@ -144,6 +177,34 @@ func (mr *MockDashMockRecorder) ErrorStore() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErrorStore", reflect.TypeOf((*MockDash)(nil).ErrorStore))
 }
 
+// Fleet mocks base method.
+func (m *MockDash) Fleet() *fleet.Fleet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fleet")
+	ret0, _ := ret[0].(*fleet.Fleet)
+	return ret0
+}
+
+// Fleet indicates an expected call of Fleet.
+func (mr *MockDashMockRecorder) Fleet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fleet", reflect.TypeOf((*MockDash)(nil).Fleet))
+}
+
+// KubeConfigEditor mocks base method.
+func (m *MockDash) KubeConfigEditor() *kubeconfig.Editor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubeConfigEditor")
+	ret0, _ := ret[0].(*kubeconfig.Editor)
+	return ret0
+}
+
+// KubeConfigEditor indicates an expected call of KubeConfigEditor.
+func (mr *MockDashMockRecorder) KubeConfigEditor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubeConfigEditor", reflect.TypeOf((*MockDash)(nil).KubeConfigEditor))
+}
+
 // KubeConfigPath mocks base method.
 func (m *MockDash) KubeConfigPath() string {
 	m.ctrl.T.Helper()
@@ -172,6 +233,20 @@ func (mr *MockDashMockRecorder) Logger() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logger", reflect.TypeOf((*MockDash)(nil).Logger))
 }
 
+// MetricsHistory mocks base method.
+func (m *MockDash) MetricsHistory() *metricshistory.History {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetricsHistory")
+	ret0, _ := ret[0].(*metricshistory.History)
+	return ret0
+}
+
+// MetricsHistory indicates an expected call of MetricsHistory.
+func (mr *MockDashMockRecorder) MetricsHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricsHistory", reflect.TypeOf((*MockDash)(nil).MetricsHistory))
+}
+
 // ModuleManager mocks base method.
 func (m *MockDash) ModuleManager() module.ManagerInterface {
 	m.ctrl.T.Helper()
@@ -186,6 +261,20 @@ func (mr *MockDashMockRecorder) ModuleManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModuleManager", reflect.TypeOf((*MockDash)(nil).ModuleManager))
 }
 
+// Notifications mocks base method.
+func (m *MockDash) Notifications() *notification.Manager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notifications")
+	ret0, _ := ret[0].(*notification.Manager)
+	return ret0
+}
+
+// Notifications indicates an expected call of Notifications.
+func (mr *MockDashMockRecorder) Notifications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifications", reflect.TypeOf((*MockDash)(nil).Notifications))
+}
+
 // ObjectPath mocks base method.
 func (m *MockDash) ObjectPath(arg0, arg1, arg2, arg3 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +332,62 @@ func (mr *MockDashMockRecorder) PortForwarder() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForwarder", reflect.TypeOf((*MockDash)(nil).PortForwarder))
 }
 
+// ReadOnly mocks base method.
+func (m *MockDash) ReadOnly() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOnly")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ReadOnly indicates an expected call of ReadOnly.
+func (mr *MockDashMockRecorder) ReadOnly() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOnly", reflect.TypeOf((*MockDash)(nil).ReadOnly))
+}
+
+// RefreshCredentials mocks base method.
+func (m *MockDash) RefreshCredentials(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCredentials", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCredentials indicates an expected call of RefreshCredentials.
+func (mr *MockDashMockRecorder) RefreshCredentials(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCredentials", reflect.TypeOf((*MockDash)(nil).RefreshCredentials), arg0)
+}
+
+// ReloadKubeConfig mocks base method.
+func (m *MockDash) ReloadKubeConfig(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadKubeConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReloadKubeConfig indicates an expected call of ReloadKubeConfig.
+func (mr *MockDashMockRecorder) ReloadKubeConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadKubeConfig", reflect.TypeOf((*MockDash)(nil).ReloadKubeConfig), arg0)
+}
+
+// SchemaLoader mocks base method.
+func (m *MockDash) SchemaLoader() *apidiscovery.SchemaLoader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaLoader")
+	ret0, _ := ret[0].(*apidiscovery.SchemaLoader)
+	return ret0
+}
+
+// SchemaLoader indicates an expected call of SchemaLoader.
+func (mr *MockDashMockRecorder) SchemaLoader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaLoader", reflect.TypeOf((*MockDash)(nil).SchemaLoader))
+}
+
 // SetContextChosenInUI mocks base method.
 func (m *MockDash) SetContextChosenInUI(arg0 bool) {
 	m.ctrl.T.Helper()
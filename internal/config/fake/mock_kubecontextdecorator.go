@@ -65,6 +65,20 @@ func (mr *MockKubeContextDecoratorMockRecorder) Contexts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contexts", reflect.TypeOf((*MockKubeContextDecorator)(nil).Contexts))
 }
 
+// CredentialStatus mocks base method.
+func (m *MockKubeContextDecorator) CredentialStatus() kubeconfig.CredentialStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CredentialStatus")
+	ret0, _ := ret[0].(kubeconfig.CredentialStatus)
+	return ret0
+}
+
+// CredentialStatus indicates an expected call of CredentialStatus.
+func (mr *MockKubeContextDecoratorMockRecorder) CredentialStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CredentialStatus", reflect.TypeOf((*MockKubeContextDecorator)(nil).CredentialStatus))
+}
+
 // CurrentContext mocks base method.
 func (m *MockKubeContextDecorator) CurrentContext() string {
 	m.ctrl.T.Helper()
@@ -79,6 +93,50 @@ func (mr *MockKubeContextDecoratorMockRecorder) CurrentContext() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentContext", reflect.TypeOf((*MockKubeContextDecorator)(nil).CurrentContext))
 }
 
+// KubeConfigEditor mocks base method.
+func (m *MockKubeContextDecorator) KubeConfigEditor() *kubeconfig.Editor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubeConfigEditor")
+	ret0, _ := ret[0].(*kubeconfig.Editor)
+	return ret0
+}
+
+// KubeConfigEditor indicates an expected call of KubeConfigEditor.
+func (mr *MockKubeContextDecoratorMockRecorder) KubeConfigEditor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubeConfigEditor", reflect.TypeOf((*MockKubeContextDecorator)(nil).KubeConfigEditor))
+}
+
+// RefreshCredentials mocks base method.
+func (m *MockKubeContextDecorator) RefreshCredentials(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCredentials", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCredentials indicates an expected call of RefreshCredentials.
+func (mr *MockKubeContextDecoratorMockRecorder) RefreshCredentials(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCredentials", reflect.TypeOf((*MockKubeContextDecorator)(nil).RefreshCredentials), arg0)
+}
+
+// Reload mocks base method.
+func (m *MockKubeContextDecorator) Reload() (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reload indicates an expected call of Reload.
+func (mr *MockKubeContextDecoratorMockRecorder) Reload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockKubeContextDecorator)(nil).Reload))
+}
+
 // SwitchContext mocks base method.
 func (m *MockKubeContextDecorator) SwitchContext(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/gobwas/glob"
+)
+
+// ReadOnlyPolicy decides which kube contexts Octant refuses mutating actions for.
+type ReadOnlyPolicy struct {
+	all      bool
+	contexts []glob.Glob
+}
+
+// NewReadOnlyPolicy creates an instance of ReadOnlyPolicy. If all is true, every context
+// is read-only. Otherwise contexts with a name matching one of contextPatterns are read-only.
+func NewReadOnlyPolicy(all bool, contextPatterns []string) (*ReadOnlyPolicy, error) {
+	p := &ReadOnlyPolicy{all: all}
+
+	for _, pattern := range contextPatterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid read-only context pattern %q: %w", pattern, err)
+		}
+		p.contexts = append(p.contexts, g)
+	}
+
+	return p, nil
+}
+
+// IsReadOnly returns true if contextName is read-only. A nil policy is never read-only.
+func (p *ReadOnlyPolicy) IsReadOnly(contextName string) bool {
+	if p == nil {
+		return false
+	}
+	if p.all {
+		return true
+	}
+
+	for _, g := range p.contexts {
+		if g.Match(contextName) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// EventsTab generates a tab with a timeline of the events of a workload and the objects
+// related to it, e.g. the replica sets, pods, persistent volume claims and autoscalers
+// of a deployment. If the object is not a workload, the returned component will be nil
+// with a nil error.
+func EventsTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+	if options.Dash == nil {
+		return nil, nil
+	}
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("convert object to unstructured: %w", err)
+	}
+	u := &unstructured.Unstructured{Object: m}
+
+	if !workloadKinds[u.GetKind()] && u.GetKind() != "CronJob" {
+		return nil, nil
+	}
+
+	visitor, err := objectvisitor.NewDefaultVisitor(options.Dash, options.Queryer)
+	if err != nil {
+		return nil, fmt.Errorf("create object visitor: %w", err)
+	}
+
+	return eventsTab(ctx, u, options, visitor)
+}
+
+func eventsTab(ctx context.Context, u *unstructured.Unstructured, options Options, visitor objectvisitor.Visitor) (component.Component, error) {
+	collector := newObjectCollector()
+	if err := visitor.Visit(ctx, u, collector, true, 1); err != nil {
+		return nil, fmt.Errorf("visit %s: %w", kubernetes.PrintObject(u), err)
+	}
+
+	events, err := objectEvents(ctx, options.ObjectStore(), collector.list())
+	if err != nil {
+		return nil, err
+	}
+
+	layout := component.NewFlexLayout("Events")
+	layout.SetAccessor("events")
+
+	var view component.Component = component.NewText(
+		fmt.Sprintf("There are no events for %s %s or its objects.", u.GetKind(), u.GetName()))
+	if len(events) > 0 {
+		view = printer.EventTimeline(printer.AggregateEvents(events))
+	}
+
+	layout.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: view},
+	})
+
+	return layout, nil
+}
+
+// objectEvents lists the events of objects. Events are listed once per namespace.
+func objectEvents(ctx context.Context, objectStore store.Store, objects []*unstructured.Unstructured) ([]corev1.Event, error) {
+	byUID := make(map[types.UID]bool)
+	byName := make(map[corev1.ObjectReference]bool)
+	namespaces := make(map[string]bool)
+
+	for _, object := range objects {
+		byUID[object.GetUID()] = true
+		byName[corev1.ObjectReference{
+			Namespace: object.GetNamespace(),
+			Kind:      object.GetKind(),
+			Name:      object.GetName(),
+		}] = true
+		if object.GetNamespace() != "" {
+			namespaces[object.GetNamespace()] = true
+		}
+	}
+
+	var names []string
+	for namespace := range namespaces {
+		names = append(names, namespace)
+	}
+	sort.Strings(names)
+
+	var events []corev1.Event
+	for _, namespace := range names {
+		key := store.Key{Namespace: namespace, APIVersion: "v1", Kind: "Event"}
+		list, _, err := objectStore.List(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("list events in namespace %s: %w", namespace, err)
+		}
+
+		for i := range list.Items {
+			event := corev1.Event{}
+			if err := kubernetes.FromUnstructured(&list.Items[i], &event); err != nil {
+				return nil, fmt.Errorf("convert event: %w", err)
+			}
+
+			involved := event.InvolvedObject
+			if involved.UID != "" && byUID[involved.UID] || involved.UID == "" && byName[corev1.ObjectReference{
+				Namespace: involved.Namespace,
+				Kind:      involved.Kind,
+				Name:      involved.Name,
+			}] {
+				events = append(events, event)
+			}
+		}
+	}
+
+	return events, nil
+}
+
+// objectCollector is an object handler which collects the objects visited by an
+// object visitor.
+type objectCollector struct {
+	mu      sync.Mutex
+	objects map[types.UID]*unstructured.Unstructured
+}
+
+var _ objectvisitor.ObjectHandler = (*objectCollector)(nil)
+
+func newObjectCollector() *objectCollector {
+	return &objectCollector{
+		objects: make(map[types.UID]*unstructured.Unstructured),
+	}
+}
+
+// AddEdge does nothing.
+func (c *objectCollector) AddEdge(ctx context.Context, v1, v2 *unstructured.Unstructured, level int) error {
+	return nil
+}
+
+// Process collects an object.
+func (c *objectCollector) Process(ctx context.Context, object *unstructured.Unstructured) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.objects[object.GetUID()] = object
+	return nil
+}
+
+// SetLevel returns the level unchanged.
+func (c *objectCollector) SetLevel(objectKind string, level int) int {
+	return level
+}
+
+func (c *objectCollector) list() []*unstructured.Unstructured {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var list []*unstructured.Unstructured
+	for _, object := range c.objects {
+		list = append(list, object)
+	}
+	return list
+}
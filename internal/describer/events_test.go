@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	visitorFake "github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_eventsTab(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Unix(1633089600, 0)
+
+	deployment := testutil.CreateDeployment("deployment")
+	replicaSet := testutil.CreateAppReplicaSet("replica-set")
+	pod := testutil.CreatePod("pod")
+
+	createEvent := func(name string, object runtime.Object, eventType, reason string, last time.Time) runtime.Object {
+		u := testutil.ToUnstructured(t, object)
+		event := testutil.CreateEvent(name)
+		event.InvolvedObject = corev1.ObjectReference{
+			Namespace:  u.GetNamespace(),
+			APIVersion: u.GetAPIVersion(),
+			Kind:       u.GetKind(),
+			Name:       u.GetName(),
+			UID:        u.GetUID(),
+		}
+		event.Type = eventType
+		event.Reason = reason
+		event.Message = reason + " message"
+		event.Count = 2
+		event.FirstTimestamp = metav1.NewTime(last.Add(-time.Minute))
+		event.LastTimestamp = metav1.NewTime(last)
+		return event
+	}
+
+	events := []runtime.Object{
+		createEvent("event-1", deployment, corev1.EventTypeNormal, "ScalingReplicaSet", now.Add(-time.Hour)),
+		createEvent("event-2", pod, corev1.EventTypeWarning, "BackOff", now.Add(-2*time.Minute)),
+		createEvent("event-3", pod, corev1.EventTypeWarning, "BackOff", now),
+		createEvent("event-4", testutil.CreatePod("other"), corev1.EventTypeWarning, "BackOff", now),
+	}
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Event"}).
+		Return(testutil.ToUnstructuredList(t, events...), false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	u := testutil.ToUnstructured(t, deployment)
+
+	visitor := visitorFake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), u, gomock.Any(), true, 1).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool, _ int) error {
+			for _, o := range []runtime.Object{deployment, replicaSet, pod} {
+				require.NoError(t, handler.Process(ctx, testutil.ToUnstructured(t, o)))
+			}
+			return nil
+		})
+
+	got, err := eventsTab(context.Background(), u, Options{Dash: dashConfig}, visitor)
+	require.NoError(t, err)
+
+	layout, ok := got.(*component.FlexLayout)
+	require.True(t, ok)
+	require.Equal(t, "events", layout.GetMetadata().Accessor)
+
+	timeline, ok := layout.Config.Sections[0][0].View.(*component.Timeline)
+	require.True(t, ok)
+
+	var titles []string
+	for _, step := range timeline.Config.Steps {
+		titles = append(titles, step.Title+" "+step.Attributes["Object"])
+	}
+	assert.Equal(t, []string{"BackOff (4) Pod pod", "ScalingReplicaSet (2) Deployment deployment"}, titles)
+	assert.Equal(t, []string{"BackOff", "ScalingReplicaSet"}, timeline.Config.Filters["Reason"].Values)
+}
+
+func TestEventsTab_unsupportedKind(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	got, err := EventsTab(context.Background(), testutil.CreateService("service"), Options{Dash: dashConfig})
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/metricshistory"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// workloadKinds are the kinds which select pods with spec.selector.
+var workloadKinds = map[string]bool{
+	"Deployment":            true,
+	"DaemonSet":             true,
+	"Job":                   true,
+	"ReplicaSet":            true,
+	"ReplicationController": true,
+	"StatefulSet":           true,
+}
+
+// MetricsTab generates a tab with charts of the recent resource usage of a pod, of the
+// pods of a workload, or of the pods in a namespace. If usage is not sampled or the
+// object has no pods, the returned component will be nil with a nil error.
+func MetricsTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+	if options.Dash == nil {
+		return nil, nil
+	}
+
+	history := options.MetricsHistory()
+	if history == nil || !history.Available() {
+		return nil, nil
+	}
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, fmt.Errorf("convert object to unstructured: %w", err)
+	}
+	u := &unstructured.Unstructured{Object: m}
+
+	var samples []metricshistory.Sample
+	switch kind := u.GetKind(); {
+	case u.GetAPIVersion() == "v1" && kind == "Pod":
+		samples = history.Pod(u.GetNamespace(), u.GetName())
+	case u.GetAPIVersion() == "v1" && kind == "Namespace":
+		samples = history.Namespace(u.GetName())
+	case workloadKinds[kind]:
+		names, err := selectedPodNames(ctx, u, options)
+		if err != nil {
+			return nil, err
+		}
+		if names == nil {
+			return nil, nil
+		}
+		samples = history.Pods(u.GetNamespace(), names)
+	default:
+		return nil, nil
+	}
+
+	layout := component.NewFlexLayout("Metrics")
+	layout.AddSections(MetricsChartsSection(samples))
+	layout.SetAccessor("metrics")
+
+	return layout, nil
+}
+
+// MetricsChartsSection creates a section with charts of the CPU and memory usage in
+// samples.
+func MetricsChartsSection(samples []metricshistory.Sample) component.FlexLayoutSection {
+	cpu := component.TimeSeries{Name: "CPU", Points: []component.TimeSeriesPoint{}}
+	memory := component.TimeSeries{Name: "Memory", Points: []component.TimeSeriesPoint{}}
+	for _, sample := range samples {
+		cpu.Points = append(cpu.Points, component.NewTimeSeriesPoint(sample.Timestamp, float64(sample.CPU)))
+		memory.Points = append(memory.Points, component.NewTimeSeriesPoint(sample.Timestamp, float64(sample.Memory)/(1<<20)))
+	}
+
+	cpuChart := component.NewTimeSeriesChart("CPU", cpu)
+	cpuChart.SetUnit("m")
+	cpuChart.SetPlaceholder("Collecting samples...")
+
+	memoryChart := component.NewTimeSeriesChart("Memory", memory)
+	memoryChart.SetUnit("MiB")
+	memoryChart.SetArea(true)
+	memoryChart.SetPlaceholder("Collecting samples...")
+
+	return component.FlexLayoutSection{
+		{Width: component.WidthHalf, View: cpuChart},
+		{Width: component.WidthHalf, View: memoryChart},
+	}
+}
+
+// selectedPodNames returns the names of the pods selected by a workload. It returns nil
+// if the workload has no selector.
+func selectedPodNames(ctx context.Context, u *unstructured.Unstructured, options Options) ([]string, error) {
+	var labelSelector *metav1.LabelSelector
+	if u.GetKind() == "ReplicationController" {
+		// replication controllers select pods with a map of labels.
+		matchLabels, found, err := unstructured.NestedStringMap(u.Object, "spec", "selector")
+		if err != nil || !found {
+			return nil, nil
+		}
+		labelSelector = &metav1.LabelSelector{MatchLabels: matchLabels}
+	} else {
+		selectorMap, found, err := unstructured.NestedMap(u.Object, "spec", "selector")
+		if err != nil || !found {
+			return nil, nil
+		}
+		labelSelector = &metav1.LabelSelector{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, labelSelector); err != nil {
+			return nil, fmt.Errorf("convert selector of %s %s: %w", u.GetKind(), u.GetName(), err)
+		}
+	}
+
+	key := store.Key{
+		Namespace:     u.GetNamespace(),
+		APIVersion:    "v1",
+		Kind:          "Pod",
+		LabelSelector: labelSelector,
+	}
+	pods, _, err := options.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("list pods for %s %s: %w", u.GetKind(), u.GetName(), err)
+	}
+
+	names := make([]string, 0, len(pods.Items))
+	for i := range pods.Items {
+		names = append(names, pods.Items[i].GetName())
+	}
+
+	return names, nil
+}
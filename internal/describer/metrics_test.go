@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package describer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/metricshistory"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestMetricsTab(t *testing.T) {
+	now := time.Unix(1633089600, 0)
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	tests := []struct {
+		name     string
+		object   runtime.Object
+		record   bool
+		expected []component.TimeSeriesPoint
+	}{
+		{
+			name:     "pod",
+			object:   testutil.CreatePod("pod-1"),
+			record:   true,
+			expected: []component.TimeSeriesPoint{{Timestamp: now.Unix(), Value: 100}},
+		},
+		{
+			name:     "deployment",
+			object:   deployment,
+			record:   true,
+			expected: []component.TimeSeriesPoint{{Timestamp: now.Unix(), Value: 300}},
+		},
+		{
+			name:   "no samples",
+			object: testutil.CreatePod("pod-1"),
+		},
+		{
+			name:   "unsupported kind",
+			object: testutil.CreateService("service"),
+			record: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			history := metricshistory.NewHistory(metricshistory.DefaultInterval, metricshistory.DefaultRetention)
+			if test.record {
+				history.Record(now, []metricshistory.PodUsage{
+					{Namespace: "namespace", Name: "pod-1", CPU: 100},
+					{Namespace: "namespace", Name: "pod-2", CPU: 200},
+				})
+			}
+
+			objectStore := objectStoreFake.NewMockStore(controller)
+			key := store.Key{Namespace: "namespace", APIVersion: "v1", Kind: "Pod", LabelSelector: deployment.Spec.Selector}
+			objectStore.EXPECT().List(gomock.Any(), key).
+				Return(testutil.ToUnstructuredList(t, testutil.CreatePod("pod-1"), testutil.CreatePod("pod-2")), false, nil).
+				AnyTimes()
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().MetricsHistory().Return(history).AnyTimes()
+			dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+			object := testutil.ToUnstructured(t, test.object)
+			got, err := MetricsTab(context.Background(), object, Options{Dash: dashConfig})
+			require.NoError(t, err)
+
+			if test.expected == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			layout, ok := got.(*component.FlexLayout)
+			require.True(t, ok)
+			require.Equal(t, "metrics", layout.GetMetadata().Accessor)
+
+			cpu, ok := layout.Config.Sections[0][0].View.(*component.TimeSeriesChart)
+			require.True(t, ok)
+			require.Equal(t, test.expected, cpu.Config.Series[0].Points)
+		})
+	}
+}
@@ -13,9 +13,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -27,6 +30,8 @@ func defaultObjectTabs() []Tab {
 		{Name: "Metadata", Factory: MetadataTab},
 		{Name: "Resource Viewer", Factory: ResourceViewerTab},
 		{Name: "YAML", Factory: YAMLViewerTab},
+		{Name: "Metrics", Factory: MetricsTab},
+		{Name: "Events", Factory: EventsTab},
 		{Name: "Logs", Factory: LogsTab},
 		{Name: "Terminal", Factory: TerminalTab},
 	}
@@ -139,6 +144,15 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 			key.ToActionPayload()), confirmation)
 	}
 
+	if options.Dash != nil {
+		if err := addWatchButton(ctx, cr, options.Dash, currentObject); err != nil {
+			return component.EmptyContentResponse, err
+		}
+		if err := addCompareButton(ctx, cr, options.Dash, currentObject); err != nil {
+			return component.EmptyContentResponse, err
+		}
+	}
+
 	config := TabsGeneratorConfig{
 		Object:      currentObject,
 		TabsFactory: objectTabsFactory(ctx, currentObject, d.tabFuncDescriptors, options),
@@ -154,6 +168,99 @@ func (d *Object) Describe(ctx context.Context, namespace string, options Options
 	return *cr, nil
 }
 
+// addWatchButton adds a button for watching the object, or for no longer watching it if the
+// user in ctx watches it already.
+func addWatchButton(ctx context.Context, cr *component.ContentResponse, dashConfig config.Dash, object runtime.Object) error {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return err
+	}
+
+	contextName := dashConfig.CurrentContext()
+	if w, ok := dashConfig.Notifications().Find(ctx, contextName, key.Namespace, key.APIVersion, key.Kind, key.Name); ok {
+		cr.AddButton("Stop Watching", action.CreatePayload(octant.ActionUnwatchObjects, action.Payload{"id": w.ID}))
+		return nil
+	}
+
+	cr.AddButton("Watch", action.CreatePayload(octant.ActionWatchObjects, key.ToActionPayload()))
+	return nil
+}
+
+// addCompareButton adds a button listing the objects with the same kind and name in the
+// kube contexts loaded into the fleet, each linking to a comparison with the object.
+func addCompareButton(ctx context.Context, cr *component.ContentResponse, dashConfig config.Dash, object runtime.Object) error {
+	clusterFleet := dashConfig.Fleet()
+	if clusterFleet == nil {
+		return nil
+	}
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return err
+	}
+	current := fleet.ObjectRef{ContextName: dashConfig.CurrentContext(), Key: key}
+
+	table := component.NewTable("Compare", "There are no other objects to compare with.",
+		component.NewTableCols("Context", "Namespace", "Compare"))
+	for _, ref := range fleetObjects(ctx, clusterFleet, key) {
+		if ref.ContextName == current.ContextName && ref.Key.Namespace == key.Namespace {
+			continue
+		}
+		table.Add(component.TableRow{
+			"Context":   component.NewText(ref.ContextName),
+			"Namespace": component.NewText(ref.Key.Namespace),
+			"Compare":   component.NewLink("", "Compare", fleet.ComparePath(fleet.CompareStripAll, current, ref)),
+		})
+	}
+	if table.IsEmpty() {
+		return nil
+	}
+
+	modal := component.NewModal(component.Title(component.NewText(fmt.Sprintf("Compare %s %s", key.Kind, key.Name))))
+	modal.SetBody(table)
+	modal.SetSize(component.ModalSizeLarge)
+	cr.AddButton("Compare", nil, component.WithModal(modal))
+	return nil
+}
+
+// fleetObjects returns the objects with the kind and name of key in every namespace of
+// the kube contexts loaded into the fleet. Contexts whose objects can't be listed are
+// skipped.
+func fleetObjects(ctx context.Context, clusterFleet *fleet.Fleet, key store.Key) []fleet.ObjectRef {
+	logger := log.From(ctx)
+
+	var refs []fleet.ObjectRef
+	for _, member := range clusterFleet.Members() {
+		memberCtx, err := member.UserContext(ctx)
+		if err != nil {
+			logger.WithErr(err).Errorf("unable to compare with context %s", member.ContextName)
+			continue
+		}
+
+		objects, _, err := member.ObjectStore.List(memberCtx, store.Key{APIVersion: key.APIVersion, Kind: key.Kind})
+		if err != nil {
+			logger.WithErr(err).Errorf("unable to list %s in context %s", key.Kind, member.ContextName)
+			continue
+		}
+
+		for i := range objects.Items {
+			if objects.Items[i].GetName() != key.Name {
+				continue
+			}
+			refs = append(refs, fleet.ObjectRef{
+				ContextName: member.ContextName,
+				Key: store.Key{
+					Namespace:  objects.Items[i].GetNamespace(),
+					APIVersion: key.APIVersion,
+					Kind:       key.Kind,
+					Name:       key.Name,
+				},
+			})
+		}
+	}
+	return refs
+}
+
 // PathFilters returns the path filters for this object.
 func (d *Object) PathFilters() []PathFilter {
 	return []PathFilter{
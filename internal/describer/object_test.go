@@ -21,13 +21,18 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/notification"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
 	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -51,6 +56,9 @@ func TestObjectDescriber(t *testing.T) {
 
 	pluginManager := plugin.NewManager(nil, moduleRegistrar, actionRegistrar, wsClient)
 	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
+	dashConfig.EXPECT().CurrentContext().Return("context")
+	dashConfig.EXPECT().Notifications().Return(notification.NewManager())
+	dashConfig.EXPECT().Fleet().Return(nil)
 
 	podSummary := component.NewText("summary")
 
@@ -107,6 +115,9 @@ func TestObjectDescriber(t *testing.T) {
 		),
 	)
 
+	watchButton := component.NewButton("Watch",
+		action.CreatePayload(octant.ActionWatchObjects, key.ToActionPayload()))
+
 	expected := component.ContentResponse{
 		Title: component.Title(component.NewText("pod")),
 		Components: []component.Component{
@@ -114,8 +125,95 @@ func TestObjectDescriber(t *testing.T) {
 		},
 		TitleComponents: []component.Component{
 			button,
+			watchButton,
 		},
 	}
 
 	testutil.AssertJSONEqual(t, &expected, &cResponse)
 }
+
+func TestObjectDescriber_compare(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	pod := testutil.CreatePod("pod")
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	otherNamespace := testutil.CreatePod("pod")
+	otherNamespace.Namespace = "other"
+
+	objects := map[string][]runtime.Object{
+		"context":    {pod, otherNamespace, testutil.CreatePod("unrelated")},
+		"production": {pod},
+	}
+	clients := make(map[string]cluster.ClientInterface)
+	stores := make(map[cluster.ClientInterface]store.Store)
+	for contextName, list := range objects {
+		client := clusterFake.NewMockClientInterface(controller)
+		client.EXPECT().Close().AnyTimes()
+		objectStore := storeFake.NewMockStore(controller)
+		objectStore.EXPECT().
+			List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+			Return(testutil.ToUnstructuredList(t, list...), false, nil)
+		clients[contextName] = client
+		stores[client] = objectStore
+	}
+	clusterFleet := fleet.New(ctx,
+		func(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+			return clients[contextName], nil
+		},
+		func(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+			return stores[client], nil
+		})
+	for contextName := range objects {
+		require.NoError(t, clusterFleet.Load(contextName))
+	}
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().CurrentContext().Return("context").AnyTimes()
+	dashConfig.EXPECT().Notifications().Return(notification.NewManager())
+	dashConfig.EXPECT().Fleet().Return(clusterFleet)
+
+	tg := describerFake.NewMockTabsGenerator(controller)
+	tg.EXPECT().Generate(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	options := describer.Options{
+		Dash: dashConfig,
+		LoadObject: func(ctx context.Context, namespace string, fields map[string]string, objectStoreKey store.Key) (*unstructured.Unstructured, error) {
+			return testutil.ToUnstructured(t, pod), nil
+		},
+	}
+
+	d := describer.NewObject(describer.ObjectConfig{
+		Path:          "/",
+		StoreKey:      key,
+		ObjectType:    describer.PodObjectType,
+		TabsGenerator: tg,
+	})
+
+	cResponse, err := d.Describe(ctx, pod.Namespace, options)
+	require.NoError(t, err)
+
+	current := fleet.ObjectRef{ContextName: "context", Key: key}
+	table := component.NewTable("Compare", "There are no other objects to compare with.",
+		component.NewTableCols("Context", "Namespace", "Compare"))
+	for _, ref := range []fleet.ObjectRef{
+		{ContextName: "context", Key: store.Key{Namespace: "other", APIVersion: "v1", Kind: "Pod", Name: "pod"}},
+		{ContextName: "production", Key: key},
+	} {
+		table.Add(component.TableRow{
+			"Context":   component.NewText(ref.ContextName),
+			"Namespace": component.NewText(ref.Key.Namespace),
+			"Compare":   component.NewLink("", "Compare", fleet.ComparePath(fleet.CompareStripAll, current, ref)),
+		})
+	}
+	modal := component.NewModal(component.Title(component.NewText("Compare Pod pod")))
+	modal.SetBody(table)
+	modal.SetSize(component.ModalSizeLarge)
+
+	require.Len(t, cResponse.TitleComponents, 3)
+	testutil.AssertJSONEqual(t, component.NewButton("Compare", nil, component.WithModal(modal)), cResponse.TitleComponents[2])
+}
@@ -102,16 +102,43 @@ func ResourceViewerTab(ctx context.Context, object runtime.Object, options Optio
 }
 
 // YAMLViewerTab generates a yaml viewer for an object.
-func YAMLViewerTab(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
+func YAMLViewerTab(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
 	yvComponent, err := yamlviewer.ToComponent(object)
 	if err != nil {
 		return nil, fmt.Errorf("create yaml viewer: %w", err)
 	}
 
+	yvComponent.Config.FieldDocs = yamlFieldDocs(ctx, object, options)
+
 	yvComponent.SetAccessor("yaml")
 	return yvComponent, nil
 }
 
+// yamlFieldDocs documents the fields of an object for the YAML viewer. Documentation
+// is optional, so failures are logged and nil is returned.
+func yamlFieldDocs(ctx context.Context, object runtime.Object, options Options) map[string]string {
+	if options.Dash == nil {
+		return nil
+	}
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil
+	}
+	u := &unstructured.Unstructured{Object: m}
+	if u.GetKind() == "" {
+		return nil
+	}
+
+	docs, err := options.SchemaLoader().FieldDocs(ctx, u)
+	if err != nil {
+		log.From(ctx).With("err", err).Debugf("unable to document YAML fields")
+		return nil
+	}
+
+	return docs
+}
+
 // LogsTab generates a logs tab for a pod. If the object is not a pod, the
 // returned component will be nil with a nil error.
 func LogsTab(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
@@ -51,10 +51,14 @@ func NewContextsGenerator(kubeContextStore KubeContextStore, options ...ContextG
 func (g *ContextsGenerator) Events(ctx context.Context) ([]event.Event, error) {
 	resp := kubeContextsResponse{
 		CurrentContext: g.KubeContextStore.CurrentContext(),
-		Contexts:       g.KubeContextStore.Contexts(),
+		Contexts:       append([]kubeconfig.Context(nil), g.KubeContextStore.Contexts()...),
 	}
 
+	// Favorite contexts are listed first.
 	sort.Slice(resp.Contexts, func(i, j int) bool {
+		if resp.Contexts[i].Favorite != resp.Contexts[j].Favorite {
+			return resp.Contexts[i].Favorite
+		}
 		return resp.Contexts[i].Name < resp.Contexts[j].Name
 	})
 
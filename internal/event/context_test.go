@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	dashConfigFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
 )
 
 func Test_kubeContextGenerator(t *testing.T) {
@@ -43,3 +44,28 @@ func Test_kubeContextGenerator(t *testing.T) {
 
 	assert.Equal(t, resp, e.Data)
 }
+
+func Test_kubeContextGenerator_favoritesFirst(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	dashConfig := dashConfigFake.NewMockDash(controller)
+	dashConfig.EXPECT().CurrentContext().Return("b")
+	dashConfig.EXPECT().Contexts().Return([]kubeconfig.Context{
+		{Name: "a"},
+		{Name: "b"},
+		{Name: "c", Favorite: true},
+	})
+
+	evs, err := NewContextsGenerator(dashConfig).Events(context.Background())
+	require.NoError(t, err)
+
+	resp := kubeContextsResponse{
+		CurrentContext: "b",
+		Contexts: []kubeconfig.Context{
+			{Name: "c", Favorite: true},
+			{Name: "a"},
+			{Name: "b"},
+		},
+	}
+	assert.Equal(t, resp, evs[0].Data)
+}
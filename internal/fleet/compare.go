@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// CompareStrip is the set of fields removed from objects before they are compared.
+type CompareStrip string
+
+const (
+	// CompareStripAll removes managed fields and status.
+	CompareStripAll CompareStrip = "all"
+	// CompareStripManagedFields removes managed fields.
+	CompareStripManagedFields CompareStrip = "managed-fields"
+	// CompareStripStatus removes status.
+	CompareStripStatus CompareStrip = "status"
+	// CompareStripNone removes nothing.
+	CompareStripNone CompareStrip = "none"
+)
+
+// coreGroup names the core API group in paths.
+const coreGroup = "core"
+
+// clusterScoped names the namespace of cluster scoped objects in paths.
+const clusterScoped = "_"
+
+// ObjectRef identifies an object in a kube context.
+type ObjectRef struct {
+	ContextName string
+	Key         store.Key
+}
+
+// String describes the object for titles.
+func (r ObjectRef) String() string {
+	if r.Key.Namespace == "" {
+		return fmt.Sprintf("%s: %s", r.ContextName, r.Key.Name)
+	}
+	return fmt.Sprintf("%s: %s/%s", r.ContextName, r.Key.Namespace, r.Key.Name)
+}
+
+// ComparePath returns the path of the page comparing left and right.
+func ComparePath(strip CompareStrip, left, right ObjectRef) string {
+	parts := []string{"/fleet", "compare", string(strip)}
+	for _, ref := range []ObjectRef{left, right} {
+		gv, _ := schema.ParseGroupVersion(ref.Key.APIVersion)
+		group := gv.Group
+		if group == "" {
+			group = coreGroup
+		}
+		namespace := ref.Key.Namespace
+		if namespace == "" {
+			namespace = clusterScoped
+		}
+
+		for _, segment := range []string{ref.ContextName, namespace, group, gv.Version, ref.Key.Kind, ref.Key.Name} {
+			parts = append(parts, url.PathEscape(segment))
+		}
+	}
+	return path.Join(parts...)
+}
+
+// ObjectRefFromFields returns the object on one side of a path created by ComparePath.
+// side is the prefix of the path filter fields, left or right.
+func ObjectRefFromFields(fields map[string]string, side string) (ObjectRef, error) {
+	values := make(map[string]string)
+	for _, name := range []string{"Context", "Namespace", "Group", "Version", "Kind", "Name"} {
+		value, err := url.PathUnescape(fields[side+name])
+		if err != nil {
+			return ObjectRef{}, fmt.Errorf("invalid %s %s: %w", side, name, err)
+		}
+		values[name] = value
+	}
+
+	group := values["Group"]
+	if group == coreGroup {
+		group = ""
+	}
+	namespace := values["Namespace"]
+	if namespace == clusterScoped {
+		namespace = ""
+	}
+
+	return ObjectRef{
+		ContextName: values["Context"],
+		Key: store.Key{
+			Namespace:  namespace,
+			APIVersion: schema.GroupVersion{Group: group, Version: values["Version"]}.String(),
+			Kind:       values["Kind"],
+			Name:       values["Name"],
+		},
+	}, nil
+}
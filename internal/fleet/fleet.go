@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package fleet keeps several kube contexts loaded at once, each with its own object store.
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// ClientFactory creates a cluster client for a kube context.
+type ClientFactory func(ctx context.Context, contextName string) (cluster.ClientInterface, error)
+
+// StoreFactory creates an object store for a cluster client.
+type StoreFactory func(ctx context.Context, client cluster.ClientInterface) (store.Store, error)
+
+// Member is a kube context loaded in the fleet. The member's object store is run with
+// Octant's credentials, so requests made for a user should use the context returned by
+// UserContext.
+type Member struct {
+	ContextName   string
+	ClusterClient cluster.ClientInterface
+	ObjectStore   store.Store
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	pool   *auth.ClientPool
+}
+
+// Fleet is a set of loaded kube contexts.
+type Fleet struct {
+	ctx           context.Context
+	clientFactory ClientFactory
+	storeFactory  StoreFactory
+
+	mu      sync.RWMutex
+	members map[string]*Member
+}
+
+// New creates an instance of Fleet. Members are loaded with clientFactory and storeFactory
+// and live until they are unloaded or ctx is done.
+func New(ctx context.Context, clientFactory ClientFactory, storeFactory StoreFactory) *Fleet {
+	return &Fleet{
+		ctx:           ctx,
+		clientFactory: clientFactory,
+		storeFactory:  storeFactory,
+		members:       make(map[string]*Member),
+	}
+}
+
+// Load loads a kube context into the fleet. Loading a context twice does nothing.
+func (f *Fleet) Load(contextName string) error {
+	if contextName == "" {
+		return fmt.Errorf("context name is required")
+	}
+
+	if _, ok := f.Member(contextName); ok {
+		return nil
+	}
+
+	// Creating the client and store can take a while, so it is done without holding the
+	// lock. If the context was loaded concurrently, the new member is discarded.
+	ctx, cancel := context.WithCancel(f.ctx)
+
+	clusterClient, err := f.clientFactory(ctx, contextName)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	objectStore, err := f.storeFactory(ctx, clusterClient)
+	if err != nil {
+		cancel()
+		clusterClient.Close()
+		return fmt.Errorf("create object store for context %s: %w", contextName, err)
+	}
+
+	member := &Member{
+		ContextName:   contextName,
+		ClusterClient: clusterClient,
+		ObjectStore:   objectStore,
+		ctx:           ctx,
+		cancel:        cancel,
+		pool:          auth.NewClientPool(),
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.members[contextName]; ok {
+		member.close()
+		return nil
+	}
+	f.members[contextName] = member
+
+	return nil
+}
+
+// Unload removes a kube context from the fleet. It returns false if the context was not loaded.
+func (f *Fleet) Unload(contextName string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	member, ok := f.members[contextName]
+	if !ok {
+		return false
+	}
+
+	member.close()
+	delete(f.members, contextName)
+	return true
+}
+
+// Member returns the member for a kube context.
+func (f *Fleet) Member(contextName string) (*Member, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	member, ok := f.members[contextName]
+	return member, ok
+}
+
+// Members returns the loaded members sorted by context name.
+func (f *Fleet) Members() []*Member {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var members []*Member
+	for _, member := range f.members {
+		members = append(members, member)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].ContextName < members[j].ContextName
+	})
+
+	return members
+}
+
+// Stop unloads every member.
+func (f *Fleet) Stop() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for name, member := range f.members {
+		member.close()
+		delete(f.members, name)
+	}
+}
+
+// UserContext returns a copy of ctx for requests to the member's cluster. If ctx has an
+// authenticated user, it carries a client for the member's cluster impersonating the
+// user, so the object store only returns objects the user can access. Otherwise it
+// carries the member's client.
+func (m *Member) UserContext(ctx context.Context) (context.Context, error) {
+	user, ok := auth.UserFrom(ctx)
+	if !ok {
+		return cluster.WithClient(ctx, m.ClusterClient), nil
+	}
+
+	client, err := m.pool.ClientFor(m.ctx, m.ClusterClient, user)
+	if err != nil {
+		return nil, fmt.Errorf("context %s: %w", m.ContextName, err)
+	}
+	return cluster.WithClient(ctx, client), nil
+}
+
+func (m *Member) close() {
+	m.cancel()
+	m.pool.Close()
+	m.ClusterClient.Close()
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestFleet(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clients := make(map[string]*clusterFake.MockClientInterface)
+	clientFactory := func(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+		if contextName == "invalid" {
+			return nil, fmt.Errorf("invalid context")
+		}
+		client := clusterFake.NewMockClientInterface(controller)
+		clients[contextName] = client
+		return client, nil
+	}
+
+	var storeContexts []context.Context
+	storeFactory := func(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+		storeContexts = append(storeContexts, ctx)
+		return storeFake.NewMockStore(controller), nil
+	}
+
+	f := New(context.Background(), clientFactory, storeFactory)
+
+	require.NoError(t, f.Load("b"))
+	require.NoError(t, f.Load("a"))
+	require.NoError(t, f.Load("a"), "loading a context twice does nothing")
+	require.Error(t, f.Load("invalid"))
+	require.Error(t, f.Load(""))
+
+	members := f.Members()
+	require.Len(t, members, 2)
+	assert.Equal(t, "a", members[0].ContextName)
+	assert.Equal(t, "b", members[1].ContextName)
+	assert.Equal(t, clients["a"], members[0].ClusterClient)
+
+	clients["a"].EXPECT().Close()
+	assert.True(t, f.Unload("a"))
+	assert.False(t, f.Unload("a"))
+	assert.Error(t, storeContexts[1].Err(), "unloading cancels the member's context")
+
+	_, ok := f.Member("a")
+	assert.False(t, ok)
+	member, ok := f.Member("b")
+	require.True(t, ok)
+	assert.Equal(t, "b", member.ContextName)
+
+	clients["b"].EXPECT().Close()
+	f.Stop()
+	assert.Empty(t, f.Members())
+	assert.Error(t, storeContexts[0].Err())
+}
+
+func TestFleet_Load_concurrent(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var mu sync.Mutex
+	var clients []*clusterFake.MockClientInterface
+	started := make(chan struct{})
+	release := make(chan struct{})
+	clientFactory := func(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+		client := clusterFake.NewMockClientInterface(controller)
+		mu.Lock()
+		clients = append(clients, client)
+		mu.Unlock()
+		started <- struct{}{}
+		<-release
+		return client, nil
+	}
+	storeFactory := func(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+		return storeFake.NewMockStore(controller), nil
+	}
+
+	f := New(context.Background(), clientFactory, storeFactory)
+
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			errs <- f.Load("a")
+		}()
+	}
+	<-started
+	<-started
+
+	assert.Empty(t, f.Members(), "the fleet can be used while contexts are loading")
+
+	var closed int32
+	for _, client := range clients {
+		client.EXPECT().Close().Do(func() { atomic.AddInt32(&closed, 1) }).MaxTimes(1)
+	}
+	close(release)
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+
+	require.Len(t, f.Members(), 1)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&closed), "the member loaded last is discarded")
+
+	f.Stop()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&closed))
+}
+
+type impersonatingClient struct {
+	*clusterFake.MockClientInterface
+	impersonated cluster.ClientInterface
+	config       rest.ImpersonationConfig
+}
+
+func (c *impersonatingClient) Impersonate(ctx context.Context, config rest.ImpersonationConfig) (cluster.ClientInterface, error) {
+	c.config = config
+	return c.impersonated, nil
+}
+
+func TestMember_UserContext(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	impersonated := clusterFake.NewMockClientInterface(controller)
+	client := &impersonatingClient{
+		MockClientInterface: clusterFake.NewMockClientInterface(controller),
+		impersonated:        impersonated,
+	}
+	clientFactory := func(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+		return client, nil
+	}
+	storeFactory := func(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+		return storeFake.NewMockStore(controller), nil
+	}
+
+	f := New(context.Background(), clientFactory, storeFactory)
+	require.NoError(t, f.Load("a"))
+	member, ok := f.Member("a")
+	require.True(t, ok)
+
+	ctx, err := member.UserContext(context.Background())
+	require.NoError(t, err)
+	got, ok := cluster.ClientFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, cluster.ClientInterface(client), got, "requests without a user use the member's client")
+
+	userCtx := auth.WithUser(context.Background(), auth.User{Name: "jane", Groups: []string{"dev"}})
+	ctx, err = member.UserContext(userCtx)
+	require.NoError(t, err)
+	got, ok = cluster.ClientFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, cluster.ClientInterface(impersonated), got)
+	assert.Equal(t, "jane", client.config.UserName)
+
+	impersonated.EXPECT().Close()
+	client.EXPECT().Close()
+	f.Stop()
+}
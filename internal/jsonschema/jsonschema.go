@@ -0,0 +1,382 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package jsonschema validates JSON documents against a JSON Schema. It supports the
+// keywords used by the component schema: $ref to local definitions, type, properties,
+// required, additionalProperties, items, enum, const, allOf, anyOf, oneOf and
+// if/then/else. Other keywords are ignored.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxErrors is the number of errors included in the message of Errors.
+const maxErrors = 5
+
+// Error is a validation error at a location in the document.
+type Error struct {
+	// Path is the JSON pointer of the invalid value, e.g. "/config/rows/0".
+	Path    string
+	Message string
+	// keyword is the keyword which failed.
+	keyword string
+}
+
+// Error returns the error message.
+func (e Error) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("%s: %s", path, e.Message)
+}
+
+// Errors are the validation errors of a document.
+type Errors []Error
+
+// Error returns the messages of the first errors.
+func (e Errors) Error() string {
+	var messages []string
+	for i, err := range e {
+		if i == maxErrors {
+			messages = append(messages, fmt.Sprintf("and %d more", len(e)-maxErrors))
+			break
+		}
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Schema is a parsed JSON Schema.
+type Schema struct {
+	root map[string]interface{}
+}
+
+// Parse parses a JSON Schema.
+func Parse(data []byte) (*Schema, error) {
+	var root map[string]interface{}
+	if err := decode(data, &root); err != nil {
+		return nil, fmt.Errorf("parse JSON schema: %w", err)
+	}
+
+	return &Schema{root: root}, nil
+}
+
+// Validate validates a JSON document against a definition of the schema, or against the
+// schema itself if definition is empty. It returns Errors if the document is invalid.
+func (s *Schema) Validate(definition string, data []byte) error {
+	var value interface{}
+	if err := decode(data, &value); err != nil {
+		return fmt.Errorf("decode document: %w", err)
+	}
+
+	schema := s.root
+	if definition != "" {
+		var err error
+		schema, err = s.resolve("#/definitions/" + definition)
+		if err != nil {
+			return err
+		}
+	}
+
+	v := validator{schema: s}
+	if errs := v.validate(schema, value, ""); len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func decode(data []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+func (s *Schema) resolve(ref string) (map[string]interface{}, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("reference %q is not local", ref)
+	}
+
+	var current interface{} = s.root
+	for _, part := range strings.Split(strings.TrimPrefix(ref, "#"), "/") {
+		if part == "" {
+			continue
+		}
+		part = strings.NewReplacer("~1", "/", "~0", "~").Replace(part)
+
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("reference %q not found", ref)
+		}
+		if current, ok = m[part]; !ok {
+			return nil, fmt.Errorf("reference %q not found", ref)
+		}
+	}
+
+	schema, ok := current.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("reference %q is not a schema", ref)
+	}
+	return schema, nil
+}
+
+type validator struct {
+	schema *Schema
+}
+
+func (v validator) validate(schema map[string]interface{}, value interface{}, path string) Errors {
+	if ref, ok := schema["$ref"].(string); ok {
+		// keywords next to $ref are ignored in draft 7.
+		resolved, err := v.schema.resolve(ref)
+		if err != nil {
+			return Errors{{Path: path, Message: err.Error(), keyword: "$ref"}}
+		}
+		return v.validate(resolved, value, path)
+	}
+
+	if t, ok := schema["type"]; ok && !matchesType(t, value) {
+		return Errors{{
+			Path:    path,
+			Message: fmt.Sprintf("expected %s, got %s", describeType(t), typeOf(value)),
+			keyword: "type",
+		}}
+	}
+
+	var errs Errors
+
+	if c, ok := schema["const"]; ok && !equal(c, value) {
+		errs = append(errs, Error{Path: path, Message: fmt.Sprintf("expected %s", format(c)), keyword: "const"})
+	}
+
+	if list, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, item := range list {
+			if equal(item, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errs = append(errs, Error{Path: path, Message: fmt.Sprintf("unknown value %s", format(value)), keyword: "enum"})
+		}
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		errs = append(errs, v.validateObject(schema, value, path)...)
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range value {
+				errs = append(errs, v.validate(items, item, fmt.Sprintf("%s/%d", path, i))...)
+			}
+		}
+	}
+
+	for _, sub := range schemas(schema["allOf"]) {
+		errs = append(errs, v.validate(sub, value, path)...)
+	}
+
+	if list := schemas(schema["anyOf"]); len(list) > 0 {
+		errs = append(errs, v.validateAlternatives(list, value, path, false)...)
+	}
+
+	if list := schemas(schema["oneOf"]); len(list) > 0 {
+		errs = append(errs, v.validateAlternatives(list, value, path, true)...)
+	}
+
+	if condition, ok := schema["if"].(map[string]interface{}); ok {
+		if len(v.validate(condition, value, path)) == 0 {
+			if then, ok := schema["then"].(map[string]interface{}); ok {
+				errs = append(errs, v.validate(then, value, path)...)
+			}
+		} else if otherwise, ok := schema["else"].(map[string]interface{}); ok {
+			errs = append(errs, v.validate(otherwise, value, path)...)
+		}
+	}
+
+	return errs
+}
+
+func (v validator) validateObject(schema map[string]interface{}, value map[string]interface{}, path string) Errors {
+	var errs Errors
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			name, ok := name.(string)
+			if !ok {
+				continue
+			}
+			if _, ok := value[name]; !ok {
+				errs = append(errs, Error{Path: path, Message: fmt.Sprintf("missing property %q", name), keyword: "required"})
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertyPath := path + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			errs = append(errs, v.validate(property, value[name], propertyPath)...)
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				errs = append(errs, Error{Path: propertyPath, Message: "unknown property", keyword: "additionalProperties"})
+			}
+		case map[string]interface{}:
+			errs = append(errs, v.validate(additional, value[name], propertyPath)...)
+		}
+	}
+
+	return errs
+}
+
+// validateAlternatives validates a value against the schemas of anyOf, or oneOf if
+// exactlyOne is true. If the value matches none of them, and all but one of them fail
+// because the value has another type, the errors of that schema are returned since they
+// are more helpful than the value not matching any of the schemas.
+func (v validator) validateAlternatives(list []map[string]interface{}, value interface{}, path string, exactlyOne bool) Errors {
+	matches := 0
+	var candidates []Errors
+	for _, sub := range list {
+		errs := v.validate(sub, value, path)
+		if len(errs) == 0 {
+			matches++
+			continue
+		}
+		if len(errs) == 1 && errs[0].Path == path && errs[0].keyword == "type" {
+			continue
+		}
+		candidates = append(candidates, errs)
+	}
+
+	switch {
+	case matches == 0 && len(candidates) == 1:
+		return candidates[0]
+	case matches == 0:
+		return Errors{{Path: path, Message: "value doesn't match any of the allowed schemas", keyword: "anyOf"}}
+	case exactlyOne && matches > 1:
+		return Errors{{Path: path, Message: "value matches more than one of the allowed schemas", keyword: "oneOf"}}
+	default:
+		return nil
+	}
+}
+
+func schemas(i interface{}) []map[string]interface{} {
+	list, ok := i.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var out []map[string]interface{}
+	for _, item := range list {
+		if schema, ok := item.(map[string]interface{}); ok {
+			out = append(out, schema)
+		}
+	}
+	return out
+}
+
+func matchesType(t interface{}, value interface{}) bool {
+	switch t := t.(type) {
+	case string:
+		return isType(t, value)
+	case []interface{}:
+		for _, item := range t {
+			if name, ok := item.(string); ok && isType(name, value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+func isType(name string, value interface{}) bool {
+	switch name {
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+		f, ok := new(big.Float).SetString(n.String())
+		return ok && f.IsInt()
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+	default:
+		return typeOf(value) == name
+	}
+}
+
+func typeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func describeType(t interface{}) string {
+	list, ok := t.([]interface{})
+	if !ok {
+		return fmt.Sprint(t)
+	}
+
+	var names []string
+	for _, item := range list {
+		names = append(names, fmt.Sprint(item))
+	}
+	return strings.Join(names, " or ")
+}
+
+func equal(a, b interface{}) bool {
+	an, aok := a.(json.Number)
+	bn, bok := b.(json.Number)
+	if aok && bok {
+		af, _ := new(big.Float).SetString(an.String())
+		bf, _ := new(big.Float).SetString(bn.String())
+		return af != nil && bf != nil && af.Cmp(bf) == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func format(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonschema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+  "$ref": "#/definitions/item",
+  "definitions": {
+    "item": {
+      "type": "object",
+      "required": ["kind", "spec"],
+      "properties": {
+        "kind": {"enum": ["a", "b"]},
+        "version": {"const": 1},
+        "count": {"type": "integer"},
+        "name": {"anyOf": [{"type": "null"}, {"$ref": "#/definitions/name"}]},
+        "tags": {"type": ["array", "null"], "items": {"type": "string"}},
+        "spec": {"type": "object"}
+      },
+      "additionalProperties": false,
+      "allOf": [
+        {
+          "if": {"properties": {"kind": {"const": "a"}}},
+          "then": {"properties": {"spec": {"required": ["a"]}}},
+          "else": {"properties": {"spec": {"required": ["b"]}}}
+        }
+      ]
+    },
+    "name": {"type": "string"},
+    "choice": {"oneOf": [{"type": "string"}, {"enum": ["x"]}]}
+  }
+}`
+
+func TestSchema_Validate(t *testing.T) {
+	schema, err := Parse([]byte(testSchema))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		definition string
+		data       string
+		wantErrors []string
+	}{
+		{
+			name: "valid",
+			data: `{"kind":"a","version":1.0,"count":3,"name":"n","tags":["x"],"spec":{"a":true}}`,
+		},
+		{
+			name: "null values",
+			data: `{"kind":"b","name":null,"tags":null,"spec":{"b":true}}`,
+		},
+		{
+			name:       "definition",
+			definition: "name",
+			data:       `"n"`,
+		},
+		{
+			name:       "wrong type",
+			data:       `[]`,
+			wantErrors: []string{"/: expected object, got array"},
+		},
+		{
+			name:       "missing property",
+			data:       `{"kind":"a"}`,
+			wantErrors: []string{`/: missing property "spec"`},
+		},
+		{
+			name:       "unknown value",
+			data:       `{"kind":"c","spec":{"b":true}}`,
+			wantErrors: []string{`/kind: unknown value "c"`},
+		},
+		{
+			name:       "const",
+			data:       `{"kind":"a","version":2,"spec":{"a":true}}`,
+			wantErrors: []string{"/version: expected 1"},
+		},
+		{
+			name:       "integer",
+			data:       `{"kind":"a","count":1.5,"spec":{"a":true}}`,
+			wantErrors: []string{"/count: expected integer, got number"},
+		},
+		{
+			name:       "nullable reference",
+			data:       `{"kind":"a","name":1,"spec":{"a":true}}`,
+			wantErrors: []string{"/name: value doesn't match any of the allowed schemas"},
+		},
+		{
+			name:       "array items",
+			data:       `{"kind":"a","tags":["x",1],"spec":{"a":true}}`,
+			wantErrors: []string{"/tags/1: expected string, got number"},
+		},
+		{
+			name:       "additional property",
+			data:       `{"kind":"a","extra":1,"spec":{"a":true}}`,
+			wantErrors: []string{"/extra: unknown property"},
+		},
+		{
+			name:       "if then",
+			data:       `{"kind":"a","spec":{"b":true}}`,
+			wantErrors: []string{`/spec: missing property "a"`},
+		},
+		{
+			name:       "if else",
+			data:       `{"kind":"b","spec":{"a":true}}`,
+			wantErrors: []string{`/spec: missing property "b"`},
+		},
+		{
+			name:       "one of matches more than one",
+			definition: "choice",
+			data:       `"x"`,
+			wantErrors: []string{"/: value matches more than one of the allowed schemas"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := schema.Validate(test.definition, []byte(test.data))
+			if len(test.wantErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs Errors
+			require.True(t, errors.As(err, &errs), "error is %v", err)
+
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			assert.Equal(t, test.wantErrors, got)
+		})
+	}
+}
+
+func TestSchema_Validate_invalid_input(t *testing.T) {
+	schema, err := Parse([]byte(testSchema))
+	require.NoError(t, err)
+
+	require.Error(t, schema.Validate("", []byte(`{`)))
+	require.Error(t, schema.Validate("missing", []byte(`{}`)))
+}
+
+func TestParse_invalid(t *testing.T) {
+	_, err := Parse([]byte(`[]`))
+	require.Error(t, err)
+}
+
+func TestErrors_Error(t *testing.T) {
+	var errs Errors
+	for i := 0; i < 7; i++ {
+		errs = append(errs, Error{Path: "/a", Message: "invalid"})
+	}
+
+	assert.Equal(t, "/a: invalid; /a: invalid; /a: invalid; /a: invalid; /a: invalid; and 2 more", errs.Error())
+}
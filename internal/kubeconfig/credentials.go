@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	internalCluster "github.com/vmware-tanzu/octant/internal/cluster"
+)
+
+// CredentialStatus describes whether the API server accepts the credentials of the current context.
+type CredentialStatus int
+
+const (
+	// CredentialsValid means the API server has not rejected the credentials.
+	CredentialsValid CredentialStatus = iota
+	// CredentialsRejected means the API server responded with 401 Unauthorized. Refreshing
+	// the credentials may fix this.
+	CredentialsRejected
+	// CredentialsExpired means the API server rejected the credentials shortly after they
+	// were refreshed. The user has to log in again.
+	CredentialsExpired
+)
+
+// credentialsRefreshWindow is how long after a refresh a 401 response means the
+// refreshed credentials were rejected as well.
+const credentialsRefreshWindow = time.Minute
+
+// CredentialStatus returns the status of the credentials of the current context.
+func (k *KubeConfigContextManager) CredentialStatus() CredentialStatus {
+	k.credentialsMu.Lock()
+	defer k.credentialsMu.Unlock()
+
+	return k.credentialStatus
+}
+
+// newClusterClient creates a cluster client for contextName which reports 401 responses.
+// Responses to clients created before are ignored.
+func (k *KubeConfigContextManager) newClusterClient(ctx context.Context, contextName string, refresh bool) (*internalCluster.Cluster, error) {
+	k.credentialsMu.Lock()
+	k.clientGeneration++
+	generation := k.clientGeneration
+	k.credentialStatus = CredentialsValid
+	if refresh {
+		k.credentialsRefreshed = time.Now()
+	} else {
+		k.credentialsRefreshed = time.Time{}
+	}
+	k.credentialsMu.Unlock()
+
+	options := append([]internalCluster.ClusterOption{}, k.clusterOptions...)
+	options = append(options, internalCluster.WithUnauthorizedHandler(func() {
+		k.unauthorized(generation)
+	}))
+
+	return internalCluster.FromClientConfig(ctx, k.clientConfig(contextName), options...)
+}
+
+func (k *KubeConfigContextManager) unauthorized(generation int) {
+	k.credentialsMu.Lock()
+	defer k.credentialsMu.Unlock()
+
+	if generation != k.clientGeneration || k.credentialStatus == CredentialsExpired {
+		return
+	}
+
+	if !k.credentialsRefreshed.IsZero() && time.Since(k.credentialsRefreshed) < credentialsRefreshWindow {
+		k.credentialStatus = CredentialsExpired
+		return
+	}
+
+	k.credentialStatus = CredentialsRejected
+}
+
+// contextFingerprint returns a hash of the cluster and user of a context. Other fields of
+// the context, like its namespace, are ignored because they do not need a new cluster client.
+func contextFingerprint(config clientcmdapi.Config, contextName string) string {
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return ""
+	}
+
+	data, err := json.Marshal(struct {
+		ClusterName string
+		Cluster     *clientcmdapi.Cluster
+		UserName    string
+		User        *clientcmdapi.AuthInfo
+	}{
+		ClusterName: kubeContext.Cluster,
+		Cluster:     config.Clusters[kubeContext.Cluster],
+		UserName:    kubeContext.AuthInfo,
+		User:        config.AuthInfos[kubeContext.AuthInfo],
+	})
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubeconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKubeConfigContextManager_CredentialStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	files := copyTestdata(t, "kubeconfig.yaml")
+	require.NoError(t, ioutil.WriteFile(files[0], []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: ctx
+clusters:
+- cluster:
+    server: %s
+  name: cluster
+contexts:
+- context:
+    cluster: cluster
+    user: user
+  name: ctx
+users:
+- name: user
+  user:
+    token: expired
+`, server.URL)), 0600))
+
+	ctx := context.Background()
+	kc, err := NewKubeConfigContextManager(ctx, WithKubeConfigList(files[0]))
+	require.NoError(t, err)
+	assert.Equal(t, CredentialsValid, kc.CredentialStatus())
+
+	listNamespaces := func() {
+		client, err := kc.ClusterClient().KubernetesClient()
+		require.NoError(t, err)
+		_, err = client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		require.Error(t, err)
+	}
+
+	listNamespaces()
+	assert.Equal(t, CredentialsRejected, kc.CredentialStatus())
+
+	require.NoError(t, kc.RefreshCredentials(ctx))
+	assert.Equal(t, CredentialsValid, kc.CredentialStatus())
+	assert.Equal(t, "ctx", kc.CurrentContext())
+
+	listNamespaces()
+	assert.Equal(t, CredentialsExpired, kc.CredentialStatus(), "refreshed credentials were rejected")
+
+	require.NoError(t, kc.SwitchContext(ctx, "ctx"))
+	assert.Equal(t, CredentialsValid, kc.CredentialStatus())
+}
+
+func TestKubeConfigContextManager_Reload(t *testing.T) {
+	files := copyTestdata(t, "kubeconfig.yaml")
+
+	kc, err := NewKubeConfigContextManager(context.TODO(), WithKubeConfigList(files[0]))
+	require.NoError(t, err)
+
+	fsContext, changed, err := kc.Reload()
+	require.NoError(t, err)
+	assert.Equal(t, "my-cluster", fsContext)
+	assert.False(t, changed)
+
+	editor := kc.KubeConfigEditor()
+	require.NoError(t, editor.SetNamespace("my-cluster", "other"))
+	require.NoError(t, editor.SetFavorite("my-cluster", true))
+
+	_, changed, err = kc.Reload()
+	require.NoError(t, err)
+	assert.False(t, changed, "the namespace and favorites do not change the client")
+
+	data, err := ioutil.ReadFile(files[0])
+	require.NoError(t, err)
+	data = bytes.Replace(data, []byte("my-token"), []byte("rotated-token"), 1)
+	require.NoError(t, ioutil.WriteFile(files[0], data, 0600))
+
+	_, changed, err = kc.Reload()
+	require.NoError(t, err)
+	assert.True(t, changed, "the user of the current context changed")
+
+	require.NoError(t, kc.SwitchContext(context.TODO(), "my-cluster"))
+	_, changed, err = kc.Reload()
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
@@ -0,0 +1,372 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubeconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// FavoriteExtension is the name of the context extension which marks a context as a favorite.
+const FavoriteExtension = "octant.dev/favorite"
+
+// BackupSuffix is appended to the name of a kube config file to name its backup.
+const BackupSuffix = ".octant.bak"
+
+// ContextDetails describes a context and the file it is defined in.
+type ContextDetails struct {
+	Name      string
+	Cluster   string
+	User      string
+	Namespace string
+	File      string
+	Favorite  bool
+}
+
+// Editor edits the kube config files in a loading chain. Like kubectl, it changes a context in
+// the first file which defines it. Files are replaced atomically and the previous version of
+// a file is kept as a backup.
+type Editor struct {
+	mu       sync.Mutex
+	files    []string
+	onChange func()
+}
+
+// NewEditor creates an instance of Editor for a loading chain. onChange is called after a
+// file has been written. It can be nil.
+func NewEditor(files []string, onChange func()) *Editor {
+	if len(files) == 0 {
+		files = []string{clientcmd.RecommendedHomeFile}
+	}
+
+	return &Editor{
+		files:    files,
+		onChange: onChange,
+	}
+}
+
+// Files returns the files in the loading chain.
+func (e *Editor) Files() []string {
+	return append([]string(nil), e.files...)
+}
+
+// Contexts returns the contexts defined in the loading chain sorted by name.
+func (e *Editor) Contexts() ([]ContextDetails, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var list []ContextDetails
+	for _, file := range e.files {
+		config, err := loadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, kubeContext := range config.Contexts {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			list = append(list, ContextDetails{
+				Name:      name,
+				Cluster:   kubeContext.Cluster,
+				User:      kubeContext.AuthInfo,
+				Namespace: kubeContext.Namespace,
+				File:      file,
+				Favorite:  isFavorite(kubeContext),
+			})
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
+
+// ImportFile merges the clusters, users and contexts in source into target. Entries with the
+// same name are replaced. It returns the names of the imported contexts.
+func (e *Editor) ImportFile(source, target string) ([]string, error) {
+	imported, err := clientcmd.LoadFromFile(source)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load kube config %s", source)
+	}
+
+	// Relative certificate paths in source must still work after they are copied to target.
+	if err := clientcmd.ResolveLocalPaths(imported); err != nil {
+		return nil, err
+	}
+
+	return e.merge(imported, target)
+}
+
+// Import merges the clusters, users and contexts in a kube config into target. Entries with
+// the same name are replaced. It returns the names of the imported contexts.
+func (e *Editor) Import(data []byte, target string) ([]string, error) {
+	imported, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse kube config")
+	}
+
+	return e.merge(imported, target)
+}
+
+func (e *Editor) merge(imported *clientcmdapi.Config, target string) ([]string, error) {
+	if len(imported.Contexts) == 0 {
+		return nil, errors.New("kube config does not contain any contexts")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.inChain(target) {
+		return nil, errors.Errorf("%s is not a kube config file in use", target)
+	}
+
+	config, err := loadFile(target)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, cluster := range imported.Clusters {
+		config.Clusters[name] = cluster
+	}
+	for name, authInfo := range imported.AuthInfos {
+		config.AuthInfos[name] = authInfo
+	}
+
+	var names []string
+	for name, kubeContext := range imported.Contexts {
+		config.Contexts[name] = kubeContext
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if config.CurrentContext == "" {
+		config.CurrentContext = imported.CurrentContext
+	}
+
+	if err := e.write(target, config); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// RenameContext renames a context. Files in the loading chain which use the context as their
+// current context are updated.
+func (e *Editor) RenameContext(name, newName string) error {
+	if newName == "" {
+		return errors.New("new context name is blank")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, _, err := e.findContext(newName); err == nil {
+		return errors.Errorf("context %s already exists", newName)
+	}
+
+	file, config, err := e.findContext(name)
+	if err != nil {
+		return err
+	}
+
+	config.Contexts[newName] = config.Contexts[name]
+	delete(config.Contexts, name)
+	if config.CurrentContext == name {
+		config.CurrentContext = newName
+	}
+	if err := e.write(file, config); err != nil {
+		return err
+	}
+
+	for _, other := range e.files {
+		if other == file {
+			continue
+		}
+
+		otherConfig, err := loadFile(other)
+		if err != nil {
+			return err
+		}
+		if otherConfig.CurrentContext != name {
+			continue
+		}
+
+		otherConfig.CurrentContext = newName
+		if err := e.write(other, otherConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteContext deletes a context. The context's cluster and user are not deleted because
+// other contexts can refer to them.
+func (e *Editor) DeleteContext(name string) error {
+	return e.updateContext(name, func(config *clientcmdapi.Config, kubeContext *clientcmdapi.Context) {
+		delete(config.Contexts, name)
+		if config.CurrentContext == name {
+			config.CurrentContext = ""
+		}
+	})
+}
+
+// SetNamespace sets the default namespace of a context. A blank namespace removes it.
+func (e *Editor) SetNamespace(name, namespace string) error {
+	return e.updateContext(name, func(config *clientcmdapi.Config, kubeContext *clientcmdapi.Context) {
+		kubeContext.Namespace = namespace
+	})
+}
+
+// SetFavorite pins or unpins a context. Favorites are stored as an extension of the context so
+// they are kept with the kube config.
+func (e *Editor) SetFavorite(name string, favorite bool) error {
+	return e.updateContext(name, func(config *clientcmdapi.Config, kubeContext *clientcmdapi.Context) {
+		if !favorite {
+			delete(kubeContext.Extensions, FavoriteExtension)
+			return
+		}
+
+		if kubeContext.Extensions == nil {
+			kubeContext.Extensions = make(map[string]runtime.Object)
+		}
+		kubeContext.Extensions[FavoriteExtension] = &runtime.Unknown{
+			Raw:         []byte("true"),
+			ContentType: runtime.ContentTypeJSON,
+		}
+	})
+}
+
+func (e *Editor) updateContext(name string, fn func(*clientcmdapi.Config, *clientcmdapi.Context)) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	file, config, err := e.findContext(name)
+	if err != nil {
+		return err
+	}
+
+	fn(config, config.Contexts[name])
+
+	return e.write(file, config)
+}
+
+// findContext finds the first file in the loading chain which defines a context.
+func (e *Editor) findContext(name string) (string, *clientcmdapi.Config, error) {
+	for _, file := range e.files {
+		config, err := loadFile(file)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if _, ok := config.Contexts[name]; ok {
+			return file, config, nil
+		}
+	}
+
+	return "", nil, errors.Errorf("context %s does not exist", name)
+}
+
+func (e *Editor) inChain(file string) bool {
+	for _, f := range e.files {
+		if f == file {
+			return true
+		}
+	}
+	return false
+}
+
+// write replaces file with config. The current file is copied to a backup first. The new file
+// is written to a temporary file in the same directory and renamed over file.
+func (e *Editor) write(file string, config *clientcmdapi.Config) error {
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return errors.Wrap(err, "encode kube config")
+	}
+
+	current, err := ioutil.ReadFile(file)
+	switch {
+	case err == nil:
+		if err := ioutil.WriteFile(file+BackupSuffix, current, 0600); err != nil {
+			return errors.Wrapf(err, "back up %s", file)
+		}
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, fmt.Sprintf(".%s-", filepath.Base(file)))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return errors.Wrapf(err, "replace %s", file)
+	}
+
+	if e.onChange != nil {
+		e.onChange()
+	}
+
+	return nil
+}
+
+// loadFile loads a kube config file. A file which does not exist is empty.
+func loadFile(file string) (*clientcmdapi.Config, error) {
+	config, err := clientcmd.LoadFromFile(file)
+	if os.IsNotExist(err) {
+		return clientcmdapi.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "load kube config %s", file)
+	}
+	return config, nil
+}
+
+func isFavorite(kubeContext *clientcmdapi.Context) bool {
+	extension, ok := kubeContext.Extensions[FavoriteExtension].(*runtime.Unknown)
+	if !ok {
+		return false
+	}
+
+	favorite, err := strconv.ParseBool(string(extension.Raw))
+	return err == nil && favorite
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestEditor(t *testing.T) {
+	files := copyTestdata(t, "kubeconfig-1.yaml", "kubeconfig-2.yaml")
+
+	changes := 0
+	editor := NewEditor(files, func() { changes++ })
+
+	contexts, err := editor.Contexts()
+	require.NoError(t, err)
+	assert.Equal(t, []ContextDetails{
+		{Name: "dev-frontend", Cluster: "development", File: files[0]},
+		{Name: "dev-storage", Cluster: "development", File: files[0]},
+		{Name: "exp-scratch", Cluster: "scratch", File: files[1]},
+	}, contexts)
+
+	require.NoError(t, editor.SetNamespace("exp-scratch", "scratch-ns"))
+	require.NoError(t, editor.SetFavorite("dev-storage", true))
+	require.NoError(t, editor.RenameContext("dev-frontend", "frontend"))
+	require.Error(t, editor.RenameContext("dev-storage", "exp-scratch"), "names must be unique")
+	require.Error(t, editor.DeleteContext("missing"))
+	require.NoError(t, editor.DeleteContext("dev-storage"))
+	assert.Equal(t, 4, changes)
+
+	contexts, err = editor.Contexts()
+	require.NoError(t, err)
+	assert.Equal(t, []ContextDetails{
+		{Name: "exp-scratch", Cluster: "scratch", Namespace: "scratch-ns", File: files[1]},
+		{Name: "frontend", Cluster: "development", File: files[0]},
+	}, contexts)
+
+	config, err := clientcmd.LoadFromFile(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, "frontend", config.CurrentContext)
+	assert.Contains(t, config.Clusters, "development", "clusters of deleted contexts are kept")
+
+	backup, err := clientcmd.LoadFromFile(files[0] + BackupSuffix)
+	require.NoError(t, err)
+	assert.Contains(t, backup.Contexts, "dev-storage", "the backup is the previous version")
+
+	info, err := os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestEditor_SetFavorite(t *testing.T) {
+	files := copyTestdata(t, "kubeconfig.yaml")
+	editor := NewEditor(files, nil)
+
+	require.NoError(t, editor.SetFavorite("other-context", true))
+
+	contexts, err := editor.Contexts()
+	require.NoError(t, err)
+	require.Len(t, contexts, 2)
+	assert.False(t, contexts[0].Favorite)
+	assert.True(t, contexts[1].Favorite)
+
+	require.NoError(t, editor.SetFavorite("other-context", false))
+
+	contexts, err = editor.Contexts()
+	require.NoError(t, err)
+	assert.False(t, contexts[1].Favorite)
+}
+
+func TestEditor_ImportFile(t *testing.T) {
+	files := copyTestdata(t, "kubeconfig-1.yaml")
+	source := filepath.Join("testdata", "kubeconfig.yaml")
+
+	editor := NewEditor(files, nil)
+
+	names, err := editor.ImportFile(source, files[0])
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-cluster", "other-context"}, names)
+
+	config, err := clientcmd.LoadFromFile(files[0])
+	require.NoError(t, err)
+	assert.Len(t, config.Contexts, 4)
+	assert.Contains(t, config.Clusters, "my-cluster")
+	assert.Equal(t, "my-token", config.AuthInfos["user"].Token)
+	assert.Equal(t, "dev-frontend", config.CurrentContext, "the current context is kept")
+
+	_, err = editor.ImportFile(source, filepath.Join(filepath.Dir(files[0]), "other"))
+	require.Error(t, err, "only files in the loading chain can be changed")
+
+	_, err = editor.Import([]byte("not a kube config"), files[0])
+	require.Error(t, err)
+}
+
+func TestKubeConfigContextManager_KubeConfigEditor(t *testing.T) {
+	files := copyTestdata(t, "kubeconfig.yaml")
+
+	kc, err := NewKubeConfigContextManager(context.TODO(), WithKubeConfigList(files[0]))
+	require.NoError(t, err)
+
+	require.NoError(t, kc.KubeConfigEditor().SetFavorite("other-context", true))
+	require.NoError(t, kc.KubeConfigEditor().RenameContext("my-cluster", "renamed"))
+
+	assert.Equal(t, []Context{
+		{Name: "other-context", Favorite: true},
+		{Name: "renamed"},
+	}, kc.Contexts())
+}
+
+// copyTestdata copies testdata files to a temporary directory and returns their paths.
+func copyTestdata(t *testing.T, names ...string) []string {
+	dir, err := ioutil.TempDir("", "editor-test")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	var paths []string
+	for _, name := range names {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+
+		p := filepath.Join(dir, strings.TrimSuffix(name, ".yaml"))
+		require.NoError(t, ioutil.WriteFile(p, data, 0644))
+		paths = append(paths, p)
+	}
+
+	return paths
+}
@@ -9,9 +9,12 @@ import (
 	"context"
 	"path/filepath"
 	"sort"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/pkg/errors"
 
@@ -69,47 +72,32 @@ func NewKubeConfigContextManager(ctx context.Context, opts ...KubeConfigOption)
 		}
 	}
 	chain := strings.Deduplicate(filepath.SplitList(options.KubeConfigList))
-	rules := &clientcmd.ClientConfigLoadingRules{
-		Precedence: chain,
+	kubeConfigCtxMgr := &KubeConfigContextManager{
+		configLoadingRules: &clientcmd.ClientConfigLoadingRules{
+			Precedence: chain,
+		},
+		clusterOptions: clusterOptions,
 	}
 
-	overrides := &clientcmd.ConfigOverrides{}
-	if options.ContextName != "" {
-		overrides.CurrentContext = options.ContextName
-	}
-	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
-	clusterClient, err := internalCluster.FromClientConfig(ctx, clientConfig, clusterOptions...)
+	clusterClient, err := kubeConfigCtxMgr.newClusterClient(ctx, options.ContextName, false)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create cluster client")
 	}
 
-	config, err := clientConfig.RawConfig()
+	config, err := kubeConfigCtxMgr.clientConfig(options.ContextName).RawConfig()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to load kube config")
 	}
-	var contextList []Context
-
-	for name := range config.Contexts {
-		contextList = append(contextList, Context{Name: name})
-	}
-
-	sort.Slice(contextList, func(i, j int) bool {
-		return contextList[i].Name < contextList[j].Name
-	})
 
 	contextName := options.ContextName
 	if contextName == "" {
 		contextName = config.CurrentContext
 	}
 
-	kubeConfigCtxMgr := &KubeConfigContextManager{
-		configLoadingRules: &clientcmd.ClientConfigLoadingRules{
-			Precedence: chain,
-		},
-		currentContext: contextName,
-		contexts:       contextList,
-		clusterOptions: clusterOptions,
-	}
+	kubeConfigCtxMgr.currentContext = contextName
+	kubeConfigCtxMgr.contexts = contextList(config)
+	kubeConfigCtxMgr.fingerprint = contextFingerprint(config, contextName)
+	kubeConfigCtxMgr.editor = NewEditor(chain, kubeConfigCtxMgr.reloadContexts)
 	kubeConfigCtxMgr.clusterClient.Store(clusterClient)
 	return kubeConfigCtxMgr, nil
 }
@@ -117,58 +105,144 @@ func NewKubeConfigContextManager(ctx context.Context, opts ...KubeConfigOption)
 type KubeConfigContextManager struct {
 	configLoadingRules *clientcmd.ClientConfigLoadingRules
 	currentContext     string
+	contextsMu         sync.RWMutex
 	contexts           []Context
 	clusterClient      atomic.Value // cluster.ClientInterface
 	clusterOptions     []internalCluster.ClusterOption
+	editor             *Editor
+	fingerprint        string
+
+	credentialsMu        sync.Mutex
+	clientGeneration     int
+	credentialStatus     CredentialStatus
+	credentialsRefreshed time.Time
 }
 
 // Context describes a kube config context.
 type Context struct {
-	Name string `json:"name"`
+	Name     string `json:"name"`
+	Favorite bool   `json:"favorite,omitempty"`
 }
 
 // UseFSContext is used to indicate a context switch to the file system Kubeconfig context
 const UseFSContext = ""
 
 func (k *KubeConfigContextManager) CurrentContext() string {
+	k.contextsMu.RLock()
+	defer k.contextsMu.RUnlock()
+
 	return k.currentContext
 }
 
 func (k *KubeConfigContextManager) Contexts() []Context {
+	k.contextsMu.RLock()
+	defer k.contextsMu.RUnlock()
+
 	return k.contexts
 }
 
+// KubeConfigEditor returns an editor for the kube config files in use. The list of contexts is
+// reloaded after the editor changes a file.
+func (k *KubeConfigContextManager) KubeConfigEditor() *Editor {
+	return k.editor
+}
+
+func (k *KubeConfigContextManager) reloadContexts() {
+	if _, _, err := k.Reload(); err != nil {
+		return
+	}
+}
+
+// Reload reloads the list of contexts from the kube config files. It returns the current
+// context set in the files and whether the cluster or user of the context in use changed,
+// e.g. because its credentials were rotated.
+func (k *KubeConfigContextManager) Reload() (string, bool, error) {
+	config, err := k.clientConfig(UseFSContext).RawConfig()
+	if err != nil {
+		return "", false, errors.Wrap(err, "unable to load kube config")
+	}
+
+	k.contextsMu.Lock()
+	defer k.contextsMu.Unlock()
+
+	k.contexts = contextList(config)
+	changed := contextFingerprint(config, k.currentContext) != k.fingerprint
+
+	return config.CurrentContext, changed, nil
+}
+
+func contextList(config clientcmdapi.Config) []Context {
+	var list []Context
+	for name, kubeContext := range config.Contexts {
+		list = append(list, Context{Name: name, Favorite: isFavorite(kubeContext)})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
 func (k *KubeConfigContextManager) SwitchContext(ctx context.Context, contextName string) error {
+	return k.switchContext(ctx, contextName, false)
+}
+
+// RefreshCredentials recreates the cluster client for the current context. The kube config
+// files are read again, and exec and auth provider plugins are invoked again.
+func (k *KubeConfigContextManager) RefreshCredentials(ctx context.Context) error {
+	return k.switchContext(ctx, k.CurrentContext(), true)
+}
+
+func (k *KubeConfigContextManager) switchContext(ctx context.Context, contextName string, refresh bool) error {
 	v := k.clusterClient.Load()
 	if v != nil {
 		clusterClient := v.(cluster.ClientInterface)
 		clusterClient.Close()
 	}
 
-	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-		k.configLoadingRules,
-		&clientcmd.ConfigOverrides{CurrentContext: contextName},
-	)
+	clientConfig := k.clientConfig(contextName)
 
-	var err error
-	clusterClient, err := internalCluster.FromClientConfig(ctx, clientConfig, k.clusterOptions...)
+	clusterClient, err := k.newClusterClient(ctx, contextName, refresh)
 	if err != nil {
 		return errors.Wrap(err, "unable to create cluster client")
 	}
 	k.clusterClient.Store(clusterClient)
 
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return errors.Wrap(err, "unable to load kube config")
+	}
+
 	if contextName == UseFSContext {
-		rawConfig, err := clientConfig.RawConfig()
-		if err != nil {
-			return errors.Wrap(err, "unable to infer context name from kube config")
-		}
 		contextName = rawConfig.CurrentContext
 	}
 
+	k.contextsMu.Lock()
+	defer k.contextsMu.Unlock()
+
 	k.currentContext = contextName
+	k.fingerprint = contextFingerprint(rawConfig, contextName)
 	return nil
 }
 
+// ClusterClientForContext creates a cluster client for contextName without switching
+// the current context. The caller is responsible for closing the client.
+func (k *KubeConfigContextManager) ClusterClientForContext(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+	clusterClient, err := internalCluster.FromClientConfig(ctx, k.clientConfig(contextName), k.clusterOptions...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create cluster client for context %s", contextName)
+	}
+	return clusterClient, nil
+}
+
+func (k *KubeConfigContextManager) clientConfig(contextName string) clientcmd.ClientConfig {
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		k.configLoadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: contextName},
+	)
+}
+
 func (k *KubeConfigContextManager) ClusterClient() cluster.ClientInterface {
 	v := k.clusterClient.Load()
 	if v == nil {
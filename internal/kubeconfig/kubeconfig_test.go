@@ -68,6 +68,22 @@ func Test_SwitchContextUpdatesClientNamespace(t *testing.T) {
 	require.Equal(t, "non-default", kubeConfigs.ClusterClient().DefaultNamespace())
 }
 
+func Test_ClusterClientForContextKeepsCurrentContext(t *testing.T) {
+	kubeConfigs, err := NewKubeConfigContextManager(
+		context.TODO(),
+		WithKubeConfigList(filepath.Join("testdata", "kubeconfig.yaml")),
+	)
+	require.NoError(t, err)
+
+	clusterClient, err := kubeConfigs.ClusterClientForContext(context.TODO(), "other-context")
+	require.NoError(t, err)
+	defer clusterClient.Close()
+
+	require.Equal(t, "non-default", clusterClient.DefaultNamespace())
+	require.Equal(t, "my-cluster", kubeConfigs.CurrentContext())
+	require.NotEqual(t, "non-default", kubeConfigs.ClusterClient().DefaultNamespace())
+}
+
 func TestFSLoader_Load(t *testing.T) {
 	dir, err := ioutil.TempDir("", "loader-test")
 	require.NoError(t, err)
@@ -7,6 +7,7 @@ package link
 
 import (
 	"net/url"
+	"strings"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -102,6 +103,37 @@ func (l *Link) ForOwner(parent runtime.Object, controllerRef *metav1.OwnerRefere
 	)
 }
 
+// MarkdownResolver returns a resolver for relative links in Markdown. Relative links
+// refer to objects in namespace by API version, kind and name, e.g.
+// "v1/ConfigMap/settings" or "apps/v1/Deployment/web".
+func MarkdownResolver(l Interface, namespace string) component.MarkdownLinkResolver {
+	return func(target string) (string, bool) {
+		parts := strings.Split(strings.TrimPrefix(target, "./"), "/")
+
+		var apiVersion string
+		switch len(parts) {
+		case 3:
+			apiVersion = parts[0]
+		case 4:
+			apiVersion = parts[0] + "/" + parts[1]
+		default:
+			return "", false
+		}
+
+		kind, name := parts[len(parts)-2], parts[len(parts)-1]
+		if kind == "" || name == "" {
+			return "", false
+		}
+
+		objectLink, err := l.ForGVK(namespace, apiVersion, kind, name, name)
+		if err != nil || objectLink.Ref() == "" {
+			return "", false
+		}
+
+		return objectLink.Ref(), true
+	}
+}
+
 func (l *Link) extractPathFromObject(object runtime.Object) (string, error) {
 	if object == nil {
 		return "", errors.New("can't generate path for nil object")
@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package link
 
 import (
+	"errors"
 	"net/url"
 	"path"
 	"testing"
@@ -139,3 +140,44 @@ func TestLink_ForOwner(t *testing.T) {
 	assert.Equal(t, expectedRef, got.Ref())
 	assert.Equal(t, "name", got.Text())
 }
+
+func TestMarkdownResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	config := fake.NewMockConfig(controller)
+	config.EXPECT().
+		ObjectPath("default", "apps/v1", "Deployment", "web").
+		Return("/overview/namespace/default/workloads/deployments/web", nil)
+	config.EXPECT().
+		ObjectPath("default", "v1", "ConfigMap", "settings").
+		Return("/overview/namespace/default/config-and-storage/config-maps/settings", nil)
+	config.EXPECT().
+		ObjectPath("default", "example.com/v1", "Widget", "w").
+		Return("", errors.New("unknown kind"))
+
+	l, err := NewFromDashConfig(config)
+	require.NoError(t, err)
+
+	resolve := MarkdownResolver(l, "default")
+
+	tests := []struct {
+		target   string
+		expected string
+		isFound  bool
+	}{
+		{target: "apps/v1/Deployment/web", expected: "/overview/namespace/default/workloads/deployments/web", isFound: true},
+		{target: "./v1/ConfigMap/settings", expected: "/overview/namespace/default/config-and-storage/config-maps/settings", isFound: true},
+		{target: "example.com/v1/Widget/w"},
+		{target: "Deployment/web"},
+		{target: "docs/runbooks/payments.md/more/parts"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.target, func(t *testing.T) {
+			actual, ok := resolve(test.target)
+			require.Equal(t, test.isFound, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package markdown renders Markdown as HTML which is safe to show in the dashboard.
+// Markdown is parsed as CommonMark with GitHub tables and strikethrough. Raw HTML in
+// the source is escaped, images are rendered as their description, and links are only
+// rendered if they use a safe scheme or can be resolved to a path in the dashboard.
+package markdown
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var (
+	safeSchemes = map[string]bool{"http": true, "https": true, "mailto": true}
+
+	// resolveKey is the parser context key of the function resolving relative links.
+	resolveKey = parser.NewContextKey()
+
+	md = goldmark.New(
+		goldmark.WithExtensions(
+			// The dashboard sanitizes style attributes, so alignment is set with attributes.
+			extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+			extension.Strikethrough,
+		),
+		goldmark.WithParserOptions(parser.WithASTTransformers(util.Prioritized(linkTransformer{}, 100))),
+		// The default HTML renderer has a priority of 1000, so these functions replace its
+		// functions for raw HTML.
+		goldmark.WithRendererOptions(renderer.WithNodeRenderers(util.Prioritized(rawHTMLRenderer{}, 100))),
+	)
+)
+
+// ToHTML renders Markdown as HTML. Links without a scheme which are not absolute paths
+// are passed to resolve, which returns the path they refer to or false if they should
+// be rendered as text. resolve can be nil.
+func ToHTML(source string, resolve func(target string) (string, bool)) string {
+	pc := parser.NewContext()
+	pc.Set(resolveKey, resolve)
+
+	var out bytes.Buffer
+	if err := md.Convert([]byte(source), &out, parser.WithContext(pc)); err != nil {
+		// Rendering to a buffer does not fail, but the source is still shown if it does.
+		return "<p>" + string(util.EscapeHTML([]byte(source))) + "</p>\n"
+	}
+	return out.String()
+}
+
+// linkTransformer replaces links which are unsafe or can't be resolved with their
+// label, and images with their description. Links outside of the dashboard are opened
+// in a new window.
+type linkTransformer struct{}
+
+var _ parser.ASTTransformer = linkTransformer{}
+
+func (linkTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	resolve, _ := pc.Get(resolveKey).(func(string) (string, bool))
+	source := reader.Source()
+
+	var nodes []ast.Node
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch n.Kind() {
+		case ast.KindLink, ast.KindAutoLink, ast.KindImage:
+			if entering {
+				nodes = append(nodes, n)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *ast.Link:
+			target, external, ok := href(string(n.Destination), resolve)
+			if !ok {
+				unwrap(n)
+				continue
+			}
+			n.Destination = []byte(target)
+			if external {
+				setExternal(n)
+			}
+		case *ast.AutoLink:
+			if n.AutoLinkType == ast.AutoLinkEmail {
+				setExternal(n)
+				continue
+			}
+			if _, external, ok := href(string(n.URL(source)), nil); !ok {
+				n.Parent().ReplaceChild(n.Parent(), n, ast.NewString(n.Label(source)))
+			} else if external {
+				setExternal(n)
+			}
+		case *ast.Image:
+			unwrap(n)
+		}
+	}
+}
+
+// href returns the URL of a link target and whether it is outside the dashboard. It
+// returns false if the link should not be rendered.
+func href(target string, resolve func(string) (string, bool)) (string, bool, bool) {
+	target = strings.TrimSpace(target)
+	if target == "" || strings.HasPrefix(target, "#") || strings.HasPrefix(target, "//") {
+		return "", false, false
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return "", false, false
+	}
+
+	if u.Scheme != "" {
+		return target, true, safeSchemes[strings.ToLower(u.Scheme)]
+	}
+
+	if strings.HasPrefix(target, "/") {
+		return target, false, true
+	}
+
+	if resolve == nil {
+		return "", false, false
+	}
+
+	p, ok := resolve(target)
+	return p, false, ok && strings.HasPrefix(p, "/")
+}
+
+func setExternal(n ast.Node) {
+	n.SetAttributeString("target", []byte("_blank"))
+	n.SetAttributeString("rel", []byte("noopener noreferrer"))
+}
+
+// unwrap replaces a node with its children.
+func unwrap(n ast.Node) {
+	parent := n.Parent()
+	for c := n.FirstChild(); c != nil; {
+		next := c.NextSibling()
+		parent.InsertBefore(parent, n, c)
+		c = next
+	}
+	parent.RemoveChild(parent, n)
+}
+
+// rawHTMLRenderer renders raw HTML as escaped text.
+type rawHTMLRenderer struct{}
+
+var _ renderer.NodeRenderer = rawHTMLRenderer{}
+
+func (rawHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindRawHTML, renderRawHTML)
+	reg.Register(ast.KindHTMLBlock, renderHTMLBlock)
+}
+
+func renderRawHTML(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkSkipChildren, nil
+	}
+
+	n := node.(*ast.RawHTML)
+	for i := 0; i < n.Segments.Len(); i++ {
+		segment := n.Segments.At(i)
+		_, _ = w.Write(util.EscapeHTML(segment.Value(source)))
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+// renderHTMLBlock renders an HTML block as a paragraph of escaped text.
+func renderHTMLBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkSkipChildren, nil
+	}
+
+	n := node.(*ast.HTMLBlock)
+	var value []byte
+	for i := 0; i < n.Lines().Len(); i++ {
+		line := n.Lines().At(i)
+		value = append(value, line.Value(source)...)
+	}
+	if n.HasClosure() {
+		value = append(value, n.ClosureLine.Value(source)...)
+	}
+
+	_, _ = w.WriteString("<p>")
+	_, _ = w.Write(util.EscapeHTML(bytes.TrimRight(value, "\n")))
+	_, _ = w.WriteString("</p>\n")
+	return ast.WalkSkipChildren, nil
+}
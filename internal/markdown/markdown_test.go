@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	resolve := func(target string) (string, bool) {
+		if target == "v1/ConfigMap/settings" {
+			return "/overview/namespace/default/config-and-storage/config-maps/settings", true
+		}
+		return "", false
+	}
+
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "headings",
+			source:   "# Runbook ##\n\nPayments\n---",
+			expected: "<h1>Runbook</h1>\n<h2>Payments</h2>\n",
+		},
+		{
+			name:     "paragraph with inline elements",
+			source:   "Restart **payments** with `kubectl rollout restart`.  \nCheck *logs*, ~~metrics~~ and snake_case_names.",
+			expected: "<p>Restart <strong>payments</strong> with <code>kubectl rollout restart</code>.<br>\nCheck <em>logs</em>, <del>metrics</del> and snake_case_names.</p>\n",
+		},
+		{
+			name:     "raw HTML is escaped",
+			source:   `<img src=x onerror="alert(1)"> & <b>bold</b>`,
+			expected: "<p>&lt;img src=x onerror=&quot;alert(1)&quot;&gt; &amp; &lt;b&gt;bold&lt;/b&gt;</p>\n",
+		},
+		{
+			name:     "HTML block is escaped",
+			source:   "<script>\nalert(1)\n</script>\n\ntext",
+			expected: "<p>&lt;script&gt;\nalert(1)\n&lt;/script&gt;</p>\n<p>text</p>\n",
+		},
+		{
+			name:     "code block",
+			source:   "```bash\nkubectl get pods <pod>\n\n```",
+			expected: "<pre><code class=\"language-bash\">kubectl get pods &lt;pod&gt;\n\n</code></pre>\n",
+		},
+		{
+			name:     "code block language is escaped",
+			source:   "~~~ \"><script>\ncode\n~~~",
+			expected: "<pre><code class=\"language-&quot;&gt;&lt;script&gt;\">code\n</code></pre>\n",
+		},
+		{
+			name:     "nested lists",
+			source:   "3. Scale down\n   - web\n   - worker\n4. Scale up",
+			expected: "<ol start=\"3\">\n<li>Scale down\n<ul>\n<li>web</li>\n<li>worker</li>\n</ul>\n</li>\n<li>Scale up</li>\n</ol>\n",
+		},
+		{
+			name:     "loose list",
+			source:   "- one\n\n- two",
+			expected: "<ul>\n<li>\n<p>one</p>\n</li>\n<li>\n<p>two</p>\n</li>\n</ul>\n",
+		},
+		{
+			name:   "table",
+			source: "| Alert | Action |\n|:---|:---:|\n| High \\| CPU | `scale` |\n| Disk |",
+			expected: "<table>\n<thead>\n<tr>\n<th align=\"left\">Alert</th>\n<th align=\"center\">Action</th>\n</tr>\n</thead>\n" +
+				"<tbody>\n<tr>\n<td align=\"left\">High | CPU</td>\n<td align=\"center\"><code>scale</code></td>\n</tr>\n" +
+				"<tr>\n<td align=\"left\">Disk</td>\n<td></td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			name:     "block quote and rule",
+			source:   "> **Note**\n> page the owner\n\n***",
+			expected: "<blockquote>\n<p><strong>Note</strong>\npage the owner</p>\n</blockquote>\n<hr>\n",
+		},
+		{
+			name:     "external links",
+			source:   `[dashboard](https://grafana.example.com/d/1 "Grafana") <mailto:oncall@example.com>`,
+			expected: "<p><a href=\"https://grafana.example.com/d/1\" title=\"Grafana\" target=\"_blank\" rel=\"noopener noreferrer\">dashboard</a> <a href=\"mailto:oncall@example.com\" target=\"_blank\" rel=\"noopener noreferrer\">mailto:oncall@example.com</a></p>\n",
+		},
+		{
+			name:     "unsafe autolinks are rendered as text",
+			source:   "<javascript:alert(1)> <oncall@example.com>",
+			expected: "<p>javascript:alert(1) <a href=\"mailto:oncall@example.com\" target=\"_blank\" rel=\"noopener noreferrer\">oncall@example.com</a></p>\n",
+		},
+		{
+			name:     "unsafe links are rendered as text",
+			source:   "[click](javascript:alert(1)) [file](file:///etc/passwd) [other](//example.com) ![image](data:image/png;base64,AAA)",
+			expected: "<p>click file other image</p>\n",
+		},
+		{
+			name:     "content paths",
+			source:   "[pods](/overview/namespace/default/workloads/pods)",
+			expected: "<p><a href=\"/overview/namespace/default/workloads/pods\">pods</a></p>\n",
+		},
+		{
+			name:     "relative links",
+			source:   "[settings](v1/ConfigMap/settings) [missing](v1/Secret/missing)",
+			expected: "<p><a href=\"/overview/namespace/default/config-and-storage/config-maps/settings\">settings</a> missing</p>\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ToHTML(test.source, resolve))
+		})
+	}
+}
+
+func TestToHTML_withoutResolver(t *testing.T) {
+	assert.Equal(t, "<p>settings</p>\n", ToHTML("[settings](v1/ConfigMap/settings)", nil))
+}
+
+func TestToHTML_crlf(t *testing.T) {
+	actual := ToHTML(strings.Join([]string{"# Title", "", "text"}, "\r\n"), nil)
+	assert.Equal(t, "<h1>Title</h1>\n<p>text</p>\n", actual)
+}
@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package metrics exposes Octant's internal metrics in the Prometheus text format.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Path is the path metrics are served from.
+const Path = "/metrics"
+
+const namespace = "octant"
+
+const (
+	// DirectionReceived labels messages received from a websocket client.
+	DirectionReceived = "received"
+	// DirectionSent labels messages sent to a websocket client.
+	DirectionSent = "sent"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	informers = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "objectstore",
+		Name:      "informers",
+		Help:      "Number of running informers by resource.",
+	}, []string{"group", "version", "resource"})
+
+	informerSync = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "objectstore",
+		Name:      "informer_sync_seconds",
+		Help:      "Time taken for an informer to sync its cache.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"group", "version", "resource"})
+
+	websocketClients = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "clients",
+		Help:      "Number of connected websocket clients.",
+	})
+
+	websocketMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "messages_total",
+		Help:      "Number of websocket messages by direction.",
+	}, []string{"direction"})
+
+	contentGeneration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "content",
+		Name:      "generation_seconds",
+		Help:      "Time taken to generate content.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"module", "path"})
+
+	pluginRPC = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "plugin",
+		Name:      "rpc_seconds",
+		Help:      "Time taken by plugin RPCs.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"plugin", "method"})
+
+	pluginRPCErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "plugin",
+		Name:      "rpc_errors_total",
+		Help:      "Number of plugin RPCs which returned an error.",
+	}, []string{"plugin", "method"})
+
+	terminals = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "terminals",
+		Help:      "Number of active terminals.",
+	})
+
+	logStreams = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "log_streams",
+		Help:      "Number of active container log streams.",
+	})
+
+	portForwards = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "port_forwards",
+		Help:      "Number of active port forwards.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		informers,
+		informerSync,
+		websocketClients,
+		websocketMessages,
+		contentGeneration,
+		pluginRPC,
+		pluginRPCErrors,
+		terminals,
+		logStreams,
+		portForwards,
+	)
+}
+
+// Handler returns a handler which serves metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// InformerStarted records an informer for a resource starting.
+func InformerStarted(gvr schema.GroupVersionResource) {
+	informers.WithLabelValues(gvr.Group, gvr.Version, gvr.Resource).Inc()
+}
+
+// InformerStopped records an informer for a resource stopping.
+func InformerStopped(gvr schema.GroupVersionResource) {
+	informers.WithLabelValues(gvr.Group, gvr.Version, gvr.Resource).Dec()
+}
+
+// ObserveInformerSync records the time an informer for a resource took to sync.
+func ObserveInformerSync(gvr schema.GroupVersionResource, elapsed time.Duration) {
+	informerSync.WithLabelValues(gvr.Group, gvr.Version, gvr.Resource).Observe(elapsed.Seconds())
+}
+
+// WebsocketClientConnected records a websocket client connecting.
+func WebsocketClientConnected() {
+	websocketClients.Inc()
+}
+
+// WebsocketClientDisconnected records a websocket client disconnecting.
+func WebsocketClientDisconnected() {
+	websocketClients.Dec()
+}
+
+// WebsocketMessage records a websocket message in a direction.
+func WebsocketMessage(direction string) {
+	websocketMessages.WithLabelValues(direction).Inc()
+}
+
+// ObserveContentGeneration records the time a module took to generate content for a path.
+func ObserveContentGeneration(module, contentPath string, elapsed time.Duration) {
+	contentGeneration.WithLabelValues(module, ContentPathLabel(contentPath)).Observe(elapsed.Seconds())
+}
+
+// ContentPathLabel reduces a module content path to a label with bounded cardinality.
+// Namespace names are replaced and only the leading segments, which name a kind of
+// content rather than an object, are kept.
+func ContentPathLabel(contentPath string) string {
+	var segments []string
+	for _, s := range strings.Split(contentPath, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+
+	var prefix []string
+	if len(segments) >= 2 && segments[0] == "namespace" {
+		prefix = []string{"namespace", "*"}
+		segments = segments[2:]
+	}
+
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+
+	return "/" + strings.Join(append(prefix, segments...), "/")
+}
+
+// PluginClientInterceptor returns a gRPC interceptor which records the latency and
+// errors of RPCs made to a plugin.
+func PluginClientInterceptor(plugin string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		now := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		ObservePluginRPC(plugin, method, time.Since(now), err)
+		return err
+	}
+}
+
+// ObservePluginRPC records the time an RPC to a plugin took, and whether it returned an
+// error. For JavaScript plugins, the method is the name of the handler which was called.
+func ObservePluginRPC(plugin, method string, elapsed time.Duration, err error) {
+	pluginRPC.WithLabelValues(plugin, method).Observe(elapsed.Seconds())
+	if err != nil {
+		pluginRPCErrors.WithLabelValues(plugin, method).Inc()
+	}
+}
+
+// TerminalStarted records a terminal starting.
+func TerminalStarted() {
+	terminals.Inc()
+}
+
+// TerminalStopped records a terminal stopping.
+func TerminalStopped() {
+	terminals.Dec()
+}
+
+// LogStreamStarted records a container log stream starting.
+func LogStreamStarted() {
+	logStreams.Inc()
+}
+
+// LogStreamStopped records a container log stream stopping.
+func LogStreamStopped() {
+	logStreams.Dec()
+}
+
+// PortForwardStarted records a port forward starting.
+func PortForwardStarted() {
+	portForwards.Inc()
+}
+
+// PortForwardStopped records a port forward stopping.
+func PortForwardStopped() {
+	portForwards.Dec()
+}
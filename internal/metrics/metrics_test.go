@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestContentPathLabel(t *testing.T) {
+	tests := []struct {
+		contentPath string
+		expected    string
+	}{
+		{contentPath: "", expected: "/"},
+		{contentPath: "/", expected: "/"},
+		{contentPath: "/nodes", expected: "/nodes"},
+		{contentPath: "/namespace/default", expected: "/namespace/*"},
+		{contentPath: "/namespace/default/workloads", expected: "/namespace/*/workloads"},
+		{contentPath: "/namespace/default/workloads/deployments/nginx", expected: "/namespace/*/workloads/deployments"},
+		{contentPath: "/custom-resources/crontabs.example.com/v1/my-crontab", expected: "/custom-resources/crontabs.example.com"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.contentPath, func(t *testing.T) {
+			assert.Equal(t, test.expected, ContentPathLabel(test.contentPath))
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	InformerStarted(gvr)
+	defer InformerStopped(gvr)
+	ObserveInformerSync(gvr, time.Second)
+	WebsocketMessage(DirectionSent)
+	ObserveContentGeneration("overview", "/namespace/default/workloads", time.Second)
+	TerminalStarted()
+	defer TerminalStopped()
+
+	interceptor := PluginClientInterceptor("plugin-name")
+	err := interceptor(context.Background(), "/dashboard.Plugin/Content", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return fmt.Errorf("error")
+		})
+	require.Error(t, err)
+	ObservePluginRPC("plugin.js", "contentHandler", time.Second, nil)
+
+	ts := httptest.NewServer(Handler())
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, res.Body.Close()) }()
+
+	data, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	body := string(data)
+
+	for _, expected := range []string{
+		`octant_objectstore_informers{group="apps",resource="deployments",version="v1"} 1`,
+		`octant_objectstore_informer_sync_seconds_count{group="apps",resource="deployments",version="v1"} 1`,
+		`octant_websocket_messages_total{direction="sent"} 1`,
+		`octant_content_generation_seconds_count{module="overview",path="/namespace/*/workloads"} 1`,
+		`octant_plugin_rpc_errors_total{method="/dashboard.Plugin/Content",plugin="plugin-name"} 1`,
+		`octant_plugin_rpc_seconds_count{method="contentHandler",plugin="plugin.js"} 1`,
+		"octant_terminals 1",
+		"go_goroutines",
+	} {
+		assert.Contains(t, body, expected)
+	}
+}
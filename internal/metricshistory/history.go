@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package metricshistory records the CPU and memory usage of pods over time, so usage
+// can be charted without an external metrics system.
+package metricshistory
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultInterval is the default interval between samples.
+	DefaultInterval = 15 * time.Second
+	// DefaultRetention is the default duration samples are kept for.
+	DefaultRetention = time.Hour
+)
+
+// Sample is the resource usage at a point in time.
+type Sample struct {
+	Timestamp time.Time
+	// CPU is the CPU usage in millicores.
+	CPU int64
+	// Memory is the memory usage in bytes.
+	Memory int64
+}
+
+// PodUsage is the resource usage of a pod.
+type PodUsage struct {
+	Namespace string
+	Name      string
+	// CPU is the CPU usage in millicores.
+	CPU int64
+	// Memory is the memory usage in bytes.
+	Memory int64
+}
+
+type podKey struct {
+	namespace string
+	name      string
+}
+
+// History keeps the recent samples of pods in bounded rings.
+type History struct {
+	retention time.Duration
+	capacity  int
+	now       func() time.Time
+
+	mu         sync.RWMutex
+	pods       map[podKey]*ring
+	namespaces map[string]time.Time
+	available  bool
+}
+
+// NewHistory creates an instance of History which keeps samples taken every interval
+// for retention.
+func NewHistory(interval, retention time.Duration) *History {
+	capacity := int(retention / interval)
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &History{
+		retention:  retention,
+		capacity:   capacity,
+		now:        time.Now,
+		pods:       make(map[podKey]*ring),
+		namespaces: make(map[string]time.Time),
+	}
+}
+
+// Record records the usage of the pods sampled at a time. Pods which were not sampled
+// are gone or in namespaces which are no longer watched, so they are forgotten.
+func (h *History) Record(at time.Time, usage []PodUsage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.available = true
+
+	sampled := make(map[podKey]bool, len(usage))
+	for _, u := range usage {
+		key := podKey{namespace: u.Namespace, name: u.Name}
+		sampled[key] = true
+
+		r, ok := h.pods[key]
+		if !ok {
+			r = newRing(h.capacity)
+			h.pods[key] = r
+		}
+		r.add(Sample{Timestamp: at, CPU: u.CPU, Memory: u.Memory})
+	}
+
+	for key := range h.pods {
+		if !sampled[key] {
+			delete(h.pods, key)
+		}
+	}
+}
+
+// Available returns true if usage has been recorded, even if no namespaces were watched.
+func (h *History) Available() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.available
+}
+
+// Reset forgets all samples. It is used when the cluster changes.
+func (h *History) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.pods = make(map[podKey]*ring)
+	h.namespaces = make(map[string]time.Time)
+	h.available = false
+}
+
+// Pod returns the samples of a pod, oldest first.
+func (h *History) Pod(namespace, name string) []Sample {
+	return h.Pods(namespace, []string{name})
+}
+
+// Latest returns the most recent sample of a pod. Unlike Pod, it does not mark the
+// namespace as watched.
+func (h *History) Latest(namespace, name string) (Sample, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	r, ok := h.pods[podKey{namespace: namespace, name: name}]
+	if !ok {
+		return Sample{}, false
+	}
+
+	return r.last(), true
+}
+
+// Pods returns the summed samples of pods in a namespace, oldest first.
+func (h *History) Pods(namespace string, names []string) []Sample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.namespaces[namespace] = h.now()
+
+	var rings []*ring
+	for _, name := range names {
+		if r, ok := h.pods[podKey{namespace: namespace, name: name}]; ok {
+			rings = append(rings, r)
+		}
+	}
+
+	return sum(rings)
+}
+
+// Namespace returns the summed samples of all pods in a namespace, oldest first.
+func (h *History) Namespace(namespace string) []Sample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.namespaces[namespace] = h.now()
+
+	var rings []*ring
+	for key, r := range h.pods {
+		if key.namespace == namespace {
+			rings = append(rings, r)
+		}
+	}
+
+	return sum(rings)
+}
+
+// WatchedNamespaces returns the namespaces with samples which were requested within the
+// retention. Only these namespaces are sampled.
+func (h *History) WatchedNamespaces() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	var list []string
+	for namespace, requestedAt := range h.namespaces {
+		if now.Sub(requestedAt) > h.retention {
+			delete(h.namespaces, namespace)
+			continue
+		}
+		list = append(list, namespace)
+	}
+
+	sort.Strings(list)
+	return list
+}
+
+// sum sums samples of rings taken at the same time.
+func sum(rings []*ring) []Sample {
+	byTime := make(map[time.Time]*Sample)
+	var samples []*Sample
+	for _, r := range rings {
+		r.each(func(s Sample) {
+			total, ok := byTime[s.Timestamp]
+			if !ok {
+				total = &Sample{Timestamp: s.Timestamp}
+				byTime[s.Timestamp] = total
+				samples = append(samples, total)
+			}
+			total.CPU += s.CPU
+			total.Memory += s.Memory
+		})
+	}
+
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Timestamp.Before(samples[j].Timestamp)
+	})
+
+	list := make([]Sample, len(samples))
+	for i := range samples {
+		list[i] = *samples[i]
+	}
+	return list
+}
+
+// ring is a fixed size buffer of samples which overwrites the oldest sample when full.
+type ring struct {
+	samples []Sample
+	start   int
+	size    int
+}
+
+func newRing(capacity int) *ring {
+	return &ring{samples: make([]Sample, capacity)}
+}
+
+func (r *ring) add(s Sample) {
+	i := (r.start + r.size) % len(r.samples)
+	r.samples[i] = s
+	if r.size < len(r.samples) {
+		r.size++
+	} else {
+		r.start = (r.start + 1) % len(r.samples)
+	}
+}
+
+func (r *ring) last() Sample {
+	return r.samples[(r.start+r.size-1)%len(r.samples)]
+}
+
+// each calls fn with each sample, oldest first.
+func (r *ring) each(fn func(s Sample)) {
+	for i := 0; i < r.size; i++ {
+		fn(r.samples[(r.start+i)%len(r.samples)])
+	}
+}
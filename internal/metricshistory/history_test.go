@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metricshistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	start := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	at := func(i int) time.Time {
+		return start.Add(time.Duration(i) * 15 * time.Second)
+	}
+
+	h := NewHistory(15*time.Second, 45*time.Second)
+	h.now = func() time.Time { return at(4) }
+	require.False(t, h.Available())
+
+	for i := 0; i < 5; i++ {
+		usage := []PodUsage{
+			{Namespace: "default", Name: "a", CPU: int64(i), Memory: 100},
+			{Namespace: "other", Name: "c", CPU: 10, Memory: 10},
+		}
+		if i >= 3 {
+			usage = append(usage, PodUsage{Namespace: "default", Name: "b", CPU: 10, Memory: 1})
+		}
+		h.Record(at(i), usage)
+	}
+	require.True(t, h.Available())
+
+	t.Run("keeps the last samples of a pod", func(t *testing.T) {
+		assert.Equal(t, []Sample{
+			{Timestamp: at(2), CPU: 2, Memory: 100},
+			{Timestamp: at(3), CPU: 3, Memory: 100},
+			{Timestamp: at(4), CPU: 4, Memory: 100},
+		}, h.Pod("default", "a"))
+	})
+
+	t.Run("sums pods", func(t *testing.T) {
+		expected := []Sample{
+			{Timestamp: at(2), CPU: 2, Memory: 100},
+			{Timestamp: at(3), CPU: 13, Memory: 101},
+			{Timestamp: at(4), CPU: 14, Memory: 101},
+		}
+		assert.Equal(t, expected, h.Pods("default", []string{"a", "b", "missing"}))
+		assert.Equal(t, expected, h.Namespace("default"))
+	})
+
+	t.Run("latest sample", func(t *testing.T) {
+		latest, ok := h.Latest("other", "c")
+		require.True(t, ok)
+		assert.Equal(t, Sample{Timestamp: at(4), CPU: 10, Memory: 10}, latest)
+
+		_, ok = h.Latest("other", "missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("forgets pods which were not sampled", func(t *testing.T) {
+		h.Record(at(5), []PodUsage{{Namespace: "default", Name: "b", CPU: 1, Memory: 1}})
+		assert.Empty(t, h.Pod("default", "a"))
+		assert.Len(t, h.Pod("default", "b"), 3)
+		_, ok := h.Latest("other", "c")
+		assert.False(t, ok)
+	})
+
+	t.Run("tracks requested namespaces", func(t *testing.T) {
+		assert.Equal(t, []string{"default"}, h.WatchedNamespaces())
+
+		h.now = func() time.Time { return at(10) }
+		assert.Empty(t, h.WatchedNamespaces())
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		h.Reset()
+		assert.False(t, h.Available())
+		assert.Empty(t, h.Namespace("default"))
+	})
+}
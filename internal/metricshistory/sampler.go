@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metricshistory
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// podMetricsResource is the resource of pod metrics served by metrics-server.
+var podMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+// UsageLister lists the resource usage of pods in a namespace.
+type UsageLister func(ctx context.Context, client cluster.ClientInterface, namespace string) ([]PodUsage, error)
+
+// SamplerOption is an option for configuring Sampler.
+type SamplerOption func(s *Sampler)
+
+// WithSamplerInterval sets the interval between samples.
+func WithSamplerInterval(interval time.Duration) SamplerOption {
+	return func(s *Sampler) {
+		s.interval = interval
+	}
+}
+
+// WithUsageLister sets the function which lists the resource usage of pods.
+func WithUsageLister(lister UsageLister) SamplerOption {
+	return func(s *Sampler) {
+		s.lister = lister
+	}
+}
+
+// Sampler samples the resource usage of pods into a History.
+type Sampler struct {
+	history       *History
+	clusterClient func() cluster.ClientInterface
+	logger        log.Logger
+	interval      time.Duration
+	lister        UsageLister
+
+	lastClient cluster.ClientInterface
+}
+
+// NewSampler creates an instance of Sampler. clusterClient returns the client of the
+// current cluster. The history is reset when the cluster changes.
+func NewSampler(history *History, clusterClient func() cluster.ClientInterface, logger log.Logger, options ...SamplerOption) *Sampler {
+	s := &Sampler{
+		history:       history,
+		clusterClient: clusterClient,
+		logger:        logger,
+		interval:      DefaultInterval,
+		lister:        listPodUsage,
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// Run samples usage until the context is canceled.
+func (s *Sampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.Sample(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sample samples usage once. Pods are only listed in the watched namespaces of the
+// history, so clusters with many namespaces are not sampled in full.
+func (s *Sampler) Sample(ctx context.Context, at time.Time) {
+	client := s.clusterClient()
+	if client == nil {
+		return
+	}
+	if client != s.lastClient {
+		s.history.Reset()
+		s.lastClient = client
+	}
+
+	if !client.ResourceExists(podMetricsResource) {
+		// metrics-server is not installed
+		return
+	}
+
+	var usage []PodUsage
+	for _, namespace := range s.history.WatchedNamespaces() {
+		list, err := s.lister(ctx, client, namespace)
+		if err != nil {
+			// The namespace's pods are forgotten, so a namespace which can't be sampled
+			// does not keep the others from being sampled.
+			s.logger.WithErr(err).With("namespace", namespace).Debugf("unable to sample pod metrics")
+			continue
+		}
+		usage = append(usage, list...)
+	}
+
+	s.history.Record(at, usage)
+}
+
+// listPodUsage lists pod metrics from metrics-server.
+func listPodUsage(ctx context.Context, client cluster.ClientInterface, namespace string) ([]PodUsage, error) {
+	dynamicClient, err := client.DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := dynamicClient.Resource(podMetricsResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make([]PodUsage, 0, len(list.Items))
+	for i := range list.Items {
+		usage = append(usage, podUsage(&list.Items[i]))
+	}
+
+	return usage, nil
+}
+
+// podUsage sums the usage of the containers of a pod metrics object.
+func podUsage(object *unstructured.Unstructured) PodUsage {
+	u := PodUsage{Namespace: object.GetNamespace(), Name: object.GetName()}
+
+	containers, _, _ := unstructured.NestedSlice(object.Object, "containers")
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		usage, _, _ := unstructured.NestedStringMap(container, "usage")
+		if q, err := resource.ParseQuantity(usage["cpu"]); err == nil {
+			u.CPU += q.MilliValue()
+		}
+		if q, err := resource.ParseQuantity(usage["memory"]); err == nil {
+			u.Memory += q.Value()
+		}
+	}
+
+	return u
+}
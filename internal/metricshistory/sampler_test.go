@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metricshistory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+)
+
+func TestSampler_Sample(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	client := clusterFake.NewMockClientInterface(controller)
+	metricsServer := true
+	client.EXPECT().ResourceExists(podMetricsResource).DoAndReturn(func(schema.GroupVersionResource) bool {
+		return metricsServer
+	}).AnyTimes()
+
+	forbidden := kerrors.NewForbidden(schema.GroupResource{Group: "metrics.k8s.io", Resource: "pods"}, "", nil)
+	pods := map[string][]PodUsage{
+		"default": {{Namespace: "default", Name: "a", CPU: 5, Memory: 10}, {Namespace: "default", Name: "b", CPU: 1, Memory: 1}},
+		"team":    {{Namespace: "team", Name: "c", CPU: 2, Memory: 2}},
+	}
+
+	var listed []string
+	lister := func(ctx context.Context, c cluster.ClientInterface, namespace string) ([]PodUsage, error) {
+		listed = append(listed, namespace)
+		if namespace == "secret" {
+			return nil, forbidden
+		}
+		return pods[namespace], nil
+	}
+
+	history := NewHistory(DefaultInterval, DefaultRetention)
+	currentClient := cluster.ClientInterface(client)
+	s := NewSampler(history, func() cluster.ClientInterface { return currentClient }, log.NopLogger(), WithUsageLister(lister))
+
+	s.Sample(context.Background(), now)
+	assert.Empty(t, listed)
+	require.True(t, history.Available())
+
+	t.Run("lists watched namespaces", func(t *testing.T) {
+		listed = nil
+		history.Namespace("default")
+		history.Namespace("secret")
+
+		s.Sample(context.Background(), now.Add(DefaultInterval))
+		assert.Equal(t, []string{"default", "secret"}, listed)
+		assert.Equal(t, []Sample{{Timestamp: now.Add(DefaultInterval), CPU: 5, Memory: 10}}, history.Pod("default", "a"))
+		_, ok := history.Latest("team", "c")
+		assert.False(t, ok)
+	})
+
+	t.Run("forgets pods which are gone", func(t *testing.T) {
+		pods["default"] = pods["default"][1:]
+
+		s.Sample(context.Background(), now.Add(2*DefaultInterval))
+		_, ok := history.Latest("default", "a")
+		assert.False(t, ok)
+		assert.Len(t, history.Pod("default", "b"), 2)
+	})
+
+	t.Run("does not sample without metrics-server", func(t *testing.T) {
+		metricsServer = false
+		listed = nil
+
+		s.Sample(context.Background(), now.Add(3*DefaultInterval))
+		assert.Empty(t, listed)
+		assert.Len(t, history.Pod("default", "b"), 2)
+	})
+
+	t.Run("resets the history when the cluster changes", func(t *testing.T) {
+		other := clusterFake.NewMockClientInterface(controller)
+		other.EXPECT().ResourceExists(podMetricsResource).Return(false)
+		currentClient = other
+
+		s.Sample(context.Background(), now.Add(4*DefaultInterval))
+		assert.False(t, history.Available())
+		assert.Empty(t, history.Pod("default", "b"))
+	})
+}
+
+func TestPodUsage(t *testing.T) {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "default", "name": "pod"},
+		"containers": []interface{}{
+			map[string]interface{}{"name": "a", "usage": map[string]interface{}{"cpu": "250m", "memory": "64Mi"}},
+			map[string]interface{}{"name": "b", "usage": map[string]interface{}{"cpu": "1", "memory": "1Ki"}},
+		},
+	}}
+
+	assert.Equal(t, PodUsage{Namespace: "default", Name: "pod", CPU: 1250, Memory: 64*1024*1024 + 1024}, podUsage(object))
+}
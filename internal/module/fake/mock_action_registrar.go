@@ -49,6 +49,20 @@ func (mr *MockActionRegistrarMockRecorder) Register(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockActionRegistrar)(nil).Register), arg0, arg1, arg2)
 }
 
+// RegisterCommand mocks base method.
+func (m *MockActionRegistrar) RegisterCommand(arg0 action.Command, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterCommand", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterCommand indicates an expected call of RegisterCommand.
+func (mr *MockActionRegistrarMockRecorder) RegisterCommand(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCommand", reflect.TypeOf((*MockActionRegistrar)(nil).RegisterCommand), arg0, arg1)
+}
+
 // Unregister mocks base method.
 func (m *MockActionRegistrar) Unregister(arg0, arg1 string) {
 	m.ctrl.T.Helper()
@@ -60,3 +74,15 @@ func (mr *MockActionRegistrarMockRecorder) Unregister(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unregister", reflect.TypeOf((*MockActionRegistrar)(nil).Unregister), arg0, arg1)
 }
+
+// UnregisterCommand mocks base method.
+func (m *MockActionRegistrar) UnregisterCommand(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnregisterCommand", arg0, arg1)
+}
+
+// UnregisterCommand indicates an expected call of UnregisterCommand.
+func (mr *MockActionRegistrarMockRecorder) UnregisterCommand(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterCommand", reflect.TypeOf((*MockActionRegistrar)(nil).UnregisterCommand), arg0, arg1)
+}
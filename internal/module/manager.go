@@ -28,9 +28,16 @@ type ActionReceiver interface {
 	ActionPaths() map[string]action.DispatcherFunc
 }
 
+// CommandProvider is a module that adds commands to the command palette.
+type CommandProvider interface {
+	Commands() []action.Command
+}
+
 type ActionRegistrar interface {
 	Register(actionPath, pluginPath string, actionFunc action.DispatcherFunc) error
 	Unregister(actionPath, pluginPath string)
+	RegisterCommand(command action.Command, pluginPath string) error
+	UnregisterCommand(commandName, pluginPath string)
 }
 
 // ManagerInterface is an interface for managing module lifecycle.
@@ -91,6 +98,15 @@ func (m *Manager) Register(mod Module) error {
 		}
 	}
 
+	if provider, ok := mod.(CommandProvider); ok {
+		for _, command := range provider.Commands() {
+			m.logger.With("command", command.Name, "module-name", mod.Name()).Infof("registering command")
+			if err := m.actionRegistrar.RegisterCommand(command, mod.Name()); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := mod.Start(); err != nil {
 		return errors.Wrapf(err, "%s module failed to start", mod.Name())
 	}
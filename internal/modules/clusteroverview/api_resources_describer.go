@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/apidiscovery"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// coreGroupPath is the path segment used for the core API group, which has no name.
+const coreGroupPath = "core"
+
+// APIResourcesDescriber lists the API resources served by the cluster, and explains
+// the OpenAPI schema of their kinds.
+type APIResourcesDescriber struct{}
+
+var _ describer.Describer = (*APIResourcesDescriber)(nil)
+
+// NewAPIResourcesDescriber creates an instance of APIResourcesDescriber.
+func NewAPIResourcesDescriber() *APIResourcesDescriber {
+	return &APIResourcesDescriber{}
+}
+
+// Describe lists API resources, or explains a kind or one of its fields.
+func (d *APIResourcesDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	if options.Fields["kind"] == "" {
+		return d.describeResources(options)
+	}
+
+	group := options.Fields["group"]
+	if group == coreGroupPath {
+		group = ""
+	}
+	gvk := schema.GroupVersionKind{Group: group, Version: options.Fields["version"], Kind: options.Fields["kind"]}
+
+	var fieldPath []string
+	if field := strings.Trim(options.Fields["field"], "/"); field != "" {
+		fieldPath = strings.Split(field, "/")
+	}
+
+	return d.describeSchema(ctx, gvk, fieldPath, options)
+}
+
+func (d *APIResourcesDescriber) describeResources(options describer.Options) (component.ContentResponse, error) {
+	discoveryClient, err := options.ClusterClient().DiscoveryClient()
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	resources, err := apidiscovery.Resources(discoveryClient)
+	if resources == nil && err != nil {
+		return component.EmptyContentResponse, fmt.Errorf("discover API resources: %w", err)
+	}
+
+	cols := component.NewTableCols("Name", "Short Names", "API Version", "Kind", "Scope", "Verbs", "Preferred")
+	table := component.NewTable("API Resources", "The cluster serves no API resources!", cols)
+
+	for _, resource := range resources {
+		gvk := resource.GroupVersionKind()
+
+		scope := "Cluster"
+		if resource.Namespaced {
+			scope = "Namespaced"
+		}
+
+		preferred := ""
+		if resource.Preferred {
+			preferred = "Yes"
+		}
+
+		table.Add(component.TableRow{
+			"Name":        component.NewLink("", resource.Name, schemaPath(gvk)),
+			"Short Names": component.NewText(strings.Join(resource.ShortNames, ", ")),
+			"API Version": component.NewText(gvk.GroupVersion().String()),
+			"Kind":        component.NewText(resource.Kind),
+			"Scope":       component.NewText(scope),
+			"Verbs":       component.NewText(strings.Join(resource.Verbs, ", ")),
+			"Preferred":   component.NewText(preferred),
+		})
+	}
+
+	components := []component.Component{table}
+	if err != nil {
+		text := component.NewTextf("Some API groups could not be discovered: %s", err)
+		text.SetStatus(component.TextStatusWarning)
+		components = append([]component.Component{text}, components...)
+	}
+
+	return component.ContentResponse{
+		Title:      component.TitleFromString("API Resources"),
+		Components: components,
+	}, nil
+}
+
+func (d *APIResourcesDescriber) describeSchema(ctx context.Context, gvk schema.GroupVersionKind, fieldPath []string, options describer.Options) (component.ContentResponse, error) {
+	title := component.Title(
+		component.NewLink("", "API Resources", "/cluster-overview/api-resources"),
+		component.NewLink("", gvk.Kind, schemaPath(gvk)))
+	for i := range fieldPath {
+		title = append(title, component.NewLink("", fieldPath[i], schemaPath(gvk, fieldPath[:i+1]...)))
+	}
+
+	explanation, err := options.SchemaLoader().Explain(ctx, gvk, fieldPath)
+	if err != nil {
+		text := component.NewText(err.Error())
+		text.SetStatus(component.TextStatusError)
+		return component.ContentResponse{
+			Title:      title,
+			Components: []component.Component{text},
+		}, nil
+	}
+
+	return component.ContentResponse{
+		Title: title,
+		Components: []component.Component{
+			explanationSummary(explanation),
+			explanationFields(explanation),
+		},
+	}, nil
+}
+
+// explanationSummary summarizes the kind or field being explained.
+func explanationSummary(explanation *apidiscovery.Explanation) *component.Summary {
+	sections := []component.SummarySection{
+		{Header: "Kind", Content: component.NewText(explanation.GroupVersionKind.Kind)},
+		{Header: "API Version", Content: component.NewText(explanation.GroupVersionKind.GroupVersion().String())},
+	}
+	if len(explanation.Path) > 0 {
+		sections = append(sections, component.SummarySection{
+			Header: "Field", Content: component.NewText(strings.Join(explanation.Path, ".")),
+		})
+	}
+	sections = append(sections,
+		component.SummarySection{Header: "Type", Content: component.NewText(explanation.Type)},
+		component.SummarySection{Header: "Description", Content: component.NewText(explanation.Description)},
+	)
+	if len(explanation.Enum) > 0 {
+		sections = append(sections, component.SummarySection{
+			Header: "Possible Values", Content: component.NewText(strings.Join(explanation.Enum, ", ")),
+		})
+	}
+
+	return component.NewSummary("Schema", sections...)
+}
+
+// explanationFields lists the fields of the kind or field being explained. Fields with
+// fields of their own link to their explanation.
+func explanationFields(explanation *apidiscovery.Explanation) *component.Table {
+	cols := component.NewTableCols("Field", "Type", "Required", "Description", "Possible Values")
+	table := component.NewTable("Fields", "This type has no fields.", cols)
+
+	for _, field := range explanation.Fields {
+		var name component.Component = component.NewText(field.Name)
+		if field.HasFields {
+			p := append(append([]string{}, explanation.Path...), field.Name)
+			name = component.NewLink("", field.Name, schemaPath(explanation.GroupVersionKind, p...))
+		}
+
+		required := ""
+		if field.Required {
+			required = "Yes"
+		}
+
+		table.Add(component.TableRow{
+			"Field":           name,
+			"Type":            component.NewText(field.Type),
+			"Required":        component.NewText(required),
+			"Description":     component.NewText(field.Description),
+			"Possible Values": component.NewText(strings.Join(field.Enum, ", ")),
+		})
+	}
+
+	return table
+}
+
+// PathFilters returns PathFilters for this describer.
+func (d *APIResourcesDescriber) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter("/api-resources", d),
+		*describer.NewPathFilter("/api-resources/(?P<group>[^/]+)/(?P<version>[^/]+)/(?P<kind>[^/]+)(?P<field>(/[^/]+)*)", d),
+	}
+}
+
+// Reset does nothing.
+func (d *APIResourcesDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// schemaPath returns the path of the schema explanation for a kind or one of its fields.
+func schemaPath(gvk schema.GroupVersionKind, fieldPath ...string) string {
+	group := gvk.Group
+	if group == "" {
+		group = coreGroupPath
+	}
+
+	parts := append([]string{"/cluster-overview", "api-resources", group, gvk.Version, gvk.Kind}, fieldPath...)
+	return path.Join(parts...)
+}
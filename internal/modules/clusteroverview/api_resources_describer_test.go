@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/vmware-tanzu/octant/internal/apidiscovery"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestAPIResourcesDescriber_resources(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &k8stesting.Fake{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "pods", Kind: "Pod", Namespaced: true, ShortNames: []string{"po"}, Verbs: []string{"get", "list"}},
+					{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+				},
+			},
+		},
+	}}
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+
+	d := NewAPIResourcesDescriber()
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	expected := component.NewTable("API Resources", "The cluster serves no API resources!",
+		component.NewTableCols("Name", "Short Names", "API Version", "Kind", "Scope", "Verbs", "Preferred"))
+	expected.Add(component.TableRow{
+		"Name":        component.NewLink("", "pods", "/cluster-overview/api-resources/core/v1/Pod"),
+		"Short Names": component.NewText("po"),
+		"API Version": component.NewText("v1"),
+		"Kind":        component.NewText("Pod"),
+		"Scope":       component.NewText("Namespaced"),
+		"Verbs":       component.NewText("get, list"),
+		"Preferred":   component.NewText("Yes"),
+	})
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, expected, cResponse.Components[0])
+}
+
+func TestAPIResourcesDescriber_schema(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/openapi/v2" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"definitions": {
+			"io.k8s.api.core.v1.Pod": {
+				"description": "Pod is a collection of containers.",
+				"properties": {
+					"spec": {"$ref": "#/definitions/io.k8s.api.core.v1.PodSpec", "description": "Specification of the pod."}
+				},
+				"x-kubernetes-group-version-kind": [{"group": "", "version": "v1", "kind": "Pod"}]
+			},
+			"io.k8s.api.core.v1.PodSpec": {
+				"required": ["containers"],
+				"properties": {
+					"containers": {"type": "array", "items": {"type": "string"}, "description": "List of containers."},
+					"restartPolicy": {"type": "string", "enum": ["Always", "Never"], "description": "Restart policy."}
+				}
+			}
+		}}`))
+	}))
+	defer server.Close()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().DiscoveryClient().
+		Return(discovery.NewDiscoveryClientForConfigOrDie(&rest.Config{Host: server.URL}), nil).AnyTimes()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().SchemaLoader().Return(apidiscovery.NewSchemaLoader(clusterClient)).AnyTimes()
+
+	d := NewAPIResourcesDescriber()
+	options := describer.Options{
+		Dash:   dashConfig,
+		Fields: map[string]string{"group": "core", "version": "v1", "kind": "Pod", "field": "/spec"},
+	}
+	cResponse, err := d.Describe(context.Background(), "", options)
+	require.NoError(t, err)
+
+	expectedTitle := component.Title(
+		component.NewLink("", "API Resources", "/cluster-overview/api-resources"),
+		component.NewLink("", "Pod", "/cluster-overview/api-resources/core/v1/Pod"),
+		component.NewLink("", "spec", "/cluster-overview/api-resources/core/v1/Pod/spec"))
+	require.Equal(t, expectedTitle, cResponse.Title)
+
+	expectedSummary := component.NewSummary("Schema",
+		component.SummarySection{Header: "Kind", Content: component.NewText("Pod")},
+		component.SummarySection{Header: "API Version", Content: component.NewText("v1")},
+		component.SummarySection{Header: "Field", Content: component.NewText("spec")},
+		component.SummarySection{Header: "Type", Content: component.NewText("PodSpec")},
+		component.SummarySection{Header: "Description", Content: component.NewText("Specification of the pod.")},
+	)
+
+	expectedFields := component.NewTable("Fields", "This type has no fields.",
+		component.NewTableCols("Field", "Type", "Required", "Description", "Possible Values"))
+	expectedFields.Add(
+		component.TableRow{
+			"Field":           component.NewText("containers"),
+			"Type":            component.NewText("[]string"),
+			"Required":        component.NewText("Yes"),
+			"Description":     component.NewText("List of containers."),
+			"Possible Values": component.NewText(""),
+		},
+		component.TableRow{
+			"Field":           component.NewText("restartPolicy"),
+			"Type":            component.NewText("string"),
+			"Required":        component.NewText(""),
+			"Description":     component.NewText("Restart policy."),
+			"Possible Values": component.NewText("Always, Never"),
+		},
+	)
+
+	require.Len(t, cResponse.Components, 2)
+	component.AssertEqual(t, expectedSummary, cResponse.Components[0])
+	component.AssertEqual(t, expectedFields, cResponse.Components[1])
+}
@@ -71,6 +71,15 @@ func New(ctx context.Context, options Options) (*ClusterOverview, error) {
 	for _, pf := range rootDescriber.PathFilters() {
 		pathMatcher.Register(ctx, pf)
 	}
+	for _, pf := range apiResourcesDescriber.PathFilters() {
+		pathMatcher.Register(ctx, pf)
+	}
+	for _, pf := range nodeUtilizationDescriber.PathFilters() {
+		pathMatcher.Register(ctx, pf)
+	}
+	for _, pf := range warningsDescriber.PathFilters() {
+		pathMatcher.Register(ctx, pf)
+	}
 
 	objectPathConfig := octant.ObjectPathConfig{
 		ModuleName:            "cluster-overview",
@@ -211,8 +220,11 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"RBAC":                        "rbac",
 			"Webhooks":                    "webhooks",
 			"Nodes":                       "nodes",
+			"Node Utilization":            "node-utilization",
+			"Warnings":                    "warnings",
 			"Storage":                     "storage",
 			"Port Forwards":               "port-forward",
+			"API Resources":               "api-resources",
 		},
 		EntriesFuncs: map[string]octant.EntriesFunc{
 			"Cluster Overview":            nil,
@@ -222,8 +234,11 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"RBAC":                        rbacEntries,
 			"Webhooks":                    webhookEntries,
 			"Nodes":                       nil,
+			"Node Utilization":            nil,
+			"Warnings":                    nil,
 			"Storage":                     storageEntries,
 			"Port Forwards":               nil,
+			"API Resources":               nil,
 		},
 		IconMap: map[string]string{
 			"Cluster Overview":            icon.Cluster,
@@ -233,8 +248,11 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"RBAC":                        icon.RBAC,
 			"Webhooks":                    icon.Webhooks,
 			"Nodes":                       icon.Nodes,
+			"Node Utilization":            icon.NodeUtilization,
+			"Warnings":                    icon.Warnings,
 			"Storage":                     icon.ConfigAndStorage,
 			"Port Forwards":               icon.PortForwards,
+			"API Resources":               icon.APIResources,
 		},
 		Order: []string{
 			"Cluster Overview",
@@ -244,8 +262,11 @@ func (co *ClusterOverview) Navigation(ctx context.Context, _ string, root string
 			"RBAC",
 			"Webhooks",
 			"Nodes",
+			"Node Utilization",
+			"Warnings",
 			"Storage",
 			"Port Forwards",
+			"API Resources",
 		},
 	}
 
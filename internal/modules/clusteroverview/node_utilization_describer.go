@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/internal/utilization"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// NodeUtilizationDescriber shows how much of the resources of each node are used and
+// requested, as heatmaps and a table.
+type NodeUtilizationDescriber struct{}
+
+var _ describer.Describer = (*NodeUtilizationDescriber)(nil)
+
+// NewNodeUtilizationDescriber creates an instance of NodeUtilizationDescriber.
+func NewNodeUtilizationDescriber() *NodeUtilizationDescriber {
+	return &NodeUtilizationDescriber{}
+}
+
+// Describe describes the utilization of all nodes.
+func (d *NodeUtilizationDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	nodes, err := listNodes(ctx, options.ObjectStore())
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	pods, err := listPods(ctx, options.ObjectStore())
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	nodeUsage, usageErr := utilization.LoadNodeUsage(ctx, options.ClusterClient())
+	if usageErr != nil {
+		log.From(ctx).With("err", usageErr).Debugf("unable to load node metrics")
+	}
+
+	podUsage := utilization.HistoryPodUsage(options.MetricsHistory())
+	list := utilization.ForNodes(nodes, pods, nodeUsage, podUsage)
+
+	cpuHeatmap := component.NewHeatmap("CPU")
+	memoryHeatmap := component.NewHeatmap("Memory")
+
+	cols := component.NewTableCols("Name", "CPU Usage", "CPU Requests", "CPU Limits",
+		"Memory Usage", "Memory Requests", "Memory Limits", "Pods")
+	table := component.NewTable("Nodes", "We couldn't find any nodes!", cols)
+
+	for _, n := range list {
+		nameLink, err := options.Link.ForGVK("", "v1", "Node", n.Name, n.Name)
+		if err != nil {
+			return component.EmptyContentResponse, err
+		}
+
+		cpuHeatmap.Add(heatmapCell(n.Name, n.CPU, nameLink.Ref()))
+		memoryHeatmap.Add(heatmapCell(n.Name, n.Memory, nameLink.Ref()))
+
+		table.Add(component.TableRow{
+			"Name":            nameLink,
+			"CPU Usage":       component.NewText(formatUsage(n.CPU, utilization.FormatCPU)),
+			"CPU Requests":    component.NewText(formatAmount(n.CPU, n.CPU.Requests, utilization.FormatCPU)),
+			"CPU Limits":      component.NewText(formatAmount(n.CPU, n.CPU.Limits, utilization.FormatCPU)),
+			"Memory Usage":    component.NewText(formatUsage(n.Memory, utilization.FormatMemory)),
+			"Memory Requests": component.NewText(formatAmount(n.Memory, n.Memory.Requests, utilization.FormatMemory)),
+			"Memory Limits":   component.NewText(formatAmount(n.Memory, n.Memory.Limits, utilization.FormatMemory)),
+			"Pods":            component.NewText(fmt.Sprintf("%d / %d", len(n.Pods), n.MaxPods)),
+		})
+	}
+
+	heatmaps := component.NewFlexLayout("")
+	heatmaps.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthHalf, View: cpuHeatmap},
+		{Width: component.WidthHalf, View: memoryHeatmap},
+	})
+
+	var components []component.Component
+	if usageErr != nil {
+		text := component.NewText("Node metrics are not available, so the heatmaps show requests instead of usage.")
+		text.SetStatus(component.TextStatusWarning)
+		components = append(components, text)
+	}
+	components = append(components, heatmaps, table)
+
+	return component.ContentResponse{
+		Title:      component.TitleFromString("Node Utilization"),
+		Components: components,
+	}, nil
+}
+
+// PathFilters returns the path filters for the node utilization page.
+func (d *NodeUtilizationDescriber) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter("/node-utilization", d),
+	}
+}
+
+// Reset does nothing since the describer has no state.
+func (d *NodeUtilizationDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func heatmapCell(name string, r utilization.Resource, ref string) component.HeatmapCell {
+	description := fmt.Sprintf("%s: requests %s, limits %s", name,
+		utilization.FormatPercent(r.Fraction(r.Requests)), utilization.FormatPercent(r.Fraction(r.Limits)))
+	if r.HasUsage {
+		description = fmt.Sprintf("%s: usage %s, requests %s, limits %s", name,
+			utilization.FormatPercent(r.Fraction(r.Usage)), utilization.FormatPercent(r.Fraction(r.Requests)),
+			utilization.FormatPercent(r.Fraction(r.Limits)))
+	}
+
+	return component.HeatmapCell{
+		Label:       name,
+		Value:       r.Pressure(),
+		Description: description,
+		Ref:         ref,
+	}
+}
+
+func formatUsage(r utilization.Resource, format func(int64) string) string {
+	if !r.HasUsage {
+		return "-"
+	}
+	return formatAmount(r, r.Usage, format)
+}
+
+func formatAmount(r utilization.Resource, value int64, format func(int64) string) string {
+	return fmt.Sprintf("%s (%s)", format(value), utilization.FormatPercent(r.Fraction(value)))
+}
+
+func listNodes(ctx context.Context, objectStore store.Store) ([]corev1.Node, error) {
+	list, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Node"})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+
+	nodes := make([]corev1.Node, len(list.Items))
+	for i := range list.Items {
+		if err := kubernetes.FromUnstructured(&list.Items[i], &nodes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
+
+func listPods(ctx context.Context, objectStore store.Store) ([]corev1.Pod, error) {
+	list, _, err := objectStore.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+
+	pods := make([]corev1.Pod, len(list.Items))
+	for i := range list.Items {
+		if err := kubernetes.FromUnstructured(&list.Items[i], &pods[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return pods, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestNodeUtilizationDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	node := testutil.CreateNode("node-1")
+	node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+		corev1.ResourcePods:   resource.MustParse("110"),
+	}
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.NodeName = "node-1"
+	pod.Spec.Containers = []corev1.Container{{
+		Name: "app",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+	}}
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Node"}).
+		Return(testutil.ToUnstructuredList(t, node), false, nil)
+	objectStore.EXPECT().List(gomock.Any(), store.Key{APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, pod), false, nil)
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().DynamicClient().Return(nil, fmt.Errorf("no metrics"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient)
+	dashConfig.EXPECT().MetricsHistory().Return(nil)
+
+	link := linkFake.NewMockInterface(controller)
+	link.EXPECT().ForGVK("", "v1", "Node", "node-1", "node-1").
+		Return(component.NewLink("", "node-1", "/cluster-overview/nodes/node-1"), nil)
+
+	d := NewNodeUtilizationDescriber()
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig, Link: link})
+	require.NoError(t, err)
+	require.Len(t, cResponse.Components, 3)
+
+	heatmaps, ok := cResponse.Components[1].(*component.FlexLayout)
+	require.True(t, ok)
+	cpuHeatmap, ok := heatmaps.Config.Sections[0][0].View.(*component.Heatmap)
+	require.True(t, ok)
+	require.Equal(t, []component.HeatmapCell{{
+		Label:       "node-1",
+		Value:       0.25,
+		Description: "node-1: requests 25%, limits 0%",
+		Ref:         "/cluster-overview/nodes/node-1",
+	}}, cpuHeatmap.Config.Cells)
+
+	expected := component.NewTable("Nodes", "We couldn't find any nodes!",
+		component.NewTableCols("Name", "CPU Usage", "CPU Requests", "CPU Limits",
+			"Memory Usage", "Memory Requests", "Memory Limits", "Pods"))
+	expected.Add(component.TableRow{
+		"Name":            component.NewLink("", "node-1", "/cluster-overview/nodes/node-1"),
+		"CPU Usage":       component.NewText("-"),
+		"CPU Requests":    component.NewText("500m (25%)"),
+		"CPU Limits":      component.NewText("0 (0%)"),
+		"Memory Usage":    component.NewText("-"),
+		"Memory Requests": component.NewText("1Gi (25%)"),
+		"Memory Limits":   component.NewText("0 (0%)"),
+		"Pods":            component.NewText("1 / 110"),
+	})
+	component.AssertEqual(t, expected, cResponse.Components[2])
+}
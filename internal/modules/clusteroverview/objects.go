@@ -139,6 +139,18 @@ var (
 
 	portForwardDescriber = NewPortForwardListDescriber()
 
+	// apiResourcesDescriber is not part of rootDescriber since listing API resources
+	// queries the API server, and the list is not a summary of the cluster.
+	apiResourcesDescriber = NewAPIResourcesDescriber()
+
+	// nodeUtilizationDescriber is not part of rootDescriber since it queries
+	// metrics-server.
+	nodeUtilizationDescriber = NewNodeUtilizationDescriber()
+
+	// warningsDescriber is not part of rootDescriber since it summarizes objects of
+	// many kinds rather than listing a resource.
+	warningsDescriber = NewWarningsDescriber()
+
 	apiServerDescriber = describer.NewSection(
 		"/api-server",
 		"API Server",
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/warnings"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// defaultWarningsWindow is how far back warning events are shown if no window is in
+// the path.
+const defaultWarningsWindow = 60
+
+// warningsWindows are the windows in minutes which are linked from the warnings page.
+var warningsWindows = []int{15, 60, 360, 1440}
+
+// WarningsDescriber shows the objects in the cluster which need attention: recent
+// warning events, failing pods, unavailable workloads, failed jobs, pending persistent
+// volume claims, nodes which are not ready and unavailable API services. The page is
+// generated from the object store each time it is polled.
+type WarningsDescriber struct {
+	now func() time.Time
+}
+
+var _ describer.Describer = (*WarningsDescriber)(nil)
+
+// NewWarningsDescriber creates an instance of WarningsDescriber.
+func NewWarningsDescriber() *WarningsDescriber {
+	return &WarningsDescriber{now: time.Now}
+}
+
+// Describe describes the warnings in the cluster. Warning events are included if they
+// were last seen in the window, in minutes, given by the minutes field.
+func (d *WarningsDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	window := defaultWarningsWindow
+	if s, ok := options.Fields["minutes"]; ok && s != "" {
+		minutes, err := strconv.Atoi(s)
+		if err != nil {
+			return component.EmptyContentResponse, fmt.Errorf("parse warnings window %q: %w", s, err)
+		}
+		window = minutes
+	}
+
+	since := d.now().Add(-time.Duration(window) * time.Minute)
+	result := warnings.Find(ctx, options.ObjectStore(), since)
+
+	var components []component.Component
+	for _, err := range result.Errors {
+		log.From(ctx).With("err", err).Debugf("unable to check for warnings")
+
+		text := component.NewText(fmt.Sprintf("Some warnings may be missing: %s", err))
+		text.SetStatus(component.TextStatusWarning)
+		components = append(components, text)
+	}
+
+	namespaces, err := warningsNamespaceTable(result.Warnings, options)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	entries, err := warningsTable(result.Warnings, options)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	components = append(components, namespaces, entries)
+
+	return component.ContentResponse{
+		Title:           component.TitleFromString("Warnings"),
+		Components:      components,
+		TitleComponents: warningsWindowLinks(window),
+	}, nil
+}
+
+// PathFilters returns the path filters for the warnings page.
+func (d *WarningsDescriber) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter("/warnings", d),
+		*describer.NewPathFilter("/warnings/(?P<minutes>[1-9][0-9]*)", d),
+	}
+}
+
+// Reset does nothing since the describer has no state.
+func (d *WarningsDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func warningsNamespaceTable(list []warnings.Warning, options describer.Options) (*component.Table, error) {
+	columns := []string{"Namespace", "Total"}
+	for _, category := range warnings.Categories {
+		columns = append(columns, string(category))
+	}
+
+	table := component.NewTable("Namespaces", "Nothing needs attention!", component.NewTableCols(columns...))
+
+	for _, count := range warnings.CountByNamespace(list) {
+		var name component.Component = component.NewText("(cluster)")
+		if count.Namespace != "" {
+			link, err := options.Link.ForGVK("", "v1", "Namespace", count.Namespace, count.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			name = link
+		}
+
+		row := component.TableRow{
+			"Namespace": name,
+			"Total":     component.NewText(strconv.Itoa(count.Total)),
+		}
+		for _, category := range warnings.Categories {
+			row[string(category)] = component.NewText(strconv.Itoa(count.ByCategory[category]))
+		}
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+func warningsTable(list []warnings.Warning, options describer.Options) (*component.Table, error) {
+	cols := component.NewTableCols("Namespace", "Category", "Kind", "Name", "Reason", "Message", "Last Seen")
+	table := component.NewTable("Needs Attention", "Nothing needs attention!", cols)
+
+	for _, w := range list {
+		var name component.Component = component.NewText(w.Name)
+		if w.APIVersion != "" {
+			link, err := options.Link.ForGVK(w.Namespace, w.APIVersion, w.Kind, w.Name, w.Name)
+			if err != nil {
+				return nil, err
+			}
+			name = link
+		}
+
+		reason := component.NewText(w.Reason)
+		reason.SetStatus(component.TextStatusWarning)
+		if w.Category != warnings.CategoryEvents {
+			reason.SetStatus(component.TextStatusError)
+		}
+
+		message := w.Message
+		if w.Count > 1 {
+			message = fmt.Sprintf("%s (x%d)", w.Message, w.Count)
+		}
+
+		var lastSeen component.Component = component.NewText("-")
+		if !w.Time.IsZero() {
+			lastSeen = component.NewTimestamp(w.Time)
+		}
+
+		table.Add(component.TableRow{
+			"Namespace": component.NewText(w.Namespace),
+			"Category":  component.NewText(string(w.Category)),
+			"Kind":      component.NewText(w.Kind),
+			"Name":      name,
+			"Reason":    reason,
+			"Message":   component.NewText(message),
+			"Last Seen": lastSeen,
+		})
+	}
+
+	return table, nil
+}
+
+// warningsWindowLinks returns links to the warnings page with the other windows.
+func warningsWindowLinks(current int) []component.Component {
+	var links []component.Component
+	for _, window := range warningsWindows {
+		if window == current {
+			continue
+		}
+		links = append(links, component.NewLink("", fmt.Sprintf("Last %s", formatWindow(window)),
+			fmt.Sprintf("/cluster-overview/warnings/%d", window)))
+	}
+	return links
+}
+
+func formatWindow(minutes int) string {
+	switch {
+	case minutes == 1:
+		return "minute"
+	case minutes%60 != 0:
+		return fmt.Sprintf("%d minutes", minutes)
+	case minutes == 60:
+		return "hour"
+	default:
+		return fmt.Sprintf("%d hours", minutes/60)
+	}
+}
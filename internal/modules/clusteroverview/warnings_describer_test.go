@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestWarningsDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := testutil.Time()
+
+	pod := testutil.CreatePod("pod")
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "app",
+		State: corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "image not found"},
+		},
+	}}
+
+	event := testutil.CreateEvent("pod.event")
+	event.Type = corev1.EventTypeWarning
+	event.Reason = "Failed"
+	event.Message = "Error: ErrImagePull"
+	event.Count = 2
+	event.LastTimestamp = metav1.NewTime(now.Add(-10 * time.Minute))
+	event.InvolvedObject = corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Namespace: pod.Namespace, Name: "pod"}
+
+	oldEvent := testutil.CreateEvent("old.event")
+	oldEvent.Type = corev1.EventTypeWarning
+	oldEvent.LastTimestamp = metav1.NewTime(now.Add(-20 * time.Minute))
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+			switch key.Kind {
+			case "Pod":
+				return testutil.ToUnstructuredList(t, pod), false, nil
+			case "Event":
+				return testutil.ToUnstructuredList(t, event, oldEvent), false, nil
+			case "APIService":
+				return nil, false, fmt.Errorf("forbidden")
+			default:
+				return &unstructured.UnstructuredList{}, false, nil
+			}
+		}).AnyTimes()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	podLink := component.NewLink("", "pod", "/overview/namespace/namespace/workloads/pods/pod")
+	namespaceLink := component.NewLink("", "namespace", "/cluster-overview/namespaces/namespace")
+
+	link := linkFake.NewMockInterface(controller)
+	link.EXPECT().ForGVK(pod.Namespace, "v1", "Pod", "pod", "pod").Return(podLink, nil).Times(2)
+	link.EXPECT().ForGVK("", "v1", "Namespace", pod.Namespace, pod.Namespace).Return(namespaceLink, nil)
+
+	d := NewWarningsDescriber()
+	d.now = func() time.Time { return now }
+
+	options := describer.Options{
+		Dash:   dashConfig,
+		Link:   link,
+		Fields: map[string]string{"minutes": "15"},
+	}
+	cResponse, err := d.Describe(context.Background(), "", options)
+	require.NoError(t, err)
+
+	require.Equal(t, []component.Component{
+		component.NewLink("", "Last hour", "/cluster-overview/warnings/60"),
+		component.NewLink("", "Last 6 hours", "/cluster-overview/warnings/360"),
+		component.NewLink("", "Last 24 hours", "/cluster-overview/warnings/1440"),
+	}, cResponse.TitleComponents)
+
+	require.Len(t, cResponse.Components, 3)
+
+	missing := component.NewText("Some warnings may be missing: list APIService: forbidden")
+	missing.SetStatus(component.TextStatusWarning)
+	component.AssertEqual(t, missing, cResponse.Components[0])
+
+	namespaces := component.NewTable("Namespaces", "Nothing needs attention!",
+		component.NewTableCols("Namespace", "Total", "Events", "Pods", "Workloads", "Storage", "Nodes", "API Services"))
+	namespaces.Add(component.TableRow{
+		"Namespace":    namespaceLink,
+		"Total":        component.NewText("2"),
+		"Events":       component.NewText("1"),
+		"Pods":         component.NewText("1"),
+		"Workloads":    component.NewText("0"),
+		"Storage":      component.NewText("0"),
+		"Nodes":        component.NewText("0"),
+		"API Services": component.NewText("0"),
+	})
+	component.AssertEqual(t, namespaces, cResponse.Components[1])
+
+	eventReason := component.NewText("Failed")
+	eventReason.SetStatus(component.TextStatusWarning)
+	podReason := component.NewText("ImagePullBackOff")
+	podReason.SetStatus(component.TextStatusError)
+
+	entries := component.NewTable("Needs Attention", "Nothing needs attention!",
+		component.NewTableCols("Namespace", "Category", "Kind", "Name", "Reason", "Message", "Last Seen"))
+	entries.Add(
+		component.TableRow{
+			"Namespace": component.NewText(pod.Namespace),
+			"Category":  component.NewText("Events"),
+			"Kind":      component.NewText("Pod"),
+			"Name":      podLink,
+			"Reason":    eventReason,
+			"Message":   component.NewText("Error: ErrImagePull (x2)"),
+			"Last Seen": component.NewTimestamp(event.LastTimestamp.Time),
+		},
+		component.TableRow{
+			"Namespace": component.NewText(pod.Namespace),
+			"Category":  component.NewText("Pods"),
+			"Kind":      component.NewText("Pod"),
+			"Name":      podLink,
+			"Reason":    podReason,
+			"Message":   component.NewText("Container app: image not found"),
+			"Last Seen": component.NewText("-"),
+		},
+	)
+	component.AssertEqual(t, entries, cResponse.Components[2])
+}
+
+func TestWarningsDescriber_PathFilters(t *testing.T) {
+	d := NewWarningsDescriber()
+
+	filters := d.PathFilters()
+	require.Len(t, filters, 2)
+	require.True(t, filters[0].Match("/warnings"))
+	require.True(t, filters[1].Match("/warnings/360"))
+	require.False(t, filters[1].Match("/warnings/0"))
+	require.Equal(t, "360", filters[1].Fields("/warnings/360")["minutes"])
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// AuditLogDescriber describes the audit log.
+type AuditLogDescriber struct {
+}
+
+var _ describer.Describer = (*AuditLogDescriber)(nil)
+
+// Describe describes the most recent audit log entries.
+func (d *AuditLogDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := append([]component.TitleComponent{}, component.NewText("Audit Log"))
+	list := component.NewList(title, nil)
+	tableCols := component.NewTableCols("Time", "User", "Context", "Source", "Action", "Target", "Result", "Payload Digest")
+	tbl := component.NewTable("Audit Log", "There are no audit log entries!", tableCols)
+	list.Add(tbl)
+
+	auditLog := options.Dash.AuditLog()
+	if auditLog == nil {
+		return component.ContentResponse{
+			Components: []component.Component{list},
+		}, nil
+	}
+
+	if p := auditLog.Path(); p != "" {
+		list.Add(component.NewText(fmt.Sprintf("Entries are written to %s.", p)))
+	}
+
+	for _, entry := range auditLog.Entries() {
+		result := entry.Result
+		if entry.Error != "" {
+			result = fmt.Sprintf("%s: %s", entry.Result, entry.Error)
+		}
+
+		tbl.Add(component.TableRow{
+			"Time":           component.NewTimestamp(entry.Time),
+			"User":           component.NewText(entry.User),
+			"Context":        component.NewText(entry.Context),
+			"Source":         component.NewText(entry.Source),
+			"Action":         component.NewText(entry.Action),
+			"Target":         component.NewText(auditTarget(entry)),
+			"Result":         component.NewText(result),
+			"Payload Digest": component.NewText(entry.PayloadDigest),
+		})
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func (d *AuditLogDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/audit-log", d)
+	return []describer.PathFilter{*filter}
+}
+
+func (d *AuditLogDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func NewAuditLogDescriber() *AuditLogDescriber {
+	return &AuditLogDescriber{}
+}
+
+// auditTarget describes the object an entry targets.
+func auditTarget(entry audit.Entry) string {
+	if entry.Target == nil {
+		return ""
+	}
+
+	var parts []string
+	for _, s := range []string{entry.Target.Namespace, entry.Target.Kind, entry.Target.Name, entry.Container} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/audit"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestAuditLogDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	auditLog, err := audit.New(log.NopLogger())
+	require.NoError(t, err)
+
+	now := time.Unix(1600000000, 0).UTC()
+	auditLog.Record(context.Background(), audit.Entry{
+		Time:          now,
+		User:          "alice",
+		Context:       "prod",
+		Source:        audit.SourceAction,
+		Action:        "action.octant.dev/deleteObject",
+		Target:        &store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"},
+		PayloadDigest: "sha256:abc",
+		Result:        audit.ResultRefused,
+		Error:         "read-only",
+	})
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().AuditLog().Return(auditLog)
+
+	d := NewAuditLogDescriber()
+
+	options := describer.Options{
+		Dash: dashConfig,
+	}
+
+	cResponse, err := d.Describe(context.Background(), "default", options)
+	require.NoError(t, err)
+
+	list := component.NewList(append([]component.TitleComponent{}, component.NewText("Audit Log")), nil)
+	tableCols := component.NewTableCols("Time", "User", "Context", "Source", "Action", "Target", "Result", "Payload Digest")
+	table := component.NewTable("Audit Log", "There are no audit log entries!", tableCols)
+	table.Add(component.TableRow{
+		"Time":           component.NewTimestamp(now),
+		"User":           component.NewText("alice"),
+		"Context":        component.NewText("prod"),
+		"Source":         component.NewText(audit.SourceAction),
+		"Action":         component.NewText("action.octant.dev/deleteObject"),
+		"Target":         component.NewText("default/Pod/pod"),
+		"Result":         component.NewText("refused: read-only"),
+		"Payload Digest": component.NewText("sha256:abc"),
+	})
+	list.Add(table)
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, list, cResponse.Components[0])
+}
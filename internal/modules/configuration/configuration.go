@@ -28,6 +28,9 @@ import (
 type Options struct {
 	DashConfig     config.Dash
 	KubeConfigPath string
+	// ServerMode is true if Octant authenticates users. The actions editing the kube config
+	// are not available in server mode.
+	ServerMode bool
 }
 
 type Configuration struct {
@@ -105,6 +108,21 @@ func (c *Configuration) Navigation(ctx context.Context, namespace, root string)
 			Path:     path.Join(c.ContentPath(), "plugins"),
 			IconName: icon.ConfigurationPlugin,
 		},
+		{
+			Title:    "Kube Config",
+			Path:     path.Join(c.ContentPath(), "kubeconfig"),
+			IconName: icon.ConfigurationKubeConfig,
+		},
+		{
+			Title:    "Audit Log",
+			Path:     path.Join(c.ContentPath(), "audit-log"),
+			IconName: icon.ConfigurationAuditLog,
+		},
+		{
+			Title:    "Notifications",
+			Path:     path.Join(c.ContentPath(), "notifications"),
+			IconName: icon.ConfigurationNotifications,
+		},
 	}, nil
 }
 
@@ -147,11 +165,33 @@ func (c Configuration) Generators() []octant.Generator {
 }
 
 func (c *Configuration) ActionPaths() map[string]action.DispatcherFunc {
-	objectDeleter := NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore())
+	return c.dispatchers().ToActionPaths()
+}
+
+// Commands returns the command palette entries for the module's actions.
+func (c *Configuration) Commands() []action.Command {
+	return c.dispatchers().ToCommands()
+}
 
-	return map[string]action.DispatcherFunc{
-		objectDeleter.ActionName(): objectDeleter.Handle,
+func (c *Configuration) dispatchers() action.Dispatchers {
+	dispatchers := action.Dispatchers{
+		NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore()),
+		NewCredentialsRefresher(c.DashConfig.Logger(), c.DashConfig),
+		NewObjectWatcher(c.DashConfig.Notifications(), c.DashConfig.CurrentContext),
+		NewObjectUnwatcher(c.DashConfig.Notifications()),
 	}
+
+	if editor := c.DashConfig.KubeConfigEditor(); editor != nil && !c.ServerMode {
+		dispatchers = append(dispatchers,
+			NewKubeConfigImporter(c.DashConfig.Logger(), editor),
+			NewContextRenamer(editor),
+			NewContextDeleter(editor),
+			NewContextNamespaceSetter(editor),
+			NewFavoriteContextSetter(editor),
+		)
+	}
+
+	return dispatchers
 }
 
 func (c *Configuration) GvkFromPath(contentPath, namespace string) (schema.GroupVersionKind, error) {
@@ -26,6 +26,21 @@ func (d *ObjectDeleter) ActionName() string {
 	return octant.ActionDeleteObject
 }
 
+// Command returns the command palette entry for deleting an object.
+func (d *ObjectDeleter) Command() action.Command {
+	return action.Command{
+		Name:        d.ActionName(),
+		Title:       "Delete Object",
+		Description: "Delete an object from the cluster",
+		Fields: []action.CommandField{
+			{Name: "apiVersion", Label: "API Version", Required: true},
+			{Name: "kind", Label: "Kind", Required: true},
+			{Name: "namespace", Label: "Namespace"},
+			{Name: "name", Label: "Name", Required: true},
+		},
+	}
+}
+
 func (d *ObjectDeleter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
 	d.logger.With("payload", payload).Debugf("deleting object")
 
@@ -36,12 +51,14 @@ func (d *ObjectDeleter) Handle(ctx context.Context, alerter action.Alerter, payl
 
 	alertType := action.AlertTypeInfo
 	message := fmt.Sprintf("Deleted %s %q", key.Kind, key.Name)
-	if err := d.store.Delete(ctx, key); err != nil {
+	err = d.store.Delete(ctx, key)
+	if err != nil {
 		alertType = action.AlertTypeWarning
 		message = fmt.Sprintf("Unable to deleted %s %q: %s", key.Kind, key.Name, err)
+		err = fmt.Errorf("delete %s %q: %w", key.Kind, key.Name, err)
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
 
-	return nil
+	return err
 }
@@ -0,0 +1,420 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/config"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// errServerMode is returned by the kube config actions when Octant authenticates users. The
+// kube config is shared by every user of the server, and imported users with exec or auth
+// provider credentials would run commands on the server, so it can only be edited in the
+// local dashboard.
+var errServerMode = errors.New("the kube config can't be edited when Octant authenticates users")
+
+// refuseInServerMode sends an alert and returns errServerMode if ctx has an authenticated user.
+func refuseInServerMode(ctx context.Context, alerter action.Alerter) error {
+	if _, ok := auth.UserFrom(ctx); !ok {
+		return nil
+	}
+
+	sendAlert(alerter, action.AlertTypeError, "The kube config can only be edited in the local dashboard")
+	return errServerMode
+}
+
+// KubeConfigImporter merges a kube config into one of the kube config files in use.
+type KubeConfigImporter struct {
+	logger log.Logger
+	editor *kubeconfig.Editor
+}
+
+var _ action.Dispatcher = (*KubeConfigImporter)(nil)
+
+// NewKubeConfigImporter creates an instance of KubeConfigImporter.
+func NewKubeConfigImporter(logger log.Logger, editor *kubeconfig.Editor) *KubeConfigImporter {
+	return &KubeConfigImporter{
+		logger: logger.With("action", octant.ActionImportKubeConfig),
+		editor: editor,
+	}
+}
+
+// ActionName returns the name of the action.
+func (i *KubeConfigImporter) ActionName() string {
+	return octant.ActionImportKubeConfig
+}
+
+// Command returns the command palette entry for importing a kube config.
+func (i *KubeConfigImporter) Command() action.Command {
+	return action.Command{
+		Name:        i.ActionName(),
+		Title:       "Import Kube Config",
+		Description: "Merge a kube config file into the kube config in use",
+		Fields: []action.CommandField{
+			{Name: "path", Label: "Kube config file", Required: true},
+			{Name: "target", Label: "Merge into (defaults to the first kube config file)"},
+		},
+	}
+}
+
+// Handle imports the kube config in the payload. The kube config is read from the
+// kubeConfig field, the path field or the first file chosen with a select file component.
+func (i *KubeConfigImporter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if err := refuseInServerMode(ctx, alerter); err != nil {
+		return err
+	}
+
+	target, err := payload.OptionalString("target")
+	if err != nil {
+		return err
+	}
+	if target == "" {
+		target = i.editor.Files()[0]
+	}
+
+	var names []string
+	data, err := payload.OptionalString("kubeConfig")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(data) != "" {
+		names, err = i.editor.Import([]byte(data), target)
+	} else {
+		var source string
+		source, err = importSource(payload)
+		if err == nil {
+			names, err = i.editor.ImportFile(source, target)
+		}
+	}
+
+	if err != nil {
+		i.logger.WithErr(err).Errorf("importing kube config")
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("Unable to import kube config: %s", err))
+		return fmt.Errorf("import kube config: %w", err)
+	}
+
+	sendAlert(alerter, action.AlertTypeInfo,
+		fmt.Sprintf("Imported %s into %s", strings.Join(names, ", "), target))
+	return nil
+}
+
+// importSource returns the path of the kube config to import.
+func importSource(payload action.Payload) (string, error) {
+	source, err := payload.OptionalString("path")
+	if err != nil {
+		return "", err
+	}
+	if source != "" {
+		return source, nil
+	}
+
+	if _, ok := payload["files"]; !ok {
+		return "", fmt.Errorf("payload does not contain a kube config")
+	}
+
+	data, err := payload.Raw("files")
+	if err != nil {
+		return "", err
+	}
+
+	var files []struct {
+		Name string `json:"name"`
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal(data, &files); err != nil {
+		return "", err
+	}
+
+	if len(files) == 0 {
+		return "", fmt.Errorf("no file was chosen")
+	}
+	if files[0].Path == "" {
+		return "", fmt.Errorf("the path of %s is not available; enter its path or paste its contents instead", files[0].Name)
+	}
+
+	return files[0].Path, nil
+}
+
+// ContextRenamer renames a kube config context.
+type ContextRenamer struct {
+	editor *kubeconfig.Editor
+}
+
+var _ action.Dispatcher = (*ContextRenamer)(nil)
+
+// NewContextRenamer creates an instance of ContextRenamer.
+func NewContextRenamer(editor *kubeconfig.Editor) *ContextRenamer {
+	return &ContextRenamer{editor: editor}
+}
+
+// ActionName returns the name of the action.
+func (r *ContextRenamer) ActionName() string {
+	return octant.ActionRenameContext
+}
+
+// Command returns the command palette entry for renaming a context.
+func (r *ContextRenamer) Command() action.Command {
+	return action.Command{
+		Name:        r.ActionName(),
+		Title:       "Rename Context",
+		Description: "Rename a kube config context",
+		Fields: []action.CommandField{
+			{Name: "contextName", Label: "Context", Required: true},
+			{Name: "newName", Label: "New name", Required: true},
+		},
+	}
+}
+
+// Handle renames the context in the payload.
+func (r *ContextRenamer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if err := refuseInServerMode(ctx, alerter); err != nil {
+		return err
+	}
+
+	contextName, err := payload.String("contextName")
+	if err != nil {
+		return err
+	}
+	newName, err := payload.String("newName")
+	if err != nil {
+		return err
+	}
+
+	if err := r.editor.RenameContext(contextName, newName); err != nil {
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("Unable to rename context %q: %s", contextName, err))
+		return fmt.Errorf("rename context %q: %w", contextName, err)
+	}
+
+	sendAlert(alerter, action.AlertTypeInfo, fmt.Sprintf("Renamed context %q to %q", contextName, newName))
+	return nil
+}
+
+// ContextDeleter deletes a kube config context.
+type ContextDeleter struct {
+	editor *kubeconfig.Editor
+}
+
+var _ action.Dispatcher = (*ContextDeleter)(nil)
+
+// NewContextDeleter creates an instance of ContextDeleter.
+func NewContextDeleter(editor *kubeconfig.Editor) *ContextDeleter {
+	return &ContextDeleter{editor: editor}
+}
+
+// ActionName returns the name of the action.
+func (d *ContextDeleter) ActionName() string {
+	return octant.ActionDeleteContext
+}
+
+// Command returns the command palette entry for deleting a context.
+func (d *ContextDeleter) Command() action.Command {
+	return action.Command{
+		Name:        d.ActionName(),
+		Title:       "Delete Context",
+		Description: "Delete a kube config context",
+		Fields: []action.CommandField{
+			{Name: "contextName", Label: "Context", Required: true},
+		},
+	}
+}
+
+// Handle deletes the context in the payload.
+func (d *ContextDeleter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if err := refuseInServerMode(ctx, alerter); err != nil {
+		return err
+	}
+
+	contextName, err := payload.String("contextName")
+	if err != nil {
+		return err
+	}
+
+	if err := d.editor.DeleteContext(contextName); err != nil {
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("Unable to delete context %q: %s", contextName, err))
+		return fmt.Errorf("delete context %q: %w", contextName, err)
+	}
+
+	sendAlert(alerter, action.AlertTypeInfo, fmt.Sprintf("Deleted context %q", contextName))
+	return nil
+}
+
+// ContextNamespaceSetter sets the default namespace of a kube config context.
+type ContextNamespaceSetter struct {
+	editor *kubeconfig.Editor
+}
+
+var _ action.Dispatcher = (*ContextNamespaceSetter)(nil)
+
+// NewContextNamespaceSetter creates an instance of ContextNamespaceSetter.
+func NewContextNamespaceSetter(editor *kubeconfig.Editor) *ContextNamespaceSetter {
+	return &ContextNamespaceSetter{editor: editor}
+}
+
+// ActionName returns the name of the action.
+func (s *ContextNamespaceSetter) ActionName() string {
+	return octant.ActionSetContextNamespace
+}
+
+// Command returns the command palette entry for setting a context's default namespace.
+func (s *ContextNamespaceSetter) Command() action.Command {
+	return action.Command{
+		Name:        s.ActionName(),
+		Title:       "Set Context Namespace",
+		Description: "Set the default namespace of a kube config context",
+		Fields: []action.CommandField{
+			{Name: "contextName", Label: "Context", Required: true},
+			{Name: "namespace", Label: "Namespace"},
+		},
+	}
+}
+
+// Handle sets the namespace of the context in the payload.
+func (s *ContextNamespaceSetter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if err := refuseInServerMode(ctx, alerter); err != nil {
+		return err
+	}
+
+	contextName, err := payload.String("contextName")
+	if err != nil {
+		return err
+	}
+	namespace, err := payload.OptionalString("namespace")
+	if err != nil {
+		return err
+	}
+
+	if err := s.editor.SetNamespace(contextName, namespace); err != nil {
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("Unable to set the namespace of context %q: %s", contextName, err))
+		return fmt.Errorf("set namespace of context %q: %w", contextName, err)
+	}
+
+	message := fmt.Sprintf("Set the namespace of context %q to %q", contextName, namespace)
+	if namespace == "" {
+		message = fmt.Sprintf("Removed the namespace of context %q", contextName)
+	}
+	sendAlert(alerter, action.AlertTypeInfo, message)
+	return nil
+}
+
+// FavoriteContextSetter pins or unpins a kube config context.
+type FavoriteContextSetter struct {
+	editor *kubeconfig.Editor
+}
+
+var _ action.Dispatcher = (*FavoriteContextSetter)(nil)
+
+// NewFavoriteContextSetter creates an instance of FavoriteContextSetter.
+func NewFavoriteContextSetter(editor *kubeconfig.Editor) *FavoriteContextSetter {
+	return &FavoriteContextSetter{editor: editor}
+}
+
+// ActionName returns the name of the action.
+func (s *FavoriteContextSetter) ActionName() string {
+	return octant.ActionSetFavoriteContext
+}
+
+// Command returns the command palette entry for pinning a context.
+func (s *FavoriteContextSetter) Command() action.Command {
+	return action.Command{
+		Name:        s.ActionName(),
+		Title:       "Pin Context",
+		Description: "Pin a kube config context as a favorite",
+		Fields: []action.CommandField{
+			{Name: "contextName", Label: "Context", Required: true},
+		},
+	}
+}
+
+// Handle pins the context in the payload. The context is unpinned if favorite is false.
+func (s *FavoriteContextSetter) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	if err := refuseInServerMode(ctx, alerter); err != nil {
+		return err
+	}
+
+	contextName, err := payload.String("contextName")
+	if err != nil {
+		return err
+	}
+
+	favorite := true
+	if _, ok := payload["favorite"]; ok {
+		if favorite, err = payload.Bool("favorite"); err != nil {
+			return err
+		}
+	}
+
+	if err := s.editor.SetFavorite(contextName, favorite); err != nil {
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("Unable to update context %q: %s", contextName, err))
+		return fmt.Errorf("update context %q: %w", contextName, err)
+	}
+
+	message := fmt.Sprintf("Pinned context %q", contextName)
+	if !favorite {
+		message = fmt.Sprintf("Unpinned context %q", contextName)
+	}
+	sendAlert(alerter, action.AlertTypeInfo, message)
+	return nil
+}
+
+// CredentialsRefresher refreshes the credentials of the current context.
+type CredentialsRefresher struct {
+	logger     log.Logger
+	dashConfig config.Dash
+}
+
+var _ action.Dispatcher = (*CredentialsRefresher)(nil)
+
+// NewCredentialsRefresher creates an instance of CredentialsRefresher.
+func NewCredentialsRefresher(logger log.Logger, dashConfig config.Dash) *CredentialsRefresher {
+	return &CredentialsRefresher{
+		logger:     logger.With("action", octant.ActionRefreshCredentials),
+		dashConfig: dashConfig,
+	}
+}
+
+// ActionName returns the name of the action.
+func (r *CredentialsRefresher) ActionName() string {
+	return octant.ActionRefreshCredentials
+}
+
+// Command returns the command palette entry for refreshing credentials.
+func (r *CredentialsRefresher) Command() action.Command {
+	return action.Command{
+		Name:        r.ActionName(),
+		Title:       "Refresh Credentials",
+		Description: "Reload the kube config and fetch the credentials of the current context again",
+	}
+}
+
+// Handle recreates the cluster client for the current context, so exec and auth provider
+// plugins are invoked again.
+func (r *CredentialsRefresher) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	contextName := r.dashConfig.CurrentContext()
+
+	if err := r.dashConfig.RefreshCredentials(ctx); err != nil {
+		r.logger.WithErr(err).Errorf("refreshing credentials")
+		sendAlert(alerter, action.AlertTypeError,
+			fmt.Sprintf("Unable to refresh credentials for context %s: %s", contextName, err))
+		return nil
+	}
+
+	sendAlert(alerter, action.AlertTypeInfo, fmt.Sprintf("Refreshing credentials for context %s", contextName))
+	return nil
+}
+
+func sendAlert(alerter action.Alerter, alertType action.AlertType, message string) {
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+}
@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://cluster:4443
+  name: cluster
+contexts:
+- context:
+    cluster: cluster
+    user: user
+  name: imported
+users:
+- name: user
+  user:
+    token: token
+`
+
+func TestKubeConfigImporter_Handle(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  func(source string) action.Payload
+		expected action.AlertType
+	}{
+		{
+			name: "path",
+			payload: func(source string) action.Payload {
+				return action.Payload{"path": source}
+			},
+			expected: action.AlertTypeInfo,
+		},
+		{
+			name: "select file",
+			payload: func(source string) action.Payload {
+				return action.Payload{"files": []interface{}{
+					map[string]interface{}{"name": "config", "path": source},
+				}}
+			},
+			expected: action.AlertTypeInfo,
+		},
+		{
+			name: "pasted",
+			payload: func(source string) action.Payload {
+				return action.Payload{"kubeConfig": testKubeConfig, "path": ""}
+			},
+			expected: action.AlertTypeInfo,
+		},
+		{
+			name: "select file without a path",
+			payload: func(source string) action.Payload {
+				return action.Payload{"files": []interface{}{
+					map[string]interface{}{"name": "config"},
+				}}
+			},
+			expected: action.AlertTypeError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dir := tempDir(t)
+			source := filepath.Join(dir, "source")
+			require.NoError(t, ioutil.WriteFile(source, []byte(testKubeConfig), 0600))
+			target := filepath.Join(dir, "config")
+
+			editor := kubeconfig.NewEditor([]string{target}, nil)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.expected, alert.Type, alert.Message)
+				})
+
+			i := NewKubeConfigImporter(log.NopLogger(), editor)
+			err := i.Handle(context.Background(), alerter, test.payload(source))
+			if test.expected == action.AlertTypeError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			contexts, err := editor.Contexts()
+			require.NoError(t, err)
+			if test.expected == action.AlertTypeInfo {
+				require.Len(t, contexts, 1)
+				assert.Equal(t, "imported", contexts[0].Name)
+			} else {
+				assert.Empty(t, contexts)
+			}
+		})
+	}
+}
+
+func TestContextActions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	file := filepath.Join(tempDir(t), "config")
+	require.NoError(t, ioutil.WriteFile(file, []byte(testKubeConfig), 0600))
+	editor := kubeconfig.NewEditor([]string{file}, nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any()).Times(4)
+
+	ctx := context.Background()
+	require.NoError(t, NewContextNamespaceSetter(editor).Handle(ctx, alerter,
+		action.Payload{"contextName": "imported", "namespace": "team"}))
+	require.NoError(t, NewFavoriteContextSetter(editor).Handle(ctx, alerter,
+		action.Payload{"contextName": "imported"}))
+	require.NoError(t, NewContextRenamer(editor).Handle(ctx, alerter,
+		action.Payload{"contextName": "imported", "newName": "team"}))
+
+	contexts, err := editor.Contexts()
+	require.NoError(t, err)
+	assert.Equal(t, []kubeconfig.ContextDetails{
+		{Name: "team", Cluster: "cluster", User: "user", Namespace: "team", File: file, Favorite: true},
+	}, contexts)
+
+	require.NoError(t, NewContextDeleter(editor).Handle(ctx, alerter,
+		action.Payload{"contextName": "team"}))
+
+	contexts, err = editor.Contexts()
+	require.NoError(t, err)
+	assert.Empty(t, contexts)
+}
+
+func TestKubeConfigActions_serverMode(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir := tempDir(t)
+	source := filepath.Join(dir, "source")
+	require.NoError(t, ioutil.WriteFile(source, []byte(testKubeConfig), 0600))
+	file := filepath.Join(dir, "config")
+	require.NoError(t, ioutil.WriteFile(file, []byte(testKubeConfig), 0600))
+	editor := kubeconfig.NewEditor([]string{file}, nil)
+
+	execKubeConfig := testKubeConfig + `    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: /bin/sh
+      args: ["-c", "touch /tmp/pwned"]
+`
+
+	tests := []struct {
+		name       string
+		dispatcher action.Dispatcher
+		payload    action.Payload
+	}{
+		{name: "import path", dispatcher: NewKubeConfigImporter(log.NopLogger(), editor), payload: action.Payload{"path": source}},
+		{name: "import pasted", dispatcher: NewKubeConfigImporter(log.NopLogger(), editor), payload: action.Payload{"kubeConfig": execKubeConfig}},
+		{name: "rename", dispatcher: NewContextRenamer(editor), payload: action.Payload{"contextName": "imported", "newName": "team"}},
+		{name: "delete", dispatcher: NewContextDeleter(editor), payload: action.Payload{"contextName": "imported"}},
+		{name: "set namespace", dispatcher: NewContextNamespaceSetter(editor), payload: action.Payload{"contextName": "imported", "namespace": "team"}},
+		{name: "pin", dispatcher: NewFavoriteContextSetter(editor), payload: action.Payload{"contextName": "imported"}},
+	}
+
+	ctx := auth.WithUser(context.Background(), auth.User{Name: "jane"})
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeError, alert.Type, alert.Message)
+				})
+
+			err := test.dispatcher.Handle(ctx, alerter, test.payload)
+			require.True(t, errors.Is(err, errServerMode), "unexpected error: %v", err)
+
+			data, err := ioutil.ReadFile(file)
+			require.NoError(t, err)
+			assert.Equal(t, testKubeConfig, string(data), "the kube config is not changed")
+		})
+	}
+}
+
+func TestConfiguration_ActionPaths_serverMode(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	file := filepath.Join(tempDir(t), "config")
+	require.NoError(t, ioutil.WriteFile(file, []byte(testKubeConfig), 0600))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+	dashConfig.EXPECT().ObjectStore().Return(nil).AnyTimes()
+	dashConfig.EXPECT().Notifications().Return(notification.NewManager()).AnyTimes()
+	dashConfig.EXPECT().KubeConfigEditor().Return(kubeconfig.NewEditor([]string{file}, nil)).AnyTimes()
+
+	local := &Configuration{Options: Options{DashConfig: dashConfig}}
+	assert.Contains(t, local.ActionPaths(), octant.ActionImportKubeConfig)
+
+	server := &Configuration{Options: Options{DashConfig: dashConfig, ServerMode: true}}
+	paths := server.ActionPaths()
+	for _, name := range []string{
+		octant.ActionImportKubeConfig,
+		octant.ActionRenameContext,
+		octant.ActionDeleteContext,
+		octant.ActionSetContextNamespace,
+		octant.ActionSetFavoriteContext,
+	} {
+		assert.NotContains(t, paths, name, "kube config actions are not available in server mode")
+	}
+	assert.Contains(t, paths, octant.ActionWatchObjects)
+}
+
+func TestCredentialsRefresher_Handle(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected action.AlertType
+	}{
+		{name: "in general", expected: action.AlertTypeInfo},
+		{name: "refresh failed", err: fmt.Errorf("boom"), expected: action.AlertTypeError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().CurrentContext().Return("context")
+			dashConfig.EXPECT().RefreshCredentials(gomock.Any()).Return(test.err)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.expected, alert.Type, alert.Message)
+				})
+
+			r := NewCredentialsRefresher(log.NopLogger(), dashConfig)
+			require.NoError(t, r.Handle(context.Background(), alerter, action.Payload{}))
+		})
+	}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "configuration-test")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	return dir
+}
@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// KubeConfigDescriber describes the kube config files in use. It offers actions for importing
+// kube configs and editing contexts.
+type KubeConfigDescriber struct {
+}
+
+var _ describer.Describer = (*KubeConfigDescriber)(nil)
+
+// NewKubeConfigDescriber creates an instance of KubeConfigDescriber.
+func NewKubeConfigDescriber() *KubeConfigDescriber {
+	return &KubeConfigDescriber{}
+}
+
+// Describe describes the contexts in the kube config files in use.
+func (d *KubeConfigDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	title := component.TitleFromString("Kube Config")
+
+	// The kube config is not shown to authenticated users, since it can only be edited in
+	// the local dashboard.
+	if _, ok := auth.UserFrom(ctx); ok {
+		return component.ContentResponse{
+			Title: title,
+			Components: []component.Component{
+				component.NewText("The kube config can only be edited in the local dashboard."),
+			},
+		}, nil
+	}
+
+	editor := options.Dash.KubeConfigEditor()
+	if editor == nil {
+		return component.ContentResponse{
+			Title: title,
+			Components: []component.Component{
+				component.NewText("Octant was not started with a kube config file."),
+			},
+		}, nil
+	}
+
+	contexts, err := editor.Contexts()
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	layout := component.NewFlexLayout("Kube Config")
+	layout.AddSections(
+		component.FlexLayoutSection{
+			{Width: component.WidthHalf, View: importCard(editor)},
+			{Width: component.WidthHalf, View: editContextsCard(editor, contexts)},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: contextsTable(contexts, options.Dash.CurrentContext())},
+		},
+	)
+
+	return component.ContentResponse{
+		Title:      title,
+		Components: []component.Component{layout},
+	}, nil
+}
+
+// PathFilters returns PathFilters for this describer.
+func (d *KubeConfigDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/kubeconfig", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *KubeConfigDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func importCard(editor *kubeconfig.Editor) *component.Card {
+	files := editor.Files()
+
+	card := component.NewCard(component.TitleFromString("Import"))
+
+	body := component.NewList(nil, nil)
+	body.Add(component.NewText(fmt.Sprintf(
+		"Clusters, users and contexts are merged into the chosen file. Entries with the same name are replaced and a backup is written to %s.",
+		files[0]+kubeconfig.BackupSuffix)))
+	body.Add(component.NewSelectFile(fmt.Sprintf("Import into %s", files[0]), false,
+		component.LayoutHorizontal, octant.ActionImportKubeConfig))
+	card.SetBody(body)
+
+	card.AddAction(component.Action{
+		Name:  "Import",
+		Title: "Import Kube Config",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldText("Path", "path", ""),
+				component.NewFormFieldTextarea("Or paste a kube config", "kubeConfig", ""),
+				component.NewFormFieldSelect("Merge into", "target", fileChoices(files), false),
+				component.NewFormFieldHidden("action", octant.ActionImportKubeConfig),
+			},
+		},
+	})
+
+	return card
+}
+
+func editContextsCard(editor *kubeconfig.Editor, contexts []kubeconfig.ContextDetails) *component.Card {
+	card := component.NewCard(component.TitleFromString("Kube Config Files"))
+	card.SetBody(component.NewText(strings.Join(editor.Files(), "\n")))
+
+	if len(contexts) == 0 {
+		return card
+	}
+
+	card.AddAction(component.Action{
+		Name:  "Rename Context",
+		Title: "Rename Context",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldSelect("Context", "contextName", contextChoices(contexts), false),
+				component.NewFormFieldText("New name", "newName", ""),
+				component.NewFormFieldHidden("action", octant.ActionRenameContext),
+			},
+		},
+	})
+	card.AddAction(component.Action{
+		Name:  "Set Namespace",
+		Title: "Set Context Namespace",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldSelect("Context", "contextName", contextChoices(contexts), false),
+				component.NewFormFieldText("Namespace", "namespace", ""),
+				component.NewFormFieldHidden("action", octant.ActionSetContextNamespace),
+			},
+		},
+	})
+
+	return card
+}
+
+func contextsTable(contexts []kubeconfig.ContextDetails, currentContext string) *component.Table {
+	table := component.NewTable("Contexts", "There are no contexts!",
+		component.NewTableCols("Name", "Favorite", "Cluster", "User", "Namespace", "File"))
+
+	for _, c := range contexts {
+		name := component.NewText(c.Name)
+		if c.Name == currentContext {
+			name = component.NewText(fmt.Sprintf("%s (current)", c.Name))
+		}
+
+		favorite := ""
+		if c.Favorite {
+			favorite = "Pinned"
+		}
+
+		row := component.TableRow{
+			"Name":      name,
+			"Favorite":  component.NewText(favorite),
+			"Cluster":   component.NewText(c.Cluster),
+			"User":      component.NewText(c.User),
+			"Namespace": component.NewText(c.Namespace),
+			"File":      component.NewText(c.File),
+		}
+
+		pinName := "Pin"
+		if c.Favorite {
+			pinName = "Unpin"
+		}
+		row.AddAction(component.GridAction{
+			Name:       pinName,
+			ActionPath: octant.ActionSetFavoriteContext,
+			Payload:    action.Payload{"contextName": c.Name, "favorite": !c.Favorite},
+			Type:       component.GridActionPrimary,
+		})
+		row.AddAction(component.GridAction{
+			Name:       "Delete",
+			ActionPath: octant.ActionDeleteContext,
+			Payload:    action.Payload{"contextName": c.Name},
+			Confirmation: &component.Confirmation{
+				Title: "Delete Context",
+				Body:  fmt.Sprintf("Are you sure you want to delete context **%s** from %s? Its cluster and user are kept.", c.Name, c.File),
+			},
+			Type: component.GridActionDanger,
+		})
+
+		table.Add(row)
+	}
+
+	return table
+}
+
+func fileChoices(files []string) []component.InputChoice {
+	var choices []component.InputChoice
+	for i, file := range files {
+		choices = append(choices, component.InputChoice{Label: file, Value: file, Checked: i == 0})
+	}
+	return choices
+}
+
+func contextChoices(contexts []kubeconfig.ContextDetails) []component.InputChoice {
+	var choices []component.InputChoice
+	for i, c := range contexts {
+		choices = append(choices, component.InputChoice{Label: c.Name, Value: c.Name, Checked: i == 0})
+	}
+	return choices
+}
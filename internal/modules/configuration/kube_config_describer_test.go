@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestKubeConfigDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	file := filepath.Join(tempDir(t), "config")
+	require.NoError(t, ioutil.WriteFile(file, []byte(testKubeConfig), 0600))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().KubeConfigEditor().Return(kubeconfig.NewEditor([]string{file}, nil))
+	dashConfig.EXPECT().CurrentContext().Return("imported")
+
+	d := NewKubeConfigDescriber()
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, cResponse.Components, 1)
+	layout, ok := cResponse.Components[0].(*component.FlexLayout)
+	require.True(t, ok)
+	require.Len(t, layout.Config.Sections, 2)
+
+	table, ok := layout.Config.Sections[1][0].View.(*component.Table)
+	require.True(t, ok)
+	rows := table.Rows()
+	require.Len(t, rows, 1)
+	assert.Equal(t, component.NewText("imported (current)"), rows[0]["Name"])
+	assert.Equal(t, component.NewText(file), rows[0]["File"])
+}
+
+func TestKubeConfigDescriber_serverMode(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	d := NewKubeConfigDescriber()
+	ctx := auth.WithUser(context.Background(), auth.User{Name: "jane"})
+	cResponse, err := d.Describe(ctx, "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	assert.Equal(t, []component.Component{
+		component.NewText("The kube config can only be edited in the local dashboard."),
+	}, cResponse.Components)
+}
+
+func TestKubeConfigDescriber_noEditor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().KubeConfigEditor().Return(nil)
+
+	d := NewKubeConfigDescriber()
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	assert.Equal(t, []component.Component{
+		component.NewText("Octant was not started with a kube config file."),
+	}, cResponse.Components)
+}
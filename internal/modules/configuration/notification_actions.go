@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+// ObjectWatcher watches an object, or the objects of a kind matching a label selector, in
+// the current context.
+type ObjectWatcher struct {
+	notifications  *notification.Manager
+	currentContext func() string
+}
+
+var _ action.Dispatcher = (*ObjectWatcher)(nil)
+
+// NewObjectWatcher creates an instance of ObjectWatcher.
+func NewObjectWatcher(notifications *notification.Manager, currentContext func() string) *ObjectWatcher {
+	return &ObjectWatcher{
+		notifications:  notifications,
+		currentContext: currentContext,
+	}
+}
+
+// ActionName returns the name of the action.
+func (w *ObjectWatcher) ActionName() string {
+	return octant.ActionWatchObjects
+}
+
+// Command returns the command palette entry for watching objects.
+func (w *ObjectWatcher) Command() action.Command {
+	return action.Command{
+		Name:        w.ActionName(),
+		Title:       "Watch Objects",
+		Description: "Get notified when the status of objects turns to warning or error, a rollout completes or a job finishes",
+		Fields: []action.CommandField{
+			{Name: "apiVersion", Label: "API version", Required: true},
+			{Name: "kind", Label: "Kind", Required: true},
+			{Name: "namespace", Label: "Namespace"},
+			{Name: "name", Label: "Name (or use a label selector)"},
+			{Name: "selector", Label: "Label selector"},
+		},
+	}
+}
+
+// Handle watches the objects in the payload.
+func (w *ObjectWatcher) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	var watch notification.Watch
+	fields := map[string]*string{
+		"namespace":  &watch.Namespace,
+		"apiVersion": &watch.APIVersion,
+		"kind":       &watch.Kind,
+		"name":       &watch.Name,
+		"selector":   &watch.Selector,
+	}
+	for name, value := range fields {
+		s, err := payload.OptionalString(name)
+		if err != nil {
+			return err
+		}
+		*value = s
+	}
+	watch.Context = w.currentContext()
+
+	watch, err := w.notifications.Add(ctx, watch)
+	if err != nil {
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("Unable to watch objects: %s", err))
+		return nil
+	}
+
+	sendAlert(alerter, action.AlertTypeInfo, fmt.Sprintf("Watching %s", watch.Target()))
+	return nil
+}
+
+// ObjectUnwatcher removes a watch.
+type ObjectUnwatcher struct {
+	notifications *notification.Manager
+}
+
+var _ action.Dispatcher = (*ObjectUnwatcher)(nil)
+
+// NewObjectUnwatcher creates an instance of ObjectUnwatcher.
+func NewObjectUnwatcher(notifications *notification.Manager) *ObjectUnwatcher {
+	return &ObjectUnwatcher{notifications: notifications}
+}
+
+// ActionName returns the name of the action.
+func (u *ObjectUnwatcher) ActionName() string {
+	return octant.ActionUnwatchObjects
+}
+
+// Handle removes the watch with the ID in the payload.
+func (u *ObjectUnwatcher) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	id, err := payload.String("id")
+	if err != nil {
+		return err
+	}
+
+	removed, err := u.notifications.Remove(ctx, id)
+	if err != nil {
+		sendAlert(alerter, action.AlertTypeError, fmt.Sprintf("Unable to stop watching: %s", err))
+		return nil
+	}
+	if !removed {
+		sendAlert(alerter, action.AlertTypeWarning, "The watch was already removed")
+		return nil
+	}
+
+	sendAlert(alerter, action.AlertTypeInfo, "Stopped watching")
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+func TestObjectWatcher_Handle(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected action.AlertType
+		watches  []notification.Watch
+	}{
+		{
+			name:     "object",
+			payload:  action.Payload{"namespace": "default", "apiVersion": "apps/v1", "kind": "Deployment", "name": "web"},
+			expected: action.AlertTypeInfo,
+			watches: []notification.Watch{
+				{ID: "watch-1", Context: "dev", Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+			},
+		},
+		{
+			name:     "label selector",
+			payload:  action.Payload{"namespace": "", "apiVersion": "v1", "kind": "Pod", "selector": "app=web"},
+			expected: action.AlertTypeInfo,
+			watches: []notification.Watch{
+				{ID: "watch-1", Context: "dev", APIVersion: "v1", Kind: "Pod", Selector: "app=web"},
+			},
+		},
+		{
+			name:     "invalid selector",
+			payload:  action.Payload{"apiVersion": "v1", "kind": "Pod", "selector": "app in web"},
+			expected: action.AlertTypeError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, test.expected, alert.Type, alert.Message)
+				})
+
+			manager := notification.NewManager()
+			w := NewObjectWatcher(manager, func() string { return "dev" })
+			ctx := context.Background()
+			require.NoError(t, w.Handle(ctx, alerter, test.payload))
+
+			watches := manager.Watches(ctx)
+			for i := range watches {
+				watches[i].Created = test.watches[i].Created
+			}
+			assert.Equal(t, test.watches, watches)
+		})
+	}
+}
+
+func TestObjectUnwatcher_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+	manager := notification.NewManager()
+	watch, err := manager.Add(ctx, notification.Watch{Context: "dev", APIVersion: "batch/v1", Kind: "Job", Name: "migrate"})
+	require.NoError(t, err)
+
+	var alerts []action.AlertType
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			alerts = append(alerts, alert.Type)
+		}).Times(3)
+
+	u := NewObjectUnwatcher(manager)
+	otherCtx := auth.WithUser(ctx, auth.User{Name: "jane"})
+	require.NoError(t, u.Handle(otherCtx, alerter, action.Payload{"id": watch.ID}))
+	require.NoError(t, u.Handle(ctx, alerter, action.Payload{"id": watch.ID}))
+	require.NoError(t, u.Handle(ctx, alerter, action.Payload{"id": watch.ID}))
+	require.Error(t, u.Handle(ctx, alerter, action.Payload{}))
+
+	assert.Equal(t, []action.AlertType{action.AlertTypeWarning, action.AlertTypeInfo, action.AlertTypeWarning}, alerts,
+		"watches of other users can't be removed")
+	assert.Empty(t, manager.Watches(ctx))
+}
@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// NotificationsDescriber describes the watched objects and the recent notifications
+// about them. It offers an action for watching the objects matching a label selector.
+type NotificationsDescriber struct {
+}
+
+var _ describer.Describer = (*NotificationsDescriber)(nil)
+
+// NewNotificationsDescriber creates an instance of NotificationsDescriber.
+func NewNotificationsDescriber() *NotificationsDescriber {
+	return &NotificationsDescriber{}
+}
+
+// Describe describes the watches and recent notifications.
+func (d *NotificationsDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	notifications := options.Dash.Notifications()
+
+	layout := component.NewFlexLayout("Notifications")
+	layout.AddSections(
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: deliveryCard(notifications.Senders())},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: watchesTable(notifications.Watches(ctx))},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: recentNotificationsTable(notifications.Recent(ctx))},
+		},
+	)
+
+	return component.ContentResponse{
+		Title:      component.TitleFromString("Notifications"),
+		Components: []component.Component{layout},
+	}, nil
+}
+
+// PathFilters returns PathFilters for this describer.
+func (d *NotificationsDescriber) PathFilters() []describer.PathFilter {
+	filter := describer.NewPathFilter("/notifications", d)
+	return []describer.PathFilter{*filter}
+}
+
+// Reset does nothing.
+func (d *NotificationsDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func deliveryCard(senders []notification.Sender) *component.Card {
+	card := component.NewCard(component.TitleFromString("Delivery"))
+
+	text := "Notifications are shown as browser notifications while Octant is open. " +
+		"Set webhookURL in " + notification.ConfigFile + " in the Octant configuration directory to also send them to a webhook."
+	if len(senders) > 0 {
+		var names []string
+		for _, sender := range senders {
+			names = append(names, sender.String())
+		}
+		text = fmt.Sprintf("Notifications are shown as browser notifications while Octant is open, and sent to the %s.",
+			strings.Join(names, ", "))
+	}
+	card.SetBody(component.NewText(text))
+
+	card.AddAction(component.Action{
+		Name:  "Watch Label Selector",
+		Title: "Watch Objects Matching a Label Selector",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldText("API version", "apiVersion", "v1"),
+				component.NewFormFieldText("Kind", "kind", "Pod"),
+				component.NewFormFieldText("Namespace (empty for all namespaces)", "namespace", ""),
+				component.NewFormFieldText("Label selector", "selector", ""),
+				component.NewFormFieldHidden("action", octant.ActionWatchObjects),
+			},
+		},
+	})
+
+	return card
+}
+
+func watchesTable(watches []notification.Watch) *component.Table {
+	table := component.NewTable("Watches", "There are no watches! Watch an object from its page.",
+		component.NewTableCols("Target", "Namespace", "Context", "Created"))
+
+	for _, w := range watches {
+		namespace := w.Namespace
+		if namespace == "" && w.Selector != "" {
+			namespace = "(all)"
+		}
+
+		row := component.TableRow{
+			"Target":    component.NewText(w.Target()),
+			"Namespace": component.NewText(namespace),
+			"Context":   component.NewText(w.Context),
+			"Created":   component.NewTimestamp(w.Created),
+		}
+		row.AddAction(component.GridAction{
+			Name:       "Stop Watching",
+			ActionPath: octant.ActionUnwatchObjects,
+			Payload:    action.Payload{"id": w.ID},
+			Type:       component.GridActionDanger,
+		})
+
+		table.Add(row)
+	}
+
+	return table
+}
+
+func recentNotificationsTable(list []notification.Notification) *component.Table {
+	table := component.NewTable("Recent Notifications", "There are no notifications!",
+		component.NewTableCols("Time", "Level", "Title", "Message", "Context"))
+
+	for _, n := range list {
+		level := component.NewText(string(n.Level))
+		switch n.Level {
+		case notification.LevelSuccess:
+			level.SetStatus(component.TextStatusOK)
+		case notification.LevelWarning:
+			level.SetStatus(component.TextStatusWarning)
+		case notification.LevelError:
+			level.SetStatus(component.TextStatusError)
+		}
+
+		var title component.Component = component.NewText(n.Title)
+		if n.Ref != "" {
+			title = component.NewLink("", n.Title, n.Ref)
+		}
+
+		table.Add(component.TableRow{
+			"Time":    component.NewTimestamp(n.Time),
+			"Level":   level,
+			"Title":   title,
+			"Message": component.NewText(n.Message),
+			"Context": component.NewText(n.Context),
+		})
+	}
+
+	return table
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/notification"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestNotificationsDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+	manager := notification.NewManager()
+	web, err := manager.Add(ctx, notification.Watch{Context: "dev", Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"})
+	require.NoError(t, err)
+	pods, err := manager.Add(ctx, notification.Watch{Context: "prod", APIVersion: "v1", Kind: "Pod", Selector: "app=web"})
+	require.NoError(t, err)
+
+	now := time.Unix(1600000000, 0).UTC()
+	manager.Publish(notification.Notification{
+		Time:    now,
+		Level:   notification.LevelSuccess,
+		Title:   "Rollout of Deployment web completed",
+		Ref:     "/overview/namespace/default/workloads/deployments/web",
+		Context: "dev",
+		WatchID: web.ID,
+	})
+	manager.Publish(notification.Notification{Time: now, Title: "Job migrate completed", Context: "dev", User: "jane"})
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().Notifications().Return(manager)
+
+	d := NewNotificationsDescriber()
+
+	cResponse, err := d.Describe(ctx, "default", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Equal(t, component.TitleFromString("Notifications"), cResponse.Title)
+	require.Len(t, cResponse.Components, 1)
+
+	layout, ok := cResponse.Components[0].(*component.FlexLayout)
+	require.True(t, ok)
+	sections := layout.Config.Sections
+	require.Len(t, sections, 3)
+
+	card, ok := sections[0][0].View.(*component.Card)
+	require.True(t, ok)
+	component.AssertEqual(t, component.NewText("Notifications are shown as browser notifications while Octant is open. "+
+		"Set webhookURL in notifications.yaml in the Octant configuration directory to also send them to a webhook."), card.Config.Body)
+	require.Len(t, card.Config.Actions, 1)
+	require.Equal(t, "Watch Label Selector", card.Config.Actions[0].Name)
+
+	watches := component.NewTable("Watches", "There are no watches! Watch an object from its page.",
+		component.NewTableCols("Target", "Namespace", "Context", "Created"))
+	webRow := component.TableRow{
+		"Target":    component.NewText("Deployment web"),
+		"Namespace": component.NewText("default"),
+		"Context":   component.NewText("dev"),
+		"Created":   component.NewTimestamp(web.Created),
+	}
+	webRow.AddAction(component.GridAction{
+		Name:       "Stop Watching",
+		ActionPath: octant.ActionUnwatchObjects,
+		Payload:    action.Payload{"id": web.ID},
+		Type:       component.GridActionDanger,
+	})
+	podsRow := component.TableRow{
+		"Target":    component.NewText("Pod app=web"),
+		"Namespace": component.NewText("(all)"),
+		"Context":   component.NewText("prod"),
+		"Created":   component.NewTimestamp(pods.Created),
+	}
+	podsRow.AddAction(component.GridAction{
+		Name:       "Stop Watching",
+		ActionPath: octant.ActionUnwatchObjects,
+		Payload:    action.Payload{"id": pods.ID},
+		Type:       component.GridActionDanger,
+	})
+	watches.Add(webRow, podsRow)
+	component.AssertEqual(t, watches, sections[1][0].View)
+
+	level := component.NewText("success")
+	level.SetStatus(component.TextStatusOK)
+
+	recent := component.NewTable("Recent Notifications", "There are no notifications!",
+		component.NewTableCols("Time", "Level", "Title", "Message", "Context"))
+	recent.Add(component.TableRow{
+		"Time":    component.NewTimestamp(now),
+		"Level":   level,
+		"Title":   component.NewLink("", "Rollout of Deployment web completed", "/overview/namespace/default/workloads/deployments/web"),
+		"Message": component.NewText(""),
+		"Context": component.NewText("dev"),
+	})
+	component.AssertEqual(t, recent, sections[2][0].View)
+}
@@ -8,11 +8,17 @@ package configuration
 import "github.com/vmware-tanzu/octant/internal/describer"
 
 var (
-	pluginDescriber = NewPluginListDescriber()
+	pluginDescriber        = NewPluginListDescriber()
+	auditLogDescriber      = NewAuditLogDescriber()
+	kubeConfigDescriber    = NewKubeConfigDescriber()
+	notificationsDescriber = NewNotificationsDescriber()
 
 	rootDescriber = describer.NewSection(
 		"/",
 		"Configuration",
 		pluginDescriber,
+		auditLogDescriber,
+		kubeConfigDescriber,
+		notificationsDescriber,
 	)
 )
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// ContextLoader loads a kube context into the fleet.
+type ContextLoader struct {
+	logger log.Logger
+	fleet  *fleet.Fleet
+}
+
+var _ action.Dispatcher = (*ContextLoader)(nil)
+
+// NewContextLoader creates an instance of ContextLoader.
+func NewContextLoader(logger log.Logger, f *fleet.Fleet) *ContextLoader {
+	return &ContextLoader{
+		logger: logger.With("action", octant.ActionLoadFleetContext),
+		fleet:  f,
+	}
+}
+
+// ActionName returns the name of the action.
+func (l *ContextLoader) ActionName() string {
+	return octant.ActionLoadFleetContext
+}
+
+// Command returns the command palette entry for loading a context.
+func (l *ContextLoader) Command() action.Command {
+	return action.Command{
+		Name:        l.ActionName(),
+		Title:       "Load Fleet Context",
+		Description: "Load a kube context into the fleet overview",
+		Fields: []action.CommandField{
+			{Name: "contextName", Label: "Context", Required: true},
+		},
+	}
+}
+
+// Handle loads the context named in the payload.
+func (l *ContextLoader) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	contextName, err := payload.String("contextName")
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Loaded context %q into the fleet", contextName)
+	if err := l.fleet.Load(contextName); err != nil {
+		l.logger.WithErr(err).Errorf("loading fleet context")
+		alertType = action.AlertTypeError
+		message = fmt.Sprintf("Unable to load context %q: %s", contextName, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// ContextUnloader unloads a kube context from the fleet.
+type ContextUnloader struct {
+	fleet *fleet.Fleet
+}
+
+var _ action.Dispatcher = (*ContextUnloader)(nil)
+
+// NewContextUnloader creates an instance of ContextUnloader.
+func NewContextUnloader(f *fleet.Fleet) *ContextUnloader {
+	return &ContextUnloader{fleet: f}
+}
+
+// ActionName returns the name of the action.
+func (u *ContextUnloader) ActionName() string {
+	return octant.ActionUnloadFleetContext
+}
+
+// Command returns the command palette entry for unloading a context.
+func (u *ContextUnloader) Command() action.Command {
+	return action.Command{
+		Name:        u.ActionName(),
+		Title:       "Unload Fleet Context",
+		Description: "Remove a kube context from the fleet overview",
+		Fields: []action.CommandField{
+			{Name: "contextName", Label: "Context", Required: true},
+		},
+	}
+}
+
+// Handle unloads the context named in the payload.
+func (u *ContextUnloader) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	contextName, err := payload.String("contextName")
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Unloaded context %q from the fleet", contextName)
+	if !u.fleet.Unload(contextName) {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Context %q is not loaded", contextName)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/pkg/config"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// objectLoadingError is returned when an object is not found because the object store
+// has not finished loading objects of its kind.
+type objectLoadingError struct {
+	ref fleet.ObjectRef
+}
+
+func (e *objectLoadingError) Error() string {
+	return fmt.Sprintf("%s %s is loading", e.ref.Key.Kind, e.ref)
+}
+
+// CompareDescriber compares two objects, which can be in different namespaces or kube
+// contexts. Contexts other than the current context must be loaded into the fleet.
+type CompareDescriber struct {
+	fleet *fleet.Fleet
+}
+
+var _ describer.Describer = (*CompareDescriber)(nil)
+
+// NewCompareDescriber creates an instance of CompareDescriber.
+func NewCompareDescriber(f *fleet.Fleet) *CompareDescriber {
+	return &CompareDescriber{fleet: f}
+}
+
+// Describe shows the diff of the YAML of two objects.
+func (d *CompareDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	strip := fleet.CompareStrip(options.Fields["strip"])
+	left, err := fleet.ObjectRefFromFields(options.Fields, "left")
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+	right, err := fleet.ObjectRefFromFields(options.Fields, "right")
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	title := component.Title(component.NewText("Compare"), component.NewText(fmt.Sprintf("%s %s", left.Key.Kind, left.Key.Name)))
+
+	leftYAML, leftErr := d.objectYAML(ctx, options.Dash, left, strip)
+	rightYAML, rightErr := d.objectYAML(ctx, options.Dash, right, strip)
+	if leftErr != nil || rightErr != nil {
+		var components []component.Component
+		for _, err := range []error{leftErr, rightErr} {
+			if err == nil {
+				continue
+			}
+			text := component.NewText(err.Error())
+			var loadingErr *objectLoadingError
+			if !errors.As(err, &loadingErr) {
+				text.SetStatus(component.TextStatusError)
+			}
+			components = append(components, text)
+		}
+		return component.ContentResponse{Title: title, Components: components}, nil
+	}
+
+	diff := component.NewDiff(left.String(), leftYAML, right.String(), rightYAML)
+
+	return component.ContentResponse{
+		Title:           title,
+		Components:      []component.Component{diff},
+		TitleComponents: stripLinks(strip, left, right),
+	}, nil
+}
+
+// PathFilters returns PathFilters for this describer.
+func (d *CompareDescriber) PathFilters() []describer.PathFilter {
+	side := func(name string) string {
+		return fmt.Sprintf("(?P<%[1]sContext>[^/]+)/(?P<%[1]sNamespace>[^/]+)/(?P<%[1]sGroup>[^/]+)/(?P<%[1]sVersion>[^/]+)/(?P<%[1]sKind>[^/]+)/(?P<%[1]sName>[^/]+)", name)
+	}
+
+	pattern := fmt.Sprintf("/compare/(?P<strip>%s|%s|%s|%s)/%s/%s",
+		fleet.CompareStripAll, fleet.CompareStripManagedFields, fleet.CompareStripStatus, fleet.CompareStripNone, side("left"), side("right"))
+
+	return []describer.PathFilter{
+		*describer.NewPathFilter(pattern, d),
+	}
+}
+
+// Reset does nothing.
+func (d *CompareDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// objectYAML loads an object and converts it to YAML without the stripped fields.
+func (d *CompareDescriber) objectYAML(ctx context.Context, dashConfig config.Dash, ref fleet.ObjectRef, strip fleet.CompareStrip) (string, error) {
+	objectStore, storeCtx, err := d.objectStore(ctx, dashConfig, ref.ContextName)
+	if err != nil {
+		return "", err
+	}
+
+	object, err := objectStore.Get(storeCtx, ref.Key)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return "", fmt.Errorf("get %s: %w", ref, err)
+	}
+	if object == nil {
+		// The first request for a kind starts its informer, so the object is not in the
+		// store until the informer has synced.
+		if objectStore.IsLoading(storeCtx, ref.Key) {
+			return "", &objectLoadingError{ref: ref}
+		}
+		return "", fmt.Errorf("%s %s was not found", ref.Key.Kind, ref)
+	}
+
+	object = object.DeepCopy()
+	if strip == fleet.CompareStripAll || strip == fleet.CompareStripManagedFields {
+		unstructured.RemoveNestedField(object.Object, "metadata", "managedFields")
+	}
+	if strip == fleet.CompareStripAll || strip == fleet.CompareStripStatus {
+		unstructured.RemoveNestedField(object.Object, "status")
+	}
+
+	data, err := yaml.Marshal(object.Object)
+	if err != nil {
+		return "", fmt.Errorf("convert %s to YAML: %w", ref, err)
+	}
+	return string(data), nil
+}
+
+// objectStore returns the object store of a context and the context to use for requests
+// to it. Contexts are never loaded here, since a URL alone should not start watching a
+// cluster that nothing unloads.
+func (d *CompareDescriber) objectStore(ctx context.Context, dashConfig config.Dash, contextName string) (store.Store, context.Context, error) {
+	if contextName == dashConfig.CurrentContext() {
+		return dashConfig.ObjectStore(), ctx, nil
+	}
+
+	member, ok := d.fleet.Member(contextName)
+	if !ok {
+		return nil, nil, fmt.Errorf("context %s is not loaded into the fleet", contextName)
+	}
+
+	memberCtx, err := member.UserContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return member.ObjectStore, memberCtx, nil
+}
+
+// stripLinks creates links which toggle whether managed fields and status are compared.
+func stripLinks(strip fleet.CompareStrip, left, right fleet.ObjectRef) []component.Component {
+	managedFields := strip == fleet.CompareStripAll || strip == fleet.CompareStripManagedFields
+	status := strip == fleet.CompareStripAll || strip == fleet.CompareStripStatus
+
+	stripFor := func(managedFields, status bool) fleet.CompareStrip {
+		switch {
+		case managedFields && status:
+			return fleet.CompareStripAll
+		case managedFields:
+			return fleet.CompareStripManagedFields
+		case status:
+			return fleet.CompareStripStatus
+		default:
+			return fleet.CompareStripNone
+		}
+	}
+
+	managedFieldsLabel := "Hide managed fields"
+	if managedFields {
+		managedFieldsLabel = "Show managed fields"
+	}
+	statusLabel := "Hide status"
+	if status {
+		statusLabel = "Show status"
+	}
+
+	return []component.Component{
+		component.NewLink("", managedFieldsLabel, fleet.ComparePath(stripFor(!managedFields, status), left, right)),
+		component.NewLink("", statusLabel, fleet.ComparePath(stripFor(managedFields, !status), left, right)),
+	}
+}
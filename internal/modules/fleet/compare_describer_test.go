@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestCompareDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	f := newTestFleet(t, controller, map[string]map[string][]runtime.Object{"staging": {}})
+
+	key := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "payments"}
+
+	member, ok := f.Member("staging")
+	require.True(t, ok)
+	stagingStore := member.ObjectStore.(*storeFake.MockStore)
+	stagingStore.EXPECT().Get(gomock.Any(), key).
+		Return(testutil.ToUnstructured(t, createDeployment("payments", 1, 1, "payments:1.2")), nil).AnyTimes()
+
+	productionStore := storeFake.NewMockStore(controller)
+	productionStore.EXPECT().Get(gomock.Any(), key).
+		Return(testutil.ToUnstructured(t, createDeployment("payments", 3, 3, "payments:1.1")), nil).AnyTimes()
+
+	missingKey := store.Key{Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "checkout"}
+	notFound := kerrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "checkout")
+	stagingStore.EXPECT().Get(gomock.Any(), missingKey).Return(nil, notFound).AnyTimes()
+	stagingStore.EXPECT().IsLoading(gomock.Any(), missingKey).Return(true).AnyTimes()
+	productionStore.EXPECT().Get(gomock.Any(), missingKey).Return(nil, notFound).AnyTimes()
+	productionStore.EXPECT().IsLoading(gomock.Any(), missingKey).Return(false).AnyTimes()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().CurrentContext().Return("production").AnyTimes()
+	dashConfig.EXPECT().ObjectStore().Return(productionStore).AnyTimes()
+
+	left := fleet.ObjectRef{ContextName: "staging", Key: key}
+	right := fleet.ObjectRef{ContextName: "production", Key: key}
+
+	d := NewCompareDescriber(f)
+
+	content := func(t *testing.T, strip fleet.CompareStrip, left, right fleet.ObjectRef) component.ContentResponse {
+		contentPath := strings.TrimPrefix(fleet.ComparePath(strip, left, right), "/fleet")
+
+		pf := d.PathFilters()[0]
+		require.True(t, pf.Match(contentPath))
+
+		options := describer.Options{Dash: dashConfig, Fields: pf.Fields(contentPath)}
+		cResponse, err := d.Describe(context.Background(), "", options)
+		require.NoError(t, err)
+		return cResponse
+	}
+
+	describe := func(t *testing.T, strip fleet.CompareStrip) *component.Diff {
+		cResponse := content(t, strip, left, right)
+		require.Len(t, cResponse.Components, 1)
+
+		diff, ok := cResponse.Components[0].(*component.Diff)
+		require.True(t, ok)
+		assert.Equal(t, "staging: namespace/payments", diff.Config.LeftTitle)
+		assert.Equal(t, "production: namespace/payments", diff.Config.RightTitle)
+		return diff
+	}
+
+	changed := func(diff *component.Diff) []string {
+		var list []string
+		for _, line := range diff.Config.Lines {
+			switch line.Type {
+			case component.DiffLineAdded:
+				list = append(list, "+"+strings.TrimSpace(line.Text))
+			case component.DiffLineRemoved:
+				list = append(list, "-"+strings.TrimSpace(line.Text))
+			}
+		}
+		return list
+	}
+
+	t.Run("status stripped", func(t *testing.T) {
+		diff := describe(t, fleet.CompareStripAll)
+		assert.Equal(t, []string{"-replicas: 1", "+replicas: 3", "-- image: payments:1.2", "+- image: payments:1.1"}, changed(diff))
+	})
+
+	t.Run("status compared", func(t *testing.T) {
+		diff := describe(t, fleet.CompareStripNone)
+		assert.Contains(t, changed(diff), "+readyReplicas: 3")
+	})
+
+	t.Run("object loading or not found", func(t *testing.T) {
+		cResponse := content(t, fleet.CompareStripAll,
+			fleet.ObjectRef{ContextName: "staging", Key: missingKey},
+			fleet.ObjectRef{ContextName: "production", Key: missingKey})
+
+		loading := component.NewText("Deployment staging: namespace/checkout is loading")
+		notFound := component.NewText("Deployment production: namespace/checkout was not found")
+		notFound.SetStatus(component.TextStatusError)
+		assert.Equal(t, []component.Component{loading, notFound}, cResponse.Components)
+	})
+
+	t.Run("context not loaded", func(t *testing.T) {
+		cResponse := content(t, fleet.CompareStripAll, fleet.ObjectRef{ContextName: "development", Key: key}, right)
+
+		text := component.NewText("context development is not loaded into the fleet")
+		text.SetStatus(component.TextStatusError)
+		assert.Equal(t, []component.Component{text}, cResponse.Components)
+		_, ok := f.Member("development")
+		assert.False(t, ok)
+	})
+}
+
+func TestComparePath(t *testing.T) {
+	left := fleet.ObjectRef{
+		ContextName: "arn:aws:eks:us-west-2:123:cluster/staging",
+		Key:         store.Key{APIVersion: "v1", Kind: "Namespace", Name: "payments"},
+	}
+	right := fleet.ObjectRef{
+		ContextName: "production",
+		Key:         store.Key{APIVersion: "v1", Kind: "Namespace", Name: "payments"},
+	}
+
+	contentPath := fleet.ComparePath(fleet.CompareStripStatus, left, right)
+	assert.Equal(t, "/fleet/compare/status/arn:aws:eks:us-west-2:123:cluster%2Fstaging/_/core/v1/Namespace/payments/production/_/core/v1/Namespace/payments", contentPath)
+
+	pf := NewCompareDescriber(nil).PathFilters()[0]
+	fields := pf.Fields(strings.TrimPrefix(contentPath, "/fleet"))
+
+	actual, err := fleet.ObjectRefFromFields(fields, "left")
+	require.NoError(t, err)
+	assert.Equal(t, left, actual)
+}
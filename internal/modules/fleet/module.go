@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/generator"
+	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/config"
+	"github.com/vmware-tanzu/octant/pkg/icon"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// Options are options for configuring Module.
+type Options struct {
+	DashConfig config.Dash
+	Fleet      *fleet.Fleet
+}
+
+// Module is a fleet module. It shows several clusters at once.
+type Module struct {
+	Options
+	pathMatcher *describer.PathMatcher
+}
+
+var _ module.Module = (*Module)(nil)
+
+// New creates an instance of Module.
+func New(ctx context.Context, options Options) *Module {
+	pm := describer.NewPathMatcher("fleet")
+
+	describers := []describer.Describer{
+		NewOverviewDescriber(options.Fleet),
+		NewWorkloadsDescriber(options.Fleet),
+		NewCompareDescriber(options.Fleet),
+	}
+	for _, d := range describers {
+		for _, pf := range d.PathFilters() {
+			pm.Register(ctx, pf)
+		}
+	}
+
+	return &Module{
+		Options:     options,
+		pathMatcher: pm,
+	}
+}
+
+// Name is the name of the module.
+func (m Module) Name() string {
+	return "fleet"
+}
+
+// Description is the description of the module.
+func (m Module) Description() string {
+	return "Fleet"
+}
+
+// ClientRequestHandlers are client handlers for the module.
+func (m Module) ClientRequestHandlers() []octant.ClientRequestHandler {
+	return nil
+}
+
+// Content generates content for a content path.
+func (m *Module) Content(ctx context.Context, contentPath string, opts module.ContentOptions) (component.ContentResponse, error) {
+	g, err := generator.NewGenerator(m.pathMatcher, m.DashConfig)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	return g.Generate(ctx, contentPath, generator.Options{})
+}
+
+// ContentPath returns the root content path for the module.
+func (m *Module) ContentPath() string {
+	return m.Name()
+}
+
+// Navigation generates navigation entries for the module.
+func (m *Module) Navigation(ctx context.Context, namespace, root string) ([]navigation.Navigation, error) {
+	rootNav := navigation.Navigation{
+		Title:    "Fleet Overview",
+		Path:     m.ContentPath(),
+		IconName: icon.Fleet,
+	}
+
+	for _, kind := range workloadKinds {
+		rootNav.Children = append(rootNav.Children, navigation.Navigation{
+			Title: kind.Title,
+			Path:  path.Join(m.ContentPath(), "workloads", kind.Path),
+		})
+	}
+
+	return []navigation.Navigation{rootNav}, nil
+}
+
+// ActionPaths returns the actions for loading and unloading contexts.
+func (m *Module) ActionPaths() map[string]action.DispatcherFunc {
+	return m.dispatchers().ToActionPaths()
+}
+
+// Commands returns the command palette entries for the module's actions.
+func (m *Module) Commands() []action.Command {
+	return m.dispatchers().ToCommands()
+}
+
+func (m *Module) dispatchers() action.Dispatchers {
+	return action.Dispatchers{
+		NewContextLoader(m.DashConfig.Logger(), m.Fleet),
+		NewContextUnloader(m.Fleet),
+	}
+}
+
+// SetNamespace does nothing.
+func (m Module) SetNamespace(namespace string) error {
+	return nil
+}
+
+// Start does nothing.
+func (m Module) Start() error {
+	return nil
+}
+
+// Stop unloads every context in the fleet.
+func (m Module) Stop() {
+	m.Fleet.Stop()
+}
+
+// SetContext does nothing. Fleet contexts are loaded independently of the current context.
+func (m Module) SetContext(ctx context.Context, contextName string) error {
+	return nil
+}
+
+// Generators does nothing.
+func (m Module) Generators() []octant.Generator {
+	return nil
+}
+
+// SupportedGroupVersionKind does nothing.
+func (m Module) SupportedGroupVersionKind() []schema.GroupVersionKind {
+	return nil
+}
+
+// GroupVersionKindPath does nothing.
+func (m Module) GroupVersionKindPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "", errors.Errorf("not supported")
+}
+
+// AddCRD does nothing.
+func (m Module) AddCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// RemoveCRD does nothing.
+func (m Module) RemoveCRD(ctx context.Context, crd *unstructured.Unstructured) error {
+	return nil
+}
+
+// ResetCRDs does nothing.
+func (m Module) ResetCRDs(ctx context.Context) error {
+	return nil
+}
+
+// GvkFromPath does nothing.
+func (m Module) GvkFromPath(contentPath, namespace string) (schema.GroupVersionKind, error) {
+	return schema.GroupVersionKind{}, errors.Errorf("not supported")
+}
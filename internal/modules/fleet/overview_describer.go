@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// OverviewDescriber describes the health of every cluster in the fleet.
+type OverviewDescriber struct {
+	fleet *fleet.Fleet
+}
+
+var _ describer.Describer = (*OverviewDescriber)(nil)
+
+// NewOverviewDescriber creates an instance of OverviewDescriber.
+func NewOverviewDescriber(f *fleet.Fleet) *OverviewDescriber {
+	return &OverviewDescriber{fleet: f}
+}
+
+// Describe describes the loaded clusters and the contexts which can be loaded.
+func (d *OverviewDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	clusters := component.NewTable("Clusters", "No contexts are loaded. Load one from the contexts below.",
+		component.NewTableCols("Context", "Nodes", "Deployments", "Pods", "Status"))
+
+	members := d.fleet.Members()
+	loaded := make(map[string]bool)
+	for _, h := range clusterHealth(ctx, members) {
+		loaded[h.ContextName] = true
+
+		row := component.TableRow{
+			"Context":     component.NewText(h.ContextName),
+			"Nodes":       component.NewTextf("%d/%d ready", h.NodesReady, h.Nodes),
+			"Deployments": component.NewTextf("%d/%d available", h.DeploymentsReady, h.Deployments),
+			"Pods":        component.NewTextf("%d running, %d pending, %d failed", h.PodsRunning, h.PodsPending, h.PodsFailed),
+			"Status":      healthStatus(h),
+		}
+		row.AddAction(component.GridAction{
+			Name:       "Unload",
+			ActionPath: octant.ActionUnloadFleetContext,
+			Payload:    action.Payload{"contextName": h.ContextName},
+			Type:       component.GridActionPrimary,
+		})
+		clusters.Add(row)
+	}
+
+	available := component.NewTable("Available Contexts", "Every context is loaded.",
+		component.NewTableCols("Context"))
+	for _, kubeContext := range options.Dash.Contexts() {
+		if loaded[kubeContext.Name] {
+			continue
+		}
+
+		row := component.TableRow{
+			"Context": component.NewText(kubeContext.Name),
+		}
+		row.AddAction(component.GridAction{
+			Name:       "Load",
+			ActionPath: octant.ActionLoadFleetContext,
+			Payload:    action.Payload{"contextName": kubeContext.Name},
+			Type:       component.GridActionPrimary,
+		})
+		available.Add(row)
+	}
+
+	return component.ContentResponse{
+		Title:      component.TitleFromString("Fleet Overview"),
+		Components: []component.Component{clusters, available},
+	}, nil
+}
+
+// PathFilters returns PathFilters for this describer. It is the root of the module.
+func (d *OverviewDescriber) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter("/", d),
+	}
+}
+
+// Reset does nothing.
+func (d *OverviewDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func healthStatus(h Health) *component.Text {
+	switch {
+	case h.Err != nil:
+		text := component.NewText(fmt.Sprintf("Error: %s", h.Err))
+		text.SetStatus(component.TextStatusError)
+		return text
+	case h.Loading:
+		return component.NewText("Loading")
+	case h.Healthy():
+		text := component.NewText("Healthy")
+		text.SetStatus(component.TextStatusOK)
+		return text
+	default:
+		text := component.NewText("Degraded")
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Health is the node and workload health of a cluster.
+type Health struct {
+	ContextName      string
+	Loading          bool
+	Nodes            int
+	NodesReady       int
+	Deployments      int
+	DeploymentsReady int
+	Pods             int
+	PodsRunning      int
+	PodsPending      int
+	PodsFailed       int
+	Err              error
+}
+
+// Healthy returns true if every node, deployment and pod is healthy.
+func (h Health) Healthy() bool {
+	return h.Err == nil &&
+		h.NodesReady == h.Nodes &&
+		h.DeploymentsReady == h.Deployments &&
+		h.PodsFailed == 0
+}
+
+// Workload is a workload found in a cluster.
+type Workload struct {
+	ContextName string
+	Namespace   string
+	Name        string
+	Desired     int64
+	Ready       int64
+	Images      []string
+}
+
+// objectRef returns the reference of the workload, which is of kind.
+func (w Workload) objectRef(kind workloadKind) fleet.ObjectRef {
+	key := kind.Key
+	key.Namespace = w.Namespace
+	key.Name = w.Name
+	return fleet.ObjectRef{ContextName: w.ContextName, Key: key}
+}
+
+// workloadKind describes how to summarize a kind of workload.
+type workloadKind struct {
+	Path  string
+	Title string
+	Key   store.Key
+	// Replicas returns the desired and ready replicas of a workload.
+	Replicas func(u *unstructured.Unstructured) (int64, int64)
+}
+
+var workloadKinds = []workloadKind{
+	{
+		Path:     "deployments",
+		Title:    "Deployments",
+		Key:      store.Key{APIVersion: "apps/v1", Kind: "Deployment"},
+		Replicas: specReplicas,
+	},
+	{
+		Path:     "statefulsets",
+		Title:    "Stateful Sets",
+		Key:      store.Key{APIVersion: "apps/v1", Kind: "StatefulSet"},
+		Replicas: specReplicas,
+	},
+	{
+		Path:  "daemonsets",
+		Title: "Daemon Sets",
+		Key:   store.Key{APIVersion: "apps/v1", Kind: "DaemonSet"},
+		Replicas: func(u *unstructured.Unstructured) (int64, int64) {
+			desired, _, _ := unstructured.NestedInt64(u.Object, "status", "desiredNumberScheduled")
+			ready, _, _ := unstructured.NestedInt64(u.Object, "status", "numberReady")
+			return desired, ready
+		},
+	},
+}
+
+func findWorkloadKind(path string) (workloadKind, bool) {
+	for _, kind := range workloadKinds {
+		if kind.Path == path {
+			return kind, true
+		}
+	}
+	return workloadKind{}, false
+}
+
+func specReplicas(u *unstructured.Unstructured) (int64, int64) {
+	desired, found, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !found {
+		desired = 1
+	}
+	ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+	return desired, ready
+}
+
+// clusterHealth summarizes the health of the members' clusters.
+func clusterHealth(ctx context.Context, members []*fleet.Member) []Health {
+	healths := make([]Health, len(members))
+
+	var wg sync.WaitGroup
+	for i := range members {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			healths[i] = memberHealth(ctx, members[i])
+		}(i)
+	}
+	wg.Wait()
+
+	return healths
+}
+
+func memberHealth(ctx context.Context, member *fleet.Member) Health {
+	h := Health{ContextName: member.ContextName}
+
+	ctx, err := member.UserContext(ctx)
+	if err != nil {
+		h.Err = err
+		return h
+	}
+
+	list := func(key store.Key) []unstructured.Unstructured {
+		if h.Err != nil {
+			return nil
+		}
+		objects, loading, err := member.ObjectStore.List(ctx, key)
+		if err != nil {
+			h.Err = err
+			return nil
+		}
+		h.Loading = h.Loading || loading
+		return objects.Items
+	}
+
+	for _, node := range list(store.Key{APIVersion: "v1", Kind: "Node"}) {
+		h.Nodes++
+		if nodeReady(&node) {
+			h.NodesReady++
+		}
+	}
+
+	for _, deployment := range list(store.Key{APIVersion: "apps/v1", Kind: "Deployment"}) {
+		h.Deployments++
+		desired, _ := specReplicas(&deployment)
+		available, _, _ := unstructured.NestedInt64(deployment.Object, "status", "availableReplicas")
+		if available >= desired {
+			h.DeploymentsReady++
+		}
+	}
+
+	for _, pod := range list(store.Key{APIVersion: "v1", Kind: "Pod"}) {
+		h.Pods++
+		phase, _, _ := unstructured.NestedString(pod.Object, "status", "phase")
+		switch phase {
+		case "Running", "Succeeded":
+			h.PodsRunning++
+		case "Failed":
+			h.PodsFailed++
+		default:
+			h.PodsPending++
+		}
+	}
+
+	return h
+}
+
+func nodeReady(node *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(node.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == "Ready" {
+			return condition["status"] == "True"
+		}
+	}
+	return false
+}
+
+// findWorkloads finds workloads of a kind in every member's cluster. If name is set,
+// only workloads with that name are returned. Workloads are sorted by name, then context
+// and namespace.
+func findWorkloads(ctx context.Context, members []*fleet.Member, kind workloadKind, name string) ([]Workload, map[string]error) {
+	var mu sync.Mutex
+	var workloads []Workload
+	errs := make(map[string]error)
+
+	var wg sync.WaitGroup
+	for i := range members {
+		wg.Add(1)
+		go func(member *fleet.Member) {
+			defer wg.Done()
+
+			var objects *unstructured.UnstructuredList
+			memberCtx, err := member.UserContext(ctx)
+			if err == nil {
+				objects, _, err = member.ObjectStore.List(memberCtx, kind.Key)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs[member.ContextName] = err
+				return
+			}
+
+			for i := range objects.Items {
+				object := &objects.Items[i]
+				if name != "" && object.GetName() != name {
+					continue
+				}
+
+				desired, ready := kind.Replicas(object)
+				workloads = append(workloads, Workload{
+					ContextName: member.ContextName,
+					Namespace:   object.GetNamespace(),
+					Name:        object.GetName(),
+					Desired:     desired,
+					Ready:       ready,
+					Images:      workloadImages(object),
+				})
+			}
+		}(members[i])
+	}
+	wg.Wait()
+
+	sort.Slice(workloads, func(i, j int) bool {
+		a, b := workloads[i], workloads[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.ContextName != b.ContextName {
+			return a.ContextName < b.ContextName
+		}
+		return a.Namespace < b.Namespace
+	})
+
+	return workloads, errs
+}
+
+func workloadImages(u *unstructured.Unstructured) []string {
+	containers, _, _ := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+
+	var images []string
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if image, ok := container["image"].(string); ok {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+// mostCommon returns the value that occurs most often. Ties are broken by the value
+// which sorts first so the result is stable.
+func mostCommon(values []string) string {
+	counts := make(map[string]int)
+	for _, v := range values {
+		counts[v]++
+	}
+
+	var common string
+	for v, count := range counts {
+		if count > counts[common] || (count == counts[common] && v < common) {
+			common = v
+		}
+	}
+	return common
+}
+
+func imagesString(images []string) string {
+	return strings.Join(images, ", ")
+}
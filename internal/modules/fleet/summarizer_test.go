@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func Test_clusterHealth(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	readyNode := testutil.CreateNode("ready")
+	readyNode.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+	notReadyNode := testutil.CreateNode("not-ready")
+
+	available := createDeployment("available", 2, 2, "nginx:1.19")
+	unavailable := createDeployment("unavailable", 3, 1, "nginx:1.19")
+
+	running := testutil.CreatePod("running")
+	running.Status.Phase = corev1.PodRunning
+	failed := testutil.CreatePod("failed")
+	failed.Status.Phase = corev1.PodFailed
+	pending := testutil.CreatePod("pending")
+	pending.Status.Phase = corev1.PodPending
+
+	f := newTestFleet(t, controller, map[string]map[string][]runtime.Object{
+		"prod": {
+			"Node":       {readyNode, notReadyNode},
+			"Deployment": {available, unavailable},
+			"Pod":        {running, failed, pending},
+		},
+		"staging": {
+			"Node":       {readyNode},
+			"Deployment": {available},
+			"Pod":        {running},
+		},
+	})
+
+	healths := clusterHealth(context.Background(), f.Members())
+	require.Len(t, healths, 2)
+
+	assert.Equal(t, Health{
+		ContextName:      "prod",
+		Nodes:            2,
+		NodesReady:       1,
+		Deployments:      2,
+		DeploymentsReady: 1,
+		Pods:             3,
+		PodsRunning:      1,
+		PodsPending:      1,
+		PodsFailed:       1,
+	}, healths[0])
+	assert.False(t, healths[0].Healthy())
+
+	assert.Equal(t, "staging", healths[1].ContextName)
+	assert.True(t, healths[1].Healthy())
+}
+
+func Test_findWorkloads(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	f := newTestFleet(t, controller, map[string]map[string][]runtime.Object{
+		"prod": {
+			"Deployment": {createDeployment("payments", 3, 3, "payments:1.1"), createDeployment("web", 2, 2, "web:1")},
+		},
+		"staging": {
+			"Deployment": {createDeployment("payments", 1, 0, "payments:1.2")},
+		},
+	})
+
+	kind, ok := findWorkloadKind("deployments")
+	require.True(t, ok)
+
+	workloads, errs := findWorkloads(context.Background(), f.Members(), kind, "payments")
+	require.Empty(t, errs)
+
+	assert.Equal(t, []Workload{
+		{ContextName: "prod", Namespace: "namespace", Name: "payments", Desired: 3, Ready: 3, Images: []string{"payments:1.1"}},
+		{ContextName: "staging", Namespace: "namespace", Name: "payments", Desired: 1, Ready: 0, Images: []string{"payments:1.2"}},
+	}, workloads)
+
+	all, errs := findWorkloads(context.Background(), f.Members(), kind, "")
+	require.Empty(t, errs)
+	assert.Len(t, all, 3)
+}
+
+func Test_mostCommon(t *testing.T) {
+	assert.Equal(t, "a", mostCommon([]string{"b", "a", "a"}))
+	assert.Equal(t, "a", mostCommon([]string{"b", "a"}))
+	assert.Equal(t, "", mostCommon(nil))
+}
+
+func createDeployment(name string, desired, ready int32, image string) *appsv1.Deployment {
+	deployment := testutil.CreateDeployment(name)
+	deployment.Spec.Replicas = pointer.Int32Ptr(desired)
+	deployment.Spec.Template.Spec.Containers = []corev1.Container{{Name: name, Image: image}}
+	deployment.Status.ReadyReplicas = ready
+	deployment.Status.AvailableReplicas = ready
+	return deployment
+}
+
+// newTestFleet creates a fleet with a member for each context. Each member's object store
+// lists the objects of each kind.
+func newTestFleet(t *testing.T, controller *gomock.Controller, objects map[string]map[string][]runtime.Object) *fleet.Fleet {
+	stores := make(map[cluster.ClientInterface]store.Store)
+	clients := make(map[string]cluster.ClientInterface)
+
+	for contextName, byKind := range objects {
+		client := clusterFake.NewMockClientInterface(controller)
+		client.EXPECT().Close().AnyTimes()
+
+		objectStore := storeFake.NewMockStore(controller)
+		for _, kind := range []string{"Node", "Deployment", "StatefulSet", "DaemonSet", "Pod"} {
+			list := testutil.ToUnstructuredList(t, byKind[kind]...)
+			objectStore.EXPECT().
+				List(gomock.Any(), kindMatcher(kind)).
+				Return(list, false, nil).
+				AnyTimes()
+		}
+
+		clients[contextName] = client
+		stores[client] = objectStore
+	}
+
+	f := fleet.New(context.Background(),
+		func(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+			return clients[contextName], nil
+		},
+		func(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+			return stores[client], nil
+		})
+
+	for contextName := range objects {
+		require.NoError(t, f.Load(contextName))
+	}
+
+	return f
+}
+
+// kindMatcher matches store keys for a kind.
+type kindMatcher string
+
+var _ gomock.Matcher = kindMatcher("")
+
+func (m kindMatcher) Matches(x interface{}) bool {
+	key, ok := x.(store.Key)
+	return ok && key.Kind == string(m)
+}
+
+func (m kindMatcher) String() string {
+	return "has kind " + string(m)
+}
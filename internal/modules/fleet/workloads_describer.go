@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// WorkloadsDescriber describes workloads across every cluster in the fleet. Workloads with
+// the same name are compared so differences in images and replicas stand out.
+type WorkloadsDescriber struct {
+	fleet *fleet.Fleet
+}
+
+var _ describer.Describer = (*WorkloadsDescriber)(nil)
+
+// NewWorkloadsDescriber creates an instance of WorkloadsDescriber.
+func NewWorkloadsDescriber(f *fleet.Fleet) *WorkloadsDescriber {
+	return &WorkloadsDescriber{fleet: f}
+}
+
+// Describe lists workloads of a kind by name, or compares the workloads with one name.
+func (d *WorkloadsDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	kind, ok := findWorkloadKind(options.Fields["kind"])
+	if !ok {
+		return component.EmptyContentResponse, fmt.Errorf("unknown workload kind %q", options.Fields["kind"])
+	}
+	name := options.Fields["name"]
+
+	workloads, errs := findWorkloads(ctx, d.fleet.Members(), kind, name)
+
+	var components []component.Component
+	if name == "" {
+		components = append(components, workloadsByName(kind, workloads))
+	} else {
+		components = append(components, compareWorkloads(kind, name, workloads))
+	}
+
+	if len(errs) > 0 {
+		components = append(components, listErrors(errs))
+	}
+
+	title := component.TitleFromString(kind.Title)
+	if name != "" {
+		title = append(component.TitleFromString(kind.Title), component.NewText(name))
+	}
+
+	return component.ContentResponse{
+		Title:      title,
+		Components: components,
+	}, nil
+}
+
+// PathFilters returns PathFilters for this describer.
+func (d *WorkloadsDescriber) PathFilters() []describer.PathFilter {
+	kinds := make([]string, len(workloadKinds))
+	for i := range workloadKinds {
+		kinds[i] = workloadKinds[i].Path
+	}
+	kindPattern := fmt.Sprintf("(?P<kind>%s)", strings.Join(kinds, "|"))
+
+	return []describer.PathFilter{
+		*describer.NewPathFilter(fmt.Sprintf("/workloads/%s", kindPattern), d),
+		*describer.NewPathFilter(fmt.Sprintf("/workloads/%s/(?P<name>[^/]+)", kindPattern), d),
+	}
+}
+
+// Reset does nothing.
+func (d *WorkloadsDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+// workloadsByName summarizes workloads grouped by name.
+func workloadsByName(kind workloadKind, workloads []Workload) *component.Table {
+	table := component.NewTable(kind.Title, fmt.Sprintf("There are no %s in the loaded clusters!", strings.ToLower(kind.Title)),
+		component.NewTableCols("Name", "Clusters", "Images", "Replicas"))
+
+	byName := make(map[string][]Workload)
+	var names []string
+	for _, w := range workloads {
+		if _, ok := byName[w.Name]; !ok {
+			names = append(names, w.Name)
+		}
+		byName[w.Name] = append(byName[w.Name], w)
+	}
+
+	for _, name := range names {
+		group := byName[name]
+
+		clusters := make(map[string]bool)
+		var images, replicas []string
+		for _, w := range group {
+			clusters[w.ContextName] = true
+			images = append(images, imagesString(w.Images))
+			replicas = append(replicas, fmt.Sprintf("%d", w.Desired))
+		}
+
+		table.Add(component.TableRow{
+			"Name":     component.NewLink("", name, path.Join("/fleet", "workloads", kind.Path, name)),
+			"Clusters": component.NewTextf("%d", len(clusters)),
+			"Images":   consistency(images),
+			"Replicas": consistency(replicas),
+		})
+	}
+
+	return table
+}
+
+// compareWorkloads compares the workloads named name. Images and replica counts which
+// differ from the most common value are flagged, and workloads link to a diff against
+// the first workload.
+func compareWorkloads(kind workloadKind, name string, workloads []Workload) *component.Table {
+	table := component.NewTable(fmt.Sprintf("%s named %s", kind.Title, name), "No clusters have a workload with this name.",
+		component.NewTableCols("Context", "Namespace", "Ready", "Images", "Compare"))
+
+	var images, desired []string
+	for _, w := range workloads {
+		images = append(images, imagesString(w.Images))
+		desired = append(desired, fmt.Sprintf("%d", w.Desired))
+	}
+	commonImages := mostCommon(images)
+	commonDesired := mostCommon(desired)
+
+	for i, w := range workloads {
+		ready := component.NewTextf("%d/%d", w.Ready, w.Desired)
+		if desired[i] != commonDesired || w.Ready < w.Desired {
+			ready.SetStatus(component.TextStatusWarning)
+		}
+
+		imageText := component.NewText(images[i])
+		if images[i] != commonImages {
+			imageText.SetStatus(component.TextStatusWarning)
+		}
+
+		var compare component.Component = component.NewText("")
+		if i > 0 {
+			compare = component.NewLink("", fmt.Sprintf("Compare with %s", workloads[0].ContextName),
+				fleet.ComparePath(fleet.CompareStripAll, workloads[0].objectRef(kind), w.objectRef(kind)))
+		}
+
+		table.Add(component.TableRow{
+			"Context":   component.NewText(w.ContextName),
+			"Namespace": component.NewText(w.Namespace),
+			"Ready":     ready,
+			"Images":    imageText,
+			"Compare":   compare,
+		})
+	}
+
+	return table
+}
+
+// consistency describes whether values are the same.
+func consistency(values []string) *component.Text {
+	distinct := make(map[string]bool)
+	for _, v := range values {
+		distinct[v] = true
+	}
+
+	if len(distinct) <= 1 {
+		text := component.NewText("Consistent")
+		text.SetStatus(component.TextStatusOK)
+		return text
+	}
+
+	text := component.NewTextf("%d variants", len(distinct))
+	text.SetStatus(component.TextStatusWarning)
+	return text
+}
+
+func listErrors(errs map[string]error) *component.Table {
+	table := component.NewTable("Errors", "", component.NewTableCols("Context", "Error"))
+
+	var names []string
+	for name := range errs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		text := component.NewText(errs[name].Error())
+		text.SetStatus(component.TextStatusError)
+		table.Add(component.TableRow{
+			"Context": component.NewText(name),
+			"Error":   text,
+		})
+	}
+
+	return table
+}
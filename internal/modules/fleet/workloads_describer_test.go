@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fleet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestWorkloadsDescriber(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	f := newTestFleet(t, controller, map[string]map[string][]runtime.Object{
+		"prod-east": {
+			"Deployment": {createDeployment("payments", 3, 3, "payments:1.1")},
+		},
+		"prod-west": {
+			"Deployment": {createDeployment("payments", 3, 3, "payments:1.1")},
+		},
+		"staging": {
+			"Deployment": {createDeployment("payments", 1, 0, "payments:1.2")},
+		},
+	})
+
+	d := NewWorkloadsDescriber(f)
+
+	t.Run("by name", func(t *testing.T) {
+		options := describer.Options{Fields: map[string]string{"kind": "deployments"}}
+		cResponse, err := d.Describe(context.Background(), "", options)
+		require.NoError(t, err)
+
+		expected := component.NewTable("Deployments", "There are no deployments in the loaded clusters!",
+			component.NewTableCols("Name", "Clusters", "Images", "Replicas"))
+		images := component.NewText("2 variants")
+		images.SetStatus(component.TextStatusWarning)
+		replicas := component.NewText("2 variants")
+		replicas.SetStatus(component.TextStatusWarning)
+		expected.Add(component.TableRow{
+			"Name":     component.NewLink("", "payments", "/fleet/workloads/deployments/payments"),
+			"Clusters": component.NewText("3"),
+			"Images":   images,
+			"Replicas": replicas,
+		})
+
+		require.Len(t, cResponse.Components, 1)
+		component.AssertEqual(t, expected, cResponse.Components[0])
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		options := describer.Options{Fields: map[string]string{"kind": "deployments", "name": "payments"}}
+		cResponse, err := d.Describe(context.Background(), "", options)
+		require.NoError(t, err)
+
+		expected := component.NewTable("Deployments named payments", "No clusters have a workload with this name.",
+			component.NewTableCols("Context", "Namespace", "Ready", "Images", "Compare"))
+		expected.Add(component.TableRow{
+			"Context":   component.NewText("prod-east"),
+			"Namespace": component.NewText("namespace"),
+			"Ready":     component.NewText("3/3"),
+			"Images":    component.NewText("payments:1.1"),
+			"Compare":   component.NewText(""),
+		})
+		expected.Add(component.TableRow{
+			"Context":   component.NewText("prod-west"),
+			"Namespace": component.NewText("namespace"),
+			"Ready":     component.NewText("3/3"),
+			"Images":    component.NewText("payments:1.1"),
+			"Compare": component.NewLink("", "Compare with prod-east",
+				"/fleet/compare/all/prod-east/namespace/apps/v1/Deployment/payments/prod-west/namespace/apps/v1/Deployment/payments"),
+		})
+		ready := component.NewText("0/1")
+		ready.SetStatus(component.TextStatusWarning)
+		images := component.NewText("payments:1.2")
+		images.SetStatus(component.TextStatusWarning)
+		expected.Add(component.TableRow{
+			"Context":   component.NewText("staging"),
+			"Namespace": component.NewText("namespace"),
+			"Ready":     ready,
+			"Images":    images,
+			"Compare": component.NewLink("", "Compare with prod-east",
+				"/fleet/compare/all/prod-east/namespace/apps/v1/Deployment/payments/staging/namespace/apps/v1/Deployment/payments"),
+		})
+
+		require.Len(t, cResponse.Components, 1)
+		component.AssertEqual(t, expected, cResponse.Components[0])
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		options := describer.Options{Fields: map[string]string{"kind": "jobs"}}
+		_, err := d.Describe(context.Background(), "", options)
+		require.Error(t, err)
+	})
+}
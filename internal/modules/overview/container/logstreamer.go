@@ -16,6 +16,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
@@ -158,7 +159,7 @@ func (s *logStreamer) Close(logCh chan<- LogEntry) {
 }
 
 func (s *logStreamer) containerStream(container string) (io.ReadCloser, error) {
-	client, err := s.config.ClusterClient().KubernetesClient()
+	client, err := cluster.ClientOrDefault(s.ctx, s.config.ClusterClient()).KubernetesClient()
 	if err != nil {
 		return nil, err
 	}
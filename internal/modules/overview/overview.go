@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/apidiscovery"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	"github.com/vmware-tanzu/octant/internal/generator"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
@@ -290,7 +291,16 @@ func (co *Overview) Content(ctx context.Context, contentPath string, opts module
 
 // ActionPaths contain the actions this module is responsible for.
 func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
-	dispatchers := action.Dispatchers{
+	return co.dispatchers().ToActionPaths()
+}
+
+// Commands returns the command palette entries for the module's actions.
+func (co *Overview) Commands() []action.Command {
+	return co.dispatchers().ToCommands()
+}
+
+func (co *Overview) dispatchers() action.Dispatchers {
+	return action.Dispatchers{
 		octant.NewDeploymentConfigurationEditor(co.logger, co.dashConfig.ObjectStore()),
 		octant.NewContainerEditor(co.dashConfig.ObjectStore()),
 		octant.NewServiceConfigurationEditor(co.dashConfig.ObjectStore()),
@@ -301,10 +311,11 @@ func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
 		octant.NewCronJobTrigger(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobSuspend(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobResume(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
-		octant.NewObjectUpdaterDispatcher(co.dashConfig.ObjectStore()),
+		octant.NewObjectUpdaterDispatcher(co.dashConfig.ObjectStore(),
+			octant.WithObjectValidator(func(ctx context.Context, source []byte) ([]apidiscovery.ValidationError, error) {
+				return co.dashConfig.SchemaLoader().Validate(ctx, source)
+			})),
 		octant.NewApplyYaml(co.logger, co.dashConfig.ObjectStore()),
 		octant.NewManifest(co.logger),
 	}
-
-	return dispatchers.ToActionPaths()
 }
@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/config"
 	"github.com/vmware-tanzu/octant/pkg/log"
 )
@@ -37,7 +38,7 @@ func (e *EphemeralContainerGenerator) UpdateObject(ctx context.Context, object r
 		return errors.New("object is nil")
 	}
 
-	client, err := e.dashConfig.ClusterClient().KubernetesClient()
+	client, err := cluster.ClientOrDefault(ctx, e.dashConfig.ClusterClient()).KubernetesClient()
 	if err != nil {
 		return err
 	}
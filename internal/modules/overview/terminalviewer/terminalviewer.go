@@ -27,7 +27,8 @@ func ToComponent(ctx context.Context, object runtime.Object, logger log.Logger,
 		return nil, fmt.Errorf("ephemeral container: %w", err)
 	}
 
-	if ecg.FeatureEnabled() {
+	// Adding a debug container changes the pod, so it is skipped in read-only mode.
+	if ecg.FeatureEnabled() && !dashConfig.ReadOnly() {
 		if err := ecg.UpdateObject(ctx, object); err != nil {
 			return nil, err
 		}
@@ -154,7 +154,17 @@ _%s_
 			View:  rv,
 		},
 	}
-	layout.AddSections(headerSection, viewerSection)
+	layout.AddSections(headerSection)
+
+	if history := options.MetricsHistory(); history != nil && history.Available() {
+		var names []string
+		for _, obj := range objects {
+			names = append(names, obj.GetName())
+		}
+		layout.AddSections(describer.MetricsChartsSection(history.Pods(namespace, names)))
+	}
+
+	layout.AddSections(viewerSection)
 
 	cr := d.createResponse(
 		layout,
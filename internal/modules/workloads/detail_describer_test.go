@@ -3,6 +3,7 @@ package workloads
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -11,6 +12,7 @@ import (
 	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/metricshistory"
 	"github.com/vmware-tanzu/octant/internal/queryer"
 	queryerFake "github.com/vmware-tanzu/octant/internal/queryer/fake"
 	"github.com/vmware-tanzu/octant/internal/testutil"
@@ -29,7 +31,8 @@ func TestDetailDescriber_Describe(t *testing.T) {
 	dd, err := NewDetailDescriber()
 	require.NoError(t, err)
 
-	tdo := newTestDescriberOptions(t, controller)
+	history := metricshistory.NewHistory(metricshistory.DefaultInterval, metricshistory.DefaultRetention)
+	tdo := newTestDescriberOptions(t, controller, history)
 	describerOptions := tdo.ToOptions()
 
 	result, err := dd.Describe(ctx, "namespace", describerOptions)
@@ -43,12 +46,38 @@ func TestDetailDescriber_Describe(t *testing.T) {
 	}
 }
 
+func TestDetailDescriber_Describe_metrics(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	ctx := context.Background()
+
+	dd, err := NewDetailDescriber()
+	require.NoError(t, err)
+
+	now := time.Unix(1633089600, 0)
+	history := metricshistory.NewHistory(metricshistory.DefaultInterval, metricshistory.DefaultRetention)
+	history.Record(now, []metricshistory.PodUsage{{Namespace: "namespace", Name: "pod", CPU: 250, Memory: 1 << 20}})
+
+	tdo := newTestDescriberOptions(t, controller, history)
+	result, err := dd.Describe(ctx, "namespace", tdo.ToOptions())
+	require.NoError(t, err)
+
+	require.Len(t, result.Components, 1)
+	f, ok := result.Components[0].(*component.FlexLayout)
+	require.True(t, ok)
+	require.Len(t, f.Config.Sections, 3)
+
+	memory, ok := f.Config.Sections[1][1].View.(*component.TimeSeriesChart)
+	require.True(t, ok)
+	require.Equal(t, []component.TimeSeriesPoint{{Timestamp: now.Unix(), Value: 1}}, memory.Config.Series[0].Points)
+}
+
 type testDescriberOptions struct {
 	dashConfig *configFake.MockDash
 	queryer    queryer.Queryer
 }
 
-func newTestDescriberOptions(t *testing.T, controller *gomock.Controller) *testDescriberOptions {
+func newTestDescriberOptions(t *testing.T, controller *gomock.Controller, history *metricshistory.History) *testDescriberOptions {
 	dashConfig := configFake.NewMockDash(controller)
 
 	clusterClient := clusterFake.NewMockClientInterface(controller)
@@ -71,6 +100,7 @@ func newTestDescriberOptions(t *testing.T, controller *gomock.Controller) *testD
 	dashConfig.EXPECT().ClusterClient().Return(clusterClient).AnyTimes()
 	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
 	dashConfig.EXPECT().PluginManager().Return(pluginManager).AnyTimes()
+	dashConfig.EXPECT().MetricsHistory().Return(history).AnyTimes()
 
 	queryer := queryerFake.NewMockQueryer(controller)
 	queryer.EXPECT().PersistentVolumeClaimsForPod(gomock.Any(), pod)
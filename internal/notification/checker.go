@@ -0,0 +1,416 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/objectstatus"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// DefaultInterval is the interval between checks of the watched objects.
+const DefaultInterval = 10 * time.Second
+
+// Cluster is the cluster of the current context.
+type Cluster interface {
+	CurrentContext() string
+	ClusterClient() cluster.ClientInterface
+	ObjectStore() store.Store
+	// ObjectPath returns the content path of an object.
+	ObjectPath(namespace, apiVersion, kind, name string) (string, error)
+}
+
+// CheckerOption is an option for configuring Checker.
+type CheckerOption func(c *Checker)
+
+// WithCheckerInterval sets the interval between checks.
+func WithCheckerInterval(interval time.Duration) CheckerOption {
+	return func(c *Checker) {
+		c.interval = interval
+	}
+}
+
+// objectState is the state of a watched object when it was last checked.
+type objectState struct {
+	status     component.NodeStatus
+	rollingOut bool
+	// jobResult is the condition type of a finished job, or empty if the job hasn't
+	// finished.
+	jobResult batchv1.JobConditionType
+}
+
+// Checker checks the watched objects and delivers a notification when the status of
+// an object turns to warning or error, when a rollout completes or when a job finishes.
+// Objects are not notified about when they are first seen. Objects are checked as the
+// user who watches them. Watched contexts other than the current one are loaded into
+// the checker's fleet while they have watches.
+type Checker struct {
+	manager  *Manager
+	cluster  Cluster
+	contexts *fleet.Fleet
+	pool     *auth.ClientPool
+	logger   log.Logger
+	interval time.Duration
+	now      func() time.Time
+
+	// states are the object states by watch ID.
+	states map[string]map[types.UID]objectState
+}
+
+// NewChecker creates an instance of Checker. contexts is the fleet watched contexts
+// other than the current context are loaded into; it is stopped when Run returns.
+func NewChecker(manager *Manager, currentCluster Cluster, contexts *fleet.Fleet, logger log.Logger, options ...CheckerOption) *Checker {
+	c := &Checker{
+		manager:  manager,
+		cluster:  currentCluster,
+		contexts: contexts,
+		pool:     auth.NewClientPool(),
+		logger:   logger,
+		interval: DefaultInterval,
+		now:      time.Now,
+		states:   make(map[string]map[types.UID]objectState),
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// Run checks the watched objects until the context is canceled.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	defer c.contexts.Stop()
+	defer c.pool.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Check(ctx)
+		}
+	}
+}
+
+// Check checks the watched objects of every user once. Watches whose objects can't be
+// listed, e.g. because their context is still loading, keep their previous states.
+func (c *Checker) Check(ctx context.Context) {
+	currentContext := c.cluster.CurrentContext()
+	all := c.manager.userWatches()
+
+	c.loadContexts(currentContext, all)
+
+	active := make(map[string]bool)
+	for _, uw := range all {
+		for _, w := range uw.watches {
+			active[w.ID] = true
+
+			objectStore, storeCtx, err := c.objectStore(ctx, uw.user, w.Context, currentContext)
+			if err != nil {
+				c.logger.WithErr(err).With("watch", w.Target()).Debugf("unable to check watched objects")
+				continue
+			}
+
+			objects, err := list(storeCtx, objectStore, w)
+			if err != nil {
+				c.logger.WithErr(err).With("watch", w.Target()).Debugf("unable to check watched objects")
+				continue
+			}
+
+			for _, n := range c.checkWatch(storeCtx, objectStore, w, objects) {
+				n.User = uw.user.Name
+				if err := c.manager.Deliver(ctx, n); err != nil {
+					c.logger.WithErr(err).Errorf("delivering notification")
+				}
+			}
+		}
+	}
+
+	for id := range c.states {
+		if !active[id] {
+			delete(c.states, id)
+		}
+	}
+}
+
+// loadContexts loads the watched contexts other than the current context into the
+// checker's fleet, and unloads the contexts which are no longer watched.
+func (c *Checker) loadContexts(currentContext string, all []userWatches) {
+	watched := make(map[string]bool)
+	for _, uw := range all {
+		for _, w := range uw.watches {
+			if w.Context != currentContext {
+				watched[w.Context] = true
+			}
+		}
+	}
+
+	for _, member := range c.contexts.Members() {
+		if !watched[member.ContextName] {
+			c.contexts.Unload(member.ContextName)
+		}
+	}
+
+	for contextName := range watched {
+		if err := c.contexts.Load(contextName); err != nil {
+			c.logger.WithErr(err).With("context", contextName).Debugf("unable to load watched context")
+		}
+	}
+}
+
+// objectStore returns the object store of a watched context and a context for requests
+// made as user. user is empty if Octant does not authenticate users.
+func (c *Checker) objectStore(ctx context.Context, user auth.User, contextName, currentContext string) (store.Store, context.Context, error) {
+	authenticated := user.Name != ""
+	if authenticated {
+		ctx = auth.WithUser(ctx, user)
+	}
+
+	if contextName == currentContext {
+		if !authenticated {
+			return c.cluster.ObjectStore(), ctx, nil
+		}
+		client, err := c.pool.ClientFor(ctx, c.cluster.ClusterClient(), user)
+		if err != nil {
+			return nil, nil, err
+		}
+		return c.cluster.ObjectStore(), cluster.WithClient(ctx, client), nil
+	}
+
+	member, ok := c.contexts.Member(contextName)
+	if !ok {
+		return nil, nil, fmt.Errorf("context %s is not loaded", contextName)
+	}
+	memberCtx, err := member.UserContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return member.ObjectStore, memberCtx, nil
+}
+
+// list lists the objects of a watch. It returns an error if the objects are still
+// loading, so they are not mistaken for deleted objects.
+func list(ctx context.Context, objectStore store.Store, w Watch) ([]*unstructured.Unstructured, error) {
+	key := store.Key{
+		Namespace:  w.Namespace,
+		APIVersion: w.APIVersion,
+		Kind:       w.Kind,
+	}
+
+	if w.Name != "" {
+		key.Name = w.Name
+		object, err := objectStore.Get(ctx, key)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+		if object == nil {
+			if objectStore.IsLoading(ctx, key) {
+				return nil, fmt.Errorf("%s is loading", w.Target())
+			}
+			return nil, nil
+		}
+		return []*unstructured.Unstructured{object}, nil
+	}
+
+	selector, err := metav1.ParseToLabelSelector(w.Selector)
+	if err != nil {
+		return nil, err
+	}
+	key.LabelSelector = selector
+
+	list, loading, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if loading {
+		return nil, fmt.Errorf("%s is loading", w.Target())
+	}
+
+	objects := make([]*unstructured.Unstructured, len(list.Items))
+	for i := range list.Items {
+		objects[i] = &list.Items[i]
+	}
+	return objects, nil
+}
+
+// checkWatch updates the states of the objects of a watch, and returns notifications for
+// the objects whose state changed.
+func (c *Checker) checkWatch(ctx context.Context, objectStore store.Store, w Watch, objects []*unstructured.Unstructured) []Notification {
+	previous, seen := c.states[w.ID]
+	current := make(map[types.UID]objectState)
+
+	var list []Notification
+	for _, object := range objects {
+		state, details, err := c.state(ctx, objectStore, object)
+		if err != nil {
+			c.logger.WithErr(err).With("object", kubernetes.PrintObject(object)).Debugf("unable to check watched object")
+			continue
+		}
+		current[object.GetUID()] = state
+
+		before, ok := previous[object.GetUID()]
+		if !seen || !ok {
+			continue
+		}
+
+		if n, ok := c.notification(w, object, before, state, details); ok {
+			list = append(list, n)
+		}
+	}
+
+	c.states[w.ID] = current
+	return list
+}
+
+func (c *Checker) notification(w Watch, object *unstructured.Unstructured, before, after objectState, details string) (Notification, bool) {
+	name := fmt.Sprintf("%s %s", object.GetKind(), object.GetName())
+
+	n := Notification{
+		Time:    c.now(),
+		Context: w.Context,
+		WatchID: w.ID,
+	}
+	if ref, err := c.cluster.ObjectPath(object.GetNamespace(), object.GetAPIVersion(), object.GetKind(), object.GetName()); err == nil {
+		n.Ref = ref
+	}
+
+	switch {
+	case before.jobResult == "" && after.jobResult == batchv1.JobComplete:
+		n.Level = LevelSuccess
+		n.Title = fmt.Sprintf("%s completed", name)
+		n.Message = details
+	case before.jobResult == "" && after.jobResult == batchv1.JobFailed:
+		n.Level = LevelError
+		n.Title = fmt.Sprintf("%s failed", name)
+		n.Message = details
+	case severity(after.status) > severity(before.status):
+		n.Level = LevelWarning
+		if after.status == component.NodeStatusError {
+			n.Level = LevelError
+		}
+		n.Title = fmt.Sprintf("%s status is %s", name, after.status)
+		n.Message = details
+	case before.rollingOut && !after.rollingOut:
+		n.Level = LevelSuccess
+		n.Title = fmt.Sprintf("Rollout of %s completed", name)
+	default:
+		return Notification{}, false
+	}
+
+	return n, true
+}
+
+// state returns the state of an object and the details of its status.
+func (c *Checker) state(ctx context.Context, objectStore store.Store, object *unstructured.Unstructured) (objectState, string, error) {
+	status, err := objectstatus.Status(ctx, object, objectStore, nil)
+	if err != nil {
+		return objectState{}, "", err
+	}
+
+	state := objectState{status: status.Status()}
+
+	switch object.GetKind() {
+	case "Deployment", "StatefulSet", "DaemonSet":
+		rollingOut, err := rolloutInProgress(object)
+		if err != nil {
+			return objectState{}, "", err
+		}
+		state.rollingOut = rollingOut
+	case "Job":
+		job := &batchv1.Job{}
+		if err := kubernetes.FromUnstructured(object, job); err != nil {
+			return objectState{}, "", err
+		}
+		for _, condition := range job.Status.Conditions {
+			if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) &&
+				condition.Status == corev1.ConditionTrue {
+				state.jobResult = condition.Type
+			}
+		}
+	}
+
+	var details []string
+	for _, detail := range status.Details {
+		if text, ok := detail.(*component.Text); ok {
+			details = append(details, text.String())
+		}
+	}
+
+	return state, strings.Join(details, ", "), nil
+}
+
+// rolloutInProgress returns true if a workload has not rolled out its latest template to
+// all of its replicas.
+func rolloutInProgress(object *unstructured.Unstructured) (bool, error) {
+	switch object.GetKind() {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := kubernetes.FromUnstructured(object, deployment); err != nil {
+			return false, err
+		}
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		s := deployment.Status
+		return deployment.Generation > s.ObservedGeneration || s.UpdatedReplicas < replicas ||
+			s.Replicas > s.UpdatedReplicas || s.AvailableReplicas < s.UpdatedReplicas, nil
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := kubernetes.FromUnstructured(object, statefulSet); err != nil {
+			return false, err
+		}
+		replicas := int32(1)
+		if statefulSet.Spec.Replicas != nil {
+			replicas = *statefulSet.Spec.Replicas
+		}
+		s := statefulSet.Status
+		return statefulSet.Generation > s.ObservedGeneration || s.UpdateRevision != s.CurrentRevision ||
+			s.ReadyReplicas < replicas, nil
+	case "DaemonSet":
+		daemonSet := &appsv1.DaemonSet{}
+		if err := kubernetes.FromUnstructured(object, daemonSet); err != nil {
+			return false, err
+		}
+		s := daemonSet.Status
+		return daemonSet.Generation > s.ObservedGeneration || s.UpdatedNumberScheduled < s.DesiredNumberScheduled ||
+			s.NumberAvailable < s.DesiredNumberScheduled, nil
+	default:
+		return false, nil
+	}
+}
+
+func severity(status component.NodeStatus) int {
+	switch status {
+	case component.NodeStatusError:
+		return 2
+	case component.NodeStatusWarning:
+		return 1
+	default:
+		return 0
+	}
+}
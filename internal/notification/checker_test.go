@@ -0,0 +1,258 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/fleet"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+type fakeCluster struct {
+	contextName string
+	client      cluster.ClientInterface
+	objectStore store.Store
+}
+
+var _ Cluster = (*fakeCluster)(nil)
+
+func (c *fakeCluster) CurrentContext() string {
+	return c.contextName
+}
+
+func (c *fakeCluster) ClusterClient() cluster.ClientInterface {
+	return c.client
+}
+
+func (c *fakeCluster) ObjectStore() store.Store {
+	return c.objectStore
+}
+
+func (c *fakeCluster) ObjectPath(namespace, apiVersion, kind, name string) (string, error) {
+	return "/overview/namespace/" + namespace + "/" + kind + "/" + name, nil
+}
+
+type fakeImpersonator struct {
+	*clusterFake.MockClientInterface
+	client cluster.ClientInterface
+}
+
+func (f *fakeImpersonator) Impersonate(_ context.Context, config rest.ImpersonationConfig) (cluster.ClientInterface, error) {
+	return f.client, nil
+}
+
+func TestChecker_Check(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := testutil.Time()
+
+	job := testutil.CreateJob("migrate")
+
+	web := testutil.CreateDeployment("web")
+	web.Spec.Replicas = conversion.PtrInt32(2)
+	web.Status.Replicas = 2
+	web.Status.UpdatedReplicas = 1
+	web.Status.AvailableReplicas = 2
+
+	api := testutil.CreateDeployment("api")
+	api.Labels = map[string]string{"app": "api"}
+	api.Spec.Replicas = conversion.PtrInt32(2)
+	api.Status.Replicas = 2
+	api.Status.UpdatedReplicas = 2
+	api.Status.AvailableReplicas = 2
+
+	backup := testutil.CreateJob("backup")
+
+	objects := map[string]runtime.Object{"Job": job, "Deployment": web}
+
+	janeClient := clusterFake.NewMockClientInterface(controller)
+	janeClient.EXPECT().Close()
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+			if user, ok := auth.UserFrom(ctx); ok {
+				require.Equal(t, "jane", user.Name)
+				client, ok := cluster.ClientFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, janeClient, client, "objects are checked as the user who watches them")
+			}
+			return testutil.ToUnstructured(t, objects[key.Kind]), nil
+		}).AnyTimes()
+	objectStore.EXPECT().List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+			require.Equal(t, map[string]string{"app": "api"}, key.LabelSelector.MatchLabels)
+			return testutil.ToUnstructuredList(t, api), false, nil
+		}).AnyTimes()
+
+	prodClient := clusterFake.NewMockClientInterface(controller)
+	prodStore := objectStoreFake.NewMockStore(controller)
+	prodStore.EXPECT().Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+			require.Equal(t, "backup", key.Name)
+			return testutil.ToUnstructured(t, backup), nil
+		}).AnyTimes()
+
+	contexts := fleet.New(context.Background(),
+		func(ctx context.Context, contextName string) (cluster.ClientInterface, error) {
+			require.Equal(t, "prod", contextName, "only watched contexts other than the current one are loaded")
+			return prodClient, nil
+		},
+		func(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+			return prodStore, nil
+		})
+
+	ctx := context.Background()
+	janeCtx := auth.WithUser(ctx, auth.User{Name: "jane"})
+
+	m := NewManager()
+	for _, w := range []Watch{
+		{Context: "dev", Namespace: "namespace", APIVersion: "batch/v1", Kind: "Job", Name: "migrate"},
+		{Context: "dev", Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+		{Context: "dev", Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Selector: "app=api"},
+		{Context: "prod", Namespace: "namespace", APIVersion: "batch/v1", Kind: "Job", Name: "backup"},
+	} {
+		_, err := m.Add(ctx, w)
+		require.NoError(t, err)
+	}
+	_, err := m.Add(janeCtx, Watch{Context: "dev", Namespace: "namespace", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"})
+	require.NoError(t, err)
+
+	currentCluster := &fakeCluster{
+		contextName: "dev",
+		client:      &fakeImpersonator{MockClientInterface: clusterFake.NewMockClientInterface(controller), client: janeClient},
+		objectStore: objectStore,
+	}
+
+	c := NewChecker(m, currentCluster, contexts, log.NopLogger())
+	c.now = func() time.Time { return now }
+	defer c.pool.Close()
+
+	c.Check(ctx)
+	require.Empty(t, m.Recent(ctx), "objects are not notified about when they are first seen")
+
+	c.Check(ctx)
+	require.Empty(t, m.Recent(ctx))
+
+	job.Status.Succeeded = 1
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	backup.Status.Succeeded = 1
+	backup.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	web.Status.UpdatedReplicas = 2
+	api.Status.UnavailableReplicas = 2
+	api.Status.AvailableReplicas = 0
+
+	c.Check(ctx)
+
+	expected := []Notification{
+		{
+			Time:    now,
+			Level:   LevelSuccess,
+			Title:   "Job backup completed",
+			Message: "Job has succeeded 1 time",
+			Ref:     "/overview/namespace/namespace/Job/backup",
+			Context: "prod",
+			WatchID: "watch-4",
+		},
+		{
+			Time:    now,
+			Level:   LevelError,
+			Title:   "Deployment api status is error",
+			Message: "No replicas exist for this deployment",
+			Ref:     "/overview/namespace/namespace/Deployment/api",
+			Context: "dev",
+			WatchID: "watch-3",
+		},
+		{
+			Time:    now,
+			Level:   LevelSuccess,
+			Title:   "Rollout of Deployment web completed",
+			Ref:     "/overview/namespace/namespace/Deployment/web",
+			Context: "dev",
+			WatchID: "watch-2",
+		},
+		{
+			Time:    now,
+			Level:   LevelSuccess,
+			Title:   "Job migrate completed",
+			Message: "Job has succeeded 1 time",
+			Ref:     "/overview/namespace/namespace/Job/migrate",
+			Context: "dev",
+			WatchID: "watch-1",
+		},
+	}
+	assert.Equal(t, expected, m.Recent(ctx))
+
+	assert.Equal(t, []Notification{
+		{
+			Time:    now,
+			Level:   LevelSuccess,
+			Title:   "Rollout of Deployment web completed",
+			Ref:     "/overview/namespace/namespace/Deployment/web",
+			Context: "dev",
+			WatchID: "watch-5",
+			User:    "jane",
+		},
+	}, m.Recent(janeCtx))
+
+	c.Check(ctx)
+	assert.Len(t, m.Recent(ctx), 4, "unchanged objects are not notified about again")
+
+	removed, err := m.Remove(ctx, "watch-1")
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = m.Remove(ctx, "watch-4")
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	prodClient.EXPECT().Close()
+	c.Check(ctx)
+	_, ok := c.states["watch-1"]
+	assert.False(t, ok)
+	assert.Empty(t, contexts.Members(), "contexts without watches are unloaded")
+}
+
+func TestChecker_Check_loading(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+	objectStore.EXPECT().IsLoading(gomock.Any(), gomock.Any()).Return(true)
+
+	ctx := context.Background()
+	m := NewManager()
+	w, err := m.Add(ctx, Watch{Context: "dev", Namespace: "namespace", APIVersion: "batch/v1", Kind: "Job", Name: "migrate"})
+	require.NoError(t, err)
+
+	contexts := fleet.New(ctx, nil, nil)
+	c := NewChecker(m, &fakeCluster{contextName: "dev", objectStore: objectStore}, contexts, log.NopLogger())
+	c.states[w.ID] = map[types.UID]objectState{"uid": {}}
+
+	c.Check(ctx)
+	assert.Equal(t, map[types.UID]objectState{"uid": {}}, c.states[w.ID], "states are kept while objects are loading")
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigFile is the name of the notification configuration file in the Octant
+// configuration directory.
+const ConfigFile = "notifications.yaml"
+
+// DefaultWebhookTimeout is how long sending a notification to a webhook may take if the
+// configuration does not set a timeout.
+const DefaultWebhookTimeout = 10 * time.Second
+
+// Config configures where notifications are sent, e.g.
+//
+//	webhookURL: https://hooks.slack.com/services/...
+//	webhookTimeout: 5s
+type Config struct {
+	// WebhookURL is the URL of an incoming webhook notifications are posted to as
+	// Slack-compatible JSON.
+	WebhookURL string `json:"webhookURL,omitempty"`
+	// WebhookTimeout limits how long sending a notification to the webhook may take.
+	WebhookTimeout metav1.Duration `json:"webhookTimeout,omitempty"`
+}
+
+// LoadConfig reads the notification configuration at path. A missing file results in
+// the default configuration, which does not send notifications to a webhook.
+func LoadConfig(path string) (Config, error) {
+	var config Config
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return Config{}, fmt.Errorf("read notification config: %w", err)
+	}
+
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return Config{}, fmt.Errorf("parse notification config %s: %w", path, err)
+	}
+	if config.WebhookTimeout.Duration < 0 {
+		return Config{}, fmt.Errorf("webhook timeout in %s must not be negative", path)
+	}
+	if config.WebhookTimeout.Duration == 0 {
+		config.WebhookTimeout.Duration = DefaultWebhookTimeout
+	}
+
+	return config, nil
+}
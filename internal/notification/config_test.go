@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected Config
+		wantErr  bool
+	}{
+		{
+			name:     "missing file",
+			expected: Config{WebhookTimeout: metav1.Duration{Duration: DefaultWebhookTimeout}},
+		},
+		{
+			name:     "webhook",
+			data:     "webhookURL: https://hooks.example.com/services/secret\nwebhookTimeout: 5s\n",
+			expected: Config{WebhookURL: "https://hooks.example.com/services/secret", WebhookTimeout: metav1.Duration{Duration: 5 * time.Second}},
+		},
+		{
+			name:     "default timeout",
+			data:     "webhookURL: https://hooks.example.com/services/secret\n",
+			expected: Config{WebhookURL: "https://hooks.example.com/services/secret", WebhookTimeout: metav1.Duration{Duration: DefaultWebhookTimeout}},
+		},
+		{
+			name:    "unknown field",
+			data:    "webhook: https://hooks.example.com/services/secret\n",
+			wantErr: true,
+		},
+		{
+			name:    "negative timeout",
+			data:    "webhookTimeout: -5s\n",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ConfigFile)
+			if test.data != "" {
+				require.NoError(t, ioutil.WriteFile(path, []byte(test.data), 0600))
+			}
+
+			config, err := LoadConfig(path)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, config)
+		})
+	}
+}
@@ -0,0 +1,494 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package notification watches objects and notifies users when the status of a watched
+// object turns to warning or error, when a rollout completes or when a job finishes.
+package notification
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// maxRecent is the number of notifications kept for display.
+const maxRecent = 100
+
+// Level is the severity of a notification.
+type Level string
+
+const (
+	// LevelInfo is the level of informational notifications.
+	LevelInfo Level = "info"
+	// LevelSuccess is the level of notifications about something which succeeded.
+	LevelSuccess Level = "success"
+	// LevelWarning is the level of notifications about an object with a warning status.
+	LevelWarning Level = "warning"
+	// LevelError is the level of notifications about an object with an error status or
+	// something which failed.
+	LevelError Level = "error"
+)
+
+// Watch is a watch of an object, or of the objects of a kind matching a label selector.
+type Watch struct {
+	ID string `json:"id"`
+	// Context is the kube context of the watched objects.
+	Context    string `json:"context"`
+	Namespace  string `json:"namespace,omitempty"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Name is the name of the watched object. Exactly one of Name and Selector is set.
+	Name string `json:"name,omitempty"`
+	// Selector is a label selector for the watched objects.
+	Selector string    `json:"selector,omitempty"`
+	Created  time.Time `json:"created"`
+}
+
+// Validate returns an error if the watch is missing fields or has an invalid selector.
+func (w Watch) Validate() error {
+	if w.APIVersion == "" || w.Kind == "" {
+		return fmt.Errorf("watch requires an API version and kind")
+	}
+	if (w.Name == "") == (w.Selector == "") {
+		return fmt.Errorf("watch requires either a name or a label selector")
+	}
+	if w.Selector != "" {
+		if _, err := metav1.ParseToLabelSelector(w.Selector); err != nil {
+			return fmt.Errorf("invalid label selector %q: %w", w.Selector, err)
+		}
+	}
+	return nil
+}
+
+// Target describes the watched objects, e.g. "Deployment payments" or "Pod app=web".
+func (w Watch) Target() string {
+	if w.Name != "" {
+		return fmt.Sprintf("%s %s", w.Kind, w.Name)
+	}
+	return fmt.Sprintf("%s %s", w.Kind, w.Selector)
+}
+
+func (w Watch) same(other Watch) bool {
+	return w.Context == other.Context && w.Namespace == other.Namespace &&
+		w.APIVersion == other.APIVersion && w.Kind == other.Kind &&
+		w.Name == other.Name && w.Selector == other.Selector
+}
+
+// Notification is a notification about a watched object.
+type Notification struct {
+	Time    time.Time `json:"time"`
+	Level   Level     `json:"level"`
+	Title   string    `json:"title"`
+	Message string    `json:"message"`
+	// Ref is the content path of the object.
+	Ref     string `json:"ref,omitempty"`
+	Context string `json:"context"`
+	WatchID string `json:"watchID"`
+	// User is the name of the user who owns the watch. It is empty if Octant does not
+	// authenticate users.
+	User string `json:"-"`
+}
+
+// Text returns the title and message of the notification as one line.
+func (n Notification) Text() string {
+	if n.Message == "" {
+		return n.Title
+	}
+	return fmt.Sprintf("%s: %s", n.Title, n.Message)
+}
+
+// Sender delivers notifications outside of Octant, e.g. to a webhook.
+type Sender interface {
+	// Send sends a notification.
+	Send(ctx context.Context, n Notification) error
+	// String describes where notifications are sent.
+	String() string
+}
+
+// WatchesFile is the name of the file in the Octant configuration directory watches are
+// persisted to.
+const WatchesFile = "notification-watches.json"
+
+// Manager keeps the watches and the recent notifications of each user, and delivers
+// notifications to the user's subscribers. The user is the authenticated user of the
+// request context; if Octant does not authenticate users, everything belongs to the
+// local user.
+type Manager struct {
+	mu          sync.Mutex
+	users       map[string]*userNotifications
+	subscribers map[int]subscriber
+	senders     []Sender
+	nextID      int
+	nextSub     int
+	now         func() time.Time
+	// path is the file watches are persisted to, or empty if they are not persisted.
+	path string
+}
+
+// userNotifications are the watches and recent notifications of a user.
+type userNotifications struct {
+	user    auth.User
+	watches []Watch
+	recent  []Notification
+}
+
+// userWatches are the watches of a user.
+type userWatches struct {
+	user    auth.User
+	watches []Watch
+}
+
+type subscriber struct {
+	user string
+	ch   chan Notification
+}
+
+// watchesFile is the format of the file watches are persisted to.
+type watchesFile struct {
+	Users []watchesFileUser `json:"users"`
+}
+
+type watchesFileUser struct {
+	Name    string   `json:"name,omitempty"`
+	UID     string   `json:"uid,omitempty"`
+	Groups  []string `json:"groups,omitempty"`
+	Watches []Watch  `json:"watches"`
+}
+
+// NewManager creates an instance of Manager.
+func NewManager() *Manager {
+	return &Manager{
+		users:       make(map[string]*userNotifications),
+		subscribers: make(map[int]subscriber),
+		now:         time.Now,
+	}
+}
+
+// Load loads the watches persisted to path, and persists changes to the watches to path
+// from now on. A missing file is not an error.
+func (m *Manager) Load(path string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.path = path
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read watches: %w", err)
+	}
+
+	var file watchesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("parse watches in %s: %w", path, err)
+	}
+
+	for _, u := range file.Users {
+		state := m.user(auth.User{Name: u.Name, UID: u.UID, Groups: u.Groups})
+		for _, w := range u.Watches {
+			if err := w.Validate(); err != nil || w.ID == "" {
+				continue
+			}
+			state.watches = append(state.watches, w)
+
+			var id int
+			if _, err := fmt.Sscanf(w.ID, "watch-%d", &id); err == nil && id > m.nextID {
+				m.nextID = id
+			}
+		}
+	}
+
+	return nil
+}
+
+// Add adds a watch for the user in ctx. If the user has an identical watch, it is
+// returned instead.
+func (m *Manager) Add(ctx context.Context, w Watch) (Watch, error) {
+	w.Selector = strings.TrimSpace(w.Selector)
+	if err := w.Validate(); err != nil {
+		return Watch{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, _ := auth.UserFrom(ctx)
+	state := m.user(user)
+	// The user's groups may have changed since the watches were persisted.
+	state.user = user
+
+	for _, existing := range state.watches {
+		if existing.same(w) {
+			return existing, nil
+		}
+	}
+
+	m.nextID++
+	w.ID = fmt.Sprintf("watch-%d", m.nextID)
+	w.Created = m.now()
+	state.watches = append(state.watches, w)
+
+	if err := m.save(); err != nil {
+		state.watches = state.watches[:len(state.watches)-1]
+		return Watch{}, err
+	}
+
+	return w, nil
+}
+
+// Remove removes a watch of the user in ctx. It returns false if the user has no watch
+// with the ID.
+func (m *Manager) Remove(ctx context.Context, id string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, _ := auth.UserFrom(ctx)
+	state, ok := m.users[user.Name]
+	if !ok {
+		return false, nil
+	}
+
+	for i := range state.watches {
+		if state.watches[i].ID == id {
+			previous := state.watches
+			state.watches = append(append([]Watch(nil), previous[:i]...), previous[i+1:]...)
+			if err := m.save(); err != nil {
+				state.watches = previous
+				return false, err
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Watches returns the watches of the user in ctx in the order they were added.
+func (m *Manager) Watches(ctx context.Context) []Watch {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, _ := auth.UserFrom(ctx)
+	state, ok := m.users[user.Name]
+	if !ok {
+		return nil
+	}
+	return append([]Watch(nil), state.watches...)
+}
+
+// Find returns the watch of an object by name of the user in ctx, or false if the user
+// isn't watching the object.
+func (m *Manager) Find(ctx context.Context, contextName, namespace, apiVersion, kind, name string) (Watch, bool) {
+	key := Watch{Context: contextName, Namespace: namespace, APIVersion: apiVersion, Kind: kind, Name: name}
+	for _, w := range m.Watches(ctx) {
+		if w.same(key) {
+			return w, true
+		}
+	}
+	return Watch{}, false
+}
+
+// userWatches returns the watches of every user with watches, ordered by user name.
+func (m *Manager) userWatches() []userWatches {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var list []userWatches
+	for _, name := range m.userNames() {
+		state := m.users[name]
+		if len(state.watches) == 0 {
+			continue
+		}
+		list = append(list, userWatches{user: state.user, watches: append([]Watch(nil), state.watches...)})
+	}
+	return list
+}
+
+// AddSender adds a sender which is used to deliver every notification.
+func (m *Manager) AddSender(sender Sender) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.senders = append(m.senders, sender)
+}
+
+// Senders returns the senders.
+func (m *Manager) Senders() []Sender {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Sender(nil), m.senders...)
+}
+
+// Recent returns the recent notifications of the user in ctx, newest first.
+func (m *Manager) Recent(ctx context.Context) []Notification {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, _ := auth.UserFrom(ctx)
+	state, ok := m.users[user.Name]
+	if !ok {
+		return []Notification{}
+	}
+
+	list := make([]Notification, len(state.recent))
+	for i, n := range state.recent {
+		list[len(state.recent)-1-i] = n
+	}
+	return list
+}
+
+// Subscribe returns a channel receiving the notifications published for the user in
+// ctx, and a function which cancels the subscription. Notifications are dropped if the
+// subscriber isn't keeping up.
+func (m *Manager) Subscribe(ctx context.Context) (<-chan Notification, func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, _ := auth.UserFrom(ctx)
+
+	id := m.nextSub
+	m.nextSub++
+	ch := make(chan Notification, 16)
+	m.subscribers[id] = subscriber{user: user.Name, ch: ch}
+
+	return ch, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.subscribers[id]; ok {
+			delete(m.subscribers, id)
+			close(ch)
+		}
+	}
+}
+
+// Publish records a notification for its user and sends it to the user's subscribers.
+// Senders are not used; see Deliver.
+func (m *Manager) Publish(n Notification) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.users[n.User]
+	if !ok {
+		state = m.user(auth.User{Name: n.User})
+	}
+	state.recent = append(state.recent, n)
+	if len(state.recent) > maxRecent {
+		state.recent = state.recent[len(state.recent)-maxRecent:]
+	}
+
+	for _, s := range m.subscribers {
+		if s.user != n.User {
+			continue
+		}
+		select {
+		case s.ch <- n:
+		default:
+		}
+	}
+}
+
+// Deliver publishes a notification and sends it with every sender. The errors of the
+// senders are returned after all of them were tried.
+func (m *Manager) Deliver(ctx context.Context, n Notification) error {
+	m.Publish(n)
+
+	var errs []string
+	for _, sender := range m.Senders() {
+		if err := sender.Send(ctx, n); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", sender, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("send notification: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// user returns the state of a user, creating it if needed. It must be called with the
+// lock held.
+func (m *Manager) user(user auth.User) *userNotifications {
+	state, ok := m.users[user.Name]
+	if !ok {
+		state = &userNotifications{user: user}
+		m.users[user.Name] = state
+	}
+	return state
+}
+
+// userNames returns the names of the users, sorted. It must be called with the lock held.
+func (m *Manager) userNames() []string {
+	var names []string
+	for name := range m.users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// save persists the watches if the manager was loaded from a file. The file is replaced
+// atomically, so it is never left partially written. It must be called with the lock held.
+func (m *Manager) save() error {
+	if m.path == "" {
+		return nil
+	}
+
+	file := watchesFile{Users: []watchesFileUser{}}
+	for _, name := range m.userNames() {
+		state := m.users[name]
+		if len(state.watches) == 0 {
+			continue
+		}
+		file.Users = append(file.Users, watchesFileUser{
+			Name:    state.user.Name,
+			UID:     state.user.UID,
+			Groups:  state.user.Groups,
+			Watches: state.watches,
+		})
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal watches: %w", err)
+	}
+
+	dir := filepath.Dir(m.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("create watches directory: %w", err)
+	}
+
+	tmp, err := ioutil.TempFile(dir, ".notification-watches-*")
+	if err != nil {
+		return fmt.Errorf("persist watches: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("persist watches: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("persist watches: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), m.path); err != nil {
+		return fmt.Errorf("persist watches: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
+)
+
+func TestWatch_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		watch   Watch
+		wantErr bool
+	}{
+		{
+			name:  "name",
+			watch: Watch{APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+		},
+		{
+			name:  "selector",
+			watch: Watch{APIVersion: "v1", Kind: "Pod", Selector: "app=web,tier in (frontend)"},
+		},
+		{
+			name:    "missing kind",
+			watch:   Watch{APIVersion: "v1", Name: "web"},
+			wantErr: true,
+		},
+		{
+			name:    "name and selector",
+			watch:   Watch{APIVersion: "v1", Kind: "Pod", Name: "web", Selector: "app=web"},
+			wantErr: true,
+		},
+		{
+			name:    "neither name nor selector",
+			watch:   Watch{APIVersion: "v1", Kind: "Pod"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid selector",
+			watch:   Watch{APIVersion: "v1", Kind: "Pod", Selector: "app in web"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.watch.Validate()
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestManager_Watches(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	ctx := context.Background()
+	m := NewManager()
+	m.now = func() time.Time { return now }
+
+	web, err := m.Add(ctx, Watch{Context: "dev", Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "watch-1", web.ID)
+	assert.Equal(t, now, web.Created)
+	assert.Equal(t, "Deployment web", web.Target())
+
+	pods, err := m.Add(ctx, Watch{Context: "dev", APIVersion: "v1", Kind: "Pod", Selector: " app=web "})
+	require.NoError(t, err)
+	assert.Equal(t, "watch-2", pods.ID)
+	assert.Equal(t, "Pod app=web", pods.Target())
+
+	again, err := m.Add(ctx, Watch{Context: "dev", Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, web, again)
+
+	_, err = m.Add(ctx, Watch{Context: "dev", APIVersion: "v1", Kind: "Pod"})
+	require.Error(t, err)
+
+	assert.Equal(t, []Watch{web, pods}, m.Watches(ctx))
+
+	janeCtx := auth.WithUser(ctx, auth.User{Name: "jane"})
+	janeWeb, err := m.Add(janeCtx, Watch{Context: "dev", Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "watch-3", janeWeb.ID, "users have their own watches")
+	assert.Equal(t, []Watch{janeWeb}, m.Watches(janeCtx))
+
+	found, ok := m.Find(ctx, "dev", "default", "apps/v1", "Deployment", "web")
+	require.True(t, ok)
+	assert.Equal(t, web, found)
+
+	_, ok = m.Find(ctx, "prod", "default", "apps/v1", "Deployment", "web")
+	assert.False(t, ok)
+
+	removed, err := m.Remove(janeCtx, web.ID)
+	require.NoError(t, err)
+	assert.False(t, removed, "watches of other users can't be removed")
+
+	removed, err = m.Remove(ctx, web.ID)
+	require.NoError(t, err)
+	assert.True(t, removed)
+	removed, err = m.Remove(ctx, web.ID)
+	require.NoError(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, []Watch{pods}, m.Watches(ctx))
+}
+
+func TestManager_Load(t *testing.T) {
+	now := time.Unix(1547211430, 0).UTC()
+	path := filepath.Join(t.TempDir(), "config", WatchesFile)
+
+	ctx := context.Background()
+	janeCtx := auth.WithUser(ctx, auth.User{Name: "jane", Groups: []string{"dev"}})
+
+	m := NewManager()
+	m.now = func() time.Time { return now }
+	require.NoError(t, m.Load(path), "a missing file is not an error")
+
+	web, err := m.Add(ctx, Watch{Context: "dev", Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "web"})
+	require.NoError(t, err)
+	pods, err := m.Add(janeCtx, Watch{Context: "prod", APIVersion: "v1", Kind: "Pod", Selector: "app=web"})
+	require.NoError(t, err)
+	jobs, err := m.Add(janeCtx, Watch{Context: "prod", APIVersion: "batch/v1", Kind: "Job", Selector: "app=web"})
+	require.NoError(t, err)
+	removed, err := m.Remove(janeCtx, pods.ID)
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	loaded := NewManager()
+	require.NoError(t, loaded.Load(path))
+	assert.Equal(t, []Watch{web}, loaded.Watches(ctx))
+	assert.Equal(t, []Watch{jobs}, loaded.Watches(janeCtx))
+	assert.Equal(t, []userWatches{
+		{watches: []Watch{web}},
+		{user: auth.User{Name: "jane", Groups: []string{"dev"}}, watches: []Watch{jobs}},
+	}, loaded.userWatches())
+
+	added, err := loaded.Add(ctx, Watch{Context: "dev", APIVersion: "v1", Kind: "Pod", Name: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "watch-4", added.ID, "IDs of loaded watches are not reused")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+	require.Error(t, NewManager().Load(path))
+}
+
+func TestManager_Publish(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager()
+
+	ch, cancel := m.Subscribe(ctx)
+	janeCh, janeCancel := m.Subscribe(auth.WithUser(ctx, auth.User{Name: "jane"}))
+	defer janeCancel()
+
+	first := Notification{Title: "first"}
+	second := Notification{Title: "second"}
+	m.Publish(first)
+	m.Publish(second)
+
+	assert.Equal(t, first, <-ch)
+	assert.Equal(t, second, <-ch)
+	assert.Equal(t, []Notification{second, first}, m.Recent(ctx))
+	assert.Empty(t, janeCh, "notifications are only sent to their user")
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	// canceling twice and publishing without subscribers is safe
+	cancel()
+	m.Publish(first)
+
+	for i := 0; i < maxRecent; i++ {
+		m.Publish(Notification{Title: fmt.Sprintf("%d", i)})
+	}
+	recent := m.Recent(ctx)
+	require.Len(t, recent, maxRecent)
+	assert.Equal(t, fmt.Sprintf("%d", maxRecent-1), recent[0].Title)
+}
+
+type fakeSender struct {
+	name string
+	err  error
+	sent []Notification
+}
+
+func (s *fakeSender) Send(ctx context.Context, n Notification) error {
+	s.sent = append(s.sent, n)
+	return s.err
+}
+
+func (s *fakeSender) String() string {
+	return s.name
+}
+
+func TestManager_Deliver(t *testing.T) {
+	m := NewManager()
+
+	failing := &fakeSender{name: "failing", err: fmt.Errorf("unavailable")}
+	working := &fakeSender{name: "working"}
+	m.AddSender(failing)
+	m.AddSender(working)
+	assert.Equal(t, []Sender{failing, working}, m.Senders())
+
+	n := Notification{Title: "Job migrate completed", Level: LevelSuccess}
+	err := m.Deliver(context.Background(), n)
+	require.EqualError(t, err, "send notification: failing: unavailable")
+
+	assert.Equal(t, []Notification{n}, failing.sent)
+	assert.Equal(t, []Notification{n}, working.sent)
+	assert.Equal(t, []Notification{n}, m.Recent(context.Background()))
+}
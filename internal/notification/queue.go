@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// queueSize is the number of notifications a Queue holds before it drops new ones.
+const queueSize = 100
+
+// Queue sends notifications with a sender in the background, so checking watched
+// objects isn't held up by a slow or unreachable sender. Each send is limited to a
+// timeout.
+type Queue struct {
+	sender  Sender
+	timeout time.Duration
+	logger  log.Logger
+	ch      chan Notification
+}
+
+var _ Sender = (*Queue)(nil)
+
+// NewQueue creates an instance of Queue. Notifications are sent once Run is called.
+func NewQueue(sender Sender, timeout time.Duration, logger log.Logger) *Queue {
+	return &Queue{
+		sender:  sender,
+		timeout: timeout,
+		logger:  logger,
+		ch:      make(chan Notification, queueSize),
+	}
+}
+
+// Send queues a notification. It returns an error if the queue is full.
+func (q *Queue) Send(ctx context.Context, n Notification) error {
+	select {
+	case q.ch <- n:
+		return nil
+	default:
+		return fmt.Errorf("queue is full")
+	}
+}
+
+// String describes where notifications are sent.
+func (q *Queue) String() string {
+	return q.sender.String()
+}
+
+// Run sends the queued notifications until the context is canceled.
+func (q *Queue) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-q.ch:
+			q.send(ctx, n)
+		}
+	}
+}
+
+func (q *Queue) send(ctx context.Context, n Notification) {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+
+	if err := q.sender.Send(ctx, n); err != nil {
+		q.logger.WithErr(err).With("sender", q.sender.String()).Errorf("sending notification")
+	}
+}
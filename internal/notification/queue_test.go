@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+// blockingSender blocks until the context of a send is done.
+type blockingSender struct {
+	sent chan error
+}
+
+func (s *blockingSender) Send(ctx context.Context, n Notification) error {
+	<-ctx.Done()
+	s.sent <- ctx.Err()
+	return ctx.Err()
+}
+
+func (s *blockingSender) String() string {
+	return "blocking"
+}
+
+func TestQueue(t *testing.T) {
+	sender := &blockingSender{sent: make(chan error, queueSize)}
+	q := NewQueue(sender, 10*time.Millisecond, log.NopLogger())
+	assert.Equal(t, "blocking", q.String())
+
+	ctx := context.Background()
+	for i := 0; i < queueSize; i++ {
+		require.NoError(t, q.Send(ctx, Notification{Title: "queued"}))
+	}
+	require.EqualError(t, q.Send(ctx, Notification{Title: "dropped"}), "queue is full")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go q.Run(ctx)
+
+	select {
+	case err := <-sender.sent:
+		assert.Equal(t, context.DeadlineExceeded, err, "sends are limited to the timeout")
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification was not sent")
+	}
+}
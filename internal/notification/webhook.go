@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// defaultWebhookTimeout is how long a webhook request may take.
+const defaultWebhookTimeout = 10 * time.Second
+
+// levelColors are the colors of Slack attachments for each level.
+var levelColors = map[Level]string{
+	LevelInfo:    "#0072a3",
+	LevelSuccess: "good",
+	LevelWarning: "warning",
+	LevelError:   "danger",
+}
+
+// WebhookOption is an option for configuring Webhook.
+type WebhookOption func(w *Webhook)
+
+// WithWebhookClient sets the HTTP client used to send notifications.
+func WithWebhookClient(client *http.Client) WebhookOption {
+	return func(w *Webhook) {
+		w.client = client
+	}
+}
+
+// Webhook sends notifications to an incoming webhook as Slack-compatible JSON. Services
+// which only read the text field receive a one line summary.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+var _ Sender = (*Webhook)(nil)
+
+// NewWebhook creates an instance of Webhook. The URL must be an http or https URL.
+func NewWebhook(rawURL string, options ...WebhookOption) (*Webhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse webhook URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("webhook URL %q must be an http or https URL", rawURL)
+	}
+
+	w := &Webhook{
+		url:    rawURL,
+		client: &http.Client{Timeout: defaultWebhookTimeout},
+	}
+
+	for _, option := range options {
+		option(w)
+	}
+
+	return w, nil
+}
+
+// String describes the webhook. Only the host is included since webhook URLs usually
+// contain a secret.
+func (w *Webhook) String() string {
+	u, err := url.Parse(w.url)
+	if err != nil {
+		return "webhook"
+	}
+	return fmt.Sprintf("webhook at %s", u.Host)
+}
+
+type webhookMessage struct {
+	Text        string              `json:"text"`
+	Attachments []webhookAttachment `json:"attachments"`
+}
+
+type webhookAttachment struct {
+	Fallback string `json:"fallback"`
+	Color    string `json:"color"`
+	Title    string `json:"title"`
+	Text     string `json:"text,omitempty"`
+	Footer   string `json:"footer"`
+	TS       int64  `json:"ts"`
+}
+
+// Send posts a notification to the webhook.
+func (w *Webhook) Send(ctx context.Context, n Notification) error {
+	footer := "Octant"
+	if n.Context != "" {
+		footer = fmt.Sprintf("Octant: %s", n.Context)
+	}
+
+	data, err := json.Marshal(webhookMessage{
+		Text: n.Text(),
+		Attachments: []webhookAttachment{{
+			Fallback: n.Text(),
+			Color:    levelColors[n.Level],
+			Title:    n.Title,
+			Text:     n.Message,
+			Footer:   footer,
+			TS:       n.Time.Unix(),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal webhook message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook returned %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+
+	return nil
+}
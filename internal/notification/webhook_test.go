@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notification
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhook(t *testing.T) {
+	w, err := NewWebhook("https://hooks.example.com/services/secret")
+	require.NoError(t, err)
+	assert.Equal(t, "webhook at hooks.example.com", w.String())
+
+	_, err = NewWebhook("ftp://hooks.example.com")
+	require.Error(t, err)
+
+	_, err = NewWebhook("hooks.example.com/services/secret")
+	require.Error(t, err)
+}
+
+func TestWebhook_Send(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(data)
+	}))
+	defer server.Close()
+
+	w, err := NewWebhook(server.URL, WithWebhookClient(server.Client()))
+	require.NoError(t, err)
+
+	n := Notification{
+		Time:    time.Unix(1547211430, 0),
+		Level:   LevelError,
+		Title:   "Pod web status is error",
+		Message: "CrashLoopBackOff",
+		Context: "dev",
+	}
+	require.NoError(t, w.Send(context.Background(), n))
+
+	expected := `{"text":"Pod web status is error: CrashLoopBackOff","attachments":[{"fallback":"Pod web status is error: CrashLoopBackOff","color":"danger","title":"Pod web status is error","text":"CrashLoopBackOff","footer":"Octant: dev","ts":1547211430}]}`
+	assert.JSONEq(t, expected, body)
+}
+
+func TestWebhook_Send_error_status(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid_token", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	w, err := NewWebhook(server.URL, WithWebhookClient(server.Client()))
+	require.NoError(t, err)
+
+	err = w.Send(context.Background(), Notification{Title: "title"})
+	require.EqualError(t, err, "webhook returned 403 Forbidden: invalid_token")
+}
@@ -28,6 +28,7 @@ import (
 
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
@@ -52,6 +53,7 @@ type DynamicCache struct {
 	knownInformers sync.Map // gvr:GenericInformer
 	unwatched      sync.Map // gvr:bool
 	gvrCache       sync.Map // gk:gvr
+	userAccess     sync.Map // userAccessKey:userAccess
 
 	removeCh chan schema.GroupVersionResource
 	mu       sync.Mutex
@@ -83,6 +85,7 @@ func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, opts .
 		knownInformers: sync.Map{},
 		unwatched:      sync.Map{},
 		gvrCache:       sync.Map{},
+		userAccess:     sync.Map{},
 		removeCh:       make(chan schema.GroupVersionResource),
 	}
 
@@ -103,7 +106,7 @@ func (d *DynamicCache) List(ctx context.Context, key store.Key) (list *unstructu
 	_, span := trace.StartSpan(ctx, "dynamicCache:List")
 	defer span.End()
 
-	resourceLister, err := d.listerForResource(ctx, key)
+	resourceLister, err := d.listerForResource(ctx, key, "list")
 	if err != nil {
 		return nil, false, err
 	}
@@ -159,7 +162,7 @@ func (d *DynamicCache) Get(ctx context.Context, key store.Key) (object *unstruct
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:Get")
 	defer span.End()
 
-	resourceLister, err := d.listerForResource(ctx, key)
+	resourceLister, err := d.listerForResource(ctx, key, "get")
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +182,7 @@ func (d *DynamicCache) Delete(ctx context.Context, key store.Key) error {
 	_, span := trace.StartSpan(ctx, "dynamicCache:delete")
 	defer span.End()
 
-	dynamicClient, err := d.client.DynamicClient()
+	dynamicClient, err := cluster.ClientOrDefault(ctx, d.client).DynamicClient()
 	if err != nil {
 		return err
 	}
@@ -246,7 +249,7 @@ func (d *DynamicCache) Update(ctx context.Context, key store.Key, updater func(*
 			return err
 		}
 
-		dynamicClient, err := d.client.DynamicClient()
+		dynamicClient, err := cluster.ClientOrDefault(ctx, d.client).DynamicClient()
 		if err != nil {
 			return err
 		}
@@ -265,10 +268,23 @@ func (d *DynamicCache) Update(ctx context.Context, key store.Key, updater func(*
 
 }
 
+// IsLoading returns true if the informer for the key's resource has been started and
+// has not synced yet. It does not look up resources, since informers are only started
+// for resources that have already been looked up.
 func (d *DynamicCache) IsLoading(ctx context.Context, key store.Key) bool {
 	_, span := trace.StartSpan(ctx, "dynamicCache:IsLoading")
 	defer span.End()
-	return false
+
+	gvr, ok := d.gvrCache.Load(key.GroupVersionKind().GroupKind())
+	if !ok {
+		return false
+	}
+
+	v, ok := d.knownInformers.Load(gvr)
+	if !ok {
+		return false
+	}
+	return !v.(interruptibleInformer).informer.Informer().HasSynced()
 }
 
 func (d *DynamicCache) Create(ctx context.Context, object *unstructured.Unstructured) error {
@@ -287,7 +303,7 @@ func (d *DynamicCache) Create(ctx context.Context, object *unstructured.Unstruct
 
 	createOptions := metav1.CreateOptions{}
 
-	dynamicClient, err := d.client.DynamicClient()
+	dynamicClient, err := cluster.ClientOrDefault(ctx, d.client).DynamicClient()
 	if err != nil {
 		return err
 	}
@@ -331,6 +347,10 @@ func (d *DynamicCache) Watch(ctx context.Context, key store.Key, handler cache.R
 		return fmt.Errorf("watcher was unable to start for %s", gvr)
 	}
 
+	if err := d.checkUserAccess(ctx, "watch", key, gvr); err != nil {
+		return err
+	}
+
 	span.AddAttributes(trace.StringAttribute("key", fmt.Sprintf("%s", key)))
 
 	d.forResource(ctx, gvr, key.Namespace, handler)
@@ -420,10 +440,18 @@ func (d *DynamicCache) forResource(ctx context.Context, gvr schema.GroupVersionR
 
 		go func() {
 			logger.Debugf("starting informer for %s", gvr)
+			metrics.InformerStarted(gvr)
 			i.Informer().Run(stopCh)
+			metrics.InformerStopped(gvr)
 			logger.Debugf("stopping informer for %s", gvr)
 		}()
 
+		go func(started time.Time) {
+			if cache.WaitForCacheSync(stopCh, i.Informer().HasSynced) {
+				metrics.ObserveInformerSync(gvr, time.Since(started))
+			}
+		}(time.Now())
+
 		ii := interruptibleInformer{
 			stopCh,
 			i,
@@ -439,7 +467,7 @@ func (d *DynamicCache) forResource(ctx context.Context, gvr schema.GroupVersionR
 	return ii
 }
 
-func (d *DynamicCache) listerForResource(ctx context.Context, key store.Key) (lister, error) {
+func (d *DynamicCache) listerForResource(ctx context.Context, key store.Key, verb string) (lister, error) {
 	ctx, span := trace.StartSpan(ctx, "dynamicCache:ListerForResource")
 	defer span.End()
 
@@ -458,6 +486,10 @@ func (d *DynamicCache) listerForResource(ctx context.Context, key store.Key) (li
 		return nil, oerrors.NewAccessError(key, "List", err)
 	}
 
+	if err := d.checkUserAccess(ctx, verb, key, gvr); err != nil {
+		return nil, err
+	}
+
 	ii := d.forResource(ctx, gvr, key.Namespace, nil)
 
 	var l lister
@@ -478,7 +510,12 @@ func (d *DynamicCache) watchErrorHandler(ctx context.Context, gvr schema.GroupVe
 		span.AddAttributes(trace.StringAttribute("gvr", fmt.Sprintf("%s", gvr)))
 
 		logger := log.From(ctx)
-		logger.Warnf("unable to start watcher ", err.Error())
+		if kerrors.IsUnauthorized(err) {
+			// expired credentials are reported once by the credentials manager
+			logger.With("gvr", gvr).Debugf("watcher was not authorized")
+		} else {
+			logger.Warnf("unable to start watcher ", err.Error())
+		}
 
 		d.removeCh <- gvr
 	}
@@ -645,5 +682,5 @@ func CreateOrUpdateFromHandler(
 // An error creating a resource halts resource creation.
 // A list of created resources is returned. You may have created resources AND a non-nil error.
 func (d *DynamicCache) CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error) {
-	return CreateOrUpdateFromHandler(ctx, namespace, input, d.Get, d.Create, d.client)
+	return CreateOrUpdateFromHandler(ctx, namespace, input, d.Get, d.Create, cluster.ClientOrDefault(ctx, d.client))
 }
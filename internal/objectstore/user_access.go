@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// userAccessTTL is how long the result of an access review for a user is reused.
+const userAccessTTL = 30 * time.Second
+
+type userAccessKey struct {
+	client    cluster.ClientInterface
+	verb      string
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+type userAccess struct {
+	allowed bool
+	expires time.Time
+}
+
+// checkUserAccess returns an access error if the user the request is made for can't perform verb on
+// the resource. Informers are run with Octant's credentials, so requests made with a user's
+// cluster client in their context are reviewed using that client before cached objects are returned.
+func (d *DynamicCache) checkUserAccess(ctx context.Context, verb string, key store.Key, gvr schema.GroupVersionResource) error {
+	client, ok := cluster.ClientFromContext(ctx)
+	if !ok || client == d.client {
+		return nil
+	}
+
+	accessKey := userAccessKey{
+		client:    client,
+		verb:      verb,
+		gvr:       gvr,
+		namespace: key.Namespace,
+	}
+
+	if v, ok := d.userAccess.Load(accessKey); ok {
+		access := v.(userAccess)
+		if time.Now().Before(access.expires) {
+			return userAccessError(access.allowed, verb, key, gvr)
+		}
+	}
+
+	kubernetesClient, err := client.KubernetesClient()
+	if err != nil {
+		return oerrors.NewAccessError(key, verb, err)
+	}
+
+	ssar := &authv1.SelfSubjectAccessReview{
+		Spec: authv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Namespace: key.Namespace,
+				Verb:      verb,
+				Group:     gvr.Group,
+				Version:   gvr.Version,
+				Resource:  gvr.Resource,
+			},
+		},
+	}
+	resp, err := kubernetesClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, ssar, metav1.CreateOptions{})
+	if err != nil {
+		return oerrors.NewAccessError(key, verb, fmt.Errorf("review access: %w", err))
+	}
+
+	d.userAccess.Store(accessKey, userAccess{
+		allowed: resp.Status.Allowed,
+		expires: time.Now().Add(userAccessTTL),
+	})
+
+	return userAccessError(resp.Status.Allowed, verb, key, gvr)
+}
+
+func userAccessError(allowed bool, verb string, key store.Key, gvr schema.GroupVersionResource) error {
+	if allowed {
+		return nil
+	}
+
+	err := fmt.Errorf("user is not allowed to %s %s", verb, gvr.GroupResource())
+	return oerrors.NewAccessError(key, verb, err)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeFake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestDynamicCache_checkUserAccess(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var reviews []authv1.ResourceAttributes
+	kubernetesClient := kubeFake.NewSimpleClientset()
+	kubernetesClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(k8stesting.CreateAction).GetObject().(*authv1.SelfSubjectAccessReview)
+		reviews = append(reviews, *ssar.Spec.ResourceAttributes)
+		ssar.Status.Allowed = ssar.Spec.ResourceAttributes.Namespace == "allowed"
+		return true, ssar, nil
+	})
+
+	userClient := clusterFake.NewMockClientInterface(controller)
+	userClient.EXPECT().KubernetesClient().Return(kubernetesClient, nil).AnyTimes()
+
+	base := clusterFake.NewMockClientInterface(controller)
+	d := &DynamicCache{client: base}
+
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	allowed := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "allowed"}
+	denied := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "denied"}
+
+	// requests without a user client are not reviewed
+	require.NoError(t, d.checkUserAccess(context.Background(), "list", denied, gvr))
+
+	ctx := cluster.WithClient(context.Background(), userClient)
+
+	require.NoError(t, d.checkUserAccess(ctx, "list", allowed, gvr))
+	require.NoError(t, d.checkUserAccess(ctx, "list", allowed, gvr))
+
+	err := d.checkUserAccess(ctx, "list", denied, gvr)
+	require.Error(t, err)
+	var accessErr *oerrors.AccessError
+	assert.True(t, errors.As(err, &accessErr))
+
+	require.Len(t, reviews, 2, "expected access reviews to be cached")
+	assert.Equal(t, authv1.ResourceAttributes{Namespace: "allowed", Verb: "list", Version: "v1", Resource: "pods"}, reviews[0])
+}
@@ -23,8 +23,42 @@ const (
 	ActionDeploymentConfiguration = "action.octant.dev/deploymentConfiguration"
 	ActionUpdateObject            = "action.octant.dev/update"
 	ActionGetManifest             = "action.octant.dev/manifest"
+	ActionStartPortForward        = "overview/startPortForward"
+	ActionStopPortForward         = "overview/stopPortForward"
+	ActionLoadFleetContext        = "action.octant.dev/loadFleetContext"
+	ActionUnloadFleetContext      = "action.octant.dev/unloadFleetContext"
+	ActionImportKubeConfig        = "action.octant.dev/importKubeConfig"
+	ActionRenameContext           = "action.octant.dev/renameContext"
+	ActionDeleteContext           = "action.octant.dev/deleteContext"
+	ActionSetContextNamespace     = "action.octant.dev/setContextNamespace"
+	ActionSetFavoriteContext      = "action.octant.dev/setFavoriteContext"
+	ActionRefreshCredentials      = "action.octant.dev/refreshCredentials"
+	ActionWatchObjects            = "action.octant.dev/watchObjects"
+	ActionUnwatchObjects          = "action.octant.dev/unwatchObjects"
 )
 
+// MutatingActions are the built-in actions which change cluster state, open access to it or
+// change the kube config. They are refused in read-only mode.
+var MutatingActions = []string{
+	ActionDeleteObject,
+	ActionOverviewCordon,
+	ActionOverviewUncordon,
+	ActionOverviewContainerEditor,
+	ActionOverviewCronjob,
+	ActionOverviewSuspendCronjob,
+	ActionOverviewResumeCronjob,
+	ActionOverviewServiceEditor,
+	ActionDeploymentConfiguration,
+	ActionUpdateObject,
+	ActionStartPortForward,
+	action.ActionApplyYaml,
+	ActionImportKubeConfig,
+	ActionRenameContext,
+	ActionDeleteContext,
+	ActionSetContextNamespace,
+	ActionSetFavoriteContext,
+}
+
 func sendAlert(alerter action.Alerter, alertType action.AlertType, message string, expiration *time.Time) {
 	alert := action.Alert{
 		Type:       alertType,
@@ -22,7 +22,7 @@ type ApplyYaml struct {
 	objectStore store.Store
 }
 
-var _ action.Dispatcher = (*ApplyYaml)(nil)
+var _ action.CommandDispatcher = (*ApplyYaml)(nil)
 
 // NewApplyYaml creates an instance of ApplyYaml
 func NewApplyYaml(logger log.Logger, objectStore store.Store) *ApplyYaml {
@@ -37,6 +37,20 @@ func (p *ApplyYaml) ActionName() string {
 	return action.ActionApplyYaml
 }
 
+// Command returns the command palette entry for applying yaml
+func (p *ApplyYaml) Command() action.Command {
+	return action.Command{
+		Name:        p.ActionName(),
+		Title:       "Apply YAML",
+		Description: "Create or update resources from YAML",
+		Shortcut:    "alt+shift+a",
+		Fields: []action.CommandField{
+			{Name: "namespace", Label: "Namespace", Required: true},
+			{Name: "update", Label: "YAML", Type: action.CommandFieldTypeTextArea, Required: true},
+		},
+	}
+}
+
 // Handle applies the requested yaml to the cluster
 func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
 	p.logger.With("payload", payload).Debugf("received action payload")
@@ -66,6 +80,9 @@ func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload
 		message := fmt.Sprintf("Applied %d resources", len(results))
 		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
 	}
+	if err != nil {
+		return errors.Wrap(err, "apply yaml")
+	}
 	return nil
 }
 
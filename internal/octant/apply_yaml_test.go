@@ -331,5 +331,5 @@ data: {
 		"namespace": "default",
 	})
 
-	require.NoError(t, applyYaml.Handle(ctx, alerter, payload))
+	require.Error(t, applyYaml.Handle(ctx, alerter, payload))
 }
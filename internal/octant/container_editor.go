@@ -76,16 +76,18 @@ func (e *ContainerEditor) Handle(ctx context.Context, alerter action.Alerter, pa
 
 	message := fmt.Sprintf("Container %q was updated", containerName)
 	alertType := action.AlertTypeInfo
-	if err := e.store.Update(ctx, key, fn); err != nil {
+	err = e.store.Update(ctx, key, fn)
+	if err != nil {
 		message = fmt.Sprintf("Unable to update container %q: %s", containerName, err)
 		alertType = action.AlertTypeWarning
 		logger := internalLog.From(ctx)
 		logger.WithErr(err).Errorf("update container")
+		err = errors.Wrapf(err, "update container %q", containerName)
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 
 	alerter.SendAlert(alert)
-	return nil
+	return err
 }
 
 func updateContainer(containersPath []string, logger log.Logger, containerName string, containerImage string) func(object *unstructured.Unstructured) error {
@@ -32,7 +32,7 @@ type Cordon struct {
 	clusterClient cluster.ClientInterface
 }
 
-var _ action.Dispatcher = (*Cordon)(nil)
+var _ action.CommandDispatcher = (*Cordon)(nil)
 
 // NewCordon creates an instance of Cordon
 func NewCordon(objectStore store.Store, clusterClient cluster.ClientInterface) *Cordon {
@@ -49,6 +49,16 @@ func (c *Cordon) ActionName() string {
 	return "action.octant.dev/cordon"
 }
 
+// Command returns the command palette entry for cordoning a node
+func (c *Cordon) Command() action.Command {
+	return action.Command{
+		Name:        c.ActionName(),
+		Title:       "Cordon Node",
+		Description: "Mark a node as unschedulable",
+		Fields:      nodeCommandFields(),
+	}
+}
+
 // Handle executing cordon
 func (c *Cordon) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
 	logger := log.From(ctx).With("actionName", c.ActionName())
@@ -75,7 +85,8 @@ func (c *Cordon) Handle(ctx context.Context, alerter action.Alerter, payload act
 
 	message := fmt.Sprintf("Node %q marked as unschedulable", key.Name)
 	alertType := action.AlertTypeInfo
-	if err := c.Cordon(node); err != nil {
+	err = c.Cordon(ctx, node)
+	if err != nil {
 		message = fmt.Sprintf("Unable to cordon node %q: %s", key.Name, err)
 		alertType = action.AlertTypeWarning
 		logger := log.From(ctx)
@@ -83,21 +94,21 @@ func (c *Cordon) Handle(ctx context.Context, alerter action.Alerter, payload act
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
-	return nil
+	return err
 }
 
 // Cordon marks a node as unschedulable
-func (c *Cordon) Cordon(node *corev1.Node) error {
+func (c *Cordon) Cordon(ctx context.Context, node *corev1.Node) error {
 	if node == nil {
 		return errors.New("nil node")
 	}
 
-	client, err := c.clusterClient.KubernetesClient()
+	client, err := cluster.ClientOrDefault(ctx, c.clusterClient).KubernetesClient()
 	if err != nil {
 		return err
 	}
 
-	currentNode, err := client.CoreV1().Nodes().Get(context.TODO(), node.Name, metav1.GetOptions{})
+	currentNode, err := client.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
 	if err != nil {
 		return errors.Wrapf(err, "unable to find node %q", node.Name)
 	}
@@ -120,9 +131,9 @@ func (c *Cordon) Cordon(node *corev1.Node) error {
 
 	patchBytes, patchErr := strategicpatch.CreateTwoWayMergePatch(originalNode, modifiedNode, node)
 	if patchErr != nil {
-		_, err = client.CoreV1().Nodes().Patch(context.TODO(), node.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+		_, err = client.CoreV1().Nodes().Patch(ctx, node.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
 	} else {
-		_, err = client.CoreV1().Nodes().Update(context.TODO(), currentNode, metav1.UpdateOptions{})
+		_, err = client.CoreV1().Nodes().Update(ctx, currentNode, metav1.UpdateOptions{})
 		return errors.Wrapf(err, "failed to cordon %q", node.Name)
 	}
 
@@ -135,7 +146,7 @@ type Uncordon struct {
 	clusterClient cluster.ClientInterface
 }
 
-var _ action.Dispatcher = (*Uncordon)(nil)
+var _ action.CommandDispatcher = (*Uncordon)(nil)
 
 // NewUncordon creates an instances of uncordon
 func NewUncordon(objectStore store.Store, clusterClient cluster.ClientInterface) *Uncordon {
@@ -152,6 +163,16 @@ func (u *Uncordon) ActionName() string {
 	return "action.octant.dev/uncordon"
 }
 
+// Command returns the command palette entry for uncordoning a node
+func (u *Uncordon) Command() action.Command {
+	return action.Command{
+		Name:        u.ActionName(),
+		Title:       "Uncordon Node",
+		Description: "Mark a node as schedulable",
+		Fields:      nodeCommandFields(),
+	}
+}
+
 // Handle executing uncordon
 func (u *Uncordon) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
 	logger := log.From(ctx).With("actionName", u.ActionName())
@@ -178,7 +199,8 @@ func (u *Uncordon) Handle(ctx context.Context, alerter action.Alerter, payload a
 
 	message := fmt.Sprintf("Node %q marked as schedulable", key.Name)
 	alertType := action.AlertTypeInfo
-	if err := u.Uncordon(node); err != nil {
+	err = u.Uncordon(ctx, node)
+	if err != nil {
 		message = fmt.Sprintf("Unable to uncordon node %q: %s", key.Name, err)
 		alertType = action.AlertTypeWarning
 		logger := log.From(ctx)
@@ -186,21 +208,21 @@ func (u *Uncordon) Handle(ctx context.Context, alerter action.Alerter, payload a
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
-	return nil
+	return err
 }
 
 // Uncordon marks a node as schedulable
-func (u *Uncordon) Uncordon(node *corev1.Node) error {
+func (u *Uncordon) Uncordon(ctx context.Context, node *corev1.Node) error {
 	if node == nil {
 		return errors.New("nil node")
 	}
 
-	client, err := u.clusterClient.KubernetesClient()
+	client, err := cluster.ClientOrDefault(ctx, u.clusterClient).KubernetesClient()
 	if err != nil {
 		return err
 	}
 
-	currentNode, err := client.CoreV1().Nodes().Get(context.TODO(), node.Name, metav1.GetOptions{})
+	currentNode, err := client.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
 	if err != nil {
 		return errors.Wrapf(err, "unable to find node %q", node.Name)
 	}
@@ -223,11 +245,19 @@ func (u *Uncordon) Uncordon(node *corev1.Node) error {
 
 	patchBytes, patchErr := strategicpatch.CreateTwoWayMergePatch(originalNode, modifiedNode, node)
 	if patchErr != nil {
-		_, err = client.CoreV1().Nodes().Patch(context.TODO(), node.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+		_, err = client.CoreV1().Nodes().Patch(ctx, node.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
 	} else {
-		_, err = client.CoreV1().Nodes().Update(context.TODO(), currentNode, metav1.UpdateOptions{})
+		_, err = client.CoreV1().Nodes().Update(ctx, currentNode, metav1.UpdateOptions{})
 		return errors.Wrapf(err, "failed to uncordon %q", node.Name)
 	}
 
 	return err
 }
+
+func nodeCommandFields() []action.CommandField {
+	return []action.CommandField{
+		{Name: "apiVersion", Type: action.CommandFieldTypeHidden, Value: "v1"},
+		{Name: "kind", Type: action.CommandFieldTypeHidden, Value: "Node"},
+		{Name: "name", Label: "Node", Required: true},
+	}
+}
@@ -104,7 +104,12 @@ func Test_Cordon(t *testing.T) {
 				"name":       tc.key.Name,
 			})
 
-			require.NoError(t, cordon.Handle(ctx, alerter, payload))
+			err = cordon.Handle(ctx, alerter, payload)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -188,7 +193,12 @@ func Test_Uncordon(t *testing.T) {
 				"name":       tc.key.Name,
 			})
 
-			require.NoError(t, uncordon.Handle(ctx, alerter, payload))
+			err = uncordon.Handle(ctx, alerter, payload)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
 		})
 	}
 }
@@ -74,26 +74,28 @@ func (c *CronJobTrigger) Handle(ctx context.Context, alerter action.Alerter, pay
 
 	newJobName := createJobName(cronjob.Name)
 
-	var message string
+	message := fmt.Sprintf("Job %s created", newJobName)
 	alertType := action.AlertTypeInfo
-	if err := c.Trigger(newJobName, cronjob); err != nil {
+	err = c.Trigger(ctx, newJobName, cronjob)
+	if err != nil {
 		message = fmt.Sprintf("Unable to create job %q: %s", key.Name, err)
+		alertType = action.AlertTypeWarning
 		logger := log.From(ctx)
 		logger.WithErr(err).Errorf("trigger cronjob")
+		err = errors.Wrapf(err, "create job %q", newJobName)
 	}
-	message = fmt.Sprintf("Job %s created", newJobName)
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
-	return nil
+	return err
 }
 
 // Trigger manually creates a new job
-func (c *CronJobTrigger) Trigger(name string, cronJob *batchv1beta1.CronJob) error {
+func (c *CronJobTrigger) Trigger(ctx context.Context, name string, cronJob *batchv1beta1.CronJob) error {
 	if cronJob == nil {
 		return errors.New("nil cronjob")
 	}
 
-	client, err := c.clusterClient.KubernetesClient()
+	client, err := cluster.ClientOrDefault(ctx, c.clusterClient).KubernetesClient()
 	if err != nil {
 		return err
 	}
@@ -116,7 +118,7 @@ func (c *CronJobTrigger) Trigger(name string, cronJob *batchv1beta1.CronJob) err
 		Spec: cronJob.Spec.JobTemplate.Spec,
 	}
 
-	_, err = client.BatchV1().Jobs(cronJob.Namespace).Create(context.TODO(), jobToCreate, metav1.CreateOptions{})
+	_, err = client.BatchV1().Jobs(cronJob.Namespace).Create(ctx, jobToCreate, metav1.CreateOptions{})
 	if err != nil {
 		return err
 	}
@@ -185,7 +187,7 @@ func (c *CronJobSuspend) Handle(ctx context.Context, alerter action.Alerter, pay
 
 	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cronjob)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	unstructuredCronJob := &unstructured.Unstructured{Object: m}
@@ -216,6 +218,7 @@ func (c *CronJobSuspend) Handle(ctx context.Context, alerter action.Alerter, pay
 			fmt.Sprintf("update: %s", err.Error()),
 			&expiration,
 		)
+		return errors.Wrap(err, "update cronjob")
 	}
 
 	successMessage := fmt.Sprintf("Suspending %s (%s) %s in %s",
@@ -278,7 +281,7 @@ func (c *CronJobResume) Handle(ctx context.Context, alerter action.Alerter, payl
 
 	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cronjob)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	unstructuredCronJob := &unstructured.Unstructured{Object: m}
@@ -309,6 +312,7 @@ func (c *CronJobResume) Handle(ctx context.Context, alerter action.Alerter, payl
 			fmt.Sprintf("update: %s", err.Error()),
 			&expiration,
 		)
+		return errors.Wrap(err, "update cronjob")
 	}
 
 	successMessage := fmt.Sprintf("Resuming %s (%s) %s in %s",
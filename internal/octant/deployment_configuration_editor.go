@@ -66,14 +66,16 @@ func (e *DeploymentConfigurationEditor) Handle(ctx context.Context, alerter acti
 
 	alertType := action.AlertTypeInfo
 	message := fmt.Sprintf("Updated Deployment %q", name)
-	if err := e.store.Update(ctx, key, fn); err != nil {
+	err = e.store.Update(ctx, key, fn)
+	if err != nil {
 		alertType = action.AlertTypeWarning
 		message = fmt.Sprintf("Unable to update Deployment %q: %s", name, err)
+		err = fmt.Errorf("update Deployment %q: %w", name, err)
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
 
-	return nil
+	return err
 }
 
 func roundToInt(val float64) int64 {
@@ -14,9 +14,11 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/apidiscovery"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/event"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
@@ -36,12 +38,24 @@ func ObjectUpdateFromPayload(payload action.Payload) (*unstructured.Unstructured
 	return object, nil
 }
 
+// ObjectValidator validates the YAML source of an object.
+type ObjectValidator func(ctx context.Context, source []byte) ([]apidiscovery.ValidationError, error)
+
 type ObjectUpdaterDispatcherOption func(dispatcher *ObjectUpdaterDispatcher)
 
+// WithObjectValidator configures a validator for objects. Objects with validation errors
+// are not updated.
+func WithObjectValidator(validator ObjectValidator) ObjectUpdaterDispatcherOption {
+	return func(dispatcher *ObjectUpdaterDispatcher) {
+		dispatcher.validator = validator
+	}
+}
+
 // ObjectUpdaterDispatcher is an action that updates an object.
 type ObjectUpdaterDispatcher struct {
 	store             store.Store
 	objectFromPayload func(payload action.Payload) (*unstructured.Unstructured, error)
+	validator         ObjectValidator
 }
 
 var _ action.Dispatcher = &ObjectUpdaterDispatcher{}
@@ -70,6 +84,15 @@ func (o ObjectUpdaterDispatcher) Handle(ctx context.Context, alerter action.Aler
 	logger := log.From(ctx)
 	expiration := time.Now().Add(10 * time.Second)
 
+	if !o.validate(ctx, alerter, payload) {
+		sendAlert(
+			alerter,
+			action.AlertTypeError,
+			"Object was not updated since it does not match the schema of its kind",
+			&expiration)
+		return fmt.Errorf("object does not match the schema of its kind")
+	}
+
 	object, err := o.objectFromPayload(payload)
 	if err != nil {
 		sendAlert(
@@ -77,7 +100,7 @@ func (o ObjectUpdaterDispatcher) Handle(ctx context.Context, alerter action.Aler
 			action.AlertTypeError,
 			fmt.Sprintf("load object from payload: %v", err.Error()),
 			&expiration)
-		return nil
+		return fmt.Errorf("load object from payload: %w", err)
 	}
 
 	key, _ := store.KeyFromPayload(payload)
@@ -108,7 +131,7 @@ func (o ObjectUpdaterDispatcher) Handle(ctx context.Context, alerter action.Aler
 			&expiration)
 
 		logger.WithErr(err).Errorf("update object")
-		return nil
+		return fmt.Errorf("update object: %w", err)
 	}
 
 	successMessage := fmt.Sprintf("Updated %s (%s) %s in %s",
@@ -120,3 +143,46 @@ func (o ObjectUpdaterDispatcher) Handle(ctx context.Context, alerter action.Aler
 
 	return nil
 }
+
+// validate validates the object source in the payload, and sends the validation errors
+// to the client. It returns false if the object is invalid. Objects which can't be
+// validated, e.g. because the schema of their kind is not published, are valid.
+func (o ObjectUpdaterDispatcher) validate(ctx context.Context, alerter action.Alerter, payload action.Payload) bool {
+	if o.validator == nil {
+		return true
+	}
+
+	source, err := payload.String("update")
+	if err != nil {
+		return true
+	}
+
+	validationErrors, err := o.validator(ctx, []byte(source))
+	if err != nil {
+		log.From(ctx).WithErr(err).Debugf("unable to validate object")
+		return true
+	}
+
+	if sender, ok := alerter.(action.EventSender); ok {
+		sender.SendEvent(CreateValidationEvent(payload, validationErrors))
+	}
+
+	return len(validationErrors) == 0
+}
+
+// CreateValidationEvent creates an event with the validation errors of an object. The
+// event includes the key of the object from the payload so editors can find their errors.
+func CreateValidationEvent(payload action.Payload, validationErrors []apidiscovery.ValidationError) event.Event {
+	if validationErrors == nil {
+		validationErrors = []apidiscovery.ValidationError{}
+	}
+
+	data := action.Payload{"errors": validationErrors}
+	for _, field := range []string{"namespace", "apiVersion", "kind", "name"} {
+		if value, err := payload.String(field); err == nil {
+			data[field] = value
+		}
+	}
+
+	return event.CreateEvent(event.EventTypeValidation, data)
+}
@@ -15,10 +15,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/apidiscovery"
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/event"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
 )
@@ -119,6 +121,7 @@ func TestObjectUpdaterDispatcher_Handle(t *testing.T) {
 				objectStore := storeFake.NewMockStore(ctrl)
 				return objectStore
 			},
+			wantErr: true,
 			initAlerter: func(ctrl *gomock.Controller) *actionFake.MockAlerter {
 				alerter := actionFake.NewMockAlerter(ctrl)
 				alerter.EXPECT().
@@ -142,6 +145,7 @@ func TestObjectUpdaterDispatcher_Handle(t *testing.T) {
 
 				return objectStore
 			},
+			wantErr: true,
 			initAlerter: func(ctrl *gomock.Controller) *actionFake.MockAlerter {
 				alerter := actionFake.NewMockAlerter(ctrl)
 				alerter.EXPECT().
@@ -176,3 +180,110 @@ func TestObjectUpdaterDispatcher_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectUpdaterDispatcher_Handle_validation(t *testing.T) {
+	pod := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+	podKey, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+	podPayload := action.Payload{
+		"namespace":  pod.GetNamespace(),
+		"apiVersion": pod.GetAPIVersion(),
+		"kind":       pod.GetKind(),
+		"name":       pod.GetName(),
+		"update":     "source",
+	}
+
+	validationError := apidiscovery.ValidationError{Line: 3, Column: 5, Field: "spec.replica", Message: "unknown field"}
+
+	tests := []struct {
+		name             string
+		validationErrors []apidiscovery.ValidationError
+		validationErr    error
+		wantEvent        bool
+		wantUpdate       bool
+	}{
+		{
+			name:       "valid",
+			wantEvent:  true,
+			wantUpdate: true,
+		},
+		{
+			name:             "invalid",
+			validationErrors: []apidiscovery.ValidationError{validationError},
+			wantEvent:        true,
+		},
+		{
+			name:          "unable to validate",
+			validationErr: fmt.Errorf("no schema"),
+			wantUpdate:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			objectStore := storeFake.NewMockStore(ctrl)
+			alerter := actionFake.NewMockAlerter(ctrl)
+			eventSender := actionFake.NewMockEventSender(ctrl)
+
+			if test.wantEvent {
+				eventSender.EXPECT().SendEvent(CreateValidationEvent(podPayload, test.validationErrors))
+			}
+			if test.wantUpdate {
+				objectStore.EXPECT().Update(gomock.Any(), podKey, gomock.Any()).Return(nil)
+				alerter.EXPECT().SendAlert(gomock.Any()).
+					DoAndReturn(func(alert action.Alert) {
+						require.Equal(t, action.AlertTypeInfo, alert.Type)
+					})
+			} else {
+				alerter.EXPECT().SendAlert(gomock.Any()).
+					DoAndReturn(func(alert action.Alert) {
+						require.Equal(t, action.AlertTypeError, alert.Type)
+					})
+			}
+
+			validator := func(ctx context.Context, source []byte) ([]apidiscovery.ValidationError, error) {
+				require.Equal(t, "source", string(source))
+				return test.validationErrors, test.validationErr
+			}
+
+			o := NewObjectUpdaterDispatcher(objectStore,
+				WithObjectValidator(validator),
+				func(dispatcher *ObjectUpdaterDispatcher) {
+					dispatcher.objectFromPayload = func(payload action.Payload) (*unstructured.Unstructured, error) {
+						return pod, nil
+					}
+				})
+
+			client := struct {
+				*actionFake.MockAlerter
+				*actionFake.MockEventSender
+			}{alerter, eventSender}
+			err := o.Handle(context.Background(), client, podPayload)
+			if !test.wantUpdate {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateValidationEvent(t *testing.T) {
+	payload := action.Payload{"namespace": "default", "apiVersion": "v1", "kind": "Pod", "name": "pod", "update": "source"}
+
+	got := CreateValidationEvent(payload, nil)
+
+	expected := event.Event{
+		Type: event.EventTypeValidation,
+		Data: map[string]interface{}{
+			"namespace":  "default",
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"name":       "pod",
+			"errors":     []apidiscovery.ValidationError{},
+		},
+	}
+	require.Equal(t, expected, got)
+}
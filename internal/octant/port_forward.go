@@ -24,7 +24,7 @@ type PortForward struct {
 	portForwarder portforward.PortForwarder
 }
 
-var _ action.Dispatcher = (*PortForward)(nil)
+var _ action.CommandDispatcher = (*PortForward)(nil)
 
 // NewPortForward creates an instance of PortForward
 func NewPortForward(logger log.Logger, objectStore store.Store, portForwarder portforward.PortForwarder) *PortForward {
@@ -37,7 +37,24 @@ func NewPortForward(logger log.Logger, objectStore store.Store, portForwarder po
 
 // ActionName returns the name of this action
 func (p *PortForward) ActionName() string {
-	return "overview/startPortForward"
+	return ActionStartPortForward
+}
+
+// Command returns the command palette entry for starting a port forward
+func (p *PortForward) Command() action.Command {
+	return action.Command{
+		Name:        p.ActionName(),
+		Title:       "Start Port Forward",
+		Description: "Forward a local port to a pod",
+		Shortcut:    "alt+shift+f",
+		Fields: []action.CommandField{
+			{Name: "apiVersion", Type: action.CommandFieldTypeHidden, Value: "v1"},
+			{Name: "kind", Type: action.CommandFieldTypeHidden, Value: "Pod"},
+			{Name: "namespace", Label: "Namespace", Required: true},
+			{Name: "name", Label: "Pod", Required: true},
+			{Name: "port", Label: "Port", Type: action.CommandFieldTypeNumber, Required: true},
+		},
+	}
 }
 
 // Handle starts a port forward
@@ -76,7 +93,7 @@ func NewPortForwardDelete(logger log.Logger, objectStore store.Store, portForwar
 
 // ActionName returns the name of this action
 func (p *PortForwardDelete) ActionName() string {
-	return "overview/stopPortForward"
+	return ActionStopPortForward
 }
 
 // Handle stops a port forward
@@ -71,12 +71,14 @@ func (s *ServiceConfigurationEditor) Handle(ctx context.Context, alerter action.
 
 	alertType := action.AlertTypeInfo
 	message := fmt.Sprintf("Updated Service %q", name)
-	if err := s.store.Update(ctx, key, fn); err != nil {
+	err = s.store.Update(ctx, key, fn)
+	if err != nil {
 		alertType = action.AlertTypeWarning
 		message = fmt.Sprintf("Unable to update Service %q: %s", name, err)
+		err = errors.Wrapf(err, "update Service %q", name)
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
 
-	return nil
+	return err
 }
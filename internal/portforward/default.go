@@ -23,9 +23,10 @@ func Default(ctx context.Context, client cluster.ClientInterface, objectStore st
 	}
 
 	pfOpts := ServiceOptions{
-		RESTClient:  restClient,
-		Config:      client.RESTConfig(),
-		ObjectStore: objectStore,
+		ClusterClient: client,
+		RESTClient:    restClient,
+		Config:        client.RESTConfig(),
+		ObjectStore:   objectStore,
 		PortForwarder: &DefaultPortForwarder{
 			IOStreams: IOStreams{
 				In:     os.Stdin,
@@ -23,7 +23,9 @@ import (
 	restclient "k8s.io/client-go/rest"
 
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
@@ -108,6 +110,10 @@ type States struct {
 
 // ServiceOptions contains all the options for running a port-forward service
 type ServiceOptions struct {
+	// ClusterClient creates the REST client port forwards are made with. The cluster client
+	// in a request's context is used instead if there is one, so port forwards created for a
+	// user are authorized with the user's RBAC. If it is nil, RESTClient and Config are used.
+	ClusterClient cluster.ClientInterface
 	RESTClient    rest.Interface
 	Config        *restclient.Config
 	ObjectStore   store.Store
@@ -290,7 +296,7 @@ func (s *Service) verifyPod(ctx context.Context, namespace, name string) (bool,
 // createForwarder creates a port forwarder, forwards traffic, and blocks until
 // port state information is populated.
 // Returns forwarder id.
-func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequest CreateRequest) (string, error) {
+func (s *Service) createForwarder(ctx context.Context, alerter action.Alerter, targetRequest, podRequest CreateRequest) (string, error) {
 	logger := s.logger.With("context", "PortForwardService.createForwarder")
 
 	if s.opts.PortForwarder == nil {
@@ -323,6 +329,11 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	}
 	podGvk := podGv.WithKind(podRequest.Kind)
 
+	restClient, config, err := s.restClient(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "fetching RESTClient")
+	}
+
 	// This child context will be cancelled if our parent context is cancelled
 	ctx, cancel := context.WithCancel(s.ctx)
 
@@ -331,8 +342,8 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 
 	o := &s.opts
 	opts := Options{
-		Config:        o.Config,
-		RESTClient:    o.RESTClient,
+		Config:        config,
+		RESTClient:    restClient,
 		Address:       []string{"localhost"},
 		Ports:         ports,
 		PortForwarder: o.PortForwarder,
@@ -365,13 +376,16 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	s.state.portForwards[forwarderID] = forwardState
 	s.state.Unlock()
 
-	req := o.RESTClient.Post().
+	req := restClient.Post().
 		Resource("pods").
 		Namespace(podRequest.Namespace).
 		Name(podRequest.Name).
 		SubResource("portforward")
 
 	go func() {
+		metrics.PortForwardStarted()
+		defer metrics.PortForwardStopped()
+
 		// Blocks until forwarder completes
 		logger.With("url", req.URL()).Debugf("starting port-forward")
 		err := s.opts.PortForwarder.ForwardPorts(alerter, "POST", req.URL(), opts)
@@ -402,6 +416,22 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 	return forwarderID, nil
 }
 
+// restClient returns the REST client and config a port forward requested with ctx is made
+// with.
+func (s *Service) restClient(ctx context.Context) (rest.Interface, *restclient.Config, error) {
+	client := cluster.ClientOrDefault(ctx, s.opts.ClusterClient)
+	if client == nil {
+		return s.opts.RESTClient, s.opts.Config, nil
+	}
+
+	restClient, err := client.RESTClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return restClient, client.RESTConfig(), nil
+}
+
 // responseForCreate creates a create response based on the state for the specified forward (by id)
 func (s *Service) responseForCreate(id string) (CreateResponse, error) {
 	var response CreateResponse
@@ -519,7 +549,7 @@ func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema
 	podReq.Name = podName
 	podReq.Kind = "Pod"
 
-	id, err := s.createForwarder(alerter, req, CreateRequest{
+	id, err := s.createForwarder(ctx, alerter, req, CreateRequest{
 		Namespace:  req.Namespace,
 		APIVersion: req.APIVersion,
 		Kind:       "Pod",
@@ -60,7 +60,7 @@ func APIServiceListHandler(ctx context.Context, list *apiregistrationv1.APIServi
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // APIServiceHandler is a printFunc that prints a api service
@@ -42,7 +42,7 @@ func ClusterRoleListHandler(ctx context.Context, list *rbacv1.ClusterRoleList, o
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ClusterRoleHandler is a printFunc that prints a cluster role
@@ -50,7 +50,7 @@ func ClusterRoleBindingListHandler(ctx context.Context, clusterRoleBindingList *
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func roleLinkFromClusterRoleBinding(clusterRoleBinding *rbacv1.ClusterRoleBinding, options Options) (*component.Link, error) {
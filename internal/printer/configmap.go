@@ -51,7 +51,7 @@ func ConfigMapListHandler(ctx context.Context, list *corev1.ConfigMapList, opts
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ConfigMapHandler is a printFunc that prints a ConfigMap
@@ -56,7 +56,7 @@ func CronJobListHandler(ctx context.Context, list *batchv1beta1.CronJobList, opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func addCronJobActions(c batchv1beta1.CronJob, row component.TableRow) error {
@@ -58,7 +58,7 @@ func CustomResourceDefinitionListHandler(
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // CustomResourceDefinitionHandler is a print func that prints a custom resource definition.
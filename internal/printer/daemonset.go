@@ -47,7 +47,7 @@ func DaemonSetListHandler(ctx context.Context, list *appsv1.DaemonSetList, opts
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // DaemonSetHandler is a printFunc that prints a daemon set
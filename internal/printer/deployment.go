@@ -59,7 +59,7 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // DeploymentHandler is a printFunc that prints a Deployments.
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// EventAttributeType is the timeline attribute containing the type of an event.
+	EventAttributeType = "Type"
+	// EventAttributeReason is the timeline attribute containing the reason of an event.
+	EventAttributeReason = "Reason"
+	// EventAttributeObject is the timeline attribute naming the object of an event.
+	EventAttributeObject = "Object"
+)
+
+// eventKey identifies events which are merged when aggregated.
+type eventKey struct {
+	object    string
+	kind      string
+	name      string
+	eventType string
+	reason    string
+	message   string
+}
+
+// AggregateEvents merges events about the same object with the same type, reason and
+// message. Merged events have the total count, the first time any of them was seen and
+// the last time any of them was seen. Events are returned last seen first.
+func AggregateEvents(events []corev1.Event) []corev1.Event {
+	var keys []eventKey
+	merged := make(map[eventKey]*corev1.Event)
+	seen := make(map[types.UID]bool)
+
+	for i := range events {
+		event := events[i]
+		if event.UID != "" {
+			if seen[event.UID] {
+				continue
+			}
+			seen[event.UID] = true
+		}
+
+		if event.Count < 1 {
+			event.Count = 1
+		}
+		first, last := eventTimes(event)
+		event.FirstTimestamp.Time, event.LastTimestamp.Time = first, last
+
+		key := eventKey{
+			object:    string(event.InvolvedObject.UID),
+			kind:      event.InvolvedObject.Kind,
+			name:      event.InvolvedObject.Name,
+			eventType: event.Type,
+			reason:    event.Reason,
+			message:   event.Message,
+		}
+
+		current, ok := merged[key]
+		if !ok {
+			keys = append(keys, key)
+			merged[key] = &event
+			continue
+		}
+
+		current.Count += event.Count
+		if first.Before(current.FirstTimestamp.Time) {
+			current.FirstTimestamp.Time = first
+		}
+		if last.After(current.LastTimestamp.Time) {
+			current.LastTimestamp.Time = last
+		}
+	}
+
+	list := make([]corev1.Event, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, *merged[key])
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].LastTimestamp.Time.After(list[j].LastTimestamp.Time)
+	})
+
+	return list
+}
+
+// EventTimeline creates a timeline of events. Events can be filtered by type and reason,
+// and grouped by object.
+func EventTimeline(events []corev1.Event) *component.Timeline {
+	timeline := component.NewTimeline([]component.TimelineStep{}, true)
+
+	for _, event := range events {
+		state := component.TimelineStepSuccess
+		if event.Type == corev1.EventTypeWarning {
+			state = component.TimelineStepError
+		}
+
+		object := fmt.Sprintf("%s %s", event.InvolvedObject.Kind, event.InvolvedObject.Name)
+
+		title := event.Reason
+		if event.Count > 1 {
+			title = fmt.Sprintf("%s (%d)", event.Reason, event.Count)
+		}
+
+		timeline.Add(component.TimelineStep{
+			State:       state,
+			Header:      event.LastTimestamp.Time.UTC().Format(time.RFC3339),
+			Title:       title,
+			Description: fmt.Sprintf("%s: %s", object, event.Message),
+			Attributes: map[string]string{
+				EventAttributeType:   event.Type,
+				EventAttributeReason: event.Reason,
+				EventAttributeObject: object,
+			},
+		})
+	}
+
+	timeline.AddFilter(EventAttributeType)
+	timeline.AddFilter(EventAttributeReason)
+	timeline.SetGroupBy(EventAttributeObject)
+
+	return timeline
+}
+
+// eventTimes returns the first and last time an event was seen. Events created with the
+// events.k8s.io API only have an event time.
+func eventTimes(event corev1.Event) (time.Time, time.Time) {
+	first, last := event.FirstTimestamp.Time, event.LastTimestamp.Time
+
+	fallback := event.EventTime.Time
+	if fallback.IsZero() {
+		fallback = event.CreationTimestamp.Time
+	}
+
+	if first.IsZero() {
+		first = fallback
+	}
+	if last.IsZero() {
+		last = first
+	}
+	return first, last
+}
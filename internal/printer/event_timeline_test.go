@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestAggregateEvents(t *testing.T) {
+	now := time.Unix(1633089600, 0).UTC()
+
+	event := func(uid, object, reason string, count int32, first, last time.Time) corev1.Event {
+		return corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid)},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Pod",
+				Name: object,
+				UID:  types.UID(object),
+			},
+			Type:           corev1.EventTypeWarning,
+			Reason:         reason,
+			Message:        reason + " message",
+			Count:          count,
+			FirstTimestamp: metav1.NewTime(first),
+			LastTimestamp:  metav1.NewTime(last),
+		}
+	}
+
+	eventTime := corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{UID: "event-time"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "pod-b", UID: "pod-b"},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "Scheduled",
+		EventTime:      metav1.NewMicroTime(now.Add(-time.Hour)),
+	}
+
+	events := []corev1.Event{
+		event("1", "pod-a", "BackOff", 3, now.Add(-10*time.Minute), now.Add(-5*time.Minute)),
+		event("2", "pod-a", "BackOff", 2, now.Add(-4*time.Minute), now),
+		event("2", "pod-a", "BackOff", 2, now.Add(-4*time.Minute), now),
+		event("3", "pod-b", "BackOff", 1, now.Add(-time.Minute), now.Add(-time.Minute)),
+		eventTime,
+	}
+
+	got := AggregateEvents(events)
+
+	expectedBackOff := event("1", "pod-a", "BackOff", 5, now.Add(-10*time.Minute), now)
+	expectedScheduled := eventTime
+	expectedScheduled.Count = 1
+	expectedScheduled.FirstTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	expectedScheduled.LastTimestamp = metav1.NewTime(now.Add(-time.Hour))
+
+	expected := []corev1.Event{
+		expectedBackOff,
+		event("3", "pod-b", "BackOff", 1, now.Add(-time.Minute), now.Add(-time.Minute)),
+		expectedScheduled,
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestEventTimeline(t *testing.T) {
+	now := time.Unix(1633089600, 0)
+
+	events := []corev1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "pod-a"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			Count:          4,
+			LastTimestamp:  metav1.NewTime(now),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Name: "web"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "ScalingReplicaSet",
+			Message:        "Scaled up replica set web-1 to 3",
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Hour)),
+		},
+	}
+
+	got := EventTimeline(events)
+
+	expected := component.NewTimeline([]component.TimelineStep{
+		{
+			State:       component.TimelineStepError,
+			Header:      "2021-10-01T12:00:00Z",
+			Title:       "BackOff (4)",
+			Description: "Pod pod-a: Back-off restarting failed container",
+			Attributes:  map[string]string{"Type": "Warning", "Reason": "BackOff", "Object": "Pod pod-a"},
+		},
+		{
+			State:       component.TimelineStepSuccess,
+			Header:      "2021-10-01T11:00:00Z",
+			Title:       "ScalingReplicaSet",
+			Description: "Deployment web: Scaled up replica set web-1 to 3",
+			Attributes:  map[string]string{"Type": "Normal", "Reason": "ScalingReplicaSet", "Object": "Deployment web"},
+		},
+	}, true)
+	expected.AddFilter(EventAttributeType)
+	expected.AddFilter(EventAttributeReason)
+	expected.SetGroupBy(EventAttributeObject)
+
+	component.AssertEqual(t, expected, got)
+	assert.Equal(t, []string{"Normal", "Warning"}, got.Config.Filters["Type"].Values)
+}
@@ -38,6 +38,7 @@ func newTestPrinterOptions(controller *gomock.Controller) *testPrinterOptions {
 	objectStore := objectStoreFake.NewMockStore(controller)
 
 	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().ListColumns(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	portForwarder := portForwardFake.NewMockPortForwarder(controller)
 
@@ -64,7 +64,7 @@ func HorizontalPodAutoscalerListHandler(ctx context.Context, list *autoscalingv1
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // HorizontalPodAutoscalerHandler is a printFunc that prints a HorizontalPodAutoscaler
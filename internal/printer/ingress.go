@@ -53,7 +53,7 @@ func IngressListHandler(ctx context.Context, list *networkingv1.IngressList, opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func getHostComponent(link string, isTLS bool) component.Component {
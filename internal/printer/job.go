@@ -52,7 +52,7 @@ func JobListHandler(ctx context.Context, list *batchv1.JobList, opts Options) (c
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 var jobConditionColumns = [][]string{
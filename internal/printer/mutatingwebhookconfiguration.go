@@ -41,7 +41,7 @@ func MutatingWebhookConfigurationListHandler(ctx context.Context, list *admissio
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // MutatingWebhookConfigurationHandler is a printFunc that prints a mutating webhook configurations
@@ -45,7 +45,7 @@ func NamespaceListHandler(ctx context.Context, list *corev1.NamespaceList, optio
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func NamespaceHandler(ctx context.Context, namespace *corev1.Namespace, options Options) (component.Component, error) {
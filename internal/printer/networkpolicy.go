@@ -49,7 +49,7 @@ func NetworkPolicyListHandler(ctx context.Context, list *networkingv1.NetworkPol
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // NetworkPolicyHandler is a printFunc that prints NetworkPolicies
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/internal/utilization"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/pkg/errors"
@@ -62,7 +64,7 @@ func NodeListHandler(ctx context.Context, list *corev1.NodeList, options Options
 	}
 	ot.SetSortOrder("Name", false)
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 var nodeConditionColumns = [][]string{
@@ -92,6 +94,9 @@ func NodeHandler(ctx context.Context, node *corev1.Node, options Options) (compo
 	if err := nh.Resources(options); err != nil {
 		return nil, errors.Wrap(err, "print node resources")
 	}
+	if err := nh.Utilization(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print node utilization")
+	}
 	if err := nh.Images(options); err != nil {
 		return nil, errors.Wrap(err, "print node images")
 	}
@@ -347,7 +352,28 @@ var (
 func createNodePodsView(ctx context.Context, node *corev1.Node, options Options) (*component.Table, error) {
 	table := component.NewTable("Pods", "There are no pods!", nodePodsColumns)
 
-	podList := &corev1.PodList{}
+	pods, err := listNodePods(ctx, node, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods {
+
+		row := component.TableRow{
+			"Name":      component.NewText(pod.Name),
+			"Namespace": component.NewText(pod.Namespace),
+		}
+
+		table.Add(row)
+	}
+
+	table.Sort("Name")
+
+	return table, nil
+}
+
+// listNodePods lists the pods scheduled on a node.
+func listNodePods(ctx context.Context, node *corev1.Node, options Options) ([]corev1.Pod, error) {
 	objectStore := options.DashConfig.ObjectStore()
 
 	key := store.Key{
@@ -360,6 +386,7 @@ func createNodePodsView(ctx context.Context, node *corev1.Node, options Options)
 		return nil, err
 	}
 
+	var pods []corev1.Pod
 	for i := range list.Items {
 		pod := &corev1.Pod{}
 		err := kubernetes.FromUnstructured(&list.Items[i], pod)
@@ -368,40 +395,130 @@ func createNodePodsView(ctx context.Context, node *corev1.Node, options Options)
 		}
 
 		if pod.Spec.NodeName == node.Name {
-			podList.Items = append(podList.Items, *pod)
+			pods = append(pods, *pod)
 		}
 	}
 
-	for _, pod := range podList.Items {
+	return pods, nil
+}
 
-		row := component.TableRow{
-			"Name":      component.NewText(pod.Name),
-			"Namespace": component.NewText(pod.Namespace),
-		}
+// loadNodeUtilization computes the utilization of a node. Usage is only included if
+// metrics-server is available.
+func loadNodeUtilization(ctx context.Context, node *corev1.Node, options Options) (utilization.Node, error) {
+	pods, err := listNodePods(ctx, node, options)
+	if err != nil {
+		return utilization.Node{}, err
+	}
 
-		table.Add(row)
+	nodeUsage, err := utilization.LoadNodeUsage(ctx, options.DashConfig.ClusterClient())
+	if err != nil {
+		log.From(ctx).With("err", err).Debugf("unable to load node metrics")
 	}
 
-	table.Sort("Name")
+	podUsage := utilization.HistoryPodUsage(options.DashConfig.MetricsHistory())
+	list := utilization.ForNodes([]corev1.Node{*node}, pods, nodeUsage, podUsage)
+
+	return list[0], nil
+}
+
+var (
+	nodeUtilizationColumns = component.NewTableCols("Resource", "Usage", "Requests", "Limits", "Allocatable")
+)
+
+func createNodeUtilizationView(n utilization.Node) *component.Table {
+	table := component.NewTable("Utilization", "There is no utilization!", nodeUtilizationColumns)
+
+	table.Add([]component.TableRow{
+		{
+			"Resource":    component.NewText("CPU"),
+			"Usage":       component.NewText(formatNodeUsage(n.CPU, utilization.FormatCPU)),
+			"Requests":    component.NewText(formatNodeAmount(n.CPU, n.CPU.Requests, utilization.FormatCPU)),
+			"Limits":      component.NewText(formatNodeAmount(n.CPU, n.CPU.Limits, utilization.FormatCPU)),
+			"Allocatable": component.NewText(utilization.FormatCPU(n.CPU.Allocatable)),
+		},
+		{
+			"Resource":    component.NewText("Memory"),
+			"Usage":       component.NewText(formatNodeUsage(n.Memory, utilization.FormatMemory)),
+			"Requests":    component.NewText(formatNodeAmount(n.Memory, n.Memory.Requests, utilization.FormatMemory)),
+			"Limits":      component.NewText(formatNodeAmount(n.Memory, n.Memory.Limits, utilization.FormatMemory)),
+			"Allocatable": component.NewText(utilization.FormatMemory(n.Memory.Allocatable)),
+		},
+		{
+			"Resource":    component.NewText("Pods"),
+			"Usage":       component.NewText(fmt.Sprintf("%d", len(n.Pods))),
+			"Requests":    component.NewText(""),
+			"Limits":      component.NewText(""),
+			"Allocatable": component.NewText(fmt.Sprintf("%d", n.MaxPods)),
+		},
+	}...)
+
+	return table
+}
+
+func formatNodeUsage(r utilization.Resource, format func(int64) string) string {
+	if !r.HasUsage {
+		return "-"
+	}
+	return formatNodeAmount(r, r.Usage, format)
+}
+
+func formatNodeAmount(r utilization.Resource, value int64, format func(int64) string) string {
+	return fmt.Sprintf("%s (%s)", format(value), utilization.FormatPercent(r.Fraction(value)))
+}
+
+// nodeTopConsumersLimit is the number of pods listed as top consumers of a node.
+const nodeTopConsumersLimit = 10
+
+var (
+	nodeTopConsumersColumns = component.NewTableCols("Name", "Namespace", "CPU Usage", "CPU Requests", "Memory Usage", "Memory Requests")
+)
+
+func createNodeTopConsumersView(n utilization.Node, options Options) (*component.Table, error) {
+	table := component.NewTable("Top Consumers", "There are no pods!", nodeTopConsumersColumns)
+
+	for _, pod := range n.TopConsumers(nodeTopConsumersLimit) {
+		nameLink, err := options.Link.ForGVK(pod.Namespace, "v1", "Pod", pod.Name, pod.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		table.Add(component.TableRow{
+			"Name":            nameLink,
+			"Namespace":       component.NewText(pod.Namespace),
+			"CPU Usage":       component.NewText(formatPodUsage(pod.CPU, utilization.FormatCPU)),
+			"CPU Requests":    component.NewText(utilization.FormatCPU(pod.CPU.Requests)),
+			"Memory Usage":    component.NewText(formatPodUsage(pod.Memory, utilization.FormatMemory)),
+			"Memory Requests": component.NewText(utilization.FormatMemory(pod.Memory.Requests)),
+		})
+	}
 
 	return table, nil
 }
 
+func formatPodUsage(r utilization.PodResource, format func(int64) string) string {
+	if !r.HasUsage {
+		return "-"
+	}
+	return format(r.Usage)
+}
+
 type nodeObject interface {
 	Config(options Options) error
 	Addresses(options Options) error
 	Resources(options Options) error
+	Utilization(ctx context.Context, options Options) error
 	Images(options Options) error
 }
 
 type nodeHandler struct {
-	node          *corev1.Node
-	configFunc    func(*corev1.Node, Options) (*component.Summary, error)
-	addressesFunc func(*corev1.Node, Options) (*component.Table, error)
-	resourcesFunc func(*corev1.Node, Options) (*component.Table, error)
-	imagesFunc    func(*corev1.Node, Options) (*component.Table, error)
-	podsFunc      func(context.Context, *corev1.Node, Options) (*component.Table, error)
-	object        *Object
+	node            *corev1.Node
+	configFunc      func(*corev1.Node, Options) (*component.Summary, error)
+	addressesFunc   func(*corev1.Node, Options) (*component.Table, error)
+	resourcesFunc   func(*corev1.Node, Options) (*component.Table, error)
+	utilizationFunc func(context.Context, *corev1.Node, Options) (utilization.Node, error)
+	imagesFunc      func(*corev1.Node, Options) (*component.Table, error)
+	podsFunc        func(context.Context, *corev1.Node, Options) (*component.Table, error)
+	object          *Object
 }
 
 var _ nodeObject = (*nodeHandler)(nil)
@@ -416,13 +533,14 @@ func newNodeHandler(node *corev1.Node, object *Object) (*nodeHandler, error) {
 	}
 
 	nh := &nodeHandler{
-		node:          node,
-		configFunc:    defaultNodeConfig,
-		addressesFunc: defaultNodeAddresses,
-		resourcesFunc: defaultNodeResources,
-		imagesFunc:    defaultNodeImages,
-		podsFunc:      defaultNodePods,
-		object:        object,
+		node:            node,
+		configFunc:      defaultNodeConfig,
+		addressesFunc:   defaultNodeAddresses,
+		resourcesFunc:   defaultNodeResources,
+		utilizationFunc: loadNodeUtilization,
+		imagesFunc:      defaultNodeImages,
+		podsFunc:        defaultNodePods,
+		object:          object,
 	}
 	return nh, nil
 }
@@ -476,6 +594,30 @@ func defaultNodeResources(node *corev1.Node, options Options) (*component.Table,
 	return createNodeResourcesView(node)
 }
 
+func (n *nodeHandler) Utilization(ctx context.Context, options Options) error {
+	if n.node == nil {
+		return errors.New("can't display utilization for nil node")
+	}
+
+	u, err := n.utilizationFunc(ctx, n.node, options)
+	if err != nil {
+		return err
+	}
+
+	n.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createNodeUtilizationView(u), nil
+		},
+	}, ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return createNodeTopConsumersView(u, options)
+		},
+	})
+	return nil
+}
+
 func (n *nodeHandler) Images(options Options) error {
 	if n.node == nil {
 		return errors.New("can't display resources for nil node")
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/utilization"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -281,6 +282,90 @@ func Test_createNodeResourcesView(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_createNodeUtilizationView(t *testing.T) {
+	n := utilization.Node{
+		Name:    "node-1",
+		CPU:     utilization.Resource{Usage: 500, HasUsage: true, Requests: 1000, Limits: 1500, Allocatable: 2000},
+		Memory:  utilization.Resource{Requests: 1 << 30, Limits: 2 << 30, Allocatable: 4 << 30},
+		Pods:    []utilization.Pod{{Namespace: "default", Name: "pod"}},
+		MaxPods: 110,
+	}
+
+	got := createNodeUtilizationView(n)
+
+	expected := component.NewTableWithRows("Utilization", "There is no utilization!", nodeUtilizationColumns, []component.TableRow{
+		{
+			"Resource":    component.NewText("CPU"),
+			"Usage":       component.NewText("500m (25%)"),
+			"Requests":    component.NewText("1 (50%)"),
+			"Limits":      component.NewText("1500m (75%)"),
+			"Allocatable": component.NewText("2"),
+		},
+		{
+			"Resource":    component.NewText("Memory"),
+			"Usage":       component.NewText("-"),
+			"Requests":    component.NewText("1Gi (25%)"),
+			"Limits":      component.NewText("2Gi (50%)"),
+			"Allocatable": component.NewText("4Gi"),
+		},
+		{
+			"Resource":    component.NewText("Pods"),
+			"Usage":       component.NewText("1"),
+			"Requests":    component.NewText(""),
+			"Limits":      component.NewText(""),
+			"Allocatable": component.NewText("110"),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createNodeTopConsumersView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	tpo.PathForGVK("default", "v1", "Pod", "small", "small", "/small")
+	tpo.PathForGVK("default", "v1", "Pod", "large", "large", "/large")
+
+	n := utilization.Node{
+		Name: "node-1",
+		Pods: []utilization.Pod{
+			{Namespace: "default", Name: "small", CPU: utilization.PodResource{Requests: 100}},
+			{
+				Namespace: "default",
+				Name:      "large",
+				CPU:       utilization.PodResource{Requests: 100, Usage: 750, HasUsage: true},
+				Memory:    utilization.PodResource{Requests: 64 << 20, Usage: 128 << 20, HasUsage: true},
+			},
+		},
+	}
+
+	got, err := createNodeTopConsumersView(n, tpo.ToOptions())
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Top Consumers", "There are no pods!", nodeTopConsumersColumns, []component.TableRow{
+		{
+			"Name":            component.NewLink("", "large", "/large"),
+			"Namespace":       component.NewText("default"),
+			"CPU Usage":       component.NewText("750m"),
+			"CPU Requests":    component.NewText("100m"),
+			"Memory Usage":    component.NewText("128Mi"),
+			"Memory Requests": component.NewText("64Mi"),
+		},
+		{
+			"Name":            component.NewLink("", "small", "/small"),
+			"Namespace":       component.NewText("default"),
+			"CPU Usage":       component.NewText("-"),
+			"CPU Requests":    component.NewText("100m"),
+			"Memory Usage":    component.NewText("-"),
+			"Memory Requests": component.NewText("0"),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
 func Test_createNodeImagesView(t *testing.T) {
 
 	node := testutil.CreateNode("node-1")
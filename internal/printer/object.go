@@ -239,6 +239,16 @@ func (o *Object) ToComponent(ctx context.Context, options Options) (component.Co
 		return nil, fmt.Errorf("generate summary component: %w", err)
 	}
 
+	runbook, err := RunbookCard(o.object, options.Link)
+	if err != nil {
+		return nil, fmt.Errorf("generate runbook component: %w", err)
+	}
+	if runbook != nil {
+		if err := o.flexLayout.AddSection().Add(runbook, component.WidthFull); err != nil {
+			return nil, fmt.Errorf("add runbook to layout: %w", err)
+		}
+	}
+
 	for _, items := range o.itemsLists {
 		section := o.flexLayout.AddSection()
 
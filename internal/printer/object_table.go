@@ -14,18 +14,24 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/objectstatus"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
+// pagedTableThreshold is the number of rows above which object tables are paged by the
+// server instead of sending all rows to clients.
+const pagedTableThreshold = 250
+
 // ObjectTable is a helper for creating a table containing a list of objects.
 type ObjectTable struct {
 	cols          []component.TableCol
 	title         string
 	placeholder   string
 	rows          []component.TableRow
+	objects       []runtime.Object
 	filters       map[string]component.TableFilter
 	sortOrder     *tableSetOrder
 	store         store.Store
@@ -111,10 +117,67 @@ func (ol *ObjectTable) AddRowForObject(ctx context.Context, object runtime.Objec
 	row.AddAction(gridAction)
 
 	ol.rows = append(ol.rows, row)
+	ol.objects = append(ol.objects, object)
+
+	return nil
+}
+
+// addPluginColumns adds the columns plugins contribute for the table's objects and
+// sets their cells in the rows. Plugins are asked for the columns of all objects at
+// once. Columns are added in the order they are first seen. Plugin columns named like
+// one of the table's own columns are ignored, so plugins can't replace their cells.
+func (ol *ObjectTable) addPluginColumns(ctx context.Context) error {
+	if ol.pluginManager == nil || len(ol.objects) == 0 {
+		return nil
+	}
+
+	responses, err := ol.pluginManager.ListColumns(ctx, ol.objects)
+	if err != nil {
+		return fmt.Errorf("get plugin list columns for objects: %w", err)
+	}
+
+	builtIn := map[string]bool{}
+	for _, col := range ol.cols {
+		builtIn[col.Name] = true
+	}
+
+	logger := log.From(ctx)
+	for i, listColumns := range responses {
+		if i >= len(ol.rows) {
+			break
+		}
+		row := ol.rows[i]
+
+		for _, name := range listColumns.Columns {
+			if builtIn[name] {
+				logger.With("column", name).Warnf("ignoring plugin list column with the name of a table column")
+				continue
+			}
+
+			if !ol.hasColumn(name) {
+				ol.cols = append(ol.cols, component.NewTableCols(name)...)
+			}
+
+			if cell, ok := listColumns.Cells[name]; ok && cell != nil {
+				row[name] = cell
+			}
+		}
+	}
+
+	ol.objects = nil
 
 	return nil
 }
 
+func (ol *ObjectTable) hasColumn(name string) bool {
+	for _, col := range ol.cols {
+		if col.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func convertNodeStatusToTextStatus(nodeStatus component.NodeStatus) component.TextStatus {
 	switch nodeStatus {
 	case component.NodeStatusOK:
@@ -165,7 +228,11 @@ func (ol *ObjectTable) SetSortOrder(name string, reverse bool) {
 }
 
 // ToComponent converts the ObjectTable instance to a component.
-func (ol *ObjectTable) ToComponent() (component.Component, error) {
+func (ol *ObjectTable) ToComponent(ctx context.Context) (component.Component, error) {
+	if err := ol.addPluginColumns(ctx); err != nil {
+		return nil, err
+	}
+
 	table := component.NewTableWithRows(ol.title, ol.placeholder, ol.cols, ol.rows)
 
 	for name, filter := range ol.filters {
@@ -179,5 +246,9 @@ func (ol *ObjectTable) ToComponent() (component.Component, error) {
 		}
 	}
 
+	if len(ol.rows) > pagedTableThreshold {
+		table.EnablePaging()
+	}
+
 	return table, nil
 }
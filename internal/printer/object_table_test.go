@@ -57,6 +57,7 @@ func TestObjectTable(t *testing.T) {
 		mutateFn             func(*ObjectTable)
 		wanted               func() *component.Table
 		enablePluginResponse bool
+		enablePluginColumns  bool
 	}{
 		{
 			name: "no mutations",
@@ -165,6 +166,30 @@ func TestObjectTable(t *testing.T) {
 				return table
 			},
 		},
+		{
+			name:                "plugin list columns",
+			enablePluginColumns: true,
+			mutateFn:            func(table *ObjectTable) {},
+			wanted: func() *component.Table {
+				pluginCols := append(component.NewTableCols("A", "B"), component.NewTableCols("Team", "Owner")...)
+				table := component.NewTableWithRows("table", "placeholder", pluginCols, []component.TableRow{
+					{
+						"A":                     pod1A,
+						"B":                     component.NewText("0"),
+						"Team":                  component.NewText("team-pod1"),
+						"Owner":                 component.NewText("owner-pod1"),
+						component.GridActionKey: genDeleteGA(pod1),
+					},
+					{
+						"A":                     pod2A,
+						"B":                     component.NewText("1"),
+						"Team":                  component.NewText("team-pod2"),
+						component.GridActionKey: genDeleteGA(pod2),
+					},
+				})
+				return table
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -186,6 +211,27 @@ func TestObjectTable(t *testing.T) {
 
 				pluginManager.EXPECT().ObjectStatus(context.Background(), pod1).Return(&pluginResponse, nil)
 				pluginManager.EXPECT().ObjectStatus(context.Background(), pod2).Return(&pluginResponse, nil)
+				pluginManager.EXPECT().ListColumns(context.Background(), gomock.Any()).Return(make([]plugin.ListColumnsResponse, 2), nil)
+				ot.EnablePluginStatus(pluginManager)
+			}
+			if test.enablePluginColumns {
+				pluginManager.EXPECT().ObjectStatus(context.Background(), gomock.Any()).Return(&plugin.ObjectStatusResponse{}, nil).Times(2)
+				pluginManager.EXPECT().ListColumns(context.Background(), []runtime.Object{pod1, pod2}).Return([]plugin.ListColumnsResponse{
+					{
+						Columns: []string{"Team", "B", "Owner"},
+						Cells: map[string]component.Component{
+							"Team":  component.NewText("team-pod1"),
+							"B":     component.NewText("plugin-b"),
+							"Owner": component.NewText("owner-pod1"),
+						},
+					},
+					{
+						Columns: []string{"Team"},
+						Cells: map[string]component.Component{
+							"Team": component.NewText("team-pod2"),
+						},
+					},
+				}, nil)
 				ot.EnablePluginStatus(pluginManager)
 			}
 
@@ -198,9 +244,38 @@ func TestObjectTable(t *testing.T) {
 			}
 			test.mutateFn(ot)
 
-			actual, err := ot.ToComponent()
+			actual, err := ot.ToComponent(context.Background())
 			require.NoError(t, err)
 			testutil.AssertJSONEqual(t, test.wanted(), actual)
 		})
 	}
 }
+
+func TestObjectTable_paging(t *testing.T) {
+	cols := component.NewTableCols("A")
+
+	tests := []struct {
+		name     string
+		rows     int
+		expected bool
+	}{
+		{name: "at threshold", rows: pagedTableThreshold},
+		{name: "above threshold", rows: pagedTableThreshold + 1, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ot := NewObjectTable("table", "placeholder", cols, nil)
+			for i := 0; i < test.rows; i++ {
+				ot.rows = append(ot.rows, component.TableRow{"A": component.NewText(fmt.Sprintf("%d", i))})
+			}
+
+			actual, err := ot.ToComponent(context.Background())
+			require.NoError(t, err)
+
+			table, ok := actual.(*component.Table)
+			require.True(t, ok)
+			require.Equal(t, test.expected, table.IsPaged())
+		})
+	}
+}
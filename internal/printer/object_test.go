@@ -30,6 +30,12 @@ func Test_Object_ToComponent(t *testing.T) {
 
 	deployment := testutil.CreateDeployment("deployment")
 
+	runbookDeployment := testutil.CreateDeployment("deployment")
+	runbookDeployment.Annotations = map[string]string{RunbookAnnotation: "Restart the **pods**."}
+
+	runbookCard := component.NewCard(component.TitleFromString("Runbook"))
+	runbookCard.SetBody(component.NewMarkdown(nil, "Restart the **pods**."))
+
 	defaultConfig := component.NewSummary("Configuration",
 		component.SummarySection{Header: "local"})
 
@@ -88,6 +94,22 @@ func Test_Object_ToComponent(t *testing.T) {
 				defaultConfigSection,
 			},
 		},
+		{
+			name:   "runbook annotation",
+			object: runbookDeployment,
+			initFunc: func(o *Object, options *initOptions) {
+				stubPlugins(options.PluginPrinter)
+			},
+			sections: []component.FlexLayoutSection{
+				defaultConfigSection,
+				{
+					{
+						Width: component.WidthFull,
+						View:  runbookCard,
+					},
+				},
+			},
+		},
 		{
 			name:   "config data from plugin",
 			object: deployment,
@@ -56,7 +56,7 @@ func PersistentVolumeListHandler(ctx context.Context, list *corev1.PersistentVol
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // PersistentVolumeHandler is a printFunc that creates a component to display a single Persistent Volume
@@ -76,7 +76,7 @@ func PersistentVolumeClaimListHandler(ctx context.Context, list *corev1.Persiste
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
 	}
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // PersistentVolumeClaimHandler is a printFunc that prints a PersistentVolumeClaim
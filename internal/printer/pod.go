@@ -111,7 +111,7 @@ func PodListHandler(ctx context.Context, list *corev1.PodList, opts Options) (co
 
 	ot.SetSortOrder("Name", false)
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 func podNode(pod *corev1.Pod, linkGenerator link.Interface) (component.Component, error) {
@@ -59,7 +59,7 @@ func ReplicationControllerListHandler(ctx context.Context, list *corev1.Replicat
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ReplicationControllerHandler is a printFunc that prints a ReplicationController
@@ -40,7 +40,7 @@ func RoleListHandler(ctx context.Context, roleList *rbacv1.RoleList, options Opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // RoleHandler is a printFunc that prints roles
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/link"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// RunbookAnnotation is the annotation containing a Markdown runbook for an object.
+const RunbookAnnotation = "octant.dev/runbook"
+
+// RunbookCard creates a card showing the runbook of an object. Relative links in the
+// runbook refer to objects in the object's namespace. It returns nil if the object
+// doesn't have a runbook.
+func RunbookCard(object runtime.Object, l link.Interface) (*component.Card, error) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil, fmt.Errorf("get object metadata: %w", err)
+	}
+
+	runbook := accessor.GetAnnotations()[RunbookAnnotation]
+	if strings.TrimSpace(runbook) == "" {
+		return nil, nil
+	}
+
+	body := component.NewMarkdown(nil, runbook)
+	if l != nil {
+		body.SetLinkResolver(link.MarkdownResolver(l, accessor.GetNamespace()))
+	}
+
+	card := component.NewCard(component.TitleFromString("Runbook"))
+	card.SetBody(body)
+	return card, nil
+}
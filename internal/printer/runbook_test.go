@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestRunbookCard(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	tpo.PathForGVK("namespace", "v1", "ConfigMap", "settings", "settings", "/config-map")
+
+	deployment := testutil.CreateDeployment("deployment")
+
+	t.Run("without runbook", func(t *testing.T) {
+		card, err := RunbookCard(deployment, tpo.link)
+		require.NoError(t, err)
+		assert.Nil(t, card)
+	})
+
+	t.Run("with runbook", func(t *testing.T) {
+		annotated := deployment.DeepCopy()
+		annotated.Annotations = map[string]string{
+			RunbookAnnotation: "## Restart\n\nCheck [settings](v1/ConfigMap/settings) first.\n\n<script>alert(1)</script>",
+		}
+
+		card, err := RunbookCard(annotated, tpo.link)
+		require.NoError(t, err)
+		require.NotNil(t, card)
+
+		markdown, ok := card.Config.Body.(*component.Markdown)
+		require.True(t, ok)
+
+		data, err := json.Marshal(markdown)
+		require.NoError(t, err)
+
+		var actual struct {
+			Config component.MarkdownConfig `json:"config"`
+		}
+		require.NoError(t, json.Unmarshal(data, &actual))
+
+		expected := "<h2>Restart</h2>\n" +
+			"<p>Check <a href=\"/config-map\">settings</a> first.</p>\n" +
+			"<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n"
+		assert.Equal(t, expected, actual.Config.HTML)
+	})
+}
@@ -49,7 +49,7 @@ func SecretListHandler(ctx context.Context, list *corev1.SecretList, options Opt
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // SecretHandler is a printFunc for printing a secret summary.
@@ -55,7 +55,7 @@ func ServiceListHandler(ctx context.Context, list *corev1.ServiceList, options O
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
 	}
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ServiceHandler is a printFunc that prints a Services.
@@ -47,7 +47,7 @@ func ServiceAccountListHandler(ctx context.Context, list *corev1.ServiceAccountL
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 type serviceAccountObject interface {
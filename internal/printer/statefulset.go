@@ -54,7 +54,7 @@ func StatefulSetListHandler(ctx context.Context, list *appsv1.StatefulSetList, o
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // StatefulSetHandler is a printFunc that prints a StatefulSet
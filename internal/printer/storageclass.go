@@ -42,7 +42,7 @@ func StorageClassListHandler(ctx context.Context, list *storagev1.StorageClassLi
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // StorageClassHandler is a printFunc that creates a component to display a single Storage Class
@@ -41,7 +41,7 @@ func ValidatingWebhookConfigurationListHandler(ctx context.Context, list *admiss
 		}
 	}
 
-	return ot.ToComponent()
+	return ot.ToComponent(ctx)
 }
 
 // ValidatingWebhookConfigurationHandler is a printFunc that prints a validating webhook configurations
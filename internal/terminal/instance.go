@@ -22,6 +22,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -248,6 +249,9 @@ func (t *instance) terminalStream() error {
 
 	ch := make(chan error, 1)
 	go func() {
+		metrics.TerminalStarted()
+		defer metrics.TerminalStopped()
+
 		err := rc.Stream(opts)
 		if err != nil {
 			ch <- err
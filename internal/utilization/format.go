@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package utilization
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/metricshistory"
+)
+
+// FormatCPU formats millicores as a quantity, e.g. 250m or 2.
+func FormatCPU(millicores int64) string {
+	return resource.NewMilliQuantity(millicores, resource.DecimalSI).String()
+}
+
+// FormatMemory formats bytes as a binary quantity, e.g. 512Mi.
+func FormatMemory(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
+
+// FormatPercent formats a fraction as a percentage.
+func FormatPercent(fraction float64) string {
+	return fmt.Sprintf("%.0f%%", fraction*100)
+}
+
+// HistoryPodUsage returns the latest usage of pods recorded in a history.
+func HistoryPodUsage(history *metricshistory.History) PodUsageFunc {
+	if history == nil {
+		return nil
+	}
+
+	return func(namespace, name string) (Usage, bool) {
+		sample, ok := history.Latest(namespace, name)
+		if !ok {
+			return Usage{}, false
+		}
+		return Usage{CPU: sample.CPU, Memory: sample.Memory}, true
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package utilization
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+)
+
+// nodeMetricsResource is the resource of node metrics served by metrics-server.
+var nodeMetricsResource = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "nodes"}
+
+// LoadNodeUsage loads the usage of nodes by name from metrics-server. An error is
+// returned if metrics-server is not installed or node metrics can't be listed.
+func LoadNodeUsage(ctx context.Context, client cluster.ClientInterface) (map[string]Usage, error) {
+	if client == nil {
+		return nil, fmt.Errorf("cluster client is nil")
+	}
+
+	dynamicClient, err := client.DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := dynamicClient.Resource(nodeMetricsResource).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list node metrics: %w", err)
+	}
+
+	usage := make(map[string]Usage, len(list.Items))
+	for i := range list.Items {
+		usage[list.Items[i].GetName()] = nodeUsage(&list.Items[i])
+	}
+
+	return usage, nil
+}
+
+func nodeUsage(object *unstructured.Unstructured) Usage {
+	var u Usage
+
+	usage, _, _ := unstructured.NestedStringMap(object.Object, "usage")
+	if q, err := resource.ParseQuantity(usage["cpu"]); err == nil {
+		u.CPU = q.MilliValue()
+	}
+	if q, err := resource.ParseQuantity(usage["memory"]); err == nil {
+		u.Memory = q.Value()
+	}
+
+	return u
+}
@@ -0,0 +1,238 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package utilization computes how much of the resources of nodes are used, requested
+// and limited by the pods scheduled on them.
+package utilization
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Usage is measured resource usage. CPU is in millicores and memory is in bytes.
+type Usage struct {
+	CPU    int64
+	Memory int64
+}
+
+// Resource is the utilization of a resource of a node. CPU is in millicores and memory
+// is in bytes.
+type Resource struct {
+	// Usage is the measured usage. It is only set if HasUsage is true.
+	Usage    int64
+	HasUsage bool
+	// Requests is the sum of the requests of the pods on the node.
+	Requests int64
+	// Limits is the sum of the limits of the pods on the node.
+	Limits      int64
+	Allocatable int64
+	Capacity    int64
+}
+
+// Fraction returns value as a fraction of the allocatable amount.
+func (r Resource) Fraction(value int64) float64 {
+	if r.Allocatable == 0 {
+		return 0
+	}
+	return float64(value) / float64(r.Allocatable)
+}
+
+// Pressure returns the fraction of the allocatable amount which is used, or requested
+// if usage is not measured.
+func (r Resource) Pressure() float64 {
+	if r.HasUsage {
+		return r.Fraction(r.Usage)
+	}
+	return r.Fraction(r.Requests)
+}
+
+// PodResource is the requests, limits and usage of a resource by a pod.
+type PodResource struct {
+	Requests int64
+	Limits   int64
+	// Usage is the measured usage. It is only set if HasUsage is true.
+	Usage    int64
+	HasUsage bool
+}
+
+// Pod is the resource consumption of a pod.
+type Pod struct {
+	Namespace string
+	Name      string
+	CPU       PodResource
+	Memory    PodResource
+}
+
+// Node is the utilization of a node.
+type Node struct {
+	Name   string
+	CPU    Resource
+	Memory Resource
+	// Pods are the pods which are scheduled on the node and not terminated.
+	Pods []Pod
+	// MaxPods is the number of pods the node allows.
+	MaxPods int64
+}
+
+// TopConsumers returns up to limit pods which consume the most CPU, then memory. Usage
+// is compared if it is measured, requests otherwise.
+func (n Node) TopConsumers(limit int) []Pod {
+	pods := make([]Pod, len(n.Pods))
+	copy(pods, n.Pods)
+
+	sort.SliceStable(pods, func(i, j int) bool {
+		a, b := consumption(pods[i].CPU), consumption(pods[j].CPU)
+		if a != b {
+			return a > b
+		}
+		return consumption(pods[i].Memory) > consumption(pods[j].Memory)
+	})
+
+	if len(pods) > limit {
+		pods = pods[:limit]
+	}
+	return pods
+}
+
+func consumption(r PodResource) int64 {
+	if r.HasUsage {
+		return r.Usage
+	}
+	return r.Requests
+}
+
+// PodUsageFunc returns the measured usage of a pod, if known.
+type PodUsageFunc func(namespace, name string) (Usage, bool)
+
+// ForNodes computes the utilization of nodes from the pods scheduled on them. nodeUsage
+// is the measured usage of nodes by name and can be nil, as can podUsage.
+func ForNodes(nodes []corev1.Node, pods []corev1.Pod, nodeUsage map[string]Usage, podUsage PodUsageFunc) []Node {
+	podsByNode := make(map[string][]Pod)
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Spec.NodeName == "" || isTerminated(pod) {
+			continue
+		}
+		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], forPod(pod, podUsage))
+	}
+
+	list := make([]Node, 0, len(nodes))
+	for i := range nodes {
+		node := &nodes[i]
+		n := Node{
+			Name: node.Name,
+			CPU: Resource{
+				Allocatable: node.Status.Allocatable.Cpu().MilliValue(),
+				Capacity:    node.Status.Capacity.Cpu().MilliValue(),
+			},
+			Memory: Resource{
+				Allocatable: node.Status.Allocatable.Memory().Value(),
+				Capacity:    node.Status.Capacity.Memory().Value(),
+			},
+			Pods:    podsByNode[node.Name],
+			MaxPods: node.Status.Allocatable.Pods().Value(),
+		}
+
+		for _, pod := range n.Pods {
+			n.CPU.Requests += pod.CPU.Requests
+			n.CPU.Limits += pod.CPU.Limits
+			n.Memory.Requests += pod.Memory.Requests
+			n.Memory.Limits += pod.Memory.Limits
+		}
+
+		if usage, ok := nodeUsage[node.Name]; ok {
+			n.CPU.Usage, n.CPU.HasUsage = usage.CPU, true
+			n.Memory.Usage, n.Memory.HasUsage = usage.Memory, true
+		}
+
+		list = append(list, n)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+func forPod(pod *corev1.Pod, podUsage PodUsageFunc) Pod {
+	requests, limits := PodRequestsAndLimits(pod)
+
+	p := Pod{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		CPU: PodResource{
+			Requests: requests.Cpu().MilliValue(),
+			Limits:   limits.Cpu().MilliValue(),
+		},
+		Memory: PodResource{
+			Requests: requests.Memory().Value(),
+			Limits:   limits.Memory().Value(),
+		},
+	}
+
+	if podUsage != nil {
+		if usage, ok := podUsage(pod.Namespace, pod.Name); ok {
+			p.CPU.Usage, p.CPU.HasUsage = usage.CPU, true
+			p.Memory.Usage, p.Memory.HasUsage = usage.Memory, true
+		}
+	}
+
+	return p
+}
+
+// PodRequestsAndLimits returns the effective requests and limits of a pod the way the
+// scheduler sees them: the sum of its containers, or the largest init container if it
+// is larger, plus the pod overhead.
+func PodRequestsAndLimits(pod *corev1.Pod) (corev1.ResourceList, corev1.ResourceList) {
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+		addResources(limits, container.Resources.Limits)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		maxResources(requests, container.Resources.Requests)
+		maxResources(limits, container.Resources.Limits)
+	}
+
+	addResources(requests, pod.Spec.Overhead)
+	for name, quantity := range pod.Spec.Overhead {
+		if _, ok := limits[name]; ok {
+			q := limits[name]
+			q.Add(quantity)
+			limits[name] = q
+		}
+	}
+
+	return requests, limits
+}
+
+func addResources(list, add corev1.ResourceList) {
+	for name, quantity := range add {
+		q, ok := list[name]
+		if !ok {
+			list[name] = quantity.DeepCopy()
+			continue
+		}
+		q.Add(quantity)
+		list[name] = q
+	}
+}
+
+func maxResources(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if q, ok := list[name]; !ok || quantity.Cmp(q) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func isTerminated(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package utilization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+)
+
+func resources(cpu, memory string) corev1.ResourceRequirements {
+	list := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+	return corev1.ResourceRequirements{Requests: list, Limits: list}
+}
+
+func TestForNodes(t *testing.T) {
+	node := testutil.CreateNode("node-1")
+	node.Status.Allocatable = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+		corev1.ResourcePods:   resource.MustParse("110"),
+	}
+	node.Status.Capacity = node.Status.Allocatable
+
+	web := testutil.CreatePod("web")
+	web.Spec.NodeName = "node-1"
+	web.Spec.Containers = []corev1.Container{
+		{Name: "app", Resources: resources("250m", "256Mi")},
+		{Name: "proxy", Resources: resources("250m", "256Mi")},
+	}
+	web.Spec.InitContainers = []corev1.Container{
+		{Name: "init", Resources: resources("1", "128Mi")},
+	}
+
+	db := testutil.CreatePod("db")
+	db.Spec.NodeName = "node-1"
+	db.Spec.Containers = []corev1.Container{{Name: "db", Resources: resources("100m", "1Gi")}}
+
+	done := testutil.CreatePod("done")
+	done.Spec.NodeName = "node-1"
+	done.Spec.Containers = []corev1.Container{{Name: "job", Resources: resources("1", "1Gi")}}
+	done.Status.Phase = corev1.PodSucceeded
+
+	pending := testutil.CreatePod("pending")
+	pending.Spec.Containers = []corev1.Container{{Name: "app", Resources: resources("1", "1Gi")}}
+
+	pods := []corev1.Pod{*web, *db, *done, *pending}
+	podUsage := func(namespace, name string) (Usage, bool) {
+		if name == "db" {
+			return Usage{CPU: 1500, Memory: 512 << 20}, true
+		}
+		return Usage{}, false
+	}
+
+	got := ForNodes([]corev1.Node{*node}, pods, map[string]Usage{"node-1": {CPU: 1000, Memory: 1 << 30}}, podUsage)
+	require.Len(t, got, 1)
+
+	n := got[0]
+	assert.Equal(t, Resource{Usage: 1000, HasUsage: true, Requests: 1100, Limits: 1100, Allocatable: 2000, Capacity: 2000}, n.CPU)
+	assert.Equal(t, int64(1536<<20), n.Memory.Requests)
+	assert.Equal(t, int64(110), n.MaxPods)
+	assert.Len(t, n.Pods, 2)
+	assert.Equal(t, 0.5, n.CPU.Pressure())
+	assert.Equal(t, 0.55, n.CPU.Fraction(n.CPU.Requests))
+
+	top := n.TopConsumers(1)
+	require.Len(t, top, 1)
+	assert.Equal(t, "db", top[0].Name)
+	assert.Equal(t, PodResource{Requests: 100, Limits: 100, Usage: 1500, HasUsage: true}, top[0].CPU)
+}
+
+func TestPodRequestsAndLimits_overhead(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{
+		{Name: "app", Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		}},
+	}
+	pod.Spec.Overhead = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")}
+
+	requests, limits := PodRequestsAndLimits(pod)
+	assert.Equal(t, int64(150), requests.Cpu().MilliValue())
+	assert.True(t, limits.Cpu().IsZero())
+}
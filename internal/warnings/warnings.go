@@ -0,0 +1,481 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package warnings finds objects in a cluster which need attention, e.g. pods which
+// crash, workloads which are unavailable and recent warning events.
+package warnings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+
+	"github.com/vmware-tanzu/octant/internal/printer"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Category is the kind of problem a warning is about.
+type Category string
+
+const (
+	// CategoryEvents is the category of warning events.
+	CategoryEvents Category = "Events"
+	// CategoryPods is the category of pods with failing containers.
+	CategoryPods Category = "Pods"
+	// CategoryWorkloads is the category of unavailable deployments and daemon sets,
+	// and failed jobs.
+	CategoryWorkloads Category = "Workloads"
+	// CategoryStorage is the category of pending persistent volume claims.
+	CategoryStorage Category = "Storage"
+	// CategoryNodes is the category of nodes which are not ready.
+	CategoryNodes Category = "Nodes"
+	// CategoryAPIServices is the category of unavailable API services.
+	CategoryAPIServices Category = "API Services"
+)
+
+// Categories are the categories of warnings in the order they are displayed.
+var Categories = []Category{
+	CategoryEvents,
+	CategoryPods,
+	CategoryWorkloads,
+	CategoryStorage,
+	CategoryNodes,
+	CategoryAPIServices,
+}
+
+// podReasons are the reasons of waiting containers which need attention.
+var podReasons = map[string]bool{
+	"CrashLoopBackOff": true,
+	"ImagePullBackOff": true,
+	"ErrImagePull":     true,
+}
+
+// oomKilled is the reason of containers terminated for running out of memory.
+const oomKilled = "OOMKilled"
+
+// Warning is an object which needs attention.
+type Warning struct {
+	Category   Category
+	Namespace  string
+	APIVersion string
+	Kind       string
+	Name       string
+	Reason     string
+	Message    string
+	// Time is when the problem was last seen. It is only set for events.
+	Time time.Time
+	// Count is the number of times the problem was seen. It is only set for events.
+	Count int32
+}
+
+// Result is the warnings found in a cluster.
+type Result struct {
+	Warnings []Warning
+	// Errors are the errors of the checks which failed, e.g. because objects can't be
+	// listed. The warnings of the other checks are still returned.
+	Errors []error
+}
+
+type check func(ctx context.Context, objectStore store.Store, since time.Time) ([]Warning, error)
+
+// Find finds the objects in the cluster which need attention. Only warning events last
+// seen after since are included. Warnings are sorted by namespace, kind and name, and
+// warnings about the same object are sorted last seen first.
+func Find(ctx context.Context, objectStore store.Store, since time.Time) Result {
+	checks := []check{
+		eventWarnings,
+		podWarnings,
+		deploymentWarnings,
+		daemonSetWarnings,
+		jobWarnings,
+		persistentVolumeClaimWarnings,
+		nodeWarnings,
+		apiServiceWarnings,
+	}
+
+	var result Result
+	for _, c := range checks {
+		list, err := c(ctx, objectStore, since)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+		result.Warnings = append(result.Warnings, list...)
+	}
+
+	sort.SliceStable(result.Warnings, func(i, j int) bool {
+		a, b := result.Warnings[i], result.Warnings[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Time.After(b.Time)
+	})
+
+	return result
+}
+
+// NamespaceCount is the number of warnings in a namespace.
+type NamespaceCount struct {
+	// Namespace is the namespace. It is empty for cluster scoped objects.
+	Namespace  string
+	Total      int
+	ByCategory map[Category]int
+}
+
+// CountByNamespace counts warnings per namespace. Counts are sorted by namespace with
+// cluster scoped objects first.
+func CountByNamespace(list []Warning) []NamespaceCount {
+	counts := make(map[string]*NamespaceCount)
+	var namespaces []string
+
+	for _, w := range list {
+		count, ok := counts[w.Namespace]
+		if !ok {
+			count = &NamespaceCount{
+				Namespace:  w.Namespace,
+				ByCategory: make(map[Category]int),
+			}
+			counts[w.Namespace] = count
+			namespaces = append(namespaces, w.Namespace)
+		}
+		count.Total++
+		count.ByCategory[w.Category]++
+	}
+
+	sort.Strings(namespaces)
+
+	result := make([]NamespaceCount, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		result = append(result, *counts[namespace])
+	}
+	return result
+}
+
+func eventWarnings(ctx context.Context, objectStore store.Store, since time.Time) ([]Warning, error) {
+	var events []corev1.Event
+	if err := forEach(ctx, objectStore, "v1", "Event", func(u *unstructured.Unstructured) error {
+		event := corev1.Event{}
+		if err := kubernetes.FromUnstructured(u, &event); err != nil {
+			return err
+		}
+		if event.Type == corev1.EventTypeWarning {
+			events = append(events, event)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var list []Warning
+	for _, event := range printer.AggregateEvents(events) {
+		if event.LastTimestamp.Time.Before(since) {
+			continue
+		}
+
+		involved := event.InvolvedObject
+		namespace := involved.Namespace
+		if namespace == "" {
+			namespace = event.Namespace
+		}
+		if involved.Kind == "Node" || involved.Kind == "Namespace" {
+			namespace = ""
+		}
+
+		list = append(list, Warning{
+			Category:   CategoryEvents,
+			Namespace:  namespace,
+			APIVersion: involved.APIVersion,
+			Kind:       involved.Kind,
+			Name:       involved.Name,
+			Reason:     event.Reason,
+			Message:    event.Message,
+			Time:       event.LastTimestamp.Time,
+			Count:      event.Count,
+		})
+	}
+
+	return list, nil
+}
+
+func podWarnings(ctx context.Context, objectStore store.Store, _ time.Time) ([]Warning, error) {
+	var list []Warning
+	err := forEach(ctx, objectStore, "v1", "Pod", func(u *unstructured.Unstructured) error {
+		pod := corev1.Pod{}
+		if err := kubernetes.FromUnstructured(u, &pod); err != nil {
+			return err
+		}
+
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...),
+			pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			reason, message, ok := containerProblem(status)
+			if !ok {
+				continue
+			}
+			list = append(list, Warning{
+				Category:   CategoryPods,
+				Namespace:  pod.Namespace,
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       pod.Name,
+				Reason:     reason,
+				Message:    fmt.Sprintf("Container %s: %s", status.Name, message),
+			})
+		}
+		return nil
+	})
+
+	return list, err
+}
+
+// containerProblem returns why a container needs attention, or false if it doesn't.
+func containerProblem(status corev1.ContainerStatus) (string, string, bool) {
+	if waiting := status.State.Waiting; waiting != nil && podReasons[waiting.Reason] {
+		message := waiting.Message
+		if message == "" {
+			message = fmt.Sprintf("restarted %d times", status.RestartCount)
+		}
+		return waiting.Reason, message, true
+	}
+
+	for _, terminated := range []*corev1.ContainerStateTerminated{status.State.Terminated, status.LastTerminationState.Terminated} {
+		if terminated != nil && terminated.Reason == oomKilled {
+			return oomKilled, fmt.Sprintf("terminated with exit code %d after running out of memory",
+				terminated.ExitCode), true
+		}
+	}
+
+	return "", "", false
+}
+
+func deploymentWarnings(ctx context.Context, objectStore store.Store, _ time.Time) ([]Warning, error) {
+	var list []Warning
+	err := forEach(ctx, objectStore, "apps/v1", "Deployment", func(u *unstructured.Unstructured) error {
+		deployment := appsv1.Deployment{}
+		if err := kubernetes.FromUnstructured(u, &deployment); err != nil {
+			return err
+		}
+
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+
+		unavailable := deployment.Status.UnavailableReplicas
+		if unavailable == 0 && deployment.Status.AvailableReplicas >= replicas {
+			return nil
+		}
+		if unavailable == 0 {
+			unavailable = replicas - deployment.Status.AvailableReplicas
+		}
+
+		list = append(list, Warning{
+			Category:   CategoryWorkloads,
+			Namespace:  deployment.Namespace,
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       deployment.Name,
+			Reason:     "Unavailable",
+			Message:    fmt.Sprintf("%d of %d replicas are unavailable", unavailable, replicas),
+		})
+		return nil
+	})
+
+	return list, err
+}
+
+func daemonSetWarnings(ctx context.Context, objectStore store.Store, _ time.Time) ([]Warning, error) {
+	var list []Warning
+	err := forEach(ctx, objectStore, "apps/v1", "DaemonSet", func(u *unstructured.Unstructured) error {
+		daemonSet := appsv1.DaemonSet{}
+		if err := kubernetes.FromUnstructured(u, &daemonSet); err != nil {
+			return err
+		}
+
+		desired := daemonSet.Status.DesiredNumberScheduled
+		unavailable := daemonSet.Status.NumberUnavailable
+		if unavailable == 0 && daemonSet.Status.NumberAvailable >= desired {
+			return nil
+		}
+		if unavailable == 0 {
+			unavailable = desired - daemonSet.Status.NumberAvailable
+		}
+
+		list = append(list, Warning{
+			Category:   CategoryWorkloads,
+			Namespace:  daemonSet.Namespace,
+			APIVersion: "apps/v1",
+			Kind:       "DaemonSet",
+			Name:       daemonSet.Name,
+			Reason:     "Unavailable",
+			Message:    fmt.Sprintf("%d of %d pods are unavailable", unavailable, desired),
+		})
+		return nil
+	})
+
+	return list, err
+}
+
+func jobWarnings(ctx context.Context, objectStore store.Store, _ time.Time) ([]Warning, error) {
+	var list []Warning
+	err := forEach(ctx, objectStore, "batch/v1", "Job", func(u *unstructured.Unstructured) error {
+		job := batchv1.Job{}
+		if err := kubernetes.FromUnstructured(u, &job); err != nil {
+			return err
+		}
+
+		for _, condition := range job.Status.Conditions {
+			if condition.Type != batchv1.JobFailed || condition.Status != corev1.ConditionTrue {
+				continue
+			}
+			list = append(list, Warning{
+				Category:   CategoryWorkloads,
+				Namespace:  job.Namespace,
+				APIVersion: "batch/v1",
+				Kind:       "Job",
+				Name:       job.Name,
+				Reason:     conditionReason(condition.Reason, "Failed"),
+				Message:    condition.Message,
+			})
+		}
+		return nil
+	})
+
+	return list, err
+}
+
+func persistentVolumeClaimWarnings(ctx context.Context, objectStore store.Store, _ time.Time) ([]Warning, error) {
+	var list []Warning
+	err := forEach(ctx, objectStore, "v1", "PersistentVolumeClaim", func(u *unstructured.Unstructured) error {
+		pvc := corev1.PersistentVolumeClaim{}
+		if err := kubernetes.FromUnstructured(u, &pvc); err != nil {
+			return err
+		}
+
+		if pvc.Status.Phase != corev1.ClaimPending {
+			return nil
+		}
+
+		list = append(list, Warning{
+			Category:   CategoryStorage,
+			Namespace:  pvc.Namespace,
+			APIVersion: "v1",
+			Kind:       "PersistentVolumeClaim",
+			Name:       pvc.Name,
+			Reason:     string(corev1.ClaimPending),
+			Message:    "Persistent volume claim is not bound",
+		})
+		return nil
+	})
+
+	return list, err
+}
+
+func nodeWarnings(ctx context.Context, objectStore store.Store, _ time.Time) ([]Warning, error) {
+	var list []Warning
+	err := forEach(ctx, objectStore, "v1", "Node", func(u *unstructured.Unstructured) error {
+		node := corev1.Node{}
+		if err := kubernetes.FromUnstructured(u, &node); err != nil {
+			return err
+		}
+
+		ready := corev1.NodeCondition{
+			Status:  corev1.ConditionUnknown,
+			Message: "Node has not reported whether it is ready",
+		}
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				ready = condition
+			}
+		}
+		if ready.Status == corev1.ConditionTrue {
+			return nil
+		}
+
+		list = append(list, Warning{
+			Category:   CategoryNodes,
+			APIVersion: "v1",
+			Kind:       "Node",
+			Name:       node.Name,
+			Reason:     conditionReason(ready.Reason, "NotReady"),
+			Message:    ready.Message,
+		})
+		return nil
+	})
+
+	return list, err
+}
+
+func apiServiceWarnings(ctx context.Context, objectStore store.Store, _ time.Time) ([]Warning, error) {
+	var list []Warning
+	err := forEach(ctx, objectStore, "apiregistration.k8s.io/v1", "APIService", func(u *unstructured.Unstructured) error {
+		apiService := apiregistrationv1.APIService{}
+		if err := kubernetes.FromUnstructured(u, &apiService); err != nil {
+			return err
+		}
+
+		available := apiregistrationv1.APIServiceCondition{
+			Status:  apiregistrationv1.ConditionUnknown,
+			Message: "API service has not reported whether it is available",
+		}
+		for _, condition := range apiService.Status.Conditions {
+			if condition.Type == apiregistrationv1.Available {
+				available = condition
+			}
+		}
+		if available.Status == apiregistrationv1.ConditionTrue {
+			return nil
+		}
+
+		list = append(list, Warning{
+			Category:   CategoryAPIServices,
+			APIVersion: "apiregistration.k8s.io/v1",
+			Kind:       "APIService",
+			Name:       apiService.Name,
+			Reason:     conditionReason(available.Reason, "Unavailable"),
+			Message:    available.Message,
+		})
+		return nil
+	})
+
+	return list, err
+}
+
+// forEach lists the objects of a kind in all namespaces and calls fn for each of them.
+func forEach(ctx context.Context, objectStore store.Store, apiVersion, kind string, fn func(u *unstructured.Unstructured) error) error {
+	objects, _, err := objectStore.List(ctx, store.Key{APIVersion: apiVersion, Kind: kind})
+	if err != nil {
+		return fmt.Errorf("list %s: %w", kind, err)
+	}
+
+	for i := range objects.Items {
+		if err := fn(&objects.Items[i]); err != nil {
+			return fmt.Errorf("convert %s %s: %w", kind, objects.Items[i].GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+func conditionReason(reason, fallback string) string {
+	if reason == "" {
+		return fallback
+	}
+	return reason
+}
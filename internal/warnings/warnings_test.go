@@ -0,0 +1,282 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package warnings
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+
+	"github.com/vmware-tanzu/octant/internal/conversion"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestFind(t *testing.T) {
+	require.NoError(t, apiregistrationv1.AddToScheme(scheme.Scheme))
+
+	now := testutil.Time()
+
+	recent := warningEvent("recent", "BackOff", now.Add(-5*time.Minute))
+	recent.Count = 3
+	old := warningEvent("old", "FailedMount", now.Add(-2*time.Hour))
+	normal := warningEvent("normal", "Pulled", now)
+	normal.Type = corev1.EventTypeNormal
+
+	crashing := testutil.CreatePod("crashing")
+	crashing.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name:         "app",
+			RestartCount: 4,
+			State: corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+			},
+		},
+		{
+			Name:  "sidecar",
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		},
+	}
+	oom := testutil.CreatePod("oom")
+	oom.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "app",
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+		},
+	}}
+	healthy := testutil.CreatePod("healthy")
+
+	unavailable := testutil.CreateDeployment("unavailable")
+	unavailable.Spec.Replicas = conversion.PtrInt32(3)
+	unavailable.Status = appsv1.DeploymentStatus{AvailableReplicas: 1, UnavailableReplicas: 2}
+	available := testutil.CreateDeployment("available")
+	available.Status = appsv1.DeploymentStatus{AvailableReplicas: 1}
+
+	daemonSet := testutil.CreateDaemonSet("daemon-set")
+	daemonSet.Status.DesiredNumberScheduled = 3
+	daemonSet.Status.NumberAvailable = 2
+
+	failed := testutil.CreateJob("failed")
+	failed.Status.Conditions = []batchv1.JobCondition{{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  "BackoffLimitExceeded",
+		Message: "Job has reached the specified backoff limit",
+	}}
+
+	pending := testutil.CreatePersistentVolumeClaim("pending")
+	pending.Status.Phase = corev1.ClaimPending
+
+	notReady := testutil.CreateNode("not-ready")
+	notReady.Status.Conditions = []corev1.NodeCondition{{
+		Type:    corev1.NodeReady,
+		Status:  corev1.ConditionFalse,
+		Reason:  "KubeletNotReady",
+		Message: "container runtime is down",
+	}}
+	ready := testutil.CreateNode("ready")
+	ready.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+
+	apiService := testutil.CreateAPIService("v1beta1", "metrics.k8s.io")
+	apiService.Status.Conditions = []apiregistrationv1.APIServiceCondition{{
+		Type:    apiregistrationv1.Available,
+		Status:  apiregistrationv1.ConditionFalse,
+		Reason:  "FailedDiscoveryCheck",
+		Message: "no response from https://10.0.0.1:443",
+	}}
+
+	objects := map[string][]runtime.Object{
+		"Event":                 {recent, old, normal},
+		"Pod":                   {crashing, oom, healthy},
+		"Deployment":            {unavailable, available},
+		"DaemonSet":             {daemonSet},
+		"Job":                   {failed},
+		"PersistentVolumeClaim": {pending},
+		"Node":                  {notReady, ready},
+		"APIService":            {apiService},
+	}
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+			return testutil.ToUnstructuredList(t, objects[key.Kind]...), false, nil
+		}).Times(len(objects))
+
+	actual := Find(context.Background(), objectStore, now.Add(-time.Hour))
+
+	namespace := crashing.Namespace
+	expected := Result{
+		Warnings: []Warning{
+			{
+				Category:   CategoryAPIServices,
+				APIVersion: "apiregistration.k8s.io/v1",
+				Kind:       "APIService",
+				Name:       "v1beta1.metrics.k8s.io",
+				Reason:     "FailedDiscoveryCheck",
+				Message:    "no response from https://10.0.0.1:443",
+			},
+			{
+				Category:   CategoryNodes,
+				APIVersion: "v1",
+				Kind:       "Node",
+				Name:       "not-ready",
+				Reason:     "KubeletNotReady",
+				Message:    "container runtime is down",
+			},
+			{
+				Category:   CategoryWorkloads,
+				Namespace:  namespace,
+				APIVersion: "apps/v1",
+				Kind:       "DaemonSet",
+				Name:       "daemon-set",
+				Reason:     "Unavailable",
+				Message:    "1 of 3 pods are unavailable",
+			},
+			{
+				Category:   CategoryWorkloads,
+				Namespace:  namespace,
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "unavailable",
+				Reason:     "Unavailable",
+				Message:    "2 of 3 replicas are unavailable",
+			},
+			{
+				Category:   CategoryWorkloads,
+				Namespace:  namespace,
+				APIVersion: "batch/v1",
+				Kind:       "Job",
+				Name:       "failed",
+				Reason:     "BackoffLimitExceeded",
+				Message:    "Job has reached the specified backoff limit",
+			},
+			{
+				Category:   CategoryStorage,
+				Namespace:  namespace,
+				APIVersion: "v1",
+				Kind:       "PersistentVolumeClaim",
+				Name:       "pending",
+				Reason:     "Pending",
+				Message:    "Persistent volume claim is not bound",
+			},
+			{
+				Category:   CategoryPods,
+				Namespace:  namespace,
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "crashing",
+				Reason:     "CrashLoopBackOff",
+				Message:    "Container app: restarted 4 times",
+			},
+			{
+				Category:   CategoryPods,
+				Namespace:  namespace,
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "oom",
+				Reason:     "OOMKilled",
+				Message:    "Container app: terminated with exit code 137 after running out of memory",
+			},
+			{
+				Category:   CategoryEvents,
+				Namespace:  namespace,
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "recent",
+				Reason:     "BackOff",
+				Message:    "recent failed",
+				Time:       recent.LastTimestamp.Time,
+				Count:      3,
+			},
+		},
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestFind_listError(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	node := testutil.CreateNode("node")
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+			switch key.Kind {
+			case "APIService":
+				return nil, false, fmt.Errorf("forbidden")
+			case "Node":
+				return testutil.ToUnstructuredList(t, node), false, nil
+			default:
+				return &unstructured.UnstructuredList{}, false, nil
+			}
+		}).AnyTimes()
+
+	actual := Find(context.Background(), objectStore, testutil.Time())
+
+	if assert.Len(t, actual.Errors, 1) {
+		assert.EqualError(t, actual.Errors[0], "list APIService: forbidden")
+	}
+	if assert.Len(t, actual.Warnings, 1) {
+		assert.Equal(t, "NotReady", actual.Warnings[0].Reason)
+		assert.Equal(t, "Node has not reported whether it is ready", actual.Warnings[0].Message)
+	}
+}
+
+func TestCountByNamespace(t *testing.T) {
+	list := []Warning{
+		{Category: CategoryPods, Namespace: "default"},
+		{Category: CategoryEvents, Namespace: "default"},
+		{Category: CategoryPods, Namespace: "default"},
+		{Category: CategoryNodes},
+		{Category: CategoryStorage, Namespace: "apps"},
+	}
+
+	expected := []NamespaceCount{
+		{Namespace: "", Total: 1, ByCategory: map[Category]int{CategoryNodes: 1}},
+		{Namespace: "apps", Total: 1, ByCategory: map[Category]int{CategoryStorage: 1}},
+		{Namespace: "default", Total: 3, ByCategory: map[Category]int{CategoryPods: 2, CategoryEvents: 1}},
+	}
+
+	assert.Equal(t, expected, CountByNamespace(list))
+}
+
+func warningEvent(pod, reason string, lastSeen time.Time) *corev1.Event {
+	event := testutil.CreateEvent(fmt.Sprintf("%s.event", pod))
+	event.Type = corev1.EventTypeWarning
+	event.Reason = reason
+	event.Message = fmt.Sprintf("%s failed", pod)
+	event.Count = 1
+	event.FirstTimestamp = metav1.NewTime(lastSeen.Add(-time.Minute))
+	event.LastTimestamp = metav1.NewTime(lastSeen)
+	event.InvolvedObject = corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  event.Namespace,
+		Name:       pod,
+		UID:        types.UID(pod),
+	}
+	return event
+}
@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package action
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CommandFieldType is the type of input used for a command field.
+type CommandFieldType string
+
+const (
+	// CommandFieldTypeText is a single line text input.
+	CommandFieldTypeText CommandFieldType = "text"
+	// CommandFieldTypeTextArea is a multi line text input.
+	CommandFieldTypeTextArea CommandFieldType = "textarea"
+	// CommandFieldTypeNumber is a number input. Its value is sent as a number.
+	CommandFieldTypeNumber CommandFieldType = "number"
+	// CommandFieldTypeSelect is a select input with a fixed set of choices.
+	CommandFieldTypeSelect CommandFieldType = "select"
+	// CommandFieldTypeCheckbox is a checkbox. Its value is sent as a boolean.
+	CommandFieldTypeCheckbox CommandFieldType = "checkbox"
+	// CommandFieldTypeHidden is not shown. Its value is always sent.
+	CommandFieldTypeHidden CommandFieldType = "hidden"
+)
+
+// CommandField is an input the command palette asks for before a command is dispatched.
+// The value of the field is added to the action payload using the field's name.
+type CommandField struct {
+	// Name is the payload key for the field's value.
+	Name string `json:"name"`
+	// Label is the label shown for the field.
+	Label string `json:"label"`
+	// Type is the type of input. Defaults to text.
+	Type CommandFieldType `json:"type,omitempty"`
+	// Choices are the values for a select field.
+	Choices []string `json:"choices,omitempty"`
+	// Required is true if the field must have a value.
+	Required bool `json:"required,omitempty"`
+	// Value is the initial value of the field. A field named `namespace` without a value
+	// is set to the current namespace.
+	Value string `json:"value,omitempty"`
+}
+
+// Command is a named action that is listed in the command palette.
+type Command struct {
+	// Name is the action path the command dispatches to.
+	Name string `json:"name"`
+	// Title is the title shown in the command palette.
+	Title string `json:"title"`
+	// Description is an optional description of the command.
+	Description string `json:"description,omitempty"`
+	// Shortcut is an optional keyboard shortcut for the command, e.g. `ctrl+shift+y`.
+	Shortcut string `json:"shortcut,omitempty"`
+	// Fields are the inputs the command palette asks for before dispatching the command.
+	Fields []CommandField `json:"fields,omitempty"`
+	// Source is the name of the plugin or module that registered the command. It is set
+	// when the command is registered.
+	Source string `json:"source,omitempty"`
+}
+
+// CommandDispatcher is a Dispatcher that is also listed in the command palette.
+type CommandDispatcher interface {
+	Dispatcher
+	Command() Command
+}
+
+var (
+	shortcutModifiers = map[string]bool{"ctrl": true, "alt": true, "shift": true, "meta": true}
+
+	commandFieldTypes = map[CommandFieldType]bool{
+		"":                       true,
+		CommandFieldTypeText:     true,
+		CommandFieldTypeTextArea: true,
+		CommandFieldTypeNumber:   true,
+		CommandFieldTypeSelect:   true,
+		CommandFieldTypeCheckbox: true,
+		CommandFieldTypeHidden:   true,
+	}
+)
+
+// Validate checks that the command has a name and title, that its shortcut can be parsed,
+// and that its fields are valid.
+func (c Command) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("command name is blank")
+	}
+	if c.Title == "" {
+		return fmt.Errorf("command %q: title is blank", c.Name)
+	}
+
+	if c.Shortcut != "" {
+		if _, err := NormalizeShortcut(c.Shortcut); err != nil {
+			return fmt.Errorf("command %q: %w", c.Name, err)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, field := range c.Fields {
+		if field.Name == "" {
+			return fmt.Errorf("command %q: field name is blank", c.Name)
+		}
+		if seen[field.Name] {
+			return fmt.Errorf("command %q: field %q is listed more than once", c.Name, field.Name)
+		}
+		seen[field.Name] = true
+
+		if !commandFieldTypes[field.Type] {
+			return fmt.Errorf("command %q: field %q has unknown type %q", c.Name, field.Name, field.Type)
+		}
+		if field.Type == CommandFieldTypeSelect && len(field.Choices) == 0 {
+			return fmt.Errorf("command %q: select field %q has no choices", c.Name, field.Name)
+		}
+	}
+
+	return nil
+}
+
+// NormalizeShortcut converts a shortcut like `Shift+Ctrl+Y` to the canonical form
+// `ctrl+shift+y`. Modifiers are ordered ctrl, alt, shift, meta. A shortcut must have
+// at least one modifier and exactly one key.
+func NormalizeShortcut(shortcut string) (string, error) {
+	var modifiers = map[string]bool{}
+	var key string
+
+	for _, part := range strings.Split(strings.ToLower(shortcut), "+") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "":
+			return "", fmt.Errorf("shortcut %q has an empty key", shortcut)
+		case shortcutModifiers[part]:
+			modifiers[part] = true
+		case key != "":
+			return "", fmt.Errorf("shortcut %q has more than one key", shortcut)
+		default:
+			key = part
+		}
+	}
+
+	if key == "" {
+		return "", fmt.Errorf("shortcut %q has no key", shortcut)
+	}
+	if len(modifiers) == 0 {
+		return "", fmt.Errorf("shortcut %q has no modifier", shortcut)
+	}
+
+	var parts []string
+	for _, modifier := range []string{"ctrl", "alt", "shift", "meta"} {
+		if modifiers[modifier] {
+			parts = append(parts, modifier)
+		}
+	}
+
+	return strings.Join(append(parts, key), "+"), nil
+}
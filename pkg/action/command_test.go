@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		command Command
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			command: Command{
+				Name:     "action",
+				Title:    "Action",
+				Shortcut: "ctrl+shift+y",
+				Fields: []CommandField{
+					{Name: "namespace", Label: "Namespace"},
+					{Name: "replicas", Label: "Replicas", Type: CommandFieldTypeNumber},
+					{Name: "mode", Label: "Mode", Type: CommandFieldTypeSelect, Choices: []string{"a", "b"}},
+				},
+			},
+		},
+		{
+			name:    "blank name",
+			command: Command{Title: "Action"},
+			wantErr: true,
+		},
+		{
+			name:    "blank title",
+			command: Command{Name: "action"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid shortcut",
+			command: Command{Name: "action", Title: "Action", Shortcut: "y"},
+			wantErr: true,
+		},
+		{
+			name: "duplicate field",
+			command: Command{Name: "action", Title: "Action", Fields: []CommandField{
+				{Name: "name"}, {Name: "name"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unknown field type",
+			command: Command{Name: "action", Title: "Action", Fields: []CommandField{
+				{Name: "name", Type: "color"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "select without choices",
+			command: Command{Name: "action", Title: "Action", Fields: []CommandField{
+				{Name: "name", Type: CommandFieldTypeSelect},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.command.Validate()
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNormalizeShortcut(t *testing.T) {
+	tests := []struct {
+		shortcut string
+		expected string
+		wantErr  bool
+	}{
+		{shortcut: "ctrl+k", expected: "ctrl+k"},
+		{shortcut: "Shift+Ctrl+Y", expected: "ctrl+shift+y"},
+		{shortcut: "meta + alt + enter", expected: "alt+meta+enter"},
+		{shortcut: "k", wantErr: true},
+		{shortcut: "ctrl+shift", wantErr: true},
+		{shortcut: "ctrl+a+b", wantErr: true},
+		{shortcut: "ctrl++", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.shortcut, func(t *testing.T) {
+			got, err := NormalizeShortcut(test.shortcut)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
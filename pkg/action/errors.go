@@ -14,3 +14,12 @@ type NotFoundError struct {
 func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("action path %q not found", e.Path)
 }
+
+// ReadOnlyError is returned when a mutating action is refused because Octant is read-only.
+type ReadOnlyError struct {
+	Action string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("%s is not allowed: octant is in read-only mode", e.Action)
+}
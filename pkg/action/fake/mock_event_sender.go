@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/vmware-tanzu/octant/pkg/action (interfaces: EventSender)
+
+// Package fake is a generated GoMock package.
+package fake
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	event "github.com/vmware-tanzu/octant/pkg/event"
+)
+
+// MockEventSender is a mock of EventSender interface.
+type MockEventSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventSenderMockRecorder
+}
+
+// MockEventSenderMockRecorder is the mock recorder for MockEventSender.
+type MockEventSenderMockRecorder struct {
+	mock *MockEventSender
+}
+
+// NewMockEventSender creates a new mock instance.
+func NewMockEventSender(ctrl *gomock.Controller) *MockEventSender {
+	mock := &MockEventSender{ctrl: ctrl}
+	mock.recorder = &MockEventSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventSender) EXPECT() *MockEventSenderMockRecorder {
+	return m.recorder
+}
+
+// SendEvent mocks base method.
+func (m *MockEventSender) SendEvent(arg0 event.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendEvent", arg0)
+}
+
+// SendEvent indicates an expected call of SendEvent.
+func (mr *MockEventSenderMockRecorder) SendEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEvent", reflect.TypeOf((*MockEventSender)(nil).SendEvent), arg0)
+}
@@ -8,13 +8,18 @@ package action
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/vmware-tanzu/octant/pkg/event"
 	"github.com/vmware-tanzu/octant/pkg/log"
 )
 
 //go:generate mockgen -destination=./fake/mock_alert.go -package=fake github.com/vmware-tanzu/octant/pkg/action Alerter
+//go:generate mockgen -destination=./fake/mock_event_sender.go -package=fake github.com/vmware-tanzu/octant/pkg/action EventSender
 
 const (
 	// DefaultAlertExpiration is the default expiration for alerts.
@@ -68,6 +73,12 @@ type Alerter interface {
 	SendAlert(alert Alert)
 }
 
+// EventSender sends events to the client which dispatched an action. Alerters passed
+// to dispatchers implement it if they are connected to a client.
+type EventSender interface {
+	SendEvent(e event.Event)
+}
+
 type alerter struct {
 	actionManager Manager
 }
@@ -83,6 +94,12 @@ func (a *alerter) SendAlert(alert Alert) {
 	// Needs websocketclient?
 }
 
+// Auditor records mutating actions.
+type Auditor interface {
+	// AuditAction records an action and the error it returned, if any.
+	AuditAction(ctx context.Context, actionPath string, payload Payload, err error)
+}
+
 // DispatcherFunc is a function that will be dispatched to handle a payload.
 type DispatcherFunc func(ctx context.Context, alerter Alerter, payload Payload) error
 
@@ -106,12 +123,33 @@ func (d Dispatchers) ToActionPaths() map[string]DispatcherFunc {
 	return m
 }
 
+// ToCommands returns the commands of the dispatchers that are CommandDispatchers.
+func (d Dispatchers) ToCommands() []Command {
+	var commands []Command
+
+	for i := range d {
+		if cd, ok := d[i].(CommandDispatcher); ok {
+			commands = append(commands, cd.Command())
+		}
+	}
+
+	return commands
+}
+
 // Manager manages actions.
 type Manager struct {
 	logger log.Logger
 
 	// key: string, value: []dispatcherEntry
 	dispatches sync.Map
+
+	commandsMu sync.RWMutex
+	commands   map[string]Command
+
+	readOnlyMu sync.RWMutex
+	readOnly   func() bool
+	mutating   map[string]bool
+	auditor    Auditor
 }
 
 type dispatcherEntry struct {
@@ -124,6 +162,79 @@ func NewManager(logger log.Logger) *Manager {
 	return &Manager{
 		logger:     logger.With("component", "action-manager"),
 		dispatches: sync.Map{},
+		commands:   map[string]Command{},
+		mutating:   map[string]bool{ActionApplyYaml: true},
+	}
+}
+
+// SetReadOnly sets the function which reports whether Octant is read-only. Mutating actions
+// are refused while it returns true.
+func (m *Manager) SetReadOnly(readOnly func() bool) {
+	m.readOnlyMu.Lock()
+	defer m.readOnlyMu.Unlock()
+
+	m.readOnly = readOnly
+}
+
+// SetAuditor sets the auditor which records dispatched mutating actions.
+func (m *Manager) SetAuditor(auditor Auditor) {
+	m.readOnlyMu.Lock()
+	defer m.readOnlyMu.Unlock()
+
+	m.auditor = auditor
+}
+
+// MarkMutating marks action paths as changing cluster state.
+func (m *Manager) MarkMutating(actionPaths ...string) {
+	m.readOnlyMu.Lock()
+	defer m.readOnlyMu.Unlock()
+
+	for _, actionPath := range actionPaths {
+		m.mutating[actionPath] = true
+	}
+}
+
+// ReadOnly returns true if mutating actions are refused.
+func (m *Manager) ReadOnly() bool {
+	m.readOnlyMu.RLock()
+	defer m.readOnlyMu.RUnlock()
+
+	return m.readOnly != nil && m.readOnly()
+}
+
+// MutatingActions returns the sorted action paths which change cluster state.
+func (m *Manager) MutatingActions() []string {
+	m.readOnlyMu.RLock()
+	defer m.readOnlyMu.RUnlock()
+
+	actionPaths := make([]string, 0, len(m.mutating))
+	for actionPath := range m.mutating {
+		actionPaths = append(actionPaths, actionPath)
+	}
+	sort.Strings(actionPaths)
+	return actionPaths
+}
+
+// refuses returns true if actionPath mutates cluster state and Octant is read-only.
+func (m *Manager) refuses(actionPath string) bool {
+	return m.isMutating(actionPath) && m.ReadOnly()
+}
+
+func (m *Manager) isMutating(actionPath string) bool {
+	m.readOnlyMu.RLock()
+	defer m.readOnlyMu.RUnlock()
+
+	return m.mutating[actionPath]
+}
+
+// audit records a mutating action with the auditor.
+func (m *Manager) audit(ctx context.Context, actionPath string, payload Payload, err error) {
+	m.readOnlyMu.RLock()
+	auditor := m.auditor
+	m.readOnlyMu.RUnlock()
+
+	if auditor != nil && m.isMutating(actionPath) {
+		auditor.AuditAction(ctx, actionPath, payload, err)
 	}
 }
 
@@ -187,12 +298,97 @@ func (m *Manager) Dispatch(ctx context.Context, alerter Alerter, actionPath stri
 		return &NotFoundError{Path: actionPath}
 	}
 
+	if m.refuses(actionPath) {
+		err := &ReadOnlyError{Action: actionPath}
+		if alerter != nil {
+			alerter.SendAlert(CreateAlert(AlertTypeError, err.Error(), DefaultAlertExpiration))
+		}
+		m.audit(ctx, actionPath, payload, err)
+		return err
+	}
+
+	var errs []error
 	entries := val.([]dispatcherEntry)
 	for _, entry := range entries {
 		if err := entry.f(ctx, alerter, payload); err != nil {
 			m.logger.Errorf("actionFunc returned err: %s", err)
+			errs = append(errs, err)
 		}
 	}
 
+	var err error
+	if len(errs) > 0 {
+		err = multierror.Append(nil, errs...)
+	}
+	m.audit(ctx, actionPath, payload, err)
+
+	return err
+}
+
+// RegisterCommand registers a command for the command palette. The command is dispatched
+// to its action path like any other action, so a dispatcher should be registered for it.
+// Registering a command again from the same source replaces it.
+func (m *Manager) RegisterCommand(command Command, pluginName string) error {
+	if err := command.Validate(); err != nil {
+		return err
+	}
+
+	if command.Shortcut != "" {
+		// Validate ensures the shortcut can be normalized.
+		command.Shortcut, _ = NormalizeShortcut(command.Shortcut)
+	}
+	command.Source = pluginName
+
+	m.commandsMu.Lock()
+	defer m.commandsMu.Unlock()
+
+	if existing, ok := m.commands[command.Name]; ok && existing.Source != pluginName {
+		return fmt.Errorf("command %q is already registered by %q", command.Name, existing.Source)
+	}
+
+	if command.Shortcut != "" {
+		for _, existing := range m.commands {
+			if existing.Name != command.Name && existing.Shortcut == command.Shortcut {
+				return fmt.Errorf("command %q: shortcut %q is already used by command %q",
+					command.Name, command.Shortcut, existing.Name)
+			}
+		}
+	}
+
+	m.commands[command.Name] = command
 	return nil
 }
+
+// UnregisterCommand unregisters a command registered by a plugin.
+func (m *Manager) UnregisterCommand(commandName string, pluginName string) {
+	m.commandsMu.Lock()
+	defer m.commandsMu.Unlock()
+
+	if existing, ok := m.commands[commandName]; ok && existing.Source == pluginName {
+		delete(m.commands, commandName)
+	}
+}
+
+// Commands returns the registered commands sorted by title. Mutating commands are left out
+// while Octant is read-only.
+func (m *Manager) Commands() []Command {
+	m.commandsMu.RLock()
+	defer m.commandsMu.RUnlock()
+
+	commands := make([]Command, 0, len(m.commands))
+	for _, command := range m.commands {
+		if m.refuses(command.Name) {
+			continue
+		}
+		commands = append(commands, command)
+	}
+
+	sort.Slice(commands, func(i, j int) bool {
+		if commands[i].Title != commands[j].Title {
+			return commands[i].Title < commands[j].Title
+		}
+		return commands[i].Name < commands[j].Name
+	})
+
+	return commands
+}
@@ -7,6 +7,7 @@ package action_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -48,3 +49,131 @@ func TestManager(t *testing.T) {
 
 	assert.True(t, payloadRan)
 }
+
+func TestManager_Commands(t *testing.T) {
+	m := action.NewManager(log.NopLogger())
+
+	apply := action.Command{Name: "apply", Title: "Apply YAML", Shortcut: "Shift+Ctrl+A"}
+	require.NoError(t, m.RegisterCommand(apply, "octant"))
+	require.NoError(t, m.RegisterCommand(action.Command{Name: "deploy", Title: "Deploy"}, "plugin"))
+
+	commands := m.Commands()
+	require.Len(t, commands, 2)
+	assert.Equal(t, "Apply YAML", commands[0].Title)
+	assert.Equal(t, "ctrl+shift+a", commands[0].Shortcut)
+	assert.Equal(t, "octant", commands[0].Source)
+	assert.Equal(t, "Deploy", commands[1].Title)
+
+	// commands can only be replaced by the source that registered them
+	require.Error(t, m.RegisterCommand(action.Command{Name: "apply", Title: "Other"}, "plugin"))
+	require.NoError(t, m.RegisterCommand(action.Command{Name: "apply", Title: "Apply", Shortcut: "ctrl+shift+a"}, "octant"))
+
+	// shortcuts can not be shared
+	require.Error(t, m.RegisterCommand(action.Command{Name: "other", Title: "Other", Shortcut: "ctrl+shift+a"}, "plugin"))
+
+	m.UnregisterCommand("apply", "plugin")
+	require.Len(t, m.Commands(), 2)
+
+	m.UnregisterCommand("apply", "octant")
+	commands = m.Commands()
+	require.Len(t, commands, 1)
+	assert.Equal(t, "deploy", commands[0].Name)
+}
+
+func TestManager_ReadOnly(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	alerter := fake.NewMockAlerter(controller)
+
+	m := action.NewManager(log.NopLogger())
+	m.MarkMutating("delete")
+
+	ran := map[string]bool{}
+	for _, actionPath := range []string{"delete", "view"} {
+		actionPath := actionPath
+		require.NoError(t, m.Register(actionPath, "internal", func(context.Context, action.Alerter, action.Payload) error {
+			ran[actionPath] = true
+			return nil
+		}))
+		require.NoError(t, m.RegisterCommand(action.Command{Name: actionPath, Title: actionPath}, "internal"))
+	}
+
+	assert.Equal(t, []string{action.ActionApplyYaml, "delete"}, m.MutatingActions())
+	assert.False(t, m.ReadOnly())
+	assert.Len(t, m.Commands(), 2)
+
+	readOnly := true
+	m.SetReadOnly(func() bool { return readOnly })
+	assert.True(t, m.ReadOnly())
+
+	alerter.EXPECT().SendAlert(gomock.Any())
+
+	ctx := context.Background()
+	err := m.Dispatch(ctx, alerter, "delete", action.Payload{})
+	var readOnlyErr *action.ReadOnlyError
+	require.ErrorAs(t, err, &readOnlyErr)
+	assert.Equal(t, "delete", readOnlyErr.Action)
+
+	require.NoError(t, m.Dispatch(ctx, alerter, "view", action.Payload{}))
+	assert.Equal(t, map[string]bool{"view": true}, ran)
+
+	commands := m.Commands()
+	require.Len(t, commands, 1)
+	assert.Equal(t, "view", commands[0].Name)
+
+	readOnly = false
+	require.NoError(t, m.Dispatch(ctx, alerter, "delete", action.Payload{}))
+	assert.True(t, ran["delete"])
+}
+
+func TestManager_SetAuditor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	alerter := fake.NewMockAlerter(controller)
+
+	m := action.NewManager(log.NopLogger())
+	m.MarkMutating("delete")
+
+	deleteErr := errors.New("delete failed")
+	require.NoError(t, m.Register("delete", "internal", func(context.Context, action.Alerter, action.Payload) error {
+		return deleteErr
+	}))
+	require.NoError(t, m.Register("view", "internal", func(context.Context, action.Alerter, action.Payload) error {
+		return nil
+	}))
+
+	auditor := &fakeAuditor{}
+	m.SetAuditor(auditor)
+
+	ctx := context.Background()
+	require.NoError(t, m.Dispatch(ctx, alerter, "view", action.Payload{}))
+	require.Error(t, m.Dispatch(ctx, alerter, "delete", action.Payload{"name": "pod"}))
+
+	m.SetReadOnly(func() bool { return true })
+	alerter.EXPECT().SendAlert(gomock.Any())
+	require.Error(t, m.Dispatch(ctx, alerter, "delete", action.Payload{}))
+
+	require.Len(t, auditor.audited, 2)
+	assert.Equal(t, "delete", auditor.audited[0].actionPath)
+	assert.Equal(t, action.Payload{"name": "pod"}, auditor.audited[0].payload)
+	assert.True(t, errors.Is(auditor.audited[0].err, deleteErr))
+
+	var readOnlyErr *action.ReadOnlyError
+	assert.ErrorAs(t, auditor.audited[1].err, &readOnlyErr)
+}
+
+type auditedAction struct {
+	actionPath string
+	payload    action.Payload
+	err        error
+}
+
+type fakeAuditor struct {
+	audited []auditedAction
+}
+
+func (a *fakeAuditor) AuditAction(_ context.Context, actionPath string, payload action.Payload, err error) {
+	a.audited = append(a.audited, auditedAction{actionPath: actionPath, payload: payload, err: err})
+}
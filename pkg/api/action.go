@@ -16,4 +16,10 @@ import (
 // ActionDispatcher dispatches actions.
 type ActionDispatcher interface {
 	Dispatch(ctx context.Context, alerter action.Alerter, actionName string, payload action.Payload) error
+	// Commands returns the commands registered for the command palette.
+	Commands() []action.Command
+	// ReadOnly returns true if mutating actions are refused.
+	ReadOnly() bool
+	// MutatingActions returns the action paths which change cluster state.
+	MutatingActions() []string
 }
@@ -36,6 +36,20 @@ func (m *MockActionDispatcher) EXPECT() *MockActionDispatcherMockRecorder {
 	return m.recorder
 }
 
+// Commands mocks base method.
+func (m *MockActionDispatcher) Commands() []action.Command {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commands")
+	ret0, _ := ret[0].([]action.Command)
+	return ret0
+}
+
+// Commands indicates an expected call of Commands.
+func (mr *MockActionDispatcherMockRecorder) Commands() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commands", reflect.TypeOf((*MockActionDispatcher)(nil).Commands))
+}
+
 // Dispatch mocks base method.
 func (m *MockActionDispatcher) Dispatch(arg0 context.Context, arg1 action.Alerter, arg2 string, arg3 action.Payload) error {
 	m.ctrl.T.Helper()
@@ -49,3 +63,31 @@ func (mr *MockActionDispatcherMockRecorder) Dispatch(arg0, arg1, arg2, arg3 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockActionDispatcher)(nil).Dispatch), arg0, arg1, arg2, arg3)
 }
+
+// MutatingActions mocks base method.
+func (m *MockActionDispatcher) MutatingActions() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MutatingActions")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// MutatingActions indicates an expected call of MutatingActions.
+func (mr *MockActionDispatcherMockRecorder) MutatingActions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MutatingActions", reflect.TypeOf((*MockActionDispatcher)(nil).MutatingActions))
+}
+
+// ReadOnly mocks base method.
+func (m *MockActionDispatcher) ReadOnly() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOnly")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ReadOnly indicates an expected call of ReadOnly.
+func (mr *MockActionDispatcherMockRecorder) ReadOnly() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOnly", reflect.TypeOf((*MockActionDispatcher)(nil).ReadOnly))
+}
@@ -54,6 +54,13 @@ type StreamingClient interface {
 	State() octant.State
 }
 
+// IdentifiedClient is a streaming client which can belong to an authenticated user.
+type IdentifiedClient interface {
+	// WithIdentity returns a copy of ctx which carries the client's user and cluster client.
+	// It returns false if the client doesn't belong to an authenticated user.
+	WithIdentity(ctx context.Context) (context.Context, bool)
+}
+
 type StreamingClientFactory interface {
 	NewConnection(http.ResponseWriter, *http.Request, ClientManager, config.Dash) (StreamingClient, context.CancelFunc, error)
 	NewTemporaryConnection(http.ResponseWriter, *http.Request, ClientManager) (StreamingClient, context.CancelFunc, error)
@@ -126,6 +126,23 @@ func (m *StreamingConnectionManager) Get(id string) event.WSEventSender {
 	return nil
 }
 
+// WithClientIdentity returns a copy of ctx which carries the user and cluster client of the
+// client with id. It returns false if there is no such client, or if the client doesn't
+// belong to an authenticated user.
+func (m *StreamingConnectionManager) WithClientIdentity(ctx context.Context, id string) (context.Context, bool) {
+	for _, client := range m.Clients() {
+		if id != client.ID() {
+			continue
+		}
+		identified, ok := client.(IdentifiedClient)
+		if !ok {
+			return nil, false
+		}
+		return identified.WithIdentity(ctx)
+	}
+	return nil, false
+}
+
 func (m *StreamingConnectionManager) Context() context.Context {
 	return m.ctx
 }
@@ -22,9 +22,12 @@ import (
 	"github.com/gorilla/websocket"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/config"
 )
 
@@ -63,6 +66,9 @@ type WebsocketClient struct {
 // NewWebsocketClient creates an instance of WebsocketClient.
 func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, manager api.ClientManager, dashConfig config.Dash, actionDispatcher api.ActionDispatcher, id uuid.UUID) *WebsocketClient {
 	logger := dashConfig.Logger().With("component", "websocket-client", "client-id", id.String())
+	if user, ok := auth.UserFrom(ctx); ok {
+		logger = logger.With("user", user.Name)
+	}
 	ctx = internalLog.WithLoggerContext(ctx, logger)
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -80,7 +86,15 @@ func NewWebsocketClient(ctx context.Context, conn *websocket.Conn, manager api.C
 		stopCh:     make(chan struct{}, 1),
 	}
 
-	state := NewWebsocketState(dashConfig, actionDispatcher, client)
+	var stateOptions []WebsocketStateOption
+	if clusterClient, ok := cluster.ClientFromContext(ctx); ok {
+		stateOptions = append(stateOptions, WebsocketStateClusterClient(clusterClient))
+	}
+	if user, ok := auth.UserFrom(ctx); ok {
+		stateOptions = append(stateOptions, WebsocketStateUser(user))
+	}
+
+	state := NewWebsocketState(dashConfig, actionDispatcher, client, stateOptions...)
 	go state.Start(ctx)
 
 	client.state = state
@@ -131,6 +145,21 @@ func NewTemporaryWebsocketClient(ctx context.Context, conn *websocket.Conn, mana
 	return client
 }
 
+// WithIdentity returns a copy of ctx which carries the user and cluster client of the request
+// which opened the websocket.
+func (c *WebsocketClient) WithIdentity(ctx context.Context) (context.Context, bool) {
+	user, ok := auth.UserFrom(c.ctx)
+	if !ok {
+		return nil, false
+	}
+
+	ctx = auth.WithUser(ctx, user)
+	if client, ok := cluster.ClientFromContext(c.ctx); ok {
+		ctx = cluster.WithClient(ctx, client)
+	}
+	return ctx, true
+}
+
 // ID returns the ID of the websocket client.
 func (c *WebsocketClient) ID() string {
 	return c.id.String()
@@ -141,7 +170,9 @@ func (c *WebsocketClient) Handlers() map[string][]octant.ClientRequestHandler {
 }
 
 func (c *WebsocketClient) readPump() {
+	metrics.WebsocketClientConnected()
 	defer func() {
+		metrics.WebsocketClientDisconnected()
 		c.isOpen.Store(false)
 		c.logger.Debugf("closing read pump")
 	}()
@@ -266,6 +297,7 @@ func (c *WebsocketClient) writePump() {
 				c.logger.WithErr(err).Errorf("Close websocket writer")
 				return
 			}
+			metrics.WebsocketMessage(metrics.DirectionSent)
 		case <-ticker.C:
 			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
 				c.logger.WithErr(err).Errorf("Set websocket write deadline")
@@ -296,6 +328,7 @@ func (c *WebsocketClient) Receive() (api.StreamRequest, error) {
 		}
 		return api.StreamRequest{}, errors.FatalStreamError(err)
 	}
+	metrics.WebsocketMessage(metrics.DirectionReceived)
 
 	var request api.StreamRequest
 	if err := json.Unmarshal(message, &request); err != nil {
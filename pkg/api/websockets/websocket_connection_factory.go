@@ -9,7 +9,9 @@ import (
 	"github.com/gorilla/websocket"
 
 	internalAPI "github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/pkg/api"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/config"
 )
 
@@ -51,7 +53,7 @@ func (wcf *WebsocketConnectionFactory) NewConnection(
 		return nil, nil, err
 	}
 
-	ctx, cancel := context.WithCancel(m.Context())
+	ctx, cancel := context.WithCancel(withRequestIdentity(m.Context(), r))
 	client := NewWebsocketClient(ctx, conn, m, dashConfig, m.ActionDispatcher(), clientID)
 
 	return client, cancel, nil
@@ -75,3 +77,16 @@ func (wcf *WebsocketConnectionFactory) NewTemporaryConnection(
 
 	return client, cancel, nil
 }
+
+// withRequestIdentity copies the authenticated user and their cluster client from the
+// request that opened a connection to ctx. The connection outlives the request, so its
+// context is not derived from the request's context.
+func withRequestIdentity(ctx context.Context, r *http.Request) context.Context {
+	if user, ok := auth.UserFrom(r.Context()); ok {
+		ctx = auth.WithUser(ctx, user)
+	}
+	if client, ok := cluster.ClientFromContext(r.Context()); ok {
+		ctx = cluster.WithClient(ctx, client)
+	}
+	return ctx
+}
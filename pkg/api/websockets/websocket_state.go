@@ -15,6 +15,7 @@ import (
 	"sync"
 
 	internalAPI "github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/util/path_util"
 
 	"github.com/vmware-tanzu/octant/pkg/api"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/cluster"
 	"github.com/vmware-tanzu/octant/pkg/config"
 )
 
@@ -31,7 +33,7 @@ var (
 	reContentPathNamespace = regexp.MustCompile(`^/namespace/(?P<namespace>[^/]+)/?`)
 )
 
-func defaultStateManagers(clientID string, dashConfig config.Dash) []api.StateManager {
+func defaultStateManagers(clientID string, dashConfig config.Dash, actionDispatcher api.ActionDispatcher) []api.StateManager {
 	logger := dashConfig.Logger().With("client-id", clientID)
 
 	return []api.StateManager{
@@ -44,6 +46,10 @@ func defaultStateManagers(clientID string, dashConfig config.Dash) []api.StateMa
 		internalAPI.NewActionRequestManager(dashConfig),
 		internalAPI.NewTerminalStateManager(dashConfig),
 		internalAPI.NewPodLogsStateManager(dashConfig),
+		internalAPI.NewCommandManager(actionDispatcher),
+		internalAPI.NewReadOnlyManager(actionDispatcher),
+		internalAPI.NewCredentialsManager(dashConfig),
+		internalAPI.NewNotificationManager(dashConfig.Notifications()),
 	}
 }
 
@@ -83,6 +89,22 @@ func WebsocketStateManagers(managers []api.StateManager) WebsocketStateOption {
 	}
 }
 
+// WebsocketStateClusterClient configures the cluster client actions dispatched by WebsocketState
+// are made with. It is used when the client belongs to an authenticated user.
+func WebsocketStateClusterClient(client cluster.ClientInterface) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.clusterClient = client
+	}
+}
+
+// WebsocketStateUser configures the authenticated user actions dispatched by WebsocketState
+// are attributed to.
+func WebsocketStateUser(user auth.User) WebsocketStateOption {
+	return func(w *WebsocketState) {
+		w.user = &user
+	}
+}
+
 // WebsocketState manages state for a websocket client.
 type WebsocketState struct {
 	dashConfig         config.Dash
@@ -96,12 +118,15 @@ type WebsocketState struct {
 	mu               sync.RWMutex
 	managers         []api.StateManager
 	actionDispatcher api.ActionDispatcher
+	clusterClient    cluster.ClientInterface
+	user             *auth.User
 
 	startCtx           context.Context
 	managersCancelFunc context.CancelFunc
 }
 
 var _ octant.State = (*WebsocketState)(nil)
+var _ action.EventSender = (*WebsocketState)(nil)
 
 // NewWebsocketState creates an instance of WebsocketState.
 func NewWebsocketState(dashConfig config.Dash, actionDispatcher api.ActionDispatcher, wsClient api.OctantClient, options ...WebsocketStateOption) *WebsocketState {
@@ -123,7 +148,7 @@ func NewWebsocketState(dashConfig config.Dash, actionDispatcher api.ActionDispat
 	}
 
 	if len(w.managers) < 1 {
-		w.managers = defaultStateManagers(wsClient.ID(), dashConfig)
+		w.managers = defaultStateManagers(wsClient.ID(), dashConfig, actionDispatcher)
 	}
 
 	return w
@@ -170,6 +195,12 @@ func (c *WebsocketState) Handlers() []octant.ClientRequestHandler {
 
 // Dispatch dispatches a message.
 func (c *WebsocketState) Dispatch(ctx context.Context, actionName string, payload action.Payload) error {
+	if c.clusterClient != nil {
+		ctx = cluster.WithClient(ctx, c.clusterClient)
+	}
+	if c.user != nil {
+		ctx = auth.WithUser(ctx, *c.user)
+	}
 	return c.actionDispatcher.Dispatch(ctx, c, actionName, payload)
 }
 
@@ -376,6 +407,11 @@ func (c *WebsocketState) SendAlert(alert action.Alert) {
 	c.wsClient.Send(CreateAlertUpdate(alert))
 }
 
+// SendEvent sends an event to the websocket client.
+func (c *WebsocketState) SendEvent(e event.Event) {
+	c.wsClient.Send(e)
+}
+
 func (c *WebsocketState) GetClientID() string {
 	if c.wsClient == nil {
 		return ""
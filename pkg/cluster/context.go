@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"k8s.io/client-go/rest"
+)
+
+type clientContextKey struct{}
+
+// WithClient returns a copy of ctx that carries the cluster client for the user making the request.
+func WithClient(ctx context.Context, client ClientInterface) context.Context {
+	return context.WithValue(ctx, clientContextKey{}, client)
+}
+
+// ClientFromContext returns the cluster client stored in ctx by WithClient.
+func ClientFromContext(ctx context.Context) (ClientInterface, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	client, ok := ctx.Value(clientContextKey{}).(ClientInterface)
+	return client, ok && client != nil
+}
+
+// ClientOrDefault returns the cluster client stored in ctx, or fallback if ctx does not carry one.
+func ClientOrDefault(ctx context.Context, fallback ClientInterface) ClientInterface {
+	if client, ok := ClientFromContext(ctx); ok {
+		return client
+	}
+	return fallback
+}
+
+// Impersonator is a cluster client that can create clients which make requests as another user.
+type Impersonator interface {
+	Impersonate(ctx context.Context, config rest.ImpersonationConfig) (ClientInterface, error)
+}
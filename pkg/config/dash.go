@@ -11,8 +11,13 @@ import (
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/apidiscovery"
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/fleet"
 	"github.com/vmware-tanzu/octant/internal/kubeconfig"
+	"github.com/vmware-tanzu/octant/internal/metricshistory"
 	"github.com/vmware-tanzu/octant/internal/module"
+	"github.com/vmware-tanzu/octant/internal/notification"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/cluster"
@@ -95,10 +100,42 @@ type Dash interface {
 
 	UseContext(ctx context.Context, contextName string) error
 
+	// ReloadKubeConfig reloads the kube config files after they changed.
+	ReloadKubeConfig(ctx context.Context) error
+
+	// RefreshCredentials recreates the cluster client so credentials are fetched again.
+	RefreshCredentials(ctx context.Context) error
+
+	// CredentialStatus returns the status of the credentials of the current context.
+	CredentialStatus() kubeconfig.CredentialStatus
+
 	CurrentContext() string
 
+	// ReadOnly returns true if mutating actions are refused for the current context.
+	ReadOnly() bool
+
+	// AuditLog returns the log of mutating actions.
+	AuditLog() *audit.Log
+
 	Contexts() []kubeconfig.Context
 
+	// KubeConfigEditor returns an editor for the kube config files in use. It is nil if
+	// Octant was not started with kube config files.
+	KubeConfigEditor() *kubeconfig.Editor
+
+	// SchemaLoader returns a loader for the OpenAPI schemas of the current cluster.
+	SchemaLoader() *apidiscovery.SchemaLoader
+
+	// MetricsHistory returns the recent resource usage of pods in the current cluster.
+	MetricsHistory() *metricshistory.History
+
+	// Notifications returns the watched objects and the notifications about them.
+	Notifications() *notification.Manager
+
+	// Fleet returns the kube contexts loaded into the fleet overview. It is nil if there
+	// is no fleet.
+	Fleet() *fleet.Fleet
+
 	DefaultNamespace() string
 
 	Validate() error
@@ -20,6 +20,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/util/path_util"
 
 	"contrib.go.opencensus.io/exporter/jaeger"
+	"github.com/gobwas/glob"
 	"github.com/skratchdot/open-golang/open"
 	"github.com/soheilhy/cmux"
 	"github.com/spf13/afero"
@@ -27,20 +28,27 @@ import (
 	"go.opencensus.io/trace"
 
 	internalAPI "github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	internalConfig "github.com/vmware-tanzu/octant/internal/config"
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	oerrors "github.com/vmware-tanzu/octant/internal/errors"
+	"github.com/vmware-tanzu/octant/internal/fleet"
 	"github.com/vmware-tanzu/octant/internal/kubeconfig"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metricshistory"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/modules/applications"
 	"github.com/vmware-tanzu/octant/internal/modules/clusteroverview"
 	"github.com/vmware-tanzu/octant/internal/modules/configuration"
+	fleetModule "github.com/vmware-tanzu/octant/internal/modules/fleet"
 	"github.com/vmware-tanzu/octant/internal/modules/localcontent"
 	"github.com/vmware-tanzu/octant/internal/modules/overview"
 	"github.com/vmware-tanzu/octant/internal/modules/workloads"
+	"github.com/vmware-tanzu/octant/internal/notification"
 	"github.com/vmware-tanzu/octant/internal/objectstore"
+	internalOctant "github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/api"
@@ -61,6 +69,7 @@ type Runner struct {
 	pluginManager              *plugin.Manager
 	moduleManager              *module.Manager
 	actionManager              *action.Manager
+	auditLog                   *audit.Log
 	streamingConnectionManager *api.StreamingConnectionManager
 	apiCreated                 bool
 	fs                         afero.Fs
@@ -82,8 +91,20 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 	}
 
 	actionManger := action.NewManager(logger)
+	actionManger.MarkMutating(internalOctant.MutatingActions...)
 	r.actionManager = actionManger
 
+	var auditOptions []audit.Option
+	if options.AuditLogFile != "" {
+		auditOptions = append(auditOptions, audit.WithFile(options.AuditLogFile, options.AuditLogMaxSize, options.AuditLogMaxBackups))
+	}
+	auditLog, err := audit.New(logger, auditOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit log: %w", err)
+	}
+	actionManger.SetAuditor(auditLog)
+	r.auditLog = auditLog
+
 	var streamingConnectionManager *api.StreamingConnectionManager
 	if options.streamingClientFactory != nil {
 		streamingConnectionManager = api.NewStreamingConnectionManager(ctx, r.actionManager, options.streamingClientFactory)
@@ -93,8 +114,6 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 	r.streamingConnectionManager = streamingConnectionManager
 	go streamingConnectionManager.Run(ctx)
 
-	var err error
-
 	var pluginService *pluginAPI.GRPCService
 	var apiService internalAPI.Service
 	var apiErr error
@@ -110,7 +129,7 @@ func NewRunner(ctx context.Context, logger log.Logger, opts ...RunnerOption) (*R
 		return nil, fmt.Errorf("failed to start service api: %w", apiErr)
 	}
 
-	d, err := newDash(options.Listener, options.Namespace, options.FrontendURL, options.BrowserPath, apiService, pluginService, logger)
+	d, err := newDash(options.Listener, options.Namespace, options.FrontendURL, options.BrowserPath, apiService, pluginService, options.Authenticator, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dash instance: %w", err)
 	}
@@ -166,9 +185,7 @@ func (r *Runner) Start(startupCh, shutdownCh chan bool, opts ...RunnerOption) er
 			}
 			r.dash.apiHandler = apiService
 			r.dash.pluginService = pluginService
-			hf := octant.NewHandlerFactory(
-				octant.BackendHandler(r.dash.apiHandler.Handler),
-				octant.FrontendURL(viper.GetString("proxy-frontend")))
+			hf := r.dash.newHandlerFactory()
 
 			r.dash.server.Handler, err = hf.Handler(r.ctx)
 			if err != nil {
@@ -188,6 +205,10 @@ func (r *Runner) Start(startupCh, shutdownCh chan bool, opts ...RunnerOption) er
 		r.pluginManager.Stop(shutdownCtx)
 	}
 
+	if err := r.auditLog.Close(); err != nil {
+		logger.WithErr(err).Errorf("closing audit log")
+	}
+
 	shutdownCh <- true
 	return nil
 }
@@ -292,6 +313,10 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		NamespaceInterface:     nsClient,
 		FrontendProxy:          frontendProxy,
 		WebsocketClientManager: r.streamingConnectionManager,
+		AuditLog:               r.auditLog,
+	}
+	if options.Authenticator != nil {
+		pluginDashboardService.Identities = r.streamingConnectionManager
 	}
 
 	pluginManager, err := initPlugin(moduleManager, r.actionManager, r.streamingConnectionManager, pluginDashboardService)
@@ -307,11 +332,21 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		Time:    options.BuildInfo.Time,
 	}
 
+	readOnlyPolicy, err := internalConfig.NewReadOnlyPolicy(options.ReadOnly, options.ReadOnlyContexts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("initializing read-only mode: %w", err)
+	}
+
 	restConfigOptions := cluster.RESTConfigOptions{
 		QPS:       options.ClientQPS,
 		Burst:     options.ClientBurst,
 		UserAgent: options.UserAgent,
 	}
+	storeFactory := func(ctx context.Context, client cluster.ClientInterface) (store.Store, error) {
+		return initObjectStore(ctx, client)
+	}
+	clusterFleet := fleet.New(ctx, fleetClientFactory(kubeContextDecorator), storeFactory)
+
 	dashConfig := internalConfig.NewLiveConfig(
 		kubeContextDecorator,
 		crdWatcher,
@@ -325,15 +360,38 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, opts ...RunnerO
 		buildInfo,
 		options.KubeConfig,
 		false,
+		readOnlyPolicy,
+		r.auditLog,
+		clusterFleet,
 	)
 
+	r.actionManager.SetReadOnly(dashConfig.ReadOnly)
+	r.auditLog.SetContextName(dashConfig.CurrentContext)
+	pluginDashboardService.ReadOnly = dashConfig.ReadOnly
+
 	pluginManager.SetOctantClient(dashConfig)
 
 	if err := watchConfigs(ctx, dashConfig, options.KubeConfig); err != nil {
 		return nil, nil, fmt.Errorf("set up config watcher: %w", err)
 	}
 
-	moduleList, err := initModules(ctx, dashConfig, options.Namespace, options)
+	metricsSampler := metricshistory.NewSampler(dashConfig.MetricsHistory(), dashConfig.ClusterClient, logger)
+	go metricsSampler.Run(ctx)
+
+	if err := initNotifications(ctx, dashConfig.Notifications(), logger); err != nil {
+		return nil, nil, fmt.Errorf("initializing notifications: %w", err)
+	}
+	// Watched contexts are loaded into a fleet of their own, so they don't show up in the
+	// fleet overview.
+	notificationChecker := notification.NewChecker(dashConfig.Notifications(), dashConfig,
+		fleet.New(ctx, fleetClientFactory(kubeContextDecorator), storeFactory), logger)
+	go notificationChecker.Run(ctx)
+
+	if err := loadFleetContexts(logger, clusterFleet, kubeContextDecorator.Contexts(), options.FleetContexts); err != nil {
+		return nil, nil, fmt.Errorf("loading fleet contexts: %w", err)
+	}
+
+	moduleList, err := initModules(ctx, dashConfig, clusterFleet, options.Namespace, options)
 	if err != nil {
 		return nil, nil, fmt.Errorf("initializing modules: %w", err)
 	}
@@ -390,7 +448,7 @@ type moduleOptions struct {
 	actionManager  *action.Manager
 }
 
-func initModules(ctx context.Context, dashConfig config.Dash, namespace string, options Options) ([]module.Module, error) {
+func initModules(ctx context.Context, dashConfig config.Dash, clusterFleet *fleet.Fleet, namespace string, options Options) ([]module.Module, error) {
 	var list []module.Module
 
 	podViewOptions := workloads.Options{
@@ -434,8 +492,15 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string,
 		list = append(list, clusterOverviewModule)
 	}
 
+	fleetOptions := fleetModule.Options{
+		DashConfig: dashConfig,
+		Fleet:      clusterFleet,
+	}
+	list = append(list, fleetModule.New(ctx, fleetOptions))
+
 	configurationOptions := configuration.Options{
 		DashConfig: dashConfig,
+		ServerMode: options.Authenticator != nil,
 	}
 	configurationModule := configuration.New(ctx, configurationOptions)
 
@@ -450,6 +515,79 @@ func initModules(ctx context.Context, dashConfig config.Dash, namespace string,
 	return list, nil
 }
 
+// fleetClientFactory creates cluster clients for fleet contexts. Contexts can only be loaded
+// when Octant is using a kube config.
+func fleetClientFactory(kubeContextDecorator internalConfig.KubeContextDecorator) fleet.ClientFactory {
+	manager, ok := kubeContextDecorator.(*kubeconfig.KubeConfigContextManager)
+	if !ok {
+		return func(context.Context, string) (cluster.ClientInterface, error) {
+			return nil, fmt.Errorf("fleet contexts can only be loaded from a kube config")
+		}
+	}
+	return manager.ClusterClientForContext
+}
+
+// initNotifications loads the persisted watches and the notification configuration from the
+// Octant configuration directory, and sends notifications to the configured webhook.
+func initNotifications(ctx context.Context, notifications *notification.Manager, logger log.Logger) error {
+	configDir := plugin.ConfigDir(plugin.DefaultConfig)
+	if configDir == "" {
+		logger.Warnf("unable to find the Octant configuration directory; watches will not be persisted")
+		return nil
+	}
+
+	if err := notifications.Load(filepath.Join(configDir, notification.WatchesFile)); err != nil {
+		return err
+	}
+
+	config, err := notification.LoadConfig(filepath.Join(configDir, notification.ConfigFile))
+	if err != nil {
+		return err
+	}
+	if config.WebhookURL == "" {
+		return nil
+	}
+
+	webhook, err := notification.NewWebhook(config.WebhookURL)
+	if err != nil {
+		return err
+	}
+	queue := notification.NewQueue(webhook, config.WebhookTimeout.Duration, logger)
+	go queue.Run(ctx)
+	notifications.AddSender(queue)
+
+	return nil
+}
+
+// loadFleetContexts loads the contexts matching one of patterns into the fleet in the background.
+func loadFleetContexts(logger log.Logger, clusterFleet *fleet.Fleet, contexts []kubeconfig.Context, patterns []string) error {
+	var globs []glob.Glob
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid fleet context pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+
+	for _, kubeContext := range contexts {
+		for _, g := range globs {
+			if !g.Match(kubeContext.Name) {
+				continue
+			}
+
+			go func(contextName string) {
+				if err := clusterFleet.Load(contextName); err != nil {
+					logger.WithErr(err).With("context", contextName).Errorf("loading fleet context")
+				}
+			}(kubeContext.Name)
+			break
+		}
+	}
+
+	return nil
+}
+
 // initModuleManager initializes the moduleManager (and currently the modules themselves)
 func initModuleManager(options *moduleOptions) (*module.Manager, error) {
 	moduleManager, err := module.NewManager(options.clusterClient, options.namespace, options.actionManager, options.logger)
@@ -473,16 +611,12 @@ type dash struct {
 	handlerFactory  *octant.HandlerFactory
 	server          http.Server
 	pluginService   pluginAPI.Service
+	authenticator   auth.Authenticator
 }
 
-func newDash(listener net.Listener, namespace, uiURL string, browserPath string, apiHandler internalAPI.Service, pluginHandler pluginAPI.Service, logger log.Logger) (*dash, error) {
-	hf := octant.NewHandlerFactory(
-		octant.BackendHandler(apiHandler.Handler),
-		octant.FrontendURL(viper.GetString("proxy-frontend")))
-
-	return &dash{
+func newDash(listener net.Listener, namespace, uiURL string, browserPath string, apiHandler internalAPI.Service, pluginHandler pluginAPI.Service, authenticator auth.Authenticator, logger log.Logger) (*dash, error) {
+	d := &dash{
 		mux:             cmux.New(listener),
-		handlerFactory:  hf,
 		listener:        listener,
 		namespace:       namespace,
 		uiURL:           uiURL,
@@ -491,15 +625,25 @@ func newDash(listener net.Listener, namespace, uiURL string, browserPath string,
 		willOpenBrowser: true,
 		apiHandler:      apiHandler,
 		pluginService:   pluginHandler,
+		authenticator:   authenticator,
 		logger:          logger,
-	}, nil
+	}
+	d.handlerFactory = d.newHandlerFactory()
+
+	return d, nil
+}
+
+// newHandlerFactory creates a handler factory for the current API service.
+func (d *dash) newHandlerFactory() *octant.HandlerFactory {
+	return octant.NewHandlerFactory(
+		octant.BackendHandler(d.apiHandler.Handler),
+		octant.FrontendURL(viper.GetString("proxy-frontend")),
+		octant.Authenticator(d.authenticator))
 }
 
 func (d *dash) SetAPIService(ctx context.Context, apiService internalAPI.Service) error {
 	d.apiHandler = apiService
-	hf := octant.NewHandlerFactory(
-		octant.BackendHandler(d.apiHandler.Handler),
-		octant.FrontendURL(viper.GetString("proxy-frontend")))
+	hf := d.newHandlerFactory()
 	var err error
 	d.server.Handler, err = hf.Handler(ctx)
 	return err
@@ -541,7 +685,7 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 		}
 	}()
 
-	dashboardURL := fmt.Sprintf("http://%s", d.listener.Addr())
+	dashboardURL := internalAPI.ListenerURL(d.listener)
 
 	d.logger.Infof("Dashboard is available at %s\n", dashboardURL)
 
@@ -549,7 +693,8 @@ func (d *dash) Run(ctx context.Context, startupCh chan bool) error {
 		startupCh <- true
 	}
 
-	if d.willOpenBrowser {
+	// Browsers can't open unix sockets.
+	if d.willOpenBrowser && d.listener.Addr().Network() != "unix" {
 		runURL := dashboardURL
 		if d.browserPath != "" {
 			runURL += path_util.PrefixedPath(d.browserPath)
@@ -235,6 +235,8 @@ func mockClusterClientReturningNamespace(controller *gomock.Controller, namespac
 	clusterClient := clusterFake.NewMockClientInterface(controller)
 	clusterClient.EXPECT().NamespaceClient().Return(nsClient, nil).MinTimes(1)
 	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+	// the metrics sampler checks for metrics-server in the background.
+	clusterClient.EXPECT().ResourceExists(gomock.Any()).Return(false).AnyTimes()
 	clusterClient.EXPECT().RESTClient()
 	clusterClient.EXPECT().RESTConfig()
 	clusterClient.EXPECT().DefaultNamespace().Return(namespace)
@@ -9,6 +9,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+
+	kubeconfig "github.com/vmware-tanzu/octant/internal/kubeconfig"
 )
 
 // MockWatcherConfig is a mock of WatcherConfig interface.
@@ -34,6 +36,20 @@ func (m *MockWatcherConfig) EXPECT() *MockWatcherConfigMockRecorder {
 	return m.recorder
 }
 
+// CredentialStatus mocks base method.
+func (m *MockWatcherConfig) CredentialStatus() kubeconfig.CredentialStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CredentialStatus")
+	ret0, _ := ret[0].(kubeconfig.CredentialStatus)
+	return ret0
+}
+
+// CredentialStatus indicates an expected call of CredentialStatus.
+func (mr *MockWatcherConfigMockRecorder) CredentialStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CredentialStatus", reflect.TypeOf((*MockWatcherConfig)(nil).CredentialStatus))
+}
+
 // CurrentContext mocks base method.
 func (m *MockWatcherConfig) CurrentContext() string {
 	m.ctrl.T.Helper()
@@ -48,6 +64,34 @@ func (mr *MockWatcherConfigMockRecorder) CurrentContext() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentContext", reflect.TypeOf((*MockWatcherConfig)(nil).CurrentContext))
 }
 
+// RefreshCredentials mocks base method.
+func (m *MockWatcherConfig) RefreshCredentials(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCredentials", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCredentials indicates an expected call of RefreshCredentials.
+func (mr *MockWatcherConfigMockRecorder) RefreshCredentials(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCredentials", reflect.TypeOf((*MockWatcherConfig)(nil).RefreshCredentials), arg0)
+}
+
+// ReloadKubeConfig mocks base method.
+func (m *MockWatcherConfig) ReloadKubeConfig(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadKubeConfig", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReloadKubeConfig indicates an expected call of ReloadKubeConfig.
+func (mr *MockWatcherConfigMockRecorder) ReloadKubeConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadKubeConfig", reflect.TypeOf((*MockWatcherConfig)(nil).ReloadKubeConfig), arg0)
+}
+
 // UseContext mocks base method.
 func (m *MockWatcherConfig) UseContext(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 
 	"k8s.io/client-go/dynamic/dynamicinformer"
 
+	"github.com/vmware-tanzu/octant/internal/auth"
 	internalCluster "github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/kubeconfig"
 	"github.com/vmware-tanzu/octant/pkg/api"
@@ -19,6 +20,10 @@ import (
 )
 
 type Options struct {
+	AuditLogFile           string
+	AuditLogMaxBackups     int
+	AuditLogMaxSize        int
+	Authenticator          auth.Authenticator
 	BrowserPath            string
 	BuildInfo              config.BuildInfo
 	ClientBurst            int
@@ -27,11 +32,14 @@ type Options struct {
 	DisableClusterOverview bool
 	EnableMemStats         bool
 	EnableOpenCensus       bool
+	FleetContexts          []string
 	FrontendURL            string
 	KubeConfig             string
 	Listener               net.Listener
 	Namespace              string
 	Namespaces             []string
+	ReadOnly               bool
+	ReadOnlyContexts       []string
 	UserAgent              string
 
 	clusterClient          cluster.ClientInterface
@@ -45,6 +53,17 @@ type RunnerOption struct {
 	nonClusterOption func(*Options)
 }
 
+// WithAuthenticator configures Octant to run in server mode. Only requests from users authenticated
+// by authenticator are served, and each user's cluster requests impersonate them.
+func WithAuthenticator(authenticator auth.Authenticator) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.Authenticator = authenticator
+		},
+	}
+}
+
 func WithBrowserPath(browserPath string) RunnerOption {
 	return RunnerOption{
 		kubeConfigOption: kubeconfig.Noop(),
@@ -197,6 +216,51 @@ func WithOpenCensus() RunnerOption {
 	}
 }
 
+// WithReadOnly refuses every mutating action.
+func WithReadOnly() RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.ReadOnly = true
+		},
+	}
+}
+
+// WithReadOnlyContexts refuses mutating actions while the current kube context matches one of
+// the glob patterns.
+func WithReadOnlyContexts(patterns []string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.ReadOnlyContexts = patterns
+		},
+	}
+}
+
+// WithAuditLog writes the audit log to path. If maxSizeMB is greater than zero, the file is
+// rotated when it grows past that size, keeping maxBackups rotated files.
+func WithAuditLog(path string, maxSizeMB, maxBackups int) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.AuditLogFile = path
+			o.AuditLogMaxSize = maxSizeMB
+			o.AuditLogMaxBackups = maxBackups
+		},
+	}
+}
+
+// WithFleetContexts loads the kube contexts matching one of the glob patterns into the fleet
+// at startup.
+func WithFleetContexts(patterns []string) RunnerOption {
+	return RunnerOption{
+		kubeConfigOption: kubeconfig.Noop(),
+		nonClusterOption: func(o *Options) {
+			o.FleetContexts = patterns
+		},
+	}
+}
+
 func WithStreamingClientFactory(factory api.StreamingClientFactory) RunnerOption {
 	return RunnerOption{
 		nonClusterOption: func(o *Options) {
@@ -10,9 +10,12 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
 	"github.com/vmware-tanzu/octant/internal/log"
 	internalStrings "github.com/vmware-tanzu/octant/internal/util/strings"
 )
@@ -75,23 +78,60 @@ func ConfigWatcherFileWatcher(fw FileWatcher) ConfigWatcherOption {
 	}
 }
 
+// ConfigWatcherDebounce sets how long ConfigWatcher waits for more file events before it
+// reloads the config.
+func ConfigWatcherDebounce(d time.Duration) ConfigWatcherOption {
+	return func(cw *ConfigWatcher) {
+		cw.debounce = d
+	}
+}
+
+// ConfigWatcherCredentialCheckInterval sets how often ConfigWatcher checks if the API server
+// rejected the credentials of the current context.
+func ConfigWatcherCredentialCheckInterval(d time.Duration) ConfigWatcherOption {
+	return func(cw *ConfigWatcher) {
+		cw.credentialCheckInterval = d
+	}
+}
+
 // WatcherConfig is an interface with configuration for ConfigWatcher.
 type WatcherConfig interface {
 	CurrentContext() string
 	UseFSContext(ctx context.Context) error
 	UseContext(ctx context.Context, name string) error
+	ReloadKubeConfig(ctx context.Context) error
+	RefreshCredentials(ctx context.Context) error
+	CredentialStatus() kubeconfig.CredentialStatus
 }
 
-// ConfigWatcher watches kubernetes configurations.
+const (
+	defaultConfigDebounce          = 500 * time.Millisecond
+	defaultCredentialCheckInterval = 5 * time.Second
+)
+
+// ConfigWatcher watches kubernetes configurations. Config files are often replaced by
+// renaming a new file over them, so the directories of the files are watched.
+// ConfigWatcher also refreshes the credentials of the current context once when the API
+// server rejects them.
 type ConfigWatcher struct {
-	FileWatcher   FileWatcher
-	watcherConfig WatcherConfig
+	FileWatcher             FileWatcher
+	watcherConfig           WatcherConfig
+	debounce                time.Duration
+	credentialCheckInterval time.Duration
+
+	mu    sync.Mutex
+	files map[string]bool
+	dirs  map[string]bool
 }
 
 // NewConfigWatcher creates an instance of ConfigWatcher.
 func NewConfigWatcher(wc WatcherConfig, options ...ConfigWatcherOption) (*ConfigWatcher, error) {
 	cw := &ConfigWatcher{
-		watcherConfig: wc,
+		watcherConfig:           wc,
+		debounce:                defaultConfigDebounce,
+		credentialCheckInterval: defaultCredentialCheckInterval,
+		files:                   make(map[string]bool),
+		dirs:                    make(map[string]bool),
 	}
 
 	for _, option := range options {
@@ -109,15 +149,27 @@ func NewConfigWatcher(wc WatcherConfig, options ...ConfigWatcherOption) (*Config
 	return cw, nil
 }
 
-// Add adds file names to be watched.
+// Add adds file names to be watched. The directory of each file is watched.
 func (cw *ConfigWatcher) Add(ctx context.Context, names ...string) error {
 	logger := log.From(ctx).With("component", "config-watcher")
 
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+
 	for _, name := range names {
+		name = filepath.Clean(name)
+		cw.files[name] = true
+
+		dir := filepath.Dir(name)
+		if cw.dirs[dir] {
+			continue
+		}
+
 		logger.With("config", name).Infof("watching config file")
-		if err := cw.FileWatcher.Add(name); err != nil {
+		if err := cw.FileWatcher.Add(dir); err != nil {
 			return fmt.Errorf("unable to watch %s: %w", name, err)
 		}
+		cw.dirs[dir] = true
 	}
 
 	return nil
@@ -126,23 +178,52 @@ func (cw *ConfigWatcher) Add(ctx context.Context, names ...string) error {
 // Watch runs the config watcher loop
 func (cw *ConfigWatcher) Watch(ctx context.Context) {
 	logger := log.From(ctx).With("component", "config-watcher")
+
+	reload := time.NewTimer(cw.debounce)
+	reload.Stop()
+	defer reload.Stop()
+
+	credentialCheck := time.NewTicker(cw.credentialCheckInterval)
+	defer credentialCheck.Stop()
+
 	done := false
 	for !done {
 		select {
 		case <-ctx.Done():
 			done = true
 			logger.Infof("shutting down config watcher")
-		case <-cw.FileWatcher.Events():
-			if err := cw.watcherConfig.UseFSContext(ctx); err != nil {
+		case e := <-cw.FileWatcher.Events():
+			if cw.isWatched(e.Name) {
+				reload.Reset(cw.debounce)
+			}
+		case <-reload.C:
+			if err := cw.watcherConfig.ReloadKubeConfig(ctx); err != nil {
 				logger.WithErr(err).Errorf("reload config")
 			}
+		case <-credentialCheck.C:
+			if cw.watcherConfig.CredentialStatus() != kubeconfig.CredentialsRejected {
+				continue
+			}
+			logger.With("context", cw.watcherConfig.CurrentContext()).
+				Infof("credentials were rejected, refreshing credentials")
+			if err := cw.watcherConfig.RefreshCredentials(ctx); err != nil {
+				logger.WithErr(err).Errorf("refresh credentials")
+			}
 		case err := <-cw.FileWatcher.Errors():
 			logger.WithErr(err).Errorf("event error")
 		}
 	}
 }
 
-// watchConfigs watches kubernetes config files. If any file changes, reload the cluster client.
+// isWatched returns true if name is one of the watched files.
+func (cw *ConfigWatcher) isWatched(name string) bool {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+
+	return cw.files[filepath.Clean(name)]
+}
+
+// watchConfigs watches kubernetes config files. If any file changes, reload the config.
 func watchConfigs(ctx context.Context, wc WatcherConfig, configChainStr string) error {
 	cw, err := NewConfigWatcher(wc)
 	if err != nil {
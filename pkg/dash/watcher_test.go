@@ -10,11 +10,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/octant/internal/kubeconfig"
 	"github.com/vmware-tanzu/octant/pkg/dash"
 	"github.com/vmware-tanzu/octant/pkg/dash/fake"
 )
@@ -23,15 +25,23 @@ func TestConfigWatcher_Add(t *testing.T) {
 	tests := []struct {
 		name         string
 		filenames    []string
+		dirs         []string
 		filenamesErr error
 	}{
 		{
 			name:      "in general",
 			filenames: []string{"kubeconfig"},
+			dirs:      []string{"."},
+		},
+		{
+			name:      "files in the same directory",
+			filenames: []string{"/home/user/.kube/config", "/home/user/.kube/other", "/etc/kubeconfig"},
+			dirs:      []string{"/home/user/.kube", "/etc"},
 		},
 		{
 			name:         "file add error",
 			filenames:    []string{"kubeconfig"},
+			dirs:         []string{"."},
 			filenamesErr: fmt.Errorf("boom"),
 		},
 	}
@@ -44,8 +54,11 @@ func TestConfigWatcher_Add(t *testing.T) {
 			watcherConfig := fake.NewMockWatcherConfig(controller)
 			fileWatcher := fake.NewMockFileWatcher(controller)
 
-			for _, f := range tt.filenames {
-				fileWatcher.EXPECT().Add(f).Return(tt.filenamesErr)
+			for _, d := range tt.dirs {
+				fileWatcher.EXPECT().Add(d).Return(tt.filenamesErr)
+				if tt.filenamesErr != nil {
+					break
+				}
 			}
 
 			fileWatcherOption := dash.ConfigWatcherFileWatcher(fileWatcher)
@@ -69,15 +82,17 @@ func TestConfigWatcher_Watch(t *testing.T) {
 	defer controller.Finish()
 
 	watcherConfig := fake.NewMockWatcherConfig(controller)
+	watcherConfig.EXPECT().CredentialStatus().Return(kubeconfig.CredentialsValid).AnyTimes()
 
 	ch := make(chan bool, 1)
-	watcherConfig.EXPECT().UseFSContext(gomock.Any()).
+	watcherConfig.EXPECT().ReloadKubeConfig(gomock.Any()).
 		DoAndReturn(func(_ context.Context) error {
 			ch <- true
 			return nil
 		})
 
 	fileWatcher := fake.NewMockFileWatcher(controller)
+	fileWatcher.EXPECT().Add("/kube").Return(nil)
 
 	eventCh := make(chan fsnotify.Event)
 	fileWatcher.EXPECT().Events().Return(eventCh).AnyTimes()
@@ -85,20 +100,64 @@ func TestConfigWatcher_Watch(t *testing.T) {
 	errCh := make(chan error)
 	fileWatcher.EXPECT().Errors().Return(errCh).AnyTimes()
 
-	fileWatcherOption := dash.ConfigWatcherFileWatcher(fileWatcher)
-
 	ctx, cancel := context.WithCancel(context.Background())
-	cw, err := dash.NewConfigWatcher(watcherConfig, fileWatcherOption)
+	defer cancel()
+
+	cw, err := dash.NewConfigWatcher(watcherConfig,
+		dash.ConfigWatcherFileWatcher(fileWatcher),
+		dash.ConfigWatcherDebounce(10*time.Millisecond))
 	require.NoError(t, err)
+	require.NoError(t, cw.Add(ctx, "/kube/config"))
 
 	go cw.Watch(ctx)
 
-	event := fsnotify.Event{
-		Name: "event",
-		Op:   0,
+	// events for other files in the directory are ignored, and events in quick
+	// succession reload the config once
+	eventCh <- fsnotify.Event{Name: "/kube/config.lock", Op: fsnotify.Create}
+	eventCh <- fsnotify.Event{Name: "/kube/config", Op: fsnotify.Remove}
+	eventCh <- fsnotify.Event{Name: "/kube/config", Op: fsnotify.Create}
+	<-ch
+
+	select {
+	case <-ch:
+		t.Fatal("config was reloaded twice")
+	case <-time.After(50 * time.Millisecond):
 	}
+}
+
+func TestConfigWatcher_Watch_refreshCredentials(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	watcherConfig := fake.NewMockWatcherConfig(controller)
+	watcherConfig.EXPECT().CurrentContext().Return("context").AnyTimes()
+
+	ch := make(chan bool, 1)
+	gomock.InOrder(
+		watcherConfig.EXPECT().CredentialStatus().Return(kubeconfig.CredentialsRejected),
+		watcherConfig.EXPECT().RefreshCredentials(gomock.Any()).
+			DoAndReturn(func(_ context.Context) error {
+				ch <- true
+				return nil
+			}),
+		watcherConfig.EXPECT().CredentialStatus().Return(kubeconfig.CredentialsExpired).AnyTimes(),
+	)
+
+	fileWatcher := fake.NewMockFileWatcher(controller)
+	fileWatcher.EXPECT().Events().Return(make(chan fsnotify.Event)).AnyTimes()
+	fileWatcher.EXPECT().Errors().Return(make(chan error)).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cw, err := dash.NewConfigWatcher(watcherConfig,
+		dash.ConfigWatcherFileWatcher(fileWatcher),
+		dash.ConfigWatcherCredentialCheckInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	go cw.Watch(ctx)
 
-	eventCh <- event
 	<-ch
-	cancel()
+	// expired credentials are not refreshed automatically
+	time.Sleep(50 * time.Millisecond)
 }
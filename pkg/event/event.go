@@ -21,6 +21,9 @@ const (
 	// EventTypeContent is a content event.
 	EventTypeContent EventType = "event.octant.dev/content"
 
+	// EventTypeContentPatch is an event with patches of the view components of content.
+	EventTypeContentPatch EventType = "event.octant.dev/contentPatch"
+
 	// EventTypeNamespaces is a namespaces event.
 	EventTypeNamespaces EventType = "event.octant.dev/namespaces"
 
@@ -63,6 +66,18 @@ const (
 	// EventTypeAppLogs is an app logs event.
 	EventTypeAppLogs EventType = "event.octant.dev/app-logs"
 
+	// EventTypeCommands is a command palette event.
+	EventTypeCommands EventType = "event.octant.dev/commands"
+
+	// EventTypeReadOnly is a read-only mode event.
+	EventTypeReadOnly EventType = "event.octant.dev/readOnly"
+
+	// EventTypeNotification is a notification about a watched object.
+	EventTypeNotification EventType = "event.octant.dev/notification"
+
+	// EventTypeValidation is an event with the validation errors of an edited object.
+	EventTypeValidation EventType = "event.octant.dev/validation"
+
 	// EventTypeTerminalFormat is a string with format specifiers to assist in generating
 	// a terminal event type.
 	EventTypeTerminalFormat string = "event.octant.dev/terminals/namespace/%s/pod/%s/container/%s"
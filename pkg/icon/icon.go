@@ -22,6 +22,9 @@ const (
 	Webhooks        = "animation"
 	Nodes           = "nodes"
 	PortForwards    = "router"
+	APIResources    = "book"
+	NodeUtilization = "dashboard"
+	Warnings        = "exclamation-triangle"
 
 	ClusterOverview                   = "objects"
 	ClusterOverviewClusterRole        = "c-role"
@@ -31,8 +34,13 @@ const (
 	ClusterOverviewPersistentVolume   = "pv"
 	ClusterOverviewStorageClass       = "sc"
 
-	Configuration       = "cog"
-	ConfigurationPlugin = "plugin"
+	Configuration              = "cog"
+	ConfigurationAuditLog      = "history"
+	ConfigurationKubeConfig    = "file-settings"
+	ConfigurationNotifications = "bell"
+	ConfigurationPlugin        = "plugin"
 
 	CustomResourceDefinition = "dna"
+
+	Fleet = "world"
 )
@@ -17,7 +17,9 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/vmware-tanzu/octant/internal/api"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 )
 
 type HandlerFactoryFunc func(ctx context.Context) (http.Handler, error)
@@ -28,6 +30,7 @@ type HandlerFactoryFunc func(ctx context.Context) (http.Handler, error)
 type HandlerFactory struct {
 	frontendHandler HandlerFactoryFunc
 	backendHandler  HandlerFactoryFunc
+	authenticator   auth.Authenticator
 
 	mu sync.RWMutex
 }
@@ -39,6 +42,7 @@ func NewHandlerFactory(optionList ...Option) *HandlerFactory {
 	hf := HandlerFactory{
 		frontendHandler: opts.frontendHandler,
 		backendHandler:  opts.backendHandler,
+		authenticator:   opts.authenticator,
 	}
 
 	return &hf
@@ -65,6 +69,12 @@ func (hf *HandlerFactory) Handler(ctx context.Context) (http.Handler, error) {
 		return nil, err
 	}
 
+	if hf.authenticator != nil {
+		auth.RegisterRoutes(router, hf.authenticator)
+		router.Use(auth.Middleware(ctx, hf.authenticator))
+	}
+
+	router.Handle(metrics.Path, metrics.Handler())
 	router.PathPrefix(api.PathPrefix).Handler(backendHandler)
 
 	router.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
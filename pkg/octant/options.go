@@ -10,6 +10,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/vmware-tanzu/octant/internal/auth"
 )
 
 // options is an internal set of options that can be used to configure Octant. These are
@@ -21,6 +23,8 @@ type options struct {
 	frontendHandler func(ctx context.Context) (http.Handler, error)
 	// backendHandler is a function that creates a backend handler.
 	backendHandler func(ctx context.Context) (http.Handler, error)
+	// authenticator authenticates requests. Requests are not authenticated if it is nil.
+	authenticator auth.Authenticator
 }
 
 // buildOptions builds an options struct from a list of functional options.
@@ -65,6 +69,13 @@ func FrontendURL(proxyURL string) Option {
 	}
 }
 
+// Authenticator configures Octant to only serve requests from users authenticated by authenticator.
+func Authenticator(authenticator auth.Authenticator) Option {
+	return func(o *options) {
+		o.authenticator = authenticator
+	}
+}
+
 var frontendHandlerFn func() (http.Handler, error)
 
 func SetFrontendHandler(handlerFn func() (http.Handler, error)) {
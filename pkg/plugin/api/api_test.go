@@ -19,10 +19,14 @@ import (
 	"google.golang.org/grpc/metadata"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/audit"
+	"github.com/vmware-tanzu/octant/internal/auth"
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
@@ -311,6 +315,104 @@ func TestAPI(t *testing.T) {
 	}
 }
 
+func TestGRPCService_readOnly(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	auditLog, err := audit.New(log.NopLogger())
+	require.NoError(t, err)
+
+	// The mocks fail the test if the service reaches them.
+	service := &api.GRPCService{
+		ObjectStore:   storeFake.NewMockStore(controller),
+		PortForwarder: portForwardFake.NewMockPortForwarder(controller),
+		ReadOnly:      func() bool { return true },
+		AuditLog:      auditLog,
+	}
+
+	ctx := context.Background()
+	object := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+
+	var readOnlyErr *action.ReadOnlyError
+	require.ErrorAs(t, service.Update(ctx, object), &readOnlyErr)
+	require.ErrorAs(t, service.Create(ctx, object), &readOnlyErr)
+	require.ErrorAs(t, service.Delete(ctx, key), &readOnlyErr)
+
+	_, err = service.ApplyYAML(ctx, "default", "")
+	require.ErrorAs(t, err, &readOnlyErr)
+
+	_, err = service.PortForward(ctx, api.PortForwardRequest{Namespace: "default", PodName: "pod", Port: 8080})
+	require.ErrorAs(t, err, &readOnlyErr)
+
+	var audited []string
+	for _, entry := range auditLog.Entries() {
+		assert.Equal(t, audit.SourcePlugin, entry.Source)
+		assert.Equal(t, audit.ResultRefused, entry.Result)
+		assert.Equal(t, auditLog.LocalUser(), entry.User)
+		audited = append(audited, entry.Action)
+	}
+	assert.Equal(t, []string{"port forward", "apply YAML", "delete", "create", "update"}, audited)
+	assert.Equal(t, &key, auditLog.Entries()[2].Target)
+}
+
+type fakeIdentities map[string]auth.User
+
+func (f fakeIdentities) WithClientIdentity(ctx context.Context, id string) (context.Context, bool) {
+	user, ok := f[id]
+	if !ok {
+		return nil, false
+	}
+	return auth.WithUser(ctx, user), true
+}
+
+func TestGRPCService_identities(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	auditLog, err := audit.New(log.NopLogger())
+	require.NoError(t, err)
+
+	service := &api.GRPCService{
+		ObjectStore: objectStore,
+		AuditLog:    auditLog,
+		Identities:  fakeIdentities{"client": {Name: "jane"}},
+	}
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "pod"}
+
+	objectStore.EXPECT().
+		Get(contextType, key).
+		DoAndReturn(func(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+			user, ok := auth.UserFrom(ctx)
+			require.True(t, ok)
+			assert.Equal(t, "jane", user.Name)
+			return &unstructured.Unstructured{}, nil
+		})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(api.ClientIDMetadataKey, "client"))
+	_, err = service.Get(ctx, key)
+	require.NoError(t, err)
+
+	// requests which aren't made for a signed-in user's client are refused.
+	_, err = service.Get(context.Background(), key)
+	require.Error(t, err)
+
+	unknownCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(api.ClientIDMetadataKey, "unknown"))
+	require.Error(t, service.Delete(unknownCtx, key))
+
+	objectStore.EXPECT().Delete(contextType, key).Return(nil)
+	require.NoError(t, service.Delete(ctx, key))
+
+	entries := auditLog.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, audit.ResultSuccess, entries[0].Result)
+	assert.Equal(t, "jane", entries[0].User)
+	assert.Equal(t, audit.ResultError, entries[1].Result)
+	assert.Empty(t, entries[1].User)
+}
+
 func checkPort(t *testing.T, isListen bool, addr string) {
 	_, err := net.Listen("tcp", addr)
 	if isListen {
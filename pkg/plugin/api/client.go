@@ -11,10 +11,12 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/event"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/spf13/viper"
 
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api/proto"
@@ -26,6 +28,10 @@ import (
 
 const MaxMessageSize int = 1024 * 1024 * 16
 
+// ClientIDMetadataKey is the metadata key of the ID of the websocket client a plugin makes a
+// request for. Octant uses it to make the request as the user the client belongs to.
+const ClientIDMetadataKey = "x-octant-client-id"
+
 type DashboardConnection interface {
 	Close() error
 	Client() proto.DashboardClient
@@ -69,6 +75,7 @@ func NewClient(address string, options ...ClientOption) (*Client, error) {
 		conn, err := grpc.Dial(address,
 			grpc.WithInsecure(),
 			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(viper.GetInt("client-max-recv-msg-size"))),
+			grpc.WithUnaryInterceptor(clientIDInterceptor),
 		)
 		if err != nil {
 			return nil, err
@@ -82,6 +89,15 @@ func NewClient(address string, options ...ClientOption) (*Client, error) {
 	return client, nil
 }
 
+// clientIDInterceptor sends the ID of the websocket client in the client state of a request's
+// context with the request.
+func clientIDInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if clientID := ocontext.ClientStateFrom(ctx).ClientID; clientID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, ClientIDMetadataKey, clientID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
 // Close closes the client's connection.
 func (c *Client) Close() error {
 	return c.DashboardConnection.Close()
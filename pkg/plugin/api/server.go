@@ -19,6 +19,7 @@ import (
 	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/pkg/event"
 
+	"github.com/vmware-tanzu/octant/internal/audit"
 	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -97,13 +98,89 @@ type GRPCService struct {
 	NamespaceInterface     cluster.NamespaceInterface
 	WebsocketClientManager event.WSClientGetter
 	LinkGenerator          octant.LinkGenerator
+	// ReadOnly reports whether mutating requests are refused. If it is nil, they are allowed.
+	ReadOnly func() bool
+	// AuditLog records mutating requests. If it is nil, they are not recorded.
+	AuditLog *audit.Log
+	// Identities finds the users websocket clients belong to. Requests plugins make for a
+	// client are made with the client's user's cluster client. If it is set, Octant serves
+	// several users, and requests which aren't made for a client are refused. If it is nil,
+	// requests are made as Octant.
+	Identities ClientIdentities
+}
+
+// ClientIdentities finds the users websocket clients belong to.
+type ClientIdentities interface {
+	// WithClientIdentity returns a copy of ctx which carries the user and cluster client of
+	// the client with id. It returns false if there is no such client.
+	WithClientIdentity(ctx context.Context, id string) (context.Context, bool)
 }
 
 var _ Service = (*GRPCService)(nil)
 
+// checkWritable returns an error if request changes cluster state and Octant is read-only.
+func (s *GRPCService) checkWritable(request string) error {
+	if s.ReadOnly != nil && s.ReadOnly() {
+		return &action.ReadOnlyError{Action: request}
+	}
+	return nil
+}
+
+// withIdentity returns a copy of ctx which carries the identity of the user the request is
+// made for.
+func (s *GRPCService) withIdentity(ctx context.Context) (context.Context, error) {
+	if s.Identities == nil {
+		return ctx, nil
+	}
+
+	var clientID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ClientIDMetadataKey); len(values) > 0 {
+			clientID = values[0]
+		}
+	}
+	if clientID == "" {
+		clientID = ocontext.ClientStateFrom(ctx).ClientID
+	}
+
+	if clientID != "" {
+		if identityCtx, ok := s.Identities.WithClientIdentity(ctx, clientID); ok {
+			return identityCtx, nil
+		}
+	}
+
+	return nil, fmt.Errorf("plugin request isn't made for a signed-in user")
+}
+
+// audit records a mutating request made by a plugin.
+func (s *GRPCService) audit(ctx context.Context, request string, key *store.Key, payload interface{}, err error) {
+	if s.AuditLog == nil {
+		return
+	}
+
+	entry := audit.Entry{
+		Source:        audit.SourcePlugin,
+		Action:        request,
+		Target:        key,
+		PayloadDigest: audit.Digest(payload),
+	}
+	if s.Identities == nil {
+		// Plugins make requests as Octant, i.e. with the credentials of the user running it.
+		// Otherwise the user the request is made for is taken from ctx.
+		entry.User = s.AuditLog.LocalUser()
+	}
+	entry.SetResult(err)
+	s.AuditLog.Record(ctx, entry)
+}
+
 // List lists objects.
 func (s *GRPCService) List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error) {
 	// TODO: support hasSynced
+	ctx, err := s.withIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx = extractObjectStoreMetadata(ctx)
 	list, _, err := s.ObjectStore.List(ctx, key)
 	return list, err
@@ -111,15 +188,33 @@ func (s *GRPCService) List(ctx context.Context, key store.Key) (*unstructured.Un
 
 // Get retrieves an object.
 func (s *GRPCService) Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	ctx, err := s.withIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx = extractObjectStoreMetadata(ctx)
 	return s.ObjectStore.Get(ctx, key)
 }
 
-func (s *GRPCService) Update(ctx context.Context, object *unstructured.Unstructured) error {
+func (s *GRPCService) Update(ctx context.Context, object *unstructured.Unstructured) (err error) {
 	key, err := store.KeyFromObject(object)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		s.audit(ctx, "update", &key, object, err)
+	}()
+
+	identityCtx, err := s.withIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = identityCtx
+
+	if err := s.checkWritable("update"); err != nil {
+		return err
+	}
 
 	ctx = extractObjectStoreMetadata(ctx)
 	return s.ObjectStore.Update(ctx, key, func(u *unstructured.Unstructured) error {
@@ -128,29 +223,90 @@ func (s *GRPCService) Update(ctx context.Context, object *unstructured.Unstructu
 	})
 }
 
-func (s *GRPCService) Create(ctx context.Context, object *unstructured.Unstructured) error {
+func (s *GRPCService) Create(ctx context.Context, object *unstructured.Unstructured) (err error) {
+	defer func() {
+		var target *store.Key
+		if key, keyErr := store.KeyFromObject(object); keyErr == nil {
+			target = &key
+		}
+		s.audit(ctx, "create", target, object, err)
+	}()
+
+	identityCtx, err := s.withIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = identityCtx
+
+	if err := s.checkWritable("create"); err != nil {
+		return err
+	}
+
 	ctx = extractObjectStoreMetadata(ctx)
 	return s.ObjectStore.Create(ctx, object)
 }
 
-func (s *GRPCService) ApplyYAML(ctx context.Context, namespace, yaml string) ([]string, error) {
+func (s *GRPCService) ApplyYAML(ctx context.Context, namespace, yaml string) (results []string, err error) {
+	defer func() {
+		s.audit(ctx, "apply YAML", &store.Key{Namespace: namespace}, yaml, err)
+	}()
+
+	identityCtx, err := s.withIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = identityCtx
+
+	if err := s.checkWritable("apply YAML"); err != nil {
+		return nil, err
+	}
+
 	ctx = extractObjectStoreMetadata(ctx)
 	return s.ObjectStore.CreateOrUpdateFromYAML(ctx, namespace, yaml)
 }
 
-func (s *GRPCService) Delete(ctx context.Context, key store.Key) error {
+func (s *GRPCService) Delete(ctx context.Context, key store.Key) (err error) {
+	defer func() {
+		s.audit(ctx, "delete", &key, key, err)
+	}()
+
+	identityCtx, err := s.withIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = identityCtx
+
+	if err := s.checkWritable("delete"); err != nil {
+		return err
+	}
+
 	ctx = extractObjectStoreMetadata(ctx)
 	return s.ObjectStore.Delete(ctx, key)
 }
 
 // PortForward creates a port forward.
-func (s *GRPCService) PortForward(ctx context.Context, req PortForwardRequest) (PortForwardResponse, error) {
+func (s *GRPCService) PortForward(ctx context.Context, req PortForwardRequest) (resp PortForwardResponse, err error) {
+	defer func() {
+		key := &store.Key{Namespace: req.Namespace, APIVersion: "v1", Kind: "Pod", Name: req.PodName}
+		s.audit(ctx, "port forward", key, req, err)
+	}()
+
+	identityCtx, err := s.withIdentity(ctx)
+	if err != nil {
+		return PortForwardResponse{}, err
+	}
+	ctx = identityCtx
+
+	if err := s.checkWritable("port forward"); err != nil {
+		return PortForwardResponse{}, err
+	}
+
 	pfResponse, err := s.PortForwarder.Create(ctx, nil, gvk.Pod, req.PodName, req.Namespace, req.Port)
 	if err != nil {
 		return PortForwardResponse{}, err
 	}
 
-	resp := PortForwardResponse{
+	resp = PortForwardResponse{
 		ID:   pfResponse.ID,
 		Port: pfResponse.Ports[0].Local,
 	}
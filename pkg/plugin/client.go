@@ -37,6 +37,11 @@ type Capabilities struct {
 	IsModule bool `json:",omitempty"`
 	// ActionNames is a list of action names this plugin handles
 	ActionNames []string `json:",omitempty"`
+	// SupportsListColumns are the GVKs the plugin will add list table columns for.
+	SupportsListColumns []schema.GroupVersionKind `json:",omitempty"`
+	// Commands are global commands this plugin adds to the command palette. Commands are
+	// dispatched to the plugin's action handler using the command name as the action name.
+	Commands []action.Command `json:",omitempty"`
 }
 
 // HasPrinterSupport returns true if this plugin supports the supplied GVK.
@@ -58,6 +63,22 @@ func (c Capabilities) HasObjectStatusSupport(gvk schema.GroupVersionKind) bool {
 	return includesGVK(gvk, c.SupportsObjectStatus)
 }
 
+// HasAction returns true if actionName is in the plugin's action names.
+func (c Capabilities) HasAction(actionName string) bool {
+	for _, name := range c.ActionNames {
+		if name == actionName {
+			return true
+		}
+	}
+	return false
+}
+
+// HasListColumnsSupport returns true if this plugin supports adding list table
+// columns for the supplied GVK.
+func (c Capabilities) HasListColumnsSupport(gvk schema.GroupVersionKind) bool {
+	return includesGVK(gvk, c.SupportsListColumns)
+}
+
 // PrintResponse is a printer response from the plugin. The dashboard
 // will use this to the add the plugin's output to a summary view.
 type PrintResponse struct {
@@ -82,6 +103,16 @@ type ObjectStatusResponse struct {
 	ObjectStatus component.PodSummary
 }
 
+// ListColumnsResponse is a list columns response from the plugin. The
+// dashboard will use this to add columns to the row for an object in
+// list tables.
+type ListColumnsResponse struct {
+	// Columns are the names of the columns added by the plugin.
+	Columns []string `json:"columns,omitempty"`
+	// Cells are the cells for the object's row keyed by column name.
+	Cells map[string]component.Component `json:"cells,omitempty"`
+}
+
 // Metadata is plugin metadata.
 type Metadata struct {
 	Name         string
@@ -96,6 +127,7 @@ type Service interface {
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTabs(ctx context.Context, object runtime.Object) ([]TabResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
+	ListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error)
 	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
 }
 
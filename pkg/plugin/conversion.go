@@ -10,6 +10,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -28,6 +29,8 @@ func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabili
 		SupportsTab:           convertToGroupVersionKindList(in.SupportsTab),
 		IsModule:              in.IsModule,
 		ActionNames:           in.ActionNames,
+		SupportsListColumns:   convertToGroupVersionKindList(in.SupportsListColumns),
+		Commands:              convertToCommands(in.Commands),
 	}
 
 	return c
@@ -42,11 +45,76 @@ func convertFromCapabilities(in Capabilities) *dashboard.RegisterResponse_Capabi
 		SupportsTab:           convertFromGroupVersionKindList(in.SupportsTab),
 		IsModule:              in.IsModule,
 		ActionNames:           in.ActionNames,
+		SupportsListColumns:   convertFromGroupVersionKindList(in.SupportsListColumns),
+		Commands:              convertFromCommands(in.Commands),
 	}
 
 	return &c
 }
 
+func convertToCommands(in []*dashboard.RegisterResponse_Command) []action.Command {
+	var list []action.Command
+
+	for _, command := range in {
+		if command == nil {
+			continue
+		}
+
+		c := action.Command{
+			Name:        command.Name,
+			Title:       command.Title,
+			Description: command.Description,
+			Shortcut:    command.Shortcut,
+		}
+
+		for _, field := range command.Fields {
+			if field == nil {
+				continue
+			}
+			c.Fields = append(c.Fields, action.CommandField{
+				Name:     field.Name,
+				Label:    field.Label,
+				Type:     action.CommandFieldType(field.Type),
+				Choices:  field.Choices,
+				Required: field.Required,
+				Value:    field.Value,
+			})
+		}
+
+		list = append(list, c)
+	}
+
+	return list
+}
+
+func convertFromCommands(in []action.Command) []*dashboard.RegisterResponse_Command {
+	var list []*dashboard.RegisterResponse_Command
+
+	for _, command := range in {
+		c := &dashboard.RegisterResponse_Command{
+			Name:        command.Name,
+			Title:       command.Title,
+			Description: command.Description,
+			Shortcut:    command.Shortcut,
+		}
+
+		for _, field := range command.Fields {
+			c.Fields = append(c.Fields, &dashboard.RegisterResponse_CommandField{
+				Name:     field.Name,
+				Label:    field.Label,
+				Type:     string(field.Type),
+				Choices:  field.Choices,
+				Required: field.Required,
+				Value:    field.Value,
+			})
+		}
+
+		list = append(list, c)
+	}
+
+	return list
+}
+
 func convertToGroupVersionKindList(in []*dashboard.RegisterResponse_GroupVersionKind) []schema.GroupVersionKind {
 	var list []schema.GroupVersionKind
 
@@ -176,3 +244,42 @@ func convertFromSummarySection(in component.SummarySection) (*dashboard.PrintRes
 		Component: data,
 	}, nil
 }
+
+func convertToListCells(in map[string][]byte) (map[string]component.Component, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	out := map[string]component.Component{}
+	for name, data := range in {
+		var typedObject component.TypedObject
+		if err := json.Unmarshal(data, &typedObject); err != nil {
+			return nil, err
+		}
+
+		view, err := typedObject.ToComponent()
+		if err != nil {
+			return nil, err
+		}
+		out[name] = view
+	}
+
+	return out, nil
+}
+
+func convertFromListCells(in map[string]component.Component) (map[string][]byte, error) {
+	out := map[string][]byte{}
+	for name, view := range in {
+		if view == nil {
+			continue
+		}
+
+		data, err := json.Marshal(view)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = data
+	}
+
+	return out, nil
+}
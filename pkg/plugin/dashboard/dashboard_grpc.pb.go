@@ -26,6 +26,7 @@ type PluginClient interface {
 	Print(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintResponse, error)
 	ObjectStatus(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*ObjectStatusResponse, error)
 	PrintTabs(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintTabResponse, error)
+	ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error)
 	WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchUpdate(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchDelete(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
@@ -102,6 +103,15 @@ func (c *pluginClient) PrintTabs(ctx context.Context, in *ObjectRequest, opts ..
 	return out, nil
 }
 
+func (c *pluginClient) ListColumns(ctx context.Context, in *ListColumnsRequest, opts ...grpc.CallOption) (*ListColumnsResponse, error) {
+	out := new(ListColumnsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Plugin/ListColumns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pluginClient) WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/dashboard.Plugin/WatchAdd", in, out, opts...)
@@ -140,6 +150,7 @@ type PluginServer interface {
 	Print(context.Context, *ObjectRequest) (*PrintResponse, error)
 	ObjectStatus(context.Context, *ObjectRequest) (*ObjectStatusResponse, error)
 	PrintTabs(context.Context, *ObjectRequest) (*PrintTabResponse, error)
+	ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error)
 	WatchAdd(context.Context, *WatchRequest) (*Empty, error)
 	WatchUpdate(context.Context, *WatchRequest) (*Empty, error)
 	WatchDelete(context.Context, *WatchRequest) (*Empty, error)
@@ -171,6 +182,9 @@ func (UnimplementedPluginServer) ObjectStatus(context.Context, *ObjectRequest) (
 func (UnimplementedPluginServer) PrintTabs(context.Context, *ObjectRequest) (*PrintTabResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrintTabs not implemented")
 }
+func (UnimplementedPluginServer) ListColumns(context.Context, *ListColumnsRequest) (*ListColumnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListColumns not implemented")
+}
 func (UnimplementedPluginServer) WatchAdd(context.Context, *WatchRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WatchAdd not implemented")
 }
@@ -319,6 +333,24 @@ func _Plugin_PrintTabs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_ListColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ListColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Plugin/ListColumns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ListColumns(ctx, req.(*ListColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Plugin_WatchAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WatchRequest)
 	if err := dec(in); err != nil {
@@ -408,6 +440,10 @@ var Plugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PrintTabs",
 			Handler:    _Plugin_PrintTabs_Handler,
 		},
+		{
+			MethodName: "ListColumns",
+			Handler:    _Plugin_ListColumns_Handler,
+		},
 		{
 			MethodName: "WatchAdd",
 			Handler:    _Plugin_WatchAdd_Handler,
@@ -41,6 +41,21 @@ func (m *MockRunners) EXPECT() *MockRunnersMockRecorder {
 	return m.recorder
 }
 
+// ListColumns mocks base method.
+func (m *MockRunners) ListColumns(arg0 plugin.ManagerStore) (plugin.ListRunner, chan plugin.PluginListColumnsResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListColumns", arg0)
+	ret0, _ := ret[0].(plugin.ListRunner)
+	ret1, _ := ret[1].(chan plugin.PluginListColumnsResponse)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns.
+func (mr *MockRunnersMockRecorder) ListColumns(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockRunners)(nil).ListColumns), arg0)
+}
+
 // ObjectStatus mocks base method.
 func (m *MockRunners) ObjectStatus(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan plugin.ObjectStatusResponse) {
 	m.ctrl.T.Helper()
@@ -382,6 +397,21 @@ func (mr *MockModuleServiceMockRecorder) HandleAction(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAction", reflect.TypeOf((*MockModuleService)(nil).HandleAction), arg0, arg1, arg2)
 }
 
+// ListColumns mocks base method.
+func (m *MockModuleService) ListColumns(arg0 context.Context, arg1 []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListColumns", arg0, arg1)
+	ret0, _ := ret[0].([]plugin.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns.
+func (mr *MockModuleServiceMockRecorder) ListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockModuleService)(nil).ListColumns), arg0, arg1)
+}
+
 // Navigation mocks base method.
 func (m *MockModuleService) Navigation(arg0 context.Context) (navigation.Navigation, error) {
 	m.ctrl.T.Helper()
@@ -494,6 +524,21 @@ func (mr *MockServiceMockRecorder) HandleAction(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAction", reflect.TypeOf((*MockService)(nil).HandleAction), arg0, arg1, arg2)
 }
 
+// ListColumns mocks base method.
+func (m *MockService) ListColumns(arg0 context.Context, arg1 []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListColumns", arg0, arg1)
+	ret0, _ := ret[0].([]plugin.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns.
+func (mr *MockServiceMockRecorder) ListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockService)(nil).ListColumns), arg0, arg1)
+}
+
 // ObjectStatus mocks base method.
 func (m *MockService) ObjectStatus(arg0 context.Context, arg1 runtime.Object) (plugin.ObjectStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -39,6 +39,21 @@ func (m *MockManagerInterface) EXPECT() *MockManagerInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ListColumns mocks base method.
+func (m *MockManagerInterface) ListColumns(arg0 context.Context, arg1 []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListColumns", arg0, arg1)
+	ret0, _ := ret[0].([]plugin.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns.
+func (mr *MockManagerInterfaceMockRecorder) ListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockManagerInterface)(nil).ListColumns), arg0, arg1)
+}
+
 // ObjectStatus mocks base method.
 func (m *MockManagerInterface) ObjectStatus(arg0 context.Context, arg1 runtime.Object) (*plugin.ObjectStatusResponse, error) {
 	m.ctrl.T.Helper()
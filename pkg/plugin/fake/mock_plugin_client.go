@@ -77,6 +77,26 @@ func (mr *MockPluginClientMockRecorder) HandleAction(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAction", reflect.TypeOf((*MockPluginClient)(nil).HandleAction), varargs...)
 }
 
+// ListColumns mocks base method.
+func (m *MockPluginClient) ListColumns(ctx context.Context, in *dashboard.ListColumnsRequest, opts ...grpc.CallOption) (*dashboard.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListColumns", varargs...)
+	ret0, _ := ret[0].(*dashboard.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns.
+func (mr *MockPluginClientMockRecorder) ListColumns(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockPluginClient)(nil).ListColumns), varargs...)
+}
+
 // Navigation mocks base method.
 func (m *MockPluginClient) Navigation(ctx context.Context, in *dashboard.NavigationRequest, opts ...grpc.CallOption) (*dashboard.NavigationResponse, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +310,21 @@ func (mr *MockPluginServerMockRecorder) HandleAction(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAction", reflect.TypeOf((*MockPluginServer)(nil).HandleAction), arg0, arg1)
 }
 
+// ListColumns mocks base method.
+func (m *MockPluginServer) ListColumns(arg0 context.Context, arg1 *dashboard.ListColumnsRequest) (*dashboard.ListColumnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListColumns", arg0, arg1)
+	ret0, _ := ret[0].(*dashboard.ListColumnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListColumns indicates an expected call of ListColumns.
+func (mr *MockPluginServerMockRecorder) ListColumns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListColumns", reflect.TypeOf((*MockPluginServer)(nil).ListColumns), arg0, arg1)
+}
+
 // Navigation mocks base method.
 func (m *MockPluginServer) Navigation(arg0 context.Context, arg1 *dashboard.NavigationRequest) (*dashboard.NavigationResponse, error) {
 	m.ctrl.T.Helper()
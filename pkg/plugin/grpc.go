@@ -7,6 +7,7 @@ package plugin
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/vmware-tanzu/octant/internal/util/json"
 
@@ -71,6 +72,11 @@ func (c *GRPCClient) Content(ctx context.Context, contentPath string) (component
 			return errors.Wrap(err, "grpc client content")
 		}
 
+		source := fmt.Sprintf("content response for %s", contentPath)
+		if err := validateOutput(ctx, source, "contentResponse", resp.ContentResponse); err != nil {
+			return err
+		}
+
 		if err := json.Unmarshal(resp.ContentResponse, &contentResponse); err != nil {
 			return errors.Wrap(err, "unmarshal content response")
 		}
@@ -228,6 +234,58 @@ func (c *GRPCClient) ObjectStatus(ctx context.Context, object runtime.Object) (O
 	return osr, nil
 }
 
+// ListColumns gets the list table columns for objects with a single request.
+func (c *GRPCClient) ListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error) {
+	var responses []ListColumnsResponse
+
+	err := c.run(func() error {
+		clientState := ocontext.ClientStateFrom(ctx)
+		clientStateData, err := json.Marshal(&clientState)
+		if err != nil {
+			return err
+		}
+
+		in := &dashboard.ListColumnsRequest{
+			ClientState: clientStateData,
+		}
+		for _, object := range objects {
+			data, err := json.Marshal(object)
+			if err != nil {
+				return err
+			}
+			in.Objects = append(in.Objects, data)
+		}
+
+		resp, err := c.client.ListColumns(ctx, in, grpc.WaitForReady(true))
+		if err != nil {
+			return errors.Wrap(err, "grpc client list columns")
+		}
+		if len(resp.Objects) != len(objects) {
+			return errors.Errorf("grpc client list columns: expected %d responses, got %d", len(objects), len(resp.Objects))
+		}
+
+		for _, object := range resp.Objects {
+			cells, err := convertToListCells(object.Cells)
+			if err != nil {
+				return errors.Wrap(err, "convert list cells")
+			}
+
+			responses = append(responses, ListColumnsResponse{
+				Columns: object.Columns,
+				Cells:   cells,
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
 // Print prints an object.
 func (c *GRPCClient) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
 	var pr PrintResponse
@@ -249,6 +307,10 @@ func (c *GRPCClient) Print(ctx context.Context, object runtime.Object) (PrintRes
 			return errors.Wrap(err, "grpc client print")
 		}
 
+		if err := validatePrintResponse(ctx, resp); err != nil {
+			return err
+		}
+
 		var items []component.FlexLayoutItem
 		if len(resp.Items) > 0 {
 			if err := json.Unmarshal(resp.Items, &items); err != nil {
@@ -520,6 +582,44 @@ func (s *GRPCServer) ObjectStatus(ctx context.Context, objectRequest *dashboard.
 	return out, nil
 }
 
+// ListColumns generates list table columns for objects.
+func (s *GRPCServer) ListColumns(ctx context.Context, listColumnsRequest *dashboard.ListColumnsRequest) (*dashboard.ListColumnsResponse, error) {
+	var objects []runtime.Object
+	for _, data := range listColumnsRequest.Objects {
+		u, err := decodeObjectRequest(&dashboard.ObjectRequest{Object: data})
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, u)
+	}
+
+	var clientState ocontext.ClientState
+	if err := json.Unmarshal(listColumnsRequest.ClientState, &clientState); err != nil {
+		return nil, err
+	}
+
+	ctx = ocontext.WithClientState(ctx, clientState)
+	responses, err := s.Impl.ListColumns(ctx, objects)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc server list columns")
+	}
+
+	out := &dashboard.ListColumnsResponse{}
+	for _, lcr := range responses {
+		cells, err := convertFromListCells(lcr.Cells)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Objects = append(out.Objects, &dashboard.ListColumnsResponse_ObjectColumns{
+			Columns: lcr.Columns,
+			Cells:   cells,
+		})
+	}
+
+	return out, nil
+}
+
 func decodeObjectRequest(req *dashboard.ObjectRequest) (*unstructured.Unstructured, error) {
 	m := map[string]interface{}{}
 
@@ -169,6 +169,16 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsPrinterItems:  inGVKs,
 				SupportsObjectStatus:  inGVKs,
 				SupportsTab:           inGVKs,
+				Commands: []*dashboard.RegisterResponse_Command{
+					{
+						Name:     "deploy",
+						Title:    "Deploy",
+						Shortcut: "ctrl+shift+d",
+						Fields: []*dashboard.RegisterResponse_CommandField{
+							{Name: "env", Label: "Environment", Type: "select", Choices: []string{"dev", "prod"}, Required: true},
+						},
+					},
+				},
 			},
 		}
 
@@ -191,6 +201,16 @@ func Test_GRPCClient_Register(t *testing.T) {
 				SupportsPrinterItems:  outGVKs,
 				SupportsObjectStatus:  outGVKs,
 				SupportsTab:           outGVKs,
+				Commands: []action.Command{
+					{
+						Name:     "deploy",
+						Title:    "Deploy",
+						Shortcut: "ctrl+shift+d",
+						Fields: []action.CommandField{
+							{Name: "env", Label: "Environment", Type: action.CommandFieldTypeSelect, Choices: []string{"dev", "prod"}, Required: true},
+						},
+					},
+				},
 			},
 		}
 		assert.Equal(t, expected, got)
@@ -370,6 +390,57 @@ func Test_GRPCClient_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCClient_ListColumns(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		clientState := ocontext.ClientState{
+			ClientID:  "foo-client",
+			Namespace: "foo-namespace",
+		}
+		clientStateData, _ := json.Marshal(&clientState)
+
+		pod1 := testutil.CreatePod("pod1")
+		pod2 := testutil.CreatePod("pod2")
+
+		listColumnsRequest := &dashboard.ListColumnsRequest{
+			ClientState: clientStateData,
+		}
+		for _, object := range []runtime.Object{pod1, pod2} {
+			objectData, err := json.Marshal(object)
+			require.NoError(t, err)
+			listColumnsRequest.Objects = append(listColumnsRequest.Objects, objectData)
+		}
+
+		cell := component.NewText("team-a")
+
+		listColumnsResponse := &dashboard.ListColumnsResponse{
+			Objects: []*dashboard.ListColumnsResponse_ObjectColumns{
+				{
+					Columns: []string{"Team"},
+					Cells:   map[string][]byte{"Team": encodeComponent(t, cell)},
+				},
+				{},
+			},
+		}
+
+		mocks.protoClient.EXPECT().ListColumns(gomock.Any(), gomock.Eq(listColumnsRequest), grpc.WaitForReady(true)).Return(listColumnsResponse, nil)
+
+		client := mocks.genClient()
+		ctx := ocontext.WithClientState(context.Background(), clientState)
+		got, err := client.ListColumns(ctx, []runtime.Object{pod1, pod2})
+		require.NoError(t, err)
+
+		expected := []plugin.ListColumnsResponse{
+			{
+				Columns: []string{"Team"},
+				Cells:   map[string]component.Component{"Team": cell},
+			},
+			{},
+		}
+
+		testutil.AssertJSONEqual(t, expected, got)
+	})
+}
+
 func Test_GRPCClient_HandleAction(t *testing.T) {
 	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
 		clientState := ocontext.ClientState{
@@ -637,6 +708,59 @@ func Test_GRPCServer_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCServer_ListColumns(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		cell := component.NewText("team-a")
+		responses := []plugin.ListColumnsResponse{
+			{
+				Columns: []string{"Team"},
+				Cells:   map[string]component.Component{"Team": cell},
+			},
+			{},
+		}
+
+		clientState := ocontext.ClientState{
+			ClientID:  "foo-client",
+			Namespace: "foo-namespace",
+		}
+		clientStateData, _ := json.Marshal(&clientState)
+
+		listColumnsRequest := &dashboard.ListColumnsRequest{
+			ClientState: clientStateData,
+		}
+		var objects []runtime.Object
+		for _, object := range []runtime.Object{testutil.CreatePod("pod1"), testutil.CreatePod("pod2")} {
+			m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+			require.NoError(t, err)
+			objects = append(objects, &unstructured.Unstructured{Object: m})
+
+			objectData, err := json.Marshal(object)
+			require.NoError(t, err)
+			listColumnsRequest.Objects = append(listColumnsRequest.Objects, objectData)
+		}
+
+		mocks.service.EXPECT().ListColumns(gomock.Any(), gomock.Eq(objects)).Return(responses, nil)
+
+		server := mocks.genServer()
+		got, err := server.ListColumns(context.Background(), listColumnsRequest)
+		require.NoError(t, err)
+
+		expected := &dashboard.ListColumnsResponse{
+			Objects: []*dashboard.ListColumnsResponse_ObjectColumns{
+				{
+					Columns: []string{"Team"},
+					Cells:   map[string][]byte{"Team": encodeComponent(t, cell)},
+				},
+				{
+					Cells: map[string][]byte{},
+				},
+			},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func encodeComponent(t *testing.T, view component.Component) []byte {
 	data, err := json.Marshal(view)
 	require.NoError(t, err)
@@ -0,0 +1,282 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package harness runs Octant plugins without a cluster or a browser. A Harness
+// loads a Go plugin binary or a JavaScript plugin, registers it against a dashboard
+// API backed by an in-memory object store, and calls the plugin's handlers directly.
+// It can be used to unit test plugins.
+package harness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/plugin/api"
+	"github.com/vmware-tanzu/octant/pkg/plugin/javascript"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// ErrNotModule is returned when module handlers are called for a plugin that is not a module.
+var ErrNotModule = errors.New("plugin is not a module")
+
+// Harness runs a plugin against an in-memory object store.
+type Harness struct {
+	store    *MemoryStore
+	service  plugin.ModuleService
+	metadata plugin.Metadata
+
+	closers []func()
+}
+
+// Option configures a Harness.
+type Option func(*options)
+
+type options struct {
+	store            *MemoryStore
+	clientFactory    plugin.ClientFactory
+	defaultNamespace string
+}
+
+// WithStore sets the object store the plugin reads from and writes to.
+func WithStore(s *MemoryStore) Option {
+	return func(o *options) {
+		o.store = s
+	}
+}
+
+// WithClientFactory sets the factory used to start Go plugins.
+func WithClientFactory(f plugin.ClientFactory) Option {
+	return func(o *options) {
+		o.clientFactory = f
+	}
+}
+
+// WithDefaultNamespace sets the namespace reported to the plugin as the initial namespace.
+func WithDefaultNamespace(namespace string) Option {
+	return func(o *options) {
+		o.defaultNamespace = namespace
+	}
+}
+
+// Load starts the plugin at pluginPath and registers it. Files ending in `.js` are
+// loaded as JavaScript plugins, everything else is run as a Go plugin binary.
+func Load(ctx context.Context, pluginPath string, opts ...Option) (*Harness, error) {
+	o := options{
+		clientFactory:    plugin.NewDefaultClientFactory(),
+		defaultNamespace: "default",
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.store == nil {
+		o.store = NewMemoryStore()
+	}
+
+	h := &Harness{store: o.store}
+
+	ctx, cancel := context.WithCancel(ctx)
+	h.closers = append(h.closers, cancel)
+
+	offline := &offlineClient{store: o.store}
+
+	// The dashboard API is started with the same defaults the plugin client uses.
+	viper.SetDefault("client-max-recv-msg-size", api.MaxMessageSize)
+	dashboardAPI, err := api.New(&api.GRPCService{
+		ObjectStore:        o.store,
+		NamespaceInterface: &namespaces{store: o.store, initial: o.defaultNamespace},
+		LinkGenerator:      offline,
+	})
+	if err != nil {
+		h.Close()
+		return nil, fmt.Errorf("creating dashboard api: %w", err)
+	}
+	if err := dashboardAPI.Start(ctx); err != nil {
+		h.Close()
+		return nil, fmt.Errorf("starting dashboard api: %w", err)
+	}
+
+	if plugin.IsJavaScriptPlugin(pluginPath) {
+		err = h.loadJavaScript(ctx, pluginPath, offline)
+	} else {
+		err = h.loadGo(ctx, pluginPath, o.clientFactory, dashboardAPI.Addr())
+	}
+	if err != nil {
+		h.Close()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// loadJavaScript loads a JavaScript plugin. JavaScript plugins are registered when
+// they are loaded.
+func (h *Harness) loadJavaScript(ctx context.Context, pluginPath string, offline *offlineClient) error {
+	factory := javascript.NewModularDashboardClientFactory(javascript.DefaultFunctions(offline, nil))
+	jsPlugin, err := plugin.NewJSPlugin(ctx, pluginPath, factory)
+	if err != nil {
+		return fmt.Errorf("loading JavaScript plugin %q: %w", pluginPath, err)
+	}
+
+	h.service = jsPlugin
+	h.metadata = *jsPlugin.Metadata()
+	h.closers = append(h.closers, jsPlugin.Close)
+	return nil
+}
+
+// loadGo starts a Go plugin binary and registers it with the dashboard API at apiAddr.
+func (h *Harness) loadGo(ctx context.Context, pluginPath string, factory plugin.ClientFactory, apiAddr string) error {
+	client := factory.Init(ctx, pluginPath)
+	h.closers = append(h.closers, client.Kill)
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		return fmt.Errorf("get rpc client for %q: %w", pluginPath, err)
+	}
+
+	raw, err := rpcClient.Dispense("plugin")
+	if err != nil {
+		return fmt.Errorf("dispensing plugin for %q: %w", pluginPath, err)
+	}
+
+	service, ok := raw.(plugin.ModuleService)
+	if !ok {
+		return fmt.Errorf("unknown type for plugin %q: %T", pluginPath, raw)
+	}
+
+	metadata, err := service.Register(ctx, apiAddr)
+	if err != nil {
+		return fmt.Errorf("register plugin %q: %w", pluginPath, err)
+	}
+
+	h.service = service
+	h.metadata = metadata
+	return nil
+}
+
+// Close stops the plugin and the dashboard API.
+func (h *Harness) Close() {
+	for i := len(h.closers) - 1; i >= 0; i-- {
+		h.closers[i]()
+	}
+	h.closers = nil
+}
+
+// Store returns the object store the plugin uses.
+func (h *Harness) Store() *MemoryStore {
+	return h.store
+}
+
+// Metadata returns the metadata the plugin returned when it was registered.
+func (h *Harness) Metadata() plugin.Metadata {
+	return h.metadata
+}
+
+// Print calls the plugin's print handler for object.
+func (h *Harness) Print(ctx context.Context, object runtime.Object) (plugin.PrintResponse, error) {
+	return h.service.Print(ctx, object)
+}
+
+// PrintTabs calls the plugin's tab handler for object.
+func (h *Harness) PrintTabs(ctx context.Context, object runtime.Object) ([]plugin.TabResponse, error) {
+	return h.service.PrintTabs(ctx, object)
+}
+
+// ObjectStatus calls the plugin's object status handler for object.
+func (h *Harness) ObjectStatus(ctx context.Context, object runtime.Object) (plugin.ObjectStatusResponse, error) {
+	return h.service.ObjectStatus(ctx, object)
+}
+
+// ListColumns calls the plugin's list columns handler for object.
+func (h *Harness) ListColumns(ctx context.Context, object runtime.Object) (plugin.ListColumnsResponse, error) {
+	responses, err := h.service.ListColumns(ctx, []runtime.Object{object})
+	if err != nil {
+		return plugin.ListColumnsResponse{}, err
+	}
+	if len(responses) != 1 {
+		return plugin.ListColumnsResponse{}, fmt.Errorf("expected 1 list columns response, got %d", len(responses))
+	}
+	return responses[0], nil
+}
+
+// HandleAction calls the plugin's action handler.
+func (h *Harness) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
+	return h.service.HandleAction(ctx, actionName, payload)
+}
+
+// Navigation returns the plugin's navigation. It returns ErrNotModule if the plugin is
+// not a module.
+func (h *Harness) Navigation(ctx context.Context) (navigation.Navigation, error) {
+	if !h.metadata.Capabilities.IsModule {
+		return navigation.Navigation{}, ErrNotModule
+	}
+	return h.service.Navigation(ctx)
+}
+
+// Content returns the plugin's content for contentPath. It returns ErrNotModule if the
+// plugin is not a module.
+func (h *Harness) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
+	if !h.metadata.Capabilities.IsModule {
+		return component.ContentResponse{}, ErrNotModule
+	}
+	return h.service.Content(ctx, contentPath)
+}
+
+// offlineClient gives JavaScript plugins access to the in-memory store.
+type offlineClient struct {
+	store store.Store
+}
+
+var _ javascript.OctantClient = (*offlineClient)(nil)
+
+// ObjectStore returns the object store.
+func (c *offlineClient) ObjectStore() store.Store {
+	return c.store
+}
+
+// ObjectPath returns a stable path for an object. The path is not routable since the
+// harness does not load any modules.
+func (c *offlineClient) ObjectPath(namespace, apiVersion, kind, name string) (string, error) {
+	if apiVersion == "" || kind == "" || name == "" {
+		return "", fmt.Errorf("apiVersion, kind and name are required")
+	}
+	return path.Join("/", namespace, apiVersion, strings.ToLower(kind), name), nil
+}
+
+// namespaces lists the namespaces in the in-memory store.
+type namespaces struct {
+	store   *MemoryStore
+	initial string
+}
+
+func (n *namespaces) Names(_ context.Context) ([]string, error) {
+	return n.store.Namespaces(), nil
+}
+
+func (n *namespaces) InitialNamespace() string {
+	return n.initial
+}
+
+func (n *namespaces) ProvidedNamespaces(_ context.Context) []string {
+	return nil
+}
+
+func (n *namespaces) HasNamespace(_ context.Context, namespace string) bool {
+	for _, name := range n.store.Namespaces() {
+		if name == namespace {
+			return true
+		}
+	}
+	return false
+}
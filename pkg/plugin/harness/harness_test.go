@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package harness
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func loadTestPlugin(t *testing.T) (*Harness, *MemoryStore) {
+	f, err := os.Open(filepath.Join("testdata", "objects.yaml"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	objects, err := ReadObjects(f)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	s := NewMemoryStore(objects...)
+
+	pluginPath, err := filepath.Abs(filepath.Join("testdata", "plugin.js"))
+	require.NoError(t, err)
+
+	h, err := Load(context.Background(), pluginPath, WithStore(s))
+	require.NoError(t, err)
+	t.Cleanup(h.Close)
+
+	return h, s
+}
+
+func TestHarness_JavaScript(t *testing.T) {
+	h, s := loadTestPlugin(t)
+	ctx := context.Background()
+
+	metadata := h.Metadata()
+	require.Equal(t, "test-plugin", metadata.Name)
+	require.True(t, metadata.Capabilities.IsModule)
+	require.NoError(t, h.Validate(ctx))
+
+	pod, err := s.Get(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"})
+	require.NoError(t, err)
+	require.NotNil(t, pod)
+
+	printResponse, err := h.Print(ctx, pod)
+	require.NoError(t, err)
+	require.Len(t, printResponse.Config, 1)
+	require.Equal(t, component.NewText("team-a"), printResponse.Config[0].Content)
+
+	tabs, err := h.PrintTabs(ctx, pod)
+	require.NoError(t, err)
+	require.Len(t, tabs, 1)
+	require.Equal(t, "Extra", tabs[0].Tab.Name)
+
+	listColumns, err := h.ListColumns(ctx, pod)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Node"}, listColumns.Columns)
+	require.Equal(t, component.NewText("none"), listColumns.Cells["Node"])
+
+	nav, err := h.Navigation(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Test Plugin", nav.Title)
+
+	content, err := h.Content(ctx, "/nested")
+	require.NoError(t, err)
+	require.Equal(t, []component.Component{component.NewText("/nested")}, content.Components)
+}
+
+func TestValidateMetadata(t *testing.T) {
+	pod := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+
+	tests := []struct {
+		name     string
+		metadata plugin.Metadata
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			metadata: plugin.Metadata{
+				Name:        "plugin",
+				Description: "description",
+				Capabilities: plugin.Capabilities{
+					SupportsPrinterConfig: []schema.GroupVersionKind{pod},
+					ActionNames:           []string{"action"},
+				},
+			},
+		},
+		{
+			name:     "blank name",
+			metadata: plugin.Metadata{Description: "description"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid name",
+			metadata: plugin.Metadata{Name: "my plugin", Description: "description"},
+			wantErr:  true,
+		},
+		{
+			name:     "blank description",
+			metadata: plugin.Metadata{Name: "plugin"},
+			wantErr:  true,
+		},
+		{
+			name: "gvk without kind",
+			metadata: plugin.Metadata{
+				Name:        "plugin",
+				Description: "description",
+				Capabilities: plugin.Capabilities{
+					SupportsTab: []schema.GroupVersionKind{{Version: "v1"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate gvk",
+			metadata: plugin.Metadata{
+				Name:        "plugin",
+				Description: "description",
+				Capabilities: plugin.Capabilities{
+					SupportsObjectStatus: []schema.GroupVersionKind{pod, pod},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate action",
+			metadata: plugin.Metadata{
+				Name:        "plugin",
+				Description: "description",
+				Capabilities: plugin.Capabilities{
+					ActionNames: []string{"action", "action"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid command",
+			metadata: plugin.Metadata{
+				Name:        "plugin",
+				Description: "description",
+				Capabilities: plugin.Capabilities{
+					Commands: []action.Command{{Name: "action", Title: "Action", Shortcut: "k"}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateMetadata(test.metadata)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
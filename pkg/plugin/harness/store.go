@@ -0,0 +1,287 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package harness
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/pkg/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// MemoryStore is a store.Store that keeps objects in memory. It is used to run plugins
+// without a cluster.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	objects map[string]*unstructured.Unstructured
+}
+
+var _ store.Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an instance of MemoryStore containing objects.
+func NewMemoryStore(objects ...*unstructured.Unstructured) *MemoryStore {
+	m := &MemoryStore{
+		objects: map[string]*unstructured.Unstructured{},
+	}
+
+	for _, object := range objects {
+		m.objects[objectID(object)] = object.DeepCopy()
+	}
+
+	return m
+}
+
+// List lists objects matching key.
+func (m *MemoryStore) List(_ context.Context, key store.Key) (*unstructured.UnstructuredList, bool, error) {
+	selector, err := keySelector(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	list := &unstructured.UnstructuredList{}
+	for _, object := range m.objects {
+		if !matches(key, object) || !selector.Matches(labels.Set(object.GetLabels())) {
+			continue
+		}
+		list.Items = append(list.Items, *object.DeepCopy())
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		return objectID(&list.Items[i]) < objectID(&list.Items[j])
+	})
+
+	return list, false, nil
+}
+
+// Get returns the object matching key. If the object does not exist, nil is returned.
+func (m *MemoryStore) Get(_ context.Context, key store.Key) (*unstructured.Unstructured, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	object, ok := m.objects[keyID(key)]
+	if !ok {
+		return nil, nil
+	}
+	return object.DeepCopy(), nil
+}
+
+// Delete deletes the object matching key.
+func (m *MemoryStore) Delete(_ context.Context, key store.Key) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.objects, keyID(key))
+	return nil
+}
+
+// Watch is a no-op since objects in the store only change when a plugin changes them.
+func (m *MemoryStore) Watch(_ context.Context, _ store.Key, _ cache.ResourceEventHandler) error {
+	return nil
+}
+
+// Unwatch is a no-op.
+func (m *MemoryStore) Unwatch(_ context.Context, _ ...schema.GroupVersionKind) error {
+	return nil
+}
+
+// UpdateClusterClient is a no-op.
+func (m *MemoryStore) UpdateClusterClient(_ context.Context, _ cluster.ClientInterface) error {
+	return nil
+}
+
+// Update updates the object matching key with updater.
+func (m *MemoryStore) Update(_ context.Context, key store.Key, updater func(*unstructured.Unstructured) error) error {
+	if updater == nil {
+		return errors.New("updater is nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := keyID(key)
+	object, ok := m.objects[id]
+	if !ok {
+		return fmt.Errorf("object %s not found", id)
+	}
+
+	object = object.DeepCopy()
+	if err := updater(object); err != nil {
+		return err
+	}
+	m.objects[id] = object
+	return nil
+}
+
+// IsLoading always returns false.
+func (m *MemoryStore) IsLoading(_ context.Context, _ store.Key) bool {
+	return false
+}
+
+// Create adds object to the store.
+func (m *MemoryStore) Create(_ context.Context, object *unstructured.Unstructured) error {
+	if object == nil {
+		return errors.New("object is nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := objectID(object)
+	if _, ok := m.objects[id]; ok {
+		return fmt.Errorf("object %s already exists", id)
+	}
+	m.objects[id] = object.DeepCopy()
+	return nil
+}
+
+// CreateOrUpdateFromYAML adds or replaces the objects in input. Objects without a
+// namespace are placed in namespace.
+func (m *MemoryStore) CreateOrUpdateFromYAML(_ context.Context, namespace, input string) ([]string, error) {
+	objects, err := ReadObjects(bytes.NewBufferString(input))
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var results []string
+	for _, object := range objects {
+		if object.GetNamespace() == "" {
+			object.SetNamespace(namespace)
+		}
+		id := objectID(object)
+		verb := "Created"
+		if _, ok := m.objects[id]; ok {
+			verb = "Updated"
+		}
+		m.objects[id] = object
+		results = append(results, fmt.Sprintf("%s %s", verb, id))
+	}
+
+	return results, nil
+}
+
+// Namespaces returns the sorted names of namespaces in the store. A namespace exists if
+// there is a Namespace object for it, or if any object is in it.
+func (m *MemoryStore) Namespaces() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	set := map[string]bool{}
+	for _, object := range m.objects {
+		if object.GetAPIVersion() == "v1" && object.GetKind() == "Namespace" {
+			set[object.GetName()] = true
+		}
+		if ns := object.GetNamespace(); ns != "" {
+			set[ns] = true
+		}
+	}
+
+	var names []string
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ReadObjects reads YAML or JSON documents from r. Documents containing a list are
+// flattened into their items.
+func ReadObjects(r io.Reader) ([]*unstructured.Unstructured, error) {
+	d := yaml.NewYAMLOrJSONDecoder(r, 4096)
+
+	var objects []*unstructured.Unstructured
+	for {
+		ext := runtime.RawExtension{}
+		if err := d.Decode(&ext); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("decode YAML: %w", err)
+		}
+
+		ext.Raw = bytes.TrimSpace(ext.Raw)
+		if len(ext.Raw) == 0 || bytes.Equal(ext.Raw, []byte("null")) {
+			continue
+		}
+
+		obj, _, err := unstructured.UnstructuredJSONScheme.Decode(ext.Raw, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decode YAML into object: %w", err)
+		}
+
+		switch t := obj.(type) {
+		case *unstructured.Unstructured:
+			objects = append(objects, t)
+		case *unstructured.UnstructuredList:
+			for i := range t.Items {
+				objects = append(objects, &t.Items[i])
+			}
+		default:
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+	}
+
+	return objects, nil
+}
+
+func keySelector(key store.Key) (labels.Selector, error) {
+	selector := labels.Everything()
+	if key.Selector != nil {
+		selector = labels.SelectorFromSet(*key.Selector)
+	}
+	if key.LabelSelector != nil {
+		s, err := metav1.LabelSelectorAsSelector(key.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+		requirements, _ := s.Requirements()
+		selector = selector.Add(requirements...)
+	}
+	return selector, nil
+}
+
+func matches(key store.Key, object *unstructured.Unstructured) bool {
+	if key.APIVersion != "" && key.APIVersion != object.GetAPIVersion() {
+		return false
+	}
+	if key.Kind != "" && key.Kind != object.GetKind() {
+		return false
+	}
+	if key.Namespace != "" && key.Namespace != object.GetNamespace() {
+		return false
+	}
+	if key.Name != "" && key.Name != object.GetName() {
+		return false
+	}
+	return true
+}
+
+func keyID(key store.Key) string {
+	return fmt.Sprintf("%s/%s/%s/%s", key.APIVersion, key.Kind, key.Namespace, key.Name)
+}
+
+func objectID(object *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s/%s", object.GetAPIVersion(), object.GetKind(), object.GetNamespace(), object.GetName())
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package harness
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	results, err := s.CreateOrUpdateFromYAML(ctx, "default", `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+  labels:
+    app: web
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: db
+  namespace: data
+`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Created v1/Pod/default/web", "Created v1/Pod/data/db"}, results)
+	require.Equal(t, []string{"data", "default"}, s.Namespaces())
+
+	list, _, err := s.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod"})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+
+	selector := labels.Set{"app": "web"}
+	list, _, err = s.List(ctx, store.Key{APIVersion: "v1", Kind: "Pod", Selector: &selector})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	require.Equal(t, "web", list.Items[0].GetName())
+
+	key := store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web"}
+	require.NoError(t, s.Update(ctx, key, func(u *unstructured.Unstructured) error {
+		u.SetAnnotations(map[string]string{"updated": "true"})
+		return nil
+	}))
+
+	got, err := s.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, "true", got.GetAnnotations()["updated"])
+
+	require.Error(t, s.Create(ctx, got))
+
+	require.NoError(t, s.Delete(ctx, key))
+	got, err = s.Get(ctx, key)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,47 @@
+export default function Plugin() {
+  this.name = "test-plugin";
+  this.description = "a test plugin";
+  this.isModule = true;
+  this.capabilities = {
+    supportPrinterConfig: [{ group: "", version: "v1", kind: "Pod" }],
+    supportTab: [{ group: "", version: "v1", kind: "Pod" }],
+    supportListColumns: [{ group: "", version: "v1", kind: "Pod" }],
+  };
+}
+
+Plugin.prototype.printHandler = function (request) {
+  var owner = dashboardClient.Get({ apiVersion: "v1", kind: "ConfigMap", namespace: "default", name: "owner" });
+  return {
+    config: [{ header: "owner", content: { metadata: { type: "text" }, config: { value: owner.data.name } } }],
+  };
+};
+
+Plugin.prototype.tabHandler = function (request) {
+  return {
+    tab: {
+      name: "Extra",
+      contents: { metadata: { type: "flexlayout" }, config: { sections: [] } },
+    },
+  };
+};
+
+Plugin.prototype.listColumnsHandler = function (request) {
+  return {
+    columns: ["Node"],
+    cells: {
+      Node: { metadata: { type: "text" }, config: { value: "none" } },
+    },
+  };
+};
+
+Plugin.prototype.navigationHandler = function () {
+  return { title: "Test Plugin", path: "test-plugin" };
+};
+
+Plugin.prototype.contentHandler = function (request) {
+  return {
+    content: {
+      viewComponents: [{ metadata: { type: "text" }, config: { value: request.contentPath } }],
+    },
+  };
+};
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package harness
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+// pluginNameRe matches names that can be used in module paths.
+var pluginNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// ValidateMetadata checks the metadata a plugin returns when it is registered. All
+// problems found are returned in a single error.
+func ValidateMetadata(metadata plugin.Metadata) error {
+	var err error
+
+	if metadata.Name == "" {
+		err = multierror.Append(err, fmt.Errorf("name is blank"))
+	} else if !pluginNameRe.MatchString(metadata.Name) {
+		err = multierror.Append(err, fmt.Errorf("name %q must only contain letters, numbers, '.', '_' and '-'", metadata.Name))
+	}
+
+	if metadata.Description == "" {
+		err = multierror.Append(err, fmt.Errorf("description is blank"))
+	}
+
+	capabilities := metadata.Capabilities
+	gvkLists := []struct {
+		name string
+		list []schema.GroupVersionKind
+	}{
+		{name: "SupportsPrinterConfig", list: capabilities.SupportsPrinterConfig},
+		{name: "SupportsPrinterStatus", list: capabilities.SupportsPrinterStatus},
+		{name: "SupportsPrinterItems", list: capabilities.SupportsPrinterItems},
+		{name: "SupportsObjectStatus", list: capabilities.SupportsObjectStatus},
+		{name: "SupportsTab", list: capabilities.SupportsTab},
+		{name: "SupportsListColumns", list: capabilities.SupportsListColumns},
+	}
+	for _, gvks := range gvkLists {
+		seen := map[schema.GroupVersionKind]bool{}
+		for _, gvk := range gvks.list {
+			if gvk.Version == "" || gvk.Kind == "" {
+				err = multierror.Append(err, fmt.Errorf("%s: %q must have a version and kind", gvks.name, gvk.String()))
+			}
+			if seen[gvk] {
+				err = multierror.Append(err, fmt.Errorf("%s: %q is listed more than once", gvks.name, gvk.String()))
+			}
+			seen[gvk] = true
+		}
+	}
+
+	actions := map[string]bool{}
+	for _, name := range capabilities.ActionNames {
+		if name == "" {
+			err = multierror.Append(err, fmt.Errorf("ActionNames: action name is blank"))
+			continue
+		}
+		if actions[name] {
+			err = multierror.Append(err, fmt.Errorf("ActionNames: %q is listed more than once", name))
+		}
+		actions[name] = true
+	}
+
+	commands := map[string]bool{}
+	for _, command := range capabilities.Commands {
+		if cmdErr := command.Validate(); cmdErr != nil {
+			err = multierror.Append(err, fmt.Errorf("Commands: %w", cmdErr))
+		}
+		if commands[command.Name] {
+			err = multierror.Append(err, fmt.Errorf("Commands: %q is listed more than once", command.Name))
+		}
+		commands[command.Name] = true
+	}
+
+	return err
+}
+
+// Validate checks the metadata of the loaded plugin. Plugins that are modules must also
+// return a navigation entry with a title and a path.
+func (h *Harness) Validate(ctx context.Context) error {
+	err := ValidateMetadata(h.metadata)
+
+	if h.metadata.Capabilities.IsModule {
+		nav, navErr := h.Navigation(ctx)
+		if navErr != nil {
+			err = multierror.Append(err, fmt.Errorf("navigation: %w", navErr))
+		} else {
+			err = validateNavigation(err, nav)
+		}
+	}
+
+	return err
+}
+
+func validateNavigation(err error, nav navigation.Navigation) error {
+	if nav.Title == "" {
+		err = multierror.Append(err, fmt.Errorf("navigation: title is blank"))
+	}
+	if nav.Path == "" {
+		err = multierror.Append(err, fmt.Errorf("navigation %q: path is blank", nav.Title))
+	}
+	for _, child := range nav.Children {
+		err = validateNavigation(err, child)
+	}
+	return err
+}
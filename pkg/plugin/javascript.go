@@ -8,9 +8,11 @@ package plugin
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
 	"path"
+	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/vmware-tanzu/octant/internal/util/json"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/dop251/goja_nodejs/eventloop"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/plugin/javascript"
 
@@ -32,7 +35,7 @@ func IsJavaScriptPlugin(pluginName string) bool {
 }
 
 // JSRuntimeFactory functions creates a JavaScript runtime for a JavaScript plugin.
-type JSRuntimeFactory func(context.Context, string) (*eventloop.EventLoop, error)
+type JSRuntimeFactory func(context.Context, string) (*eventloop.EventLoop, *javascript.Timers, error)
 
 // JSClassExtractor functions extract the default class from a runtime.
 type JSClassExtractor func(*goja.Runtime) (*goja.Object, error)
@@ -61,6 +64,20 @@ func WithMetadataExtractor(pme JSMetadataExtractor) func(*jsPlugin) {
 	}
 }
 
+// WithAllowList option sets the hosts a JSPlugin is allowed to make HTTP requests to.
+func WithAllowList(allowList javascript.AllowList) func(*jsPlugin) {
+	return func(js *jsPlugin) {
+		js.allowList = allowList
+	}
+}
+
+// WithStorageDir option sets the directory a JSPlugin persists its storage to.
+func WithStorageDir(dir string) func(*jsPlugin) {
+	return func(js *jsPlugin) {
+		js.storageDir = dir
+	}
+}
+
 // JSOption is an option that overrides a default value of a JSPlugin.
 type JSOption func(*jsPlugin)
 
@@ -75,12 +92,14 @@ type JSPlugin interface {
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTabs(ctx context.Context, object runtime.Object) ([]TabResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
+	ListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error)
 	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
 	Content(ctx context.Context, contentPath string) (component.ContentResponse, error)
 }
 
 type jsPlugin struct {
-	loop *eventloop.EventLoop
+	loop   *eventloop.EventLoop
+	timers *javascript.Timers
 
 	metadata    *Metadata
 	pluginClass *goja.Object
@@ -90,17 +109,27 @@ type jsPlugin struct {
 	classExtractor    JSClassExtractor
 	metadataExtractor JSMetadataExtractor
 
-	mu     sync.Mutex
-	ctx    context.Context
-	logger log.Logger
+	allowList  javascript.AllowList
+	storageDir string
+
+	mu         sync.Mutex
+	ctx        context.Context
+	cancel     context.CancelFunc
+	requestCtx *requestContext
+	logger     log.Logger
 }
 
 var _ JSPlugin = (*jsPlugin)(nil)
 
 // NewJSPlugin creates a new instances of a JavaScript plugin.
 func NewJSPlugin(ctx context.Context, pluginPath string, dashboardClientFactory octant.DashboardClientFactory, options ...JSOption) (*jsPlugin, error) {
+	// The plugin's context lives until the plugin is closed.
+	ctx, cancel := context.WithCancel(ctx)
+
 	plugin := &jsPlugin{
 		ctx:               ctx,
+		cancel:            cancel,
+		requestCtx:        newRequestContext(ctx),
 		pluginPath:        pluginPath,
 		runtimeFactory:    javascript.CreateRuntimeLoop,
 		classExtractor:    javascript.ExtractDefaultClass,
@@ -111,45 +140,64 @@ func NewJSPlugin(ctx context.Context, pluginPath string, dashboardClientFactory
 		o(plugin)
 	}
 
-	loop, err := plugin.runtimeFactory(ctx, pluginPath)
+	loop, timers, err := plugin.runtimeFactory(ctx, pluginPath)
 
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("initializing runtime: %w", err)
 	}
 
+	var storage *javascript.Storage
+	if plugin.storageDir != "" {
+		name := strings.TrimSuffix(filepath.Base(pluginPath), filepath.Ext(pluginPath))
+		storage, err = javascript.NewStorage(filepath.Join(plugin.storageDir, name+".json"))
+		if err != nil {
+			cancel()
+			timers.Stop()
+			loop.Stop()
+			return nil, fmt.Errorf("initializing storage: %w", err)
+		}
+	}
+
 	var pluginClass *goja.Object
 	var metadata *Metadata
 
 	errCh := make(chan error)
 
 	loop.RunOnLoop(func(vm *goja.Runtime) {
-		var err error
-
-		buf, err := ioutil.ReadFile(pluginPath)
+		loader, err := javascript.NewModuleLoader(filepath.Dir(pluginPath))
 		if err != nil {
-			errCh <- fmt.Errorf("reading script: %w", err)
+			errCh <- fmt.Errorf("creating module loader: %w", err)
+			return
 		}
-		program, err := goja.Compile(pluginPath, string(buf), false)
-		if err != nil {
-			errCh <- fmt.Errorf("compiling: %w", err)
+		loader.Enable(vm)
+
+		if err := javascript.EnableFetch(ctx, loop, vm, plugin.allowList); err != nil {
+			errCh <- err
+			return
 		}
-		_, err = vm.RunProgram(program)
-		if err != nil {
-			errCh <- fmt.Errorf("script execution: %w", err)
+		if storage != nil {
+			vm.Set("pluginStorage", javascript.CreateStorageObject(vm, storage))
+		}
+		if err := loader.RunMain(pluginPath); err != nil {
+			errCh <- err
+			return
 		}
 
 		// Convert these to use require.RegisterNativeModule
-		vm.Set("httpClient", javascript.CreateHTTPClientObject(vm, pluginClass))
-		vm.Set("dashboardClient", dashboardClientFactory.Create(ctx, vm))
+		vm.Set("httpClient", javascript.CreateHTTPClientObject(vm, pluginClass, plugin.allowList))
+		vm.Set("dashboardClient", dashboardClientFactory.Create(plugin.requestCtx, vm))
 
 		pluginClass, err = plugin.classExtractor(vm)
 		if err != nil {
-			errCh <- fmt.Errorf("loading pluginClass: %w", err)
+			errCh <- fmt.Errorf("loading pluginClass: %w", javascript.ErrorWithStack(err))
+			return
 		}
 
 		metadata, err = plugin.metadataExtractor(vm, pluginClass)
 		if err != nil {
 			errCh <- fmt.Errorf("loading metadata: %w", err)
+			return
 		}
 
 		errCh <- nil
@@ -158,18 +206,24 @@ func NewJSPlugin(ctx context.Context, pluginPath string, dashboardClientFactory
 
 	err = <-errCh
 	if err != nil {
+		cancel()
+		timers.Stop()
+		loop.Stop()
 		return nil, fmt.Errorf("javascript loop: %w", err)
 	}
 
 	plugin.loop = loop
+	plugin.timers = timers
 	plugin.pluginClass = pluginClass
 	plugin.metadata = metadata
 
 	return plugin, nil
 }
 
-// Close closes the dashboard client connection.
+// Close cancels the plugin's requests in flight and pending timers, and stops its runtime.
 func (t *jsPlugin) Close() {
+	t.cancel()
+	t.timers.Stop()
 	t.loop.Stop()
 }
 
@@ -179,9 +233,10 @@ func (t *jsPlugin) PluginPath() string {
 }
 
 // Navigation returns the navigation for a JavaScript plugin.
-func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error) {
+func (t *jsPlugin) Navigation(_ context.Context) (_ navigation.Navigation, err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	defer t.observeCall("navigationHandler", time.Now(), &err)
 
 	nav := navigation.Navigation{}
 	errCh := make(chan error)
@@ -201,7 +256,7 @@ func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error)
 
 		s, err := cHandler(t.pluginClass)
 		if err != nil {
-			errCh <- fmt.Errorf("calling navigationHandler: %w", err)
+			errCh <- fmt.Errorf("calling navigationHandler: %w", javascript.ErrorWithStack(err))
 			return
 		}
 
@@ -218,8 +273,7 @@ func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error)
 		errCh <- nil
 	})
 
-	err := <-errCh
-	if err != nil {
+	if err := <-errCh; err != nil {
 		return nav, err
 	}
 
@@ -227,14 +281,16 @@ func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error)
 }
 
 // Content returns the content response for a JavaScript plugin acting as a module.
-func (t *jsPlugin) Content(ctx context.Context, contentPath string) (component.ContentResponse, error) {
+func (t *jsPlugin) Content(ctx context.Context, contentPath string) (_ component.ContentResponse, err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	defer t.observeCall("contentHandler", time.Now(), &err)
 
 	cr := component.ContentResponse{}
 	errCh := make(chan error)
 
 	t.loop.RunOnLoop(func(vm *goja.Runtime) {
+		defer t.requestCtx.use(ctx)()
 		clientState := ClientStateFrom(ctx)
 
 		handler, err := vm.RunString("_concretePlugin.contentHandler")
@@ -260,7 +316,7 @@ func (t *jsPlugin) Content(ctx context.Context, contentPath string) (component.C
 
 		s, err := cHandler(t.pluginClass, obj)
 		if err != nil {
-			errCh <- fmt.Errorf("calling contentHandler: %w", err)
+			errCh <- fmt.Errorf("calling contentHandler: %w", javascript.ErrorWithStack(err))
 			return
 		}
 
@@ -282,6 +338,12 @@ func (t *jsPlugin) Content(ctx context.Context, contentPath string) (component.C
 			return
 		}
 
+		source := fmt.Sprintf("content response for %s from %s", contentPath, filepath.Base(t.pluginPath))
+		if err := validateOutputValue(ctx, source, "contentResponse", contentObj); err != nil {
+			errCh <- err
+			return
+		}
+
 		rawTitle, ok := contentObj["title"]
 		if ok {
 			titles, ok := rawTitle.([]interface{})
@@ -328,6 +390,11 @@ func (t *jsPlugin) Content(ctx context.Context, contentPath string) (component.C
 
 		rawButtonGroup, ok := contentObj["buttonGroup"]
 		if ok {
+			if err := validateOutputValue(ctx, source+" button group", "ButtonGroup", rawButtonGroup); err != nil {
+				errCh <- err
+				return
+			}
+
 			realButtonGroup, err := javascript.ConvertToComponent("buttonGroup", rawButtonGroup)
 			if err != nil {
 				errCh <- fmt.Errorf("unable to extract buttonGroup: %w", err)
@@ -362,9 +429,10 @@ func (t *jsPlugin) Register(_ context.Context, _ string) (Metadata, error) {
 }
 
 // PrintTabs returns the tab(s) response from a JavaScript plugins tab handler.
-func (t *jsPlugin) PrintTabs(ctx context.Context, object runtime.Object) ([]TabResponse, error) {
+func (t *jsPlugin) PrintTabs(ctx context.Context, object runtime.Object) (_ []TabResponse, err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	defer t.observeCall("tabHandler", time.Now(), &err)
 
 	tabResponse, err := t.objectRequestCall(ctx, "tabHandler", object)
 	if err != nil {
@@ -414,9 +482,10 @@ func (t *jsPlugin) PrintTabs(ctx context.Context, object runtime.Object) ([]TabR
 }
 
 // ObjectStats returns the object status from a JavaScript plugins object status handler.
-func (t *jsPlugin) ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error) {
+func (t *jsPlugin) ObjectStatus(ctx context.Context, object runtime.Object) (_ ObjectStatusResponse, err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	defer t.observeCall("objectStatusHandler", time.Now(), &err)
 
 	osResponse, err := t.objectRequestCall(ctx, "objectStatusHandler", object)
 	if err != nil {
@@ -448,14 +517,67 @@ func (t *jsPlugin) ObjectStatus(ctx context.Context, object runtime.Object) (Obj
 	}, nil
 }
 
+// ListColumns returns the list table columns from a JavaScript plugins list columns handler.
+// The handler is called once for each object.
+func (t *jsPlugin) ListColumns(ctx context.Context, objects []runtime.Object) (_ []ListColumnsResponse, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	defer t.observeCall("listColumnsHandler", time.Now(), &err)
+
+	responses := make([]ListColumnsResponse, len(objects))
+	for i, object := range objects {
+		response, err := t.listColumns(ctx, object)
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = response
+	}
+
+	return responses, nil
+}
+
+func (t *jsPlugin) listColumns(ctx context.Context, object runtime.Object) (ListColumnsResponse, error) {
+	lcResponse, err := t.objectRequestCall(ctx, "listColumnsHandler", object)
+	if err != nil {
+		return ListColumnsResponse{}, err
+	}
+
+	var response ListColumnsResponse
+
+	if columns, ok := lcResponse.Get("columns").Export().([]interface{}); ok {
+		for i, column := range columns {
+			name, ok := column.(string)
+			if !ok {
+				return ListColumnsResponse{}, fmt.Errorf("column %d is not a string", i)
+			}
+			response.Columns = append(response.Columns, name)
+		}
+	}
+
+	if cells, ok := lcResponse.Get("cells").Export().(map[string]interface{}); ok {
+		response.Cells = map[string]component.Component{}
+		for name, cell := range cells {
+			c, err := javascript.ConvertToComponent(fmt.Sprintf("cells[%s]", name), cell)
+			if err != nil {
+				return ListColumnsResponse{}, fmt.Errorf("unable to extract cell: %w", err)
+			}
+			response.Cells[name] = c
+		}
+	}
+
+	return response, nil
+}
+
 // HandleAction calls the JavaScript plugins action handler.
-func (t *jsPlugin) HandleAction(ctx context.Context, actionPath string, payload action.Payload) error {
+func (t *jsPlugin) HandleAction(ctx context.Context, actionPath string, payload action.Payload) (err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	defer t.observeCall("actionHandler", time.Now(), &err)
 
 	errCh := make(chan error)
 
 	t.loop.RunOnLoop(func(vm *goja.Runtime) {
+		defer t.requestCtx.use(ctx)()
 		clientState := ClientStateFrom(ctx)
 
 		handler, err := vm.RunString("_concretePlugin.actionHandler")
@@ -489,7 +611,7 @@ func (t *jsPlugin) HandleAction(ctx context.Context, actionPath string, payload
 
 		s, err := cHandler(t.pluginClass, obj)
 		if err != nil {
-			errCh <- fmt.Errorf("calling actionHandler: %w", err)
+			errCh <- fmt.Errorf("calling actionHandler: %w", javascript.ErrorWithStack(err))
 			return
 		}
 
@@ -513,9 +635,10 @@ func (t *jsPlugin) HandleAction(ctx context.Context, actionPath string, payload
 }
 
 // Print returns the print response from the JavaScript plugins print handler.
-func (t *jsPlugin) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
+func (t *jsPlugin) Print(ctx context.Context, object runtime.Object) (_ PrintResponse, err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	defer t.observeCall("printHandler", time.Now(), &err)
 
 	printResponse, err := t.objectRequestCall(ctx, "printHandler", object)
 	if err != nil {
@@ -527,6 +650,11 @@ func (t *jsPlugin) Print(ctx context.Context, object runtime.Object) (PrintRespo
 		return PrintResponse{}, fmt.Errorf("unable to parse printHandler response sections")
 	}
 
+	source := fmt.Sprintf("print response from %s", filepath.Base(t.pluginPath))
+	if err := validateOutputValue(ctx, source, "printResponse", sections); err != nil {
+		return PrintResponse{}, err
+	}
+
 	var configSections []component.SummarySection
 	var statusSections []component.SummarySection
 	var flexItems []component.FlexLayoutItem
@@ -564,11 +692,18 @@ func (t *jsPlugin) Print(ctx context.Context, object runtime.Object) (PrintRespo
 	return response, nil
 }
 
+// observeCall records the time a call to a plugin handler took, and whether it failed.
+// err points to the error returned by the call.
+func (t *jsPlugin) observeCall(handlerName string, start time.Time, err *error) {
+	metrics.ObservePluginRPC(filepath.Base(t.pluginPath), handlerName, time.Since(start), *err)
+}
+
 func (t *jsPlugin) objectRequestCall(ctx context.Context, handlerName string, object runtime.Object) (*goja.Object, error) {
 	errCh := make(chan error)
 	var response *goja.Object
 
 	t.loop.RunOnLoop(func(vm *goja.Runtime) {
+		defer t.requestCtx.use(ctx)()
 		clientState := ClientStateFrom(ctx)
 
 		handler, err := vm.RunString(fmt.Sprintf("_concretePlugin.%s", handlerName))
@@ -594,7 +729,7 @@ func (t *jsPlugin) objectRequestCall(ctx context.Context, handlerName string, ob
 		}
 		s, err := cHandler(t.pluginClass, obj)
 		if err != nil {
-			errCh <- err
+			errCh <- javascript.ErrorWithStack(err)
 			return
 		}
 
@@ -666,12 +801,24 @@ func extractMetadata(vm *goja.Runtime, pluginValue goja.Value) (*Metadata, error
 					return nil, fmt.Errorf("extractGvks: %w", err)
 				}
 				metadata.Capabilities.SupportsTab = append(metadata.Capabilities.SupportsTab, GVKs...)
+			case "supportListColumns":
+				GVKs, err := javascript.ConvertToGVKs(k, v)
+				if err != nil {
+					return nil, fmt.Errorf("extractGvks: %w", err)
+				}
+				metadata.Capabilities.SupportsListColumns = append(metadata.Capabilities.SupportsListColumns, GVKs...)
 			case "actionNames":
 				actions, err := javascript.ConvertToActions(v)
 				if err != nil {
 					return nil, fmt.Errorf("extractActions: %w", err)
 				}
 				metadata.Capabilities.ActionNames = append(metadata.Capabilities.ActionNames, actions...)
+			case "commands":
+				commands, err := javascript.ConvertToCommands(v)
+				if err != nil {
+					return nil, fmt.Errorf("extractCommands: %w", err)
+				}
+				metadata.Capabilities.Commands = append(metadata.Capabilities.Commands, commands...)
 			default:
 				fmt.Printf("unknown capability: %s\n", k)
 			}
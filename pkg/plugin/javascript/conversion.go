@@ -12,6 +12,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/internal/util/json"
 
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -128,6 +129,35 @@ func ConvertToActions(i interface{}) ([]string, error) {
 	return actionNames, nil
 }
 
+// ConvertToCommands attempts to convert interface i to a list of commands.
+func ConvertToCommands(i interface{}) ([]action.Command, error) {
+	rawCommands, ok := i.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unable to parse commands")
+	}
+
+	var commands []action.Command
+	for i, rawCommand := range rawCommands {
+		if _, ok := rawCommand.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("unable to parse command in position %d", i)
+		}
+
+		data, err := json.Marshal(rawCommand)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal command in position %d: %w", i, err)
+		}
+
+		var command action.Command
+		if err := json.Unmarshal(data, &command); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal command in position %d: %w", i, err)
+		}
+
+		commands = append(commands, command)
+	}
+
+	return commands, nil
+}
+
 // ConvertToGVKs attempts to convert interface i to a list of GroupVersionKind.
 func ConvertToGVKs(name string, i interface{}) ([]schema.GroupVersionKind, error) {
 	GVKs, ok := i.([]interface{})
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dop251/goja/parser"
+	"github.com/evanw/esbuild/pkg/api"
+)
+
+// IsScript returns true if src is a script the JavaScript runtime used by Octant can
+// compile as is. The runtime parses ES2015 without generators and async functions, and
+// ES modules are not scripts.
+func IsScript(filename, src string) bool {
+	_, err := parser.ParseFile(nil, filename, src, 0)
+	return err == nil
+}
+
+// TransformESM converts the ES module in src into an ES2015 CommonJS module with esbuild.
+// It returns the converted source and its source map. If src references a source map,
+// the returned source map maps positions to the sources of that map instead of src.
+// Newer syntax is lowered to ES2015 where esbuild can. Syntax the runtime can't run,
+// like generators and async functions, is returned as an unsupported syntax error.
+func TransformESM(filename, src string) (string, []byte, error) {
+	src, err := inlineSourceMap(filename, src)
+	if err != nil {
+		return "", nil, fmt.Errorf("loading source map for %s: %w", filename, err)
+	}
+
+	result := api.Transform(src, api.TransformOptions{
+		Loader:     api.LoaderJS,
+		Format:     api.FormatCommonJS,
+		Target:     api.ES2015,
+		Sourcemap:  api.SourceMapExternal,
+		Sourcefile: filename,
+		LogLevel:   api.LogLevelSilent,
+	})
+	if len(result.Errors) > 0 {
+		return "", nil, esbuildError(result.Errors)
+	}
+	if _, err := parser.ParseFile(nil, filename, string(result.Code), 0, parser.WithDisableSourceMaps); err != nil {
+		return "", nil, fmt.Errorf("%s: unsupported syntax, generators and async functions can't be used in plugins: %w", filename, err)
+	}
+
+	return string(result.Code), result.Map, nil
+}
+
+// esbuildError converts esbuild error messages into an error.
+func esbuildError(messages []api.Message) error {
+	var errs []string
+	for _, m := range messages {
+		if m.Location == nil {
+			errs = append(errs, m.Text)
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s:%d:%d: %s", m.Location.File, m.Location.Line, m.Location.Column+1, m.Text))
+	}
+	return fmt.Errorf("%s", strings.Join(errs, "; "))
+}
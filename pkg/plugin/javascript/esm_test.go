@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package javascript
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsScript(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want bool
+	}{
+		{
+			name: "script",
+			src:  "var a = 1;\nmodule.exports = { a: a };",
+			want: true,
+		},
+		{
+			name: "module syntax in a string",
+			src:  "var s = \"import x from 'y'\";\n// export default s;",
+			want: true,
+		},
+		{
+			name: "module",
+			src:  "export default function () {}",
+			want: false,
+		},
+		{
+			name: "ES2015 syntax",
+			src:  "const a = () => 1;\nlet b = class { get c() { return a(); } };",
+			want: true,
+		},
+		{
+			name: "async function",
+			src:  "async function a() { await 1; }",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsScript("plugin.js", tt.src))
+		})
+	}
+}
+
+func TestTransformESM(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		check   string
+		want    interface{}
+		wantErr string
+	}{
+		{
+			name:  "default import",
+			src:   "import dep from './dep';\nexport var value = dep.value;",
+			check: "module.exports.value",
+			want:  int64(42),
+		},
+		{
+			name:  "named and aliased imports",
+			src:   "import { named, other as aliased } from './dep';\nexport { named, aliased as renamed };",
+			check: "module.exports.named + module.exports.renamed",
+			want:  int64(3),
+		},
+		{
+			name:  "namespace import",
+			src:   "import * as ns from './dep';\nexport default ns.named;",
+			check: "module.exports.default",
+			want:  int64(1),
+		},
+		{
+			name:  "multi-line import",
+			src:   "import {\n  named,\n  other\n} from './dep';\nexport function sum() { return named + other; }",
+			check: "module.exports.sum()",
+			want:  int64(3),
+		},
+		{
+			name:  "re-export",
+			src:   "export * from './dep';",
+			check: "module.exports.other",
+			want:  int64(2),
+		},
+		{
+			name:  "named default function",
+			src:   "export default function plugin() { return 'plugin'; }",
+			check: "module.exports.default()",
+			want:  "plugin",
+		},
+		{
+			name:  "multiple declarations",
+			src:   "export var a = 1, b = 2;",
+			check: "module.exports.a + module.exports.b",
+			want:  int64(3),
+		},
+		{
+			name:  "module syntax in strings and comments",
+			src:   "var s = \"import x from 'y'\";\n// export default s;\nexport { s as value };",
+			check: "module.exports.value + ':' + module.exports.default",
+			want:  "import x from 'y':undefined",
+		},
+		{
+			name:  "const and let",
+			src:   "const a = 1;\nlet b = 2;\nb += a;\nexport { b };",
+			check: "module.exports.b",
+			want:  int64(3),
+		},
+		{
+			name:  "arrow functions",
+			src:   "import { named } from './dep';\nexport const add = (x) => x + named;",
+			check: "module.exports.add(2)",
+			want:  int64(3),
+		},
+		{
+			name:  "classes",
+			src:   "class Base { constructor(n) { this.n = n; } get double() { return this.n * 2; } }\nexport default class Plugin extends Base { static create() { return new Plugin(21); } }",
+			check: "module.exports.default.create().double",
+			want:  int64(42),
+		},
+		{
+			name:  "syntax lowered to ES2015",
+			src:   "const { a, ...rest } = { a: 1, b: 2, c: 3 };\nexport const value = rest?.b ?? 0;\nexport const pow = 2 ** 3;",
+			check: "module.exports.value + module.exports.pow",
+			want:  int64(10),
+		},
+		{
+			name:    "async function",
+			src:     "export async function load() { return 1; }",
+			wantErr: "plugin.js: unsupported syntax",
+		},
+		{
+			name:    "syntax error",
+			src:     "export default {",
+			wantErr: "plugin.js:1:17: Expected identifier but found end of file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, sourceMap, err := TransformESM("plugin.js", tt.src)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), tt.wantErr), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, sourceMap)
+			require.True(t, IsScript("plugin.js", got), got)
+
+			vm := goja.New()
+			_, err = vm.RunString(`
+var __dep = { __esModule: true, default: { value: 42 }, named: 1, other: 2 };
+function require(name) { return __dep; }
+var module = { exports: {} };
+`)
+			require.NoError(t, err)
+
+			_, err = vm.RunString("(function (exports, require, module) {\n" + got + "\n})(module.exports, require, module);")
+			require.NoError(t, err, got)
+
+			v, err := vm.RunString(tt.check)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, v.Export())
+		})
+	}
+}
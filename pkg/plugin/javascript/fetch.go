@@ -0,0 +1,299 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+	"github.com/gobwas/glob"
+)
+
+const (
+	// fetchTimeout is the maximum duration of a fetch request.
+	fetchTimeout = 30 * time.Second
+	// maxFetchBodySize is the maximum size of a fetch response body.
+	maxFetchBodySize = 10 << 20
+	// maxRedirects is the maximum number of redirects a plugin HTTP request follows.
+	maxRedirects = 10
+)
+
+// fetchSource implements the WHATWG `fetch`, `Headers` and `AbortController` APIs on top
+// of the native `__octantFetch` function.
+const fetchSource = `
+(function (global) {
+  function Headers(init) {
+    this._map = {};
+    if (init instanceof Headers) { init = init._map; }
+    if (init) {
+      for (var name in init) {
+        if (Object.prototype.hasOwnProperty.call(init, name)) { this.append(name, init[name]); }
+      }
+    }
+  }
+  Headers.prototype.append = function (name, value) {
+    name = String(name).toLowerCase();
+    this._map[name] = this._map.hasOwnProperty(name) ? this._map[name] + ", " + value : String(value);
+  };
+  Headers.prototype.set = function (name, value) { this._map[String(name).toLowerCase()] = String(value); };
+  Headers.prototype.get = function (name) {
+    name = String(name).toLowerCase();
+    return this._map.hasOwnProperty(name) ? this._map[name] : null;
+  };
+  Headers.prototype.has = function (name) { return this._map.hasOwnProperty(String(name).toLowerCase()); };
+  Headers.prototype["delete"] = function (name) { delete this._map[String(name).toLowerCase()]; };
+  Headers.prototype.forEach = function (fn, thisArg) {
+    for (var name in this._map) {
+      if (this._map.hasOwnProperty(name)) { fn.call(thisArg, this._map[name], name, this); }
+    }
+  };
+
+  function AbortSignal() {
+    this.aborted = false;
+    this.onabort = null;
+    this._listeners = [];
+  }
+  AbortSignal.prototype.addEventListener = function (type, fn) {
+    if (type === "abort") { this._listeners.push(fn); }
+  };
+  AbortSignal.prototype.removeEventListener = function (type, fn) {
+    this._listeners = this._listeners.filter(function (l) { return l !== fn; });
+  };
+
+  function AbortController() { this.signal = new AbortSignal(); }
+  AbortController.prototype.abort = function () {
+    var signal = this.signal;
+    if (signal.aborted) { return; }
+    signal.aborted = true;
+    var event = { type: "abort", target: signal };
+    if (typeof signal.onabort === "function") { signal.onabort(event); }
+    signal._listeners.slice().forEach(function (fn) { fn(event); });
+  };
+
+  function abortError() {
+    var err = new Error("The operation was aborted.");
+    err.name = "AbortError";
+    return err;
+  }
+
+  function Response(res) {
+    this.status = res.status;
+    this.statusText = res.statusText;
+    this.ok = res.status >= 200 && res.status < 300;
+    this.url = res.url;
+    this.headers = new Headers(res.headers);
+    this.bodyUsed = false;
+    this._body = res.body;
+  }
+  Response.prototype.text = function () {
+    if (this.bodyUsed) { return Promise.reject(new TypeError("body has already been consumed")); }
+    this.bodyUsed = true;
+    return Promise.resolve(this._body);
+  };
+  Response.prototype.json = function () {
+    return this.text().then(function (text) { return JSON.parse(text); });
+  };
+
+  global.fetch = function (input, init) {
+    init = init || {};
+    var request = typeof input === "string" ? { url: input } : input;
+    var signal = init.signal || request.signal;
+    var headers = new Headers(init.headers || request.headers);
+    var body = init.body !== undefined ? init.body : request.body;
+
+    return new Promise(function (resolve, reject) {
+      if (signal && signal.aborted) { return reject(abortError()); }
+
+      var done = false;
+      var cancel = __octantFetch({
+        url: String(request.url),
+        method: String(init.method || request.method || "GET").toUpperCase(),
+        headers: headers._map,
+        body: body === undefined || body === null ? "" : String(body)
+      }, function (err, res) {
+        if (done) { return; }
+        done = true;
+        if (err) { return reject(new TypeError(err)); }
+        resolve(new Response(res));
+      });
+
+      if (signal) {
+        signal.addEventListener("abort", function () {
+          if (done) { return; }
+          done = true;
+          cancel();
+          reject(abortError());
+        });
+      }
+    });
+  };
+  global.Headers = Headers;
+  global.Response = Response;
+  global.AbortController = AbortController;
+  global.AbortSignal = AbortSignal;
+})(this);
+`
+
+// AllowList is a list of host patterns a plugin is allowed to make HTTP requests to.
+// Patterns are globs matched against the host, and the host and port, of a URL. An
+// empty AllowList doesn't allow any host to be fetched. It is set with the
+// --plugin-fetch-allow-list flag.
+type AllowList []string
+
+// Allowed returns true if the host of u matches the allow list.
+func (a AllowList) Allowed(u *url.URL) bool {
+	for _, pattern := range a {
+		g, err := glob.Compile(pattern, '.', ':')
+		if err != nil {
+			continue
+		}
+		if g.Match(u.Hostname()) || g.Match(u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns an error if rawURL is not a http(s) URL that is allowed by the allow list.
+func (a AllowList) Check(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported url scheme %q", u.Scheme)
+	}
+	if !a.Allowed(u) {
+		return nil, fmt.Errorf("host %q is not in the plugin allow list", u.Host)
+	}
+	return u, nil
+}
+
+// newHTTPClient creates a HTTP client which checks every redirect against allowList.
+func newHTTPClient(allowList AllowList, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if _, err := allowList.Check(req.URL.String()); err != nil {
+				return fmt.Errorf("redirect: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+type fetchRequest struct {
+	url     string
+	method  string
+	headers map[string]string
+	body    string
+}
+
+// EnableFetch installs `fetch`, `Headers` and `AbortController` in vm. Requests are
+// made in the background and their results are delivered on the event loop. Only
+// hosts in allowList can be requested, including hosts requests are redirected to.
+// Requests in flight are canceled when ctx is done. It must be called on the event
+// loop after EnablePromise.
+func EnableFetch(ctx context.Context, loop *eventloop.EventLoop, vm *goja.Runtime, allowList AllowList) error {
+	client := newHTTPClient(allowList, fetchTimeout)
+
+	vm.Set("__octantFetch", func(call goja.FunctionCall) goja.Value {
+		req := fetchRequest{headers: map[string]string{}}
+		options := call.Argument(0).ToObject(vm)
+		req.url = options.Get("url").String()
+		req.method = options.Get("method").String()
+		req.body = options.Get("body").String()
+		if headers, ok := options.Get("headers").Export().(map[string]interface{}); ok {
+			for k, v := range headers {
+				req.headers[k] = fmt.Sprint(v)
+			}
+		}
+		callback, ok := goja.AssertFunction(call.Argument(1))
+		if !ok {
+			panic(vm.NewTypeError("callback must be a function"))
+		}
+
+		reqCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			defer cancel()
+			res, err := doFetch(reqCtx, client, allowList, req)
+			loop.RunOnLoop(func(vm *goja.Runtime) {
+				if err != nil {
+					_, _ = callback(goja.Undefined(), vm.ToValue(err.Error()))
+					return
+				}
+				_, _ = callback(goja.Undefined(), goja.Null(), vm.ToValue(res))
+			})
+		}()
+
+		return vm.ToValue(func(goja.FunctionCall) goja.Value {
+			cancel()
+			return goja.Undefined()
+		})
+	})
+
+	if _, err := vm.RunString(fetchSource); err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+	return nil
+}
+
+func doFetch(ctx context.Context, client *http.Client, allowList AllowList, req fetchRequest) (map[string]interface{}, error) {
+	u, err := allowList.Check(req.url)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if req.body != "" {
+		body = strings.NewReader(req.body)
+	}
+
+	r, err := http.NewRequestWithContext(ctx, req.method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range req.headers {
+		r.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxFetchBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	headers := map[string]interface{}{}
+	for k, v := range resp.Header {
+		headers[strings.ToLower(k)] = strings.Join(v, ", ")
+	}
+
+	return map[string]interface{}{
+		"status":     resp.StatusCode,
+		"statusText": strings.TrimSpace(strings.TrimPrefix(resp.Status, fmt.Sprint(resp.StatusCode))),
+		"url":        resp.Request.URL.String(),
+		"headers":    headers,
+		"body":       string(data),
+	}, nil
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package javascript
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowList_Allowed(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowList AllowList
+		url       string
+		want      bool
+	}{
+		{name: "empty", url: "https://example.com", want: false},
+		{name: "exact", allowList: AllowList{"example.com"}, url: "https://example.com/path", want: true},
+		{name: "wildcard", allowList: AllowList{"*.example.com"}, url: "https://api.example.com", want: true},
+		{name: "wildcard does not match parent", allowList: AllowList{"*.example.com"}, url: "https://example.com", want: false},
+		{name: "host and port", allowList: AllowList{"127.0.0.1:8080"}, url: "http://127.0.0.1:8080", want: true},
+		{name: "not listed", allowList: AllowList{"example.com"}, url: "https://evil.com", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, tt.allowList.Allowed(u))
+		})
+	}
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		case "/redirect":
+			http.Redirect(w, r, "http://example.invalid/echo", http.StatusFound)
+			return
+		case "/echo":
+			body, _ := ioutil.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"method": %q, "token": %q, "body": %q}`, r.Method, r.Header.Get("X-Token"), body)
+		}
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	allowed := AllowList{u.Host}
+
+	tests := []struct {
+		name      string
+		allowList AllowList
+		script    string
+		want      string
+	}{
+		{
+			name:      "post with headers",
+			allowList: allowed,
+			script: `fetch(url + "/echo", { method: "post", headers: { "X-Token": "secret" }, body: "payload" })
+  .then(function (res) { return res.json().then(function (data) { return res.status + " " + res.headers.get("content-type") + " " + data.method + " " + data.token + " " + data.body; }); })`,
+			want: "200 application/json POST secret payload",
+		},
+		{
+			name:      "abort",
+			allowList: allowed,
+			script:    `(function () { var c = new AbortController(); var p = fetch(url + "/slow", { signal: c.signal }); c.abort(); return p; })()`,
+			want:      "AbortError",
+		},
+		{
+			name:      "host not allowed",
+			allowList: AllowList{"example.com"},
+			script:    `fetch(url + "/echo")`,
+			want:      fmt.Sprintf("TypeError: host %q is not in the plugin allow list", u.Host),
+		},
+		{
+			name:   "empty allow list",
+			script: `fetch(url + "/echo")`,
+			want:   fmt.Sprintf("TypeError: host %q is not in the plugin allow list", u.Host),
+		},
+		{
+			name:      "redirect to host not allowed",
+			allowList: allowed,
+			script:    `fetch(url + "/redirect")`,
+			want:      `TypeError: Get "http://example.invalid/echo": redirect: host "example.invalid" is not in the plugin allow list`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loop, _, err := CreateRuntimeLoop(context.Background(), "fetch")
+			require.NoError(t, err)
+			defer loop.Stop()
+
+			runOnLoop(t, loop, func(vm *goja.Runtime) error {
+				if err := EnableFetch(context.Background(), loop, vm, tt.allowList); err != nil {
+					return err
+				}
+				vm.Set("url", server.URL)
+				_, err := vm.RunString(`var result; (` + tt.script + `).then(function (v) { result = v; }, function (e) { result = e.name === "AbortError" ? e.name : String(e); });`)
+				return err
+			})
+
+			require.Equal(t, tt.want, waitFor(t, loop, "result").Export())
+		})
+	}
+}
+
+func TestFetch_canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	loop, _, err := CreateRuntimeLoop(context.Background(), "fetch")
+	require.NoError(t, err)
+	defer loop.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	runOnLoop(t, loop, func(vm *goja.Runtime) error {
+		if err := EnableFetch(ctx, loop, vm, AllowList{u.Host}); err != nil {
+			return err
+		}
+		vm.Set("url", server.URL)
+		_, err := vm.RunString(`var result; fetch(url).then(function () { result = "done"; }, function (e) { result = "failed"; });`)
+		return err
+	})
+
+	cancel()
+	require.Equal(t, "failed", waitFor(t, loop, "result").Export())
+}
@@ -18,17 +18,20 @@ import (
 )
 
 type httpClient struct {
-	vm   *goja.Runtime
-	this *goja.Object
+	vm        *goja.Runtime
+	this      *goja.Object
+	allowList AllowList
 }
 
 // CreateHTTPClientObject creates an object that wraps HTTP client calls and exposes
-// them as methods to be used in the JavaScript runtime.
-func CreateHTTPClientObject(vm *goja.Runtime, this *goja.Object) goja.Value {
+// them as methods to be used in the JavaScript runtime. If allowList is empty any host
+// can be requested, as before the allow list existed; otherwise only hosts in allowList.
+func CreateHTTPClientObject(vm *goja.Runtime, this *goja.Object, allowList AllowList) goja.Value {
 	client := vm.NewObject()
 	h := &httpClient{
-		vm:   vm,
-		this: this,
+		vm:        vm,
+		this:      this,
+		allowList: allowList,
 	}
 	if err := client.Set("get", h.get); err != nil {
 		return vm.NewTypeError(fmt.Errorf("httpClient.Set.get: %w", err))
@@ -52,6 +55,12 @@ func (h *httpClient) httpGet(c goja.FunctionCall) (goja.Callable, []byte, error)
 		return nil, nil, fmt.Errorf("empty url")
 	}
 
+	if len(h.allowList) > 0 {
+		if _, err := h.allowList.Check(urlArg); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	callbackArg := c.Argument(1).ToObject(h.vm)
 	callback, ok := goja.AssertFunction(callbackArg)
 	if !ok || callback == nil {
@@ -59,6 +68,9 @@ func (h *httpClient) httpGet(c goja.FunctionCall) (goja.Callable, []byte, error)
 	}
 
 	client := &http.Client{Timeout: 10 * time.Second}
+	if len(h.allowList) > 0 {
+		client = newHTTPClient(h.allowList, 10*time.Second)
+	}
 	r, err := client.Get(urlArg)
 	if err != nil {
 		return nil, nil, fmt.Errorf("get: %w", err)
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package javascript
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClient_getJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"name": "octant"}`)
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		allowList AllowList
+		want      string
+	}{
+		{
+			name: "default config allows any host",
+			want: "octant",
+		},
+		{
+			name:      "allowed host",
+			allowList: AllowList{u.Host},
+			want:      "octant",
+		},
+		{
+			name:      "host not allowed",
+			allowList: AllowList{"example.com"},
+			want:      "TypeError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vm := goja.New()
+			vm.Set("httpClient", CreateHTTPClientObject(vm, vm.NewObject(), tt.allowList))
+			vm.Set("url", server.URL)
+
+			v, err := vm.RunString(`String(httpClient.getJSON(url, function (data) { return data.name; }))`)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, v.Export())
+		})
+	}
+}
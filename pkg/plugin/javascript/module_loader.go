@@ -0,0 +1,309 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dop251/goja"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// ErrModuleNotFound is returned when a module specifier can not be resolved.
+var ErrModuleNotFound = errors.New("module not found")
+
+// moduleExtensions are the file extensions tried, in order, when resolving a module.
+var moduleExtensions = []string{".js", ".mjs", ".cjs", ".json"}
+
+// ModuleLoader loads CommonJS and ES modules for a JavaScript plugin. Relative imports
+// are resolved from the importing module and bare imports are looked up in `node_modules`
+// directories between the importing module and the plugin directory. Modules are never
+// loaded from outside of the plugin directory.
+type ModuleLoader struct {
+	root     string
+	vm       *goja.Runtime
+	fallback goja.Callable
+	modules  map[string]*goja.Object
+}
+
+// NewModuleLoader creates an instance of ModuleLoader rooted at the plugin directory root.
+func NewModuleLoader(root string) (*ModuleLoader, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("resolving plugin directory: %w", err)
+	}
+
+	return &ModuleLoader{
+		root:    abs,
+		modules: map[string]*goja.Object{},
+	}, nil
+}
+
+// Enable installs the loader's `require` function in vm. Specifiers that are not
+// found on disk are passed to the previously installed `require`, so native modules
+// such as `console` keep working. Enable must be called on the event loop.
+func (m *ModuleLoader) Enable(vm *goja.Runtime) {
+	m.vm = vm
+	if fallback, ok := goja.AssertFunction(vm.Get("require")); ok {
+		m.fallback = fallback
+	}
+
+	vm.Set("require", m.requireFunc(m.root))
+}
+
+// RunMain runs the plugin entry point at filename. Entry points using ES module
+// syntax are loaded as modules and their exports are assigned to the global
+// `module.exports`. Other entry points run as scripts in the global scope.
+func (m *ModuleLoader) RunMain(filename string) error {
+	buf, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("reading script: %w", err)
+	}
+
+	src := string(buf)
+	if IsScript(filename, src) {
+		sourceMap, err := readSourceMap(filename, src)
+		if err != nil {
+			return fmt.Errorf("loading source map for %s: %w", filename, err)
+		}
+		program, err := CompileWithSourceMap(filename, src, 0, sourceMap)
+		if err != nil {
+			return fmt.Errorf("compiling: %w", err)
+		}
+		if _, err := m.vm.RunProgram(program); err != nil {
+			return fmt.Errorf("script execution: %w", ErrorWithStack(err))
+		}
+		return nil
+	}
+
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	exports, err := m.load(abs)
+	if err != nil {
+		return fmt.Errorf("module execution: %w", ErrorWithStack(err))
+	}
+
+	module := m.vm.Get("module")
+	if module == nil || goja.IsUndefined(module) {
+		return fmt.Errorf("global module object is not defined")
+	}
+	return module.ToObject(m.vm).Set("exports", exports)
+}
+
+// Resolve resolves specifier relative to the directory dir.
+func (m *ModuleLoader) Resolve(specifier, dir string) (string, error) {
+	if specifier == "" {
+		return "", fmt.Errorf("empty module specifier")
+	}
+
+	if strings.HasPrefix(specifier, "./") || strings.HasPrefix(specifier, "../") || filepath.IsAbs(specifier) {
+		p := specifier
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, filepath.FromSlash(specifier))
+		}
+		if !m.inRoot(p) {
+			return "", fmt.Errorf("module %q is outside of the plugin directory", specifier)
+		}
+		return m.resolvePath(p)
+	}
+
+	for current := dir; m.inRoot(current); current = filepath.Dir(current) {
+		p, err := m.resolvePath(filepath.Join(current, "node_modules", filepath.FromSlash(specifier)))
+		if err == nil {
+			return p, nil
+		}
+		if !errors.Is(err, ErrModuleNotFound) {
+			return "", err
+		}
+		if current == m.root {
+			break
+		}
+	}
+
+	return "", ErrModuleNotFound
+}
+
+// Require loads the module identified by specifier relative to the directory dir.
+func (m *ModuleLoader) Require(specifier, dir string) (goja.Value, error) {
+	p, err := m.Resolve(specifier, dir)
+	if err != nil {
+		if errors.Is(err, ErrModuleNotFound) && m.fallback != nil {
+			return m.fallback(goja.Undefined(), m.vm.ToValue(specifier))
+		}
+		return nil, fmt.Errorf("cannot find module %q: %w", specifier, err)
+	}
+
+	return m.load(p)
+}
+
+func (m *ModuleLoader) inRoot(p string) bool {
+	rel, err := filepath.Rel(m.root, p)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (m *ModuleLoader) resolvePath(p string) (string, error) {
+	if isFile(p) {
+		return p, nil
+	}
+	for _, ext := range moduleExtensions {
+		if isFile(p + ext) {
+			return p + ext, nil
+		}
+	}
+
+	if fi, err := os.Stat(p); err == nil && fi.IsDir() {
+		if main := packageMain(p); main != "" {
+			entry := filepath.Join(p, filepath.FromSlash(main))
+			if m.inRoot(entry) {
+				if resolved, err := m.resolvePath(entry); err == nil {
+					return resolved, nil
+				}
+			}
+		}
+		for _, ext := range moduleExtensions {
+			index := filepath.Join(p, "index"+ext)
+			if isFile(index) {
+				return index, nil
+			}
+		}
+	}
+
+	return "", ErrModuleNotFound
+}
+
+func (m *ModuleLoader) load(p string) (goja.Value, error) {
+	if module, ok := m.modules[p]; ok {
+		return module.Get("exports"), nil
+	}
+
+	buf, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("reading module: %w", err)
+	}
+
+	module := m.vm.NewObject()
+	exports := m.vm.NewObject()
+	if err := module.Set("exports", exports); err != nil {
+		return nil, err
+	}
+	if err := module.Set("id", p); err != nil {
+		return nil, err
+	}
+	if err := module.Set("filename", p); err != nil {
+		return nil, err
+	}
+	m.modules[p] = module
+
+	if filepath.Ext(p) == ".json" {
+		var data interface{}
+		if err := json.Unmarshal(buf, &data); err != nil {
+			delete(m.modules, p)
+			return nil, fmt.Errorf("parsing %s: %w", p, err)
+		}
+		if err := module.Set("exports", m.vm.ToValue(data)); err != nil {
+			return nil, err
+		}
+		return module.Get("exports"), nil
+	}
+
+	src, sourceMap, err := moduleSource(p, string(buf))
+	if err != nil {
+		delete(m.modules, p)
+		return nil, err
+	}
+	// The wrapper is placed on its own line so columns on the first line of the
+	// module are not shifted. The source map is offset by that line.
+	wrapped := "(function (exports, require, module, __filename, __dirname) {\n" + src + "\n})"
+
+	program, err := CompileWithSourceMap(p, wrapped, 1, sourceMap)
+	if err != nil {
+		delete(m.modules, p)
+		return nil, fmt.Errorf("compiling %s: %w", p, err)
+	}
+
+	fn, err := m.vm.RunProgram(program)
+	if err != nil {
+		delete(m.modules, p)
+		return nil, err
+	}
+	call, ok := goja.AssertFunction(fn)
+	if !ok {
+		delete(m.modules, p)
+		return nil, fmt.Errorf("invalid module %s", p)
+	}
+
+	dir := filepath.Dir(p)
+	if _, err := call(exports, exports, m.vm.ToValue(m.requireFunc(dir)), module, m.vm.ToValue(p), m.vm.ToValue(dir)); err != nil {
+		delete(m.modules, p)
+		return nil, err
+	}
+	if err := module.Set("loaded", true); err != nil {
+		return nil, err
+	}
+
+	return module.Get("exports"), nil
+}
+
+func (m *ModuleLoader) requireFunc(dir string) func(goja.FunctionCall) goja.Value {
+	return func(call goja.FunctionCall) goja.Value {
+		v, err := m.Require(call.Argument(0).String(), dir)
+		if err != nil {
+			panic(m.vm.NewGoError(err))
+		}
+		return v
+	}
+}
+
+// moduleSource returns the module source src of filename as a script and its source
+// map. ES modules are transformed into CommonJS modules.
+func moduleSource(filename, src string) (string, []byte, error) {
+	if !IsScript(filename, src) {
+		return TransformESM(filename, src)
+	}
+
+	sourceMap, err := readSourceMap(filename, src)
+	if err != nil {
+		return "", nil, fmt.Errorf("loading source map for %s: %w", filename, err)
+	}
+	return src, sourceMap, nil
+}
+
+func packageMain(dir string) string {
+	buf, err := ioutil.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return ""
+	}
+
+	var pkg struct {
+		Main   string `json:"main"`
+		Module string `json:"module"`
+	}
+	if err := json.Unmarshal(buf, &pkg); err != nil {
+		return ""
+	}
+
+	// CommonJS entry points are preferred since they are more likely to be ES2015.
+	if pkg.Main != "" {
+		return pkg.Main
+	}
+	return pkg.Module
+}
+
+func isFile(p string) bool {
+	fi, err := os.Stat(p)
+	return err == nil && fi.Mode().IsRegular()
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package javascript
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+	}
+}
+
+func TestModuleLoader_RunMain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "module-loader")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFiles(t, dir, map[string]string{
+		"plugin.js": "import { greet } from './lib/greet';\n" +
+			"import pad from 'left-pad';\n" +
+			"import config from './config.json';\n" +
+			"export default function Plugin() { this.name = pad(greet(config.name), 2); }\n",
+		"lib/greet.js":                        "export function greet(name) { return 'hello ' + name; }\n",
+		"config.json":                         `{"name": "octant"}`,
+		"node_modules/left-pad/package.json":  `{"main": "lib/index.js"}`,
+		"node_modules/left-pad/lib/index.js":  "module.exports = function (s, n) { return new Array(n + 1).join(' ') + s; };\n",
+		"node_modules/outside/index.js":       "module.exports = require('../../../escape');\n",
+		"node_modules/native-only/index.js":   "module.exports = require('console');\n",
+		"node_modules/needs-missing/index.js": "module.exports = require('does-not-exist');\n",
+	})
+
+	vm := goja.New()
+	vm.Set("module", vm.NewObject())
+	vm.Set("require", func(call goja.FunctionCall) goja.Value {
+		if call.Argument(0).String() == "console" {
+			return vm.ToValue("native console")
+		}
+		panic(vm.NewGoError(ErrModuleNotFound))
+	})
+
+	loader, err := NewModuleLoader(dir)
+	require.NoError(t, err)
+	loader.Enable(vm)
+
+	require.NoError(t, loader.RunMain(filepath.Join(dir, "plugin.js")))
+
+	v, err := vm.RunString("new module.exports.default().name")
+	require.NoError(t, err)
+	require.Equal(t, "  hello octant", v.Export())
+
+	v, err = vm.RunString("require('native-only')")
+	require.NoError(t, err)
+	require.Equal(t, "native console", v.Export())
+
+	_, err = vm.RunString("require('outside')")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "outside of the plugin directory")
+
+	_, err = vm.RunString("require('needs-missing')")
+	require.Error(t, err)
+}
+
+func TestModuleLoader_sourceMap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "module-loader")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Maps generated line 2 to line 10 of plugin.ts.
+	sourceMap := `{"version":3,"file":"plugin.js","sources":["plugin.ts"],"names":[],"mappings":";AASA,cAAc"}`
+
+	writeFiles(t, dir, map[string]string{
+		"plugin.js": "export var value = 1;\nthrow new Error('boom');\n" +
+			"//# sourceMappingURL=data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(sourceMap)),
+	})
+
+	vm := goja.New()
+	vm.Set("module", vm.NewObject())
+
+	loader, err := NewModuleLoader(dir)
+	require.NoError(t, err)
+	loader.Enable(vm)
+
+	err = loader.RunMain(filepath.Join(dir, "plugin.js"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), filepath.Join(dir, "plugin.ts")+":10:1")
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"fmt"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// promiseSource is a small Promises/A+ implementation used when the runtime does not
+// provide `Promise`. Reactions are queued on the event loop with `__octantEnqueue`.
+const promiseSource = `
+(function (global) {
+  if (typeof global.Promise === "function") { return; }
+
+  var PENDING = 0, FULFILLED = 1, REJECTED = 2;
+
+  function Promise(executor) {
+    if (!(this instanceof Promise)) { throw new TypeError("Promise must be called with new"); }
+    if (typeof executor !== "function") { throw new TypeError("Promise executor is not a function"); }
+    this._state = PENDING;
+    this._value = undefined;
+    this._reactions = [];
+    var settled = false, self = this;
+    try {
+      executor(function (v) { if (!settled) { settled = true; resolve(self, v); } },
+               function (r) { if (!settled) { settled = true; settle(self, REJECTED, r); } });
+    } catch (e) {
+      if (!settled) { settled = true; settle(self, REJECTED, e); }
+    }
+  }
+
+  function settle(p, state, value) {
+    if (p._state !== PENDING) { return; }
+    p._state = state;
+    p._value = value;
+    var reactions = p._reactions;
+    p._reactions = [];
+    for (var i = 0; i < reactions.length; i++) { schedule(p, reactions[i]); }
+  }
+
+  function resolve(p, v) {
+    if (v === p) { return settle(p, REJECTED, new TypeError("Promise resolved with itself")); }
+    if (v !== null && (typeof v === "object" || typeof v === "function")) {
+      var then;
+      try { then = v.then; } catch (e) { return settle(p, REJECTED, e); }
+      if (typeof then === "function") {
+        var called = false;
+        try {
+          then.call(v,
+            function (y) { if (!called) { called = true; resolve(p, y); } },
+            function (r) { if (!called) { called = true; settle(p, REJECTED, r); } });
+        } catch (e) {
+          if (!called) { called = true; settle(p, REJECTED, e); }
+        }
+        return;
+      }
+    }
+    settle(p, FULFILLED, v);
+  }
+
+  function schedule(p, reaction) {
+    __octantEnqueue(function () {
+      var handler = p._state === FULFILLED ? reaction.onFulfilled : reaction.onRejected;
+      if (typeof handler !== "function") {
+        if (p._state === FULFILLED) { resolve(reaction.promise, p._value); }
+        else { settle(reaction.promise, REJECTED, p._value); }
+        return;
+      }
+      var result;
+      try { result = handler(p._value); } catch (e) { return settle(reaction.promise, REJECTED, e); }
+      resolve(reaction.promise, result);
+    });
+  }
+
+  Promise.prototype.then = function (onFulfilled, onRejected) {
+    var next = new Promise(function () {});
+    var reaction = { onFulfilled: onFulfilled, onRejected: onRejected, promise: next };
+    if (this._state === PENDING) { this._reactions.push(reaction); }
+    else { schedule(this, reaction); }
+    return next;
+  };
+
+  Promise.prototype["catch"] = function (onRejected) {
+    return this.then(undefined, onRejected);
+  };
+
+  Promise.prototype["finally"] = function (fn) {
+    return this.then(
+      function (v) { return Promise.resolve(fn()).then(function () { return v; }); },
+      function (r) { return Promise.resolve(fn()).then(function () { throw r; }); });
+  };
+
+  Promise.resolve = function (v) {
+    if (v instanceof Promise) { return v; }
+    return new Promise(function (res) { res(v); });
+  };
+
+  Promise.reject = function (r) {
+    return new Promise(function (res, rej) { rej(r); });
+  };
+
+  Promise.all = function (list) {
+    return new Promise(function (res, rej) {
+      var results = [], remaining = list.length;
+      if (remaining === 0) { return res(results); }
+      for (var i = 0; i < list.length; i++) {
+        (function (i) {
+          Promise.resolve(list[i]).then(function (v) {
+            results[i] = v;
+            if (--remaining === 0) { res(results); }
+          }, rej);
+        })(i);
+      }
+    });
+  };
+
+  Promise.race = function (list) {
+    return new Promise(function (res, rej) {
+      for (var i = 0; i < list.length; i++) { Promise.resolve(list[i]).then(res, rej); }
+    });
+  };
+
+  global.Promise = Promise;
+})(this);
+`
+
+// EnablePromise installs a `Promise` implementation in vm if the runtime does not
+// provide one. It must be called on the event loop.
+func EnablePromise(loop *eventloop.EventLoop, vm *goja.Runtime, logger log.Logger) error {
+	vm.Set("__octantEnqueue", func(call goja.FunctionCall) goja.Value {
+		fn, ok := goja.AssertFunction(call.Argument(0))
+		if !ok {
+			panic(vm.NewTypeError("job must be a function"))
+		}
+		loop.RunOnLoop(func(vm *goja.Runtime) {
+			if _, err := fn(goja.Undefined()); err != nil {
+				logger.Errorf("promise job: %s", ErrorWithStack(err))
+			}
+		})
+		return goja.Undefined()
+	})
+
+	if _, err := vm.RunString(promiseSource); err != nil {
+		return fmt.Errorf("promise: %w", err)
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package javascript
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dop251/goja"
 	"github.com/dop251/goja_nodejs/console"
@@ -15,12 +17,13 @@ import (
 
 // CreateRuntimeLoop creates and starts a new EventLoop. An EventLoop contains a JavaScript runtime.
 // The runtime for an EventLoop should never be accessed from outside the the loop.
-// You can safely nest RunOnLoop calls.
-func CreateRuntimeLoop(ctx context.Context, logName string) (*eventloop.EventLoop, error) {
+// You can safely nest RunOnLoop calls. The returned Timers must be stopped when the loop is stopped.
+func CreateRuntimeLoop(ctx context.Context, logName string) (*eventloop.EventLoop, *Timers, error) {
 	loop := eventloop.NewEventLoop()
 	loop.Start()
 
 	errCh := make(chan error)
+	var timers *Timers
 
 	loop.RunOnLoop(func(vm *goja.Runtime) {
 		vm.Set("global", vm.GlobalObject())
@@ -43,6 +46,12 @@ var exports = module.exports;
 		registry.RegisterNativeModule("console", console.RequireWithPrinter(printer))
 		console.Enable(vm)
 
+		timers = EnableTimers(loop, vm, logger)
+		if err := EnablePromise(loop, vm, logger); err != nil {
+			errCh <- fmt.Errorf("runtime promise: %w", err)
+			return
+		}
+
 		// This maps Caps fields to lower fields from struct to Object based on the JSON annotations.
 		vm.SetFieldNameMapper(goja.TagFieldNameMapper("json", true))
 		errCh <- nil
@@ -50,10 +59,11 @@ var exports = module.exports;
 
 	err := <-errCh
 	if err != nil {
-		return nil, err
+		loop.Stop()
+		return nil, nil, err
 	}
 
-	return loop, nil
+	return loop, timers, nil
 }
 
 // ExtractDefaultClass extracts the `default` class from the JavaScript runtime.
@@ -76,6 +86,17 @@ func ExtractDefaultClass(vm *goja.Runtime) (*goja.Object, error) {
 	return pluginClass, nil
 }
 
+// ErrorWithStack returns err with the full JavaScript stack trace if err is a JavaScript
+// exception. Stack frame positions are resolved through the source map of the module
+// that raised the exception when one is available.
+func ErrorWithStack(err error) error {
+	var exception *goja.Exception
+	if errors.As(err, &exception) {
+		return fmt.Errorf("%s", strings.TrimSpace(mapStackTrace(exception.String())))
+	}
+	return err
+}
+
 type logPrinter struct {
 	logger log.Logger
 }
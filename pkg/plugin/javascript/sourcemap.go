@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja/parser"
+	"github.com/go-sourcemap/sourcemap"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+const sourceMappingURLPrefix = "//# sourceMappingURL="
+
+// sourceMaps holds the sourceMapping of each compiled program by file name.
+var sourceMaps sync.Map
+
+// sourceMapping maps positions in a compiled program to its original sources.
+type sourceMapping struct {
+	// lineOffset is the number of lines added before the source by the module wrapper.
+	lineOffset int
+	consumer   *sourcemap.Consumer
+}
+
+// stackFrameRe matches the position of a stack frame in a JavaScript stack trace.
+var stackFrameRe = regexp.MustCompile(`^(\tat (?:[^(]* \()?)(.+):(\d+):(\d+)\(\d+\)(\)?)$`)
+
+// CompileWithSourceMap compiles src as a program named filename. The first lineOffset
+// lines of src are not part of the source described by sourceMap. If sourceMap is
+// not nil, positions in stack traces returned by ErrorWithStack refer to the
+// original sources.
+func CompileWithSourceMap(filename, src string, lineOffset int, sourceMap []byte) (*goja.Program, error) {
+	// The parser can load referenced source maps itself, but it does not know about the
+	// module wrapper or transforms, so positions are mapped by ErrorWithStack instead.
+	program, err := parser.ParseFile(nil, filename, src, 0, parser.WithDisableSourceMaps)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceMaps.Delete(filename)
+	if sourceMap != nil {
+		consumer, err := sourcemap.Parse("", sourceMap)
+		if err != nil {
+			return nil, fmt.Errorf("parsing source map for %s: %w", filename, err)
+		}
+		sourceMaps.Store(filename, &sourceMapping{lineOffset: lineOffset, consumer: consumer})
+	}
+
+	return goja.CompileAST(program, false)
+}
+
+// mapStackTrace rewrites the stack frame positions in trace that belong to programs
+// compiled with a source map to positions in the original sources.
+func mapStackTrace(trace string) string {
+	lines := strings.Split(trace, "\n")
+	for i, line := range lines {
+		parts := stackFrameRe.FindStringSubmatch(line)
+		if parts == nil {
+			continue
+		}
+		v, ok := sourceMaps.Load(parts[2])
+		if !ok {
+			continue
+		}
+		mapping := v.(*sourceMapping)
+
+		row, _ := strconv.Atoi(parts[3])
+		col, _ := strconv.Atoi(parts[4])
+		source, _, row, col, ok := mapping.consumer.Source(row-mapping.lineOffset, col-1)
+		if !ok {
+			continue
+		}
+		lines[i] = fmt.Sprintf("%s%s:%d:%d%s", parts[1], source, row, col+1, parts[5])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// inlineSourceMap replaces a source map referenced by the last line of src with an
+// inline source map, so it is picked up by transforms that can't read files.
+func inlineSourceMap(filename, src string) (string, error) {
+	data, err := readSourceMap(filename, src)
+	if err != nil || data == nil {
+		return src, err
+	}
+
+	trimmed := strings.TrimRight(src, " \t\r\n")
+	return trimmed[:strings.LastIndexByte(trimmed, '\n')+1] + sourceMappingURLPrefix +
+		"data:application/json;base64," + base64.StdEncoding.EncodeToString(data) + "\n", nil
+}
+
+// readSourceMap returns the source map referenced by the last line of src, with the
+// paths of its sources made absolute. It returns nil if src does not reference a
+// source map or the referenced file does not exist.
+func readSourceMap(filename, src string) ([]byte, error) {
+	src = strings.TrimRight(src, " \t\r\n")
+	lastLine := src[strings.LastIndexByte(src, '\n')+1:]
+	if !strings.HasPrefix(lastLine, sourceMappingURLPrefix) {
+		return nil, nil
+	}
+	mapURL := strings.TrimSpace(strings.TrimPrefix(lastLine, sourceMappingURLPrefix))
+
+	var data []byte
+	mapPath := filename
+	if strings.HasPrefix(mapURL, "data:") {
+		i := strings.Index(mapURL, ";base64,")
+		if i < 0 {
+			return nil, fmt.Errorf("unsupported inline source map encoding")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(mapURL[i+len(";base64,"):])
+		if err != nil {
+			return nil, fmt.Errorf("decoding inline source map: %w", err)
+		}
+		data = decoded
+	} else {
+		mapPath = strings.TrimPrefix(mapURL, "file://")
+		if !filepath.IsAbs(mapPath) {
+			mapPath = filepath.Join(filepath.Dir(filename), filepath.FromSlash(mapPath))
+		}
+		b, err := ioutil.ReadFile(mapPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Plugins are often distributed without their source maps.
+				return nil, nil
+			}
+			return nil, err
+		}
+		data = b
+	}
+
+	return absSources(filepath.Dir(mapPath), data)
+}
+
+// absSources resolves the relative source paths of the source map in data from dir.
+func absSources(dir string, data []byte) ([]byte, error) {
+	var sm map[string]interface{}
+	if err := json.Unmarshal(data, &sm); err != nil {
+		return nil, fmt.Errorf("parsing source map: %w", err)
+	}
+
+	root, _ := sm["sourceRoot"].(string)
+	if strings.Contains(root, "://") {
+		return data, nil
+	}
+	sources, _ := sm["sources"].([]interface{})
+	for i, source := range sources {
+		s, ok := source.(string)
+		if !ok || strings.Contains(s, "://") {
+			continue
+		}
+		p := filepath.FromSlash(s)
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, filepath.FromSlash(root), p)
+		}
+		sources[i] = p
+	}
+	delete(sm, "sourceRoot")
+
+	return json.Marshal(sm)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/dop251/goja"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// Storage is a key/value store for a JavaScript plugin. Values must be serializable
+// as JSON. The store is persisted to a JSON file after every change.
+type Storage struct {
+	path string
+
+	mu   sync.Mutex
+	data map[string]interface{}
+}
+
+// NewStorage creates an instance of Storage persisted at path. Existing values are
+// loaded if path exists.
+func NewStorage(path string) (*Storage, error) {
+	s := &Storage{
+		path: path,
+		data: map[string]interface{}{},
+	}
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("reading plugin storage: %w", err)
+	}
+
+	if err := json.Unmarshal(buf, &s.data); err != nil {
+		return nil, fmt.Errorf("decoding plugin storage %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Get returns the value for key.
+func (s *Storage) Get(key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.data[key]
+	return v, ok
+}
+
+// Set sets the value for key. The store is left unchanged if it can't be saved.
+func (s *Storage) Set(key string, value interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := json.Marshal(value); err != nil {
+		return fmt.Errorf("value for %q is not serializable: %w", key, err)
+	}
+
+	data := s.copyData()
+	data[key] = value
+	return s.save(data)
+}
+
+// Delete removes key. The store is left unchanged if it can't be saved.
+func (s *Storage) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := s.copyData()
+	delete(data, key)
+	return s.save(data)
+}
+
+// Clear removes all keys. The store is left unchanged if it can't be saved.
+func (s *Storage) Clear() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.save(map[string]interface{}{})
+}
+
+// Keys returns the sorted list of keys.
+func (s *Storage) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var keys []string
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// copyData returns a shallow copy of the store's values. The caller must hold the lock.
+func (s *Storage) copyData() map[string]interface{} {
+	data := make(map[string]interface{}, len(s.data))
+	for k, v := range s.data {
+		data[k] = v
+	}
+	return data
+}
+
+// save writes data to a temporary file, renames it over the existing file and then
+// replaces the store's values with data. The caller must hold the lock.
+func (s *Storage) save(data map[string]interface{}) error {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("encoding plugin storage: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("creating plugin storage directory: %w", err)
+	}
+
+	f, err := ioutil.TempFile(dir, filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating plugin storage file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+
+	if _, err := f.Write(buf); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing plugin storage: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing plugin storage: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("writing plugin storage: %w", err)
+	}
+
+	s.data = data
+	return nil
+}
+
+// CreateStorageObject creates an object that exposes storage to the JavaScript runtime
+// with `getItem`, `setItem`, `removeItem`, `clear` and `keys` methods.
+func CreateStorageObject(vm *goja.Runtime, storage *Storage) goja.Value {
+	obj := vm.NewObject()
+
+	methods := map[string]func(goja.FunctionCall) goja.Value{
+		"getItem": func(c goja.FunctionCall) goja.Value {
+			v, ok := storage.Get(c.Argument(0).String())
+			if !ok {
+				return goja.Null()
+			}
+			return vm.ToValue(v)
+		},
+		"setItem": func(c goja.FunctionCall) goja.Value {
+			if err := storage.Set(c.Argument(0).String(), c.Argument(1).Export()); err != nil {
+				panic(vm.NewGoError(err))
+			}
+			return goja.Undefined()
+		},
+		"removeItem": func(c goja.FunctionCall) goja.Value {
+			if err := storage.Delete(c.Argument(0).String()); err != nil {
+				panic(vm.NewGoError(err))
+			}
+			return goja.Undefined()
+		},
+		"clear": func(c goja.FunctionCall) goja.Value {
+			if err := storage.Clear(); err != nil {
+				panic(vm.NewGoError(err))
+			}
+			return goja.Undefined()
+		},
+		"keys": func(c goja.FunctionCall) goja.Value {
+			return vm.ToValue(storage.Keys())
+		},
+	}
+
+	for name, fn := range methods {
+		if err := obj.Set(name, fn); err != nil {
+			return vm.NewTypeError(fmt.Errorf("storage.Set.%s: %w", name, err))
+		}
+	}
+
+	return obj
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package javascript
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugin-storage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "storage", "plugin.json")
+
+	storage, err := NewStorage(path)
+	require.NoError(t, err)
+
+	vm := goja.New()
+	vm.Set("pluginStorage", CreateStorageObject(vm, storage))
+
+	_, err = vm.RunString(`
+pluginStorage.setItem("theme", "dark");
+pluginStorage.setItem("favorites", ["a", "b"]);
+pluginStorage.setItem("removed", 1);
+pluginStorage.removeItem("removed");
+`)
+	require.NoError(t, err)
+
+	v, err := vm.RunString(`pluginStorage.getItem("missing")`)
+	require.NoError(t, err)
+	require.True(t, goja.IsNull(v))
+
+	reloaded, err := NewStorage(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"favorites", "theme"}, reloaded.Keys())
+
+	got, ok := reloaded.Get("favorites")
+	require.True(t, ok)
+	require.Equal(t, []interface{}{"a", "b"}, got)
+
+	require.NoError(t, reloaded.Clear())
+	reloaded, err = NewStorage(path)
+	require.NoError(t, err)
+	require.Empty(t, reloaded.Keys())
+}
+
+func TestStorage_save_error(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plugin-storage")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "storage", "plugin.json")
+
+	storage, err := NewStorage(path)
+	require.NoError(t, err)
+	require.NoError(t, storage.Set("theme", "dark"))
+
+	// Replace the storage directory with a file so saving fails.
+	require.NoError(t, os.RemoveAll(filepath.Dir(path)))
+	require.NoError(t, ioutil.WriteFile(filepath.Dir(path), nil, 0600))
+
+	require.Error(t, storage.Set("theme", "light"))
+	require.Error(t, storage.Delete("theme"))
+	require.Error(t, storage.Clear())
+
+	got, ok := storage.Get("theme")
+	require.True(t, ok)
+	require.Equal(t, "dark", got)
+}
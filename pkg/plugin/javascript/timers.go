@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package javascript
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// minimumInterval is the shortest delay allowed for repeating timers.
+const minimumInterval = 10 * time.Millisecond
+
+type timer struct {
+	id       int64
+	fn       goja.Callable
+	args     []goja.Value
+	delay    time.Duration
+	repeat   bool
+	deadline *time.Timer
+}
+
+// Timers implements `setTimeout`, `setInterval`, `clearTimeout` and `clearInterval`
+// for a JavaScript runtime. Callbacks are always run on the event loop and errors
+// raised by callbacks are logged instead of being discarded.
+type Timers struct {
+	loop   *eventloop.EventLoop
+	logger log.Logger
+
+	mu     sync.Mutex
+	nextID int64
+	active map[int64]*timer
+}
+
+// EnableTimers installs the timer functions in vm. It must be called on the event loop.
+func EnableTimers(loop *eventloop.EventLoop, vm *goja.Runtime, logger log.Logger) *Timers {
+	t := &Timers{
+		loop:   loop,
+		logger: logger,
+		active: map[int64]*timer{},
+	}
+
+	vm.Set("setTimeout", func(call goja.FunctionCall) goja.Value {
+		return vm.ToValue(t.schedule(vm, call, false))
+	})
+	vm.Set("setInterval", func(call goja.FunctionCall) goja.Value {
+		return vm.ToValue(t.schedule(vm, call, true))
+	})
+	vm.Set("clearTimeout", func(call goja.FunctionCall) goja.Value {
+		t.clear(call.Argument(0).ToInteger())
+		return goja.Undefined()
+	})
+	vm.Set("clearInterval", func(call goja.FunctionCall) goja.Value {
+		t.clear(call.Argument(0).ToInteger())
+		return goja.Undefined()
+	})
+
+	return t
+}
+
+// Stop cancels all pending timers.
+func (t *Timers) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for id, tm := range t.active {
+		tm.deadline.Stop()
+		delete(t.active, id)
+	}
+}
+
+func (t *Timers) schedule(vm *goja.Runtime, call goja.FunctionCall, repeat bool) int64 {
+	fn, ok := goja.AssertFunction(call.Argument(0))
+	if !ok {
+		panic(vm.NewTypeError("callback must be a function"))
+	}
+
+	delay := time.Duration(call.Argument(1).ToInteger()) * time.Millisecond
+	if delay < 0 {
+		delay = 0
+	}
+	if repeat && delay < minimumInterval {
+		delay = minimumInterval
+	}
+
+	var args []goja.Value
+	if len(call.Arguments) > 2 {
+		args = call.Arguments[2:]
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.nextID++
+	tm := &timer{
+		id:     t.nextID,
+		fn:     fn,
+		args:   args,
+		delay:  delay,
+		repeat: repeat,
+	}
+	t.active[tm.id] = tm
+	t.arm(tm)
+
+	return tm.id
+}
+
+// arm starts the deadline for tm. The caller must hold the lock.
+func (t *Timers) arm(tm *timer) {
+	tm.deadline = time.AfterFunc(tm.delay, func() {
+		t.loop.RunOnLoop(func(vm *goja.Runtime) {
+			t.fire(tm)
+		})
+	})
+}
+
+func (t *Timers) fire(tm *timer) {
+	t.mu.Lock()
+	if _, ok := t.active[tm.id]; !ok {
+		t.mu.Unlock()
+		return
+	}
+	if !tm.repeat {
+		delete(t.active, tm.id)
+	}
+	t.mu.Unlock()
+
+	if _, err := tm.fn(goja.Undefined(), tm.args...); err != nil {
+		t.logger.Errorf("timer callback: %s", ErrorWithStack(err))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// The callback may have cleared its own interval.
+	if _, ok := t.active[tm.id]; ok && tm.repeat {
+		t.arm(tm)
+	}
+}
+
+func (t *Timers) clear(id int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if tm, ok := t.active[id]; ok {
+		tm.deadline.Stop()
+		delete(t.active, id)
+	}
+}
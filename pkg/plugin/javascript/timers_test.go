@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package javascript
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+	"github.com/stretchr/testify/require"
+)
+
+// runOnLoop runs fn on loop and waits for it to complete.
+func runOnLoop(t *testing.T, loop *eventloop.EventLoop, fn func(vm *goja.Runtime) error) {
+	errCh := make(chan error, 1)
+	loop.RunOnLoop(func(vm *goja.Runtime) {
+		errCh <- fn(vm)
+	})
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event loop")
+	}
+}
+
+// waitFor polls the global named name until it is truthy.
+func waitFor(t *testing.T, loop *eventloop.EventLoop, name string) goja.Value {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		var v goja.Value
+		runOnLoop(t, loop, func(vm *goja.Runtime) error {
+			v = vm.Get(name)
+			return nil
+		})
+		if v != nil && v.ToBoolean() {
+			return v
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", name)
+	return nil
+}
+
+func TestTimers(t *testing.T) {
+	loop, _, err := CreateRuntimeLoop(context.Background(), "timers")
+	require.NoError(t, err)
+	defer loop.Stop()
+
+	runOnLoop(t, loop, func(vm *goja.Runtime) error {
+		_, err := vm.RunString(`
+var timeoutArgs;
+var ticks = 0;
+var intervalDone;
+var cleared = false;
+setTimeout(function (a, b) { timeoutArgs = a + b; }, 5, 1, 2);
+var removed = setTimeout(function () { cleared = true; }, 5);
+clearTimeout(removed);
+var interval = setInterval(function () {
+  ticks++;
+  if (ticks === 3) { clearInterval(interval); intervalDone = ticks; }
+}, 1);
+`)
+		return err
+	})
+
+	require.Equal(t, int64(3), waitFor(t, loop, "timeoutArgs").Export())
+	require.Equal(t, int64(3), waitFor(t, loop, "intervalDone").Export())
+
+	time.Sleep(50 * time.Millisecond)
+	var ticks, cleared goja.Value
+	runOnLoop(t, loop, func(vm *goja.Runtime) error {
+		ticks, cleared = vm.Get("ticks"), vm.Get("cleared")
+		return nil
+	})
+	require.Equal(t, int64(3), ticks.Export())
+	require.False(t, cleared.ToBoolean())
+}
+
+func TestPromise(t *testing.T) {
+	loop, _, err := CreateRuntimeLoop(context.Background(), "promise")
+	require.NoError(t, err)
+	defer loop.Stop()
+
+	runOnLoop(t, loop, func(vm *goja.Runtime) error {
+		_, err := vm.RunString(`
+var result;
+Promise.all([
+  Promise.resolve(1),
+  new Promise(function (resolve) { setTimeout(function () { resolve(2); }, 1); }),
+  Promise.reject(new Error("boom"))["catch"](function (e) { return e.message; }),
+]).then(function (values) { result = values.join(","); });
+`)
+		return err
+	})
+
+	require.Equal(t, "1,2,boom", waitFor(t, loop, "result").Export())
+}
+
+func TestTimers_Stop(t *testing.T) {
+	loop, timers, err := CreateRuntimeLoop(context.Background(), "timers")
+	require.NoError(t, err)
+	defer loop.Stop()
+
+	runOnLoop(t, loop, func(vm *goja.Runtime) error {
+		_, err := vm.RunString(`
+var fired = false;
+setTimeout(function () { fired = true; }, 20);
+`)
+		return err
+	})
+
+	timers.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	var fired goja.Value
+	runOnLoop(t, loop, func(vm *goja.Runtime) error {
+		fired = vm.Get("fired")
+		return nil
+	})
+	require.False(t, fired.ToBoolean())
+}
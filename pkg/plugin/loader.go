@@ -8,6 +8,7 @@ package plugin
 import (
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"sort"
 	"strings"
@@ -57,11 +58,7 @@ func (c *defaultConfig) PluginDirs(home string) ([]string, error) {
 		return []string{}, nil
 	}
 
-	defaultDir := filepath.Join(home, ".config", configDir, "plugins")
-
-	if c.os == "windows" || viper.GetString("xdg-config-home") != "" {
-		defaultDir = filepath.Join(home, configDir, "plugins")
-	}
+	defaultDir := filepath.Join(configRoot(home, c.os), "plugins")
 
 	if path := viper.GetString("plugin-path"); path != "" {
 		path = strings.Trim(path, string(filepath.ListSeparator))
@@ -71,6 +68,34 @@ func (c *defaultConfig) PluginDirs(home string) ([]string, error) {
 	return []string{defaultDir}, nil
 }
 
+// StorageDir returns the directory JavaScript plugins persist their storage to. It
+// returns an empty string if the home directory is unknown.
+func StorageDir(config Config) string {
+	dir := ConfigDir(config)
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, "plugin-storage")
+}
+
+// ConfigDir returns the Octant configuration directory. It returns an empty string
+// if the home directory is unknown.
+func ConfigDir(config Config) string {
+	home := config.Home()
+	if home == "" {
+		return ""
+	}
+	return configRoot(home, config.OS())
+}
+
+// configRoot returns the Octant configuration directory within home.
+func configRoot(home, os string) string {
+	if os == "windows" || viper.GetString("xdg-config-home") != "" {
+		return filepath.Join(home, configDir)
+	}
+	return filepath.Join(home, ".config", configDir)
+}
+
 func (c *defaultConfig) Home() string {
 	if c.homeFn == nil {
 		c.homeFn = func() string {
@@ -133,7 +158,13 @@ func AvailablePlugins(config Config) ([]string, error) {
 			// Windows does not have unix style executable bits.
 			if IsJavaScriptPlugin(fi.Name()) {
 				pluginPath := filepath.Join(dir, fi.Name())
-				list = append(list, pluginPath)
+				ok, err := exportsJavaScriptPlugin(config.Fs(), pluginPath)
+				if err != nil {
+					return nil, errors.Wrap(err, "read JavaScript plugin")
+				}
+				if ok {
+					list = append(list, pluginPath)
+				}
 			} else if mode|64 == mode || config.OS() == "windows" {
 				pluginPath := filepath.Join(dir, fi.Name())
 				list = append(list, pluginPath)
@@ -145,3 +176,27 @@ func AvailablePlugins(config Config) ([]string, error) {
 
 	return list, nil
 }
+
+// jsPluginExportRe matches the ways a JavaScript file exports a plugin: a default
+// export, either as an ES module or compiled to CommonJS, or the `_octantPlugin`
+// library created by the Octant webpack configuration.
+var jsPluginExportRe = regexp.MustCompile(`\bexport\s+default\b|\bexports(?:\.default|\[\s*["']default["']\s*\])\s*=|\bexports,\s*["']default["']|\b_octantPlugin\b`)
+
+// exportsJavaScriptPlugin returns true if the JavaScript file at pluginPath exports a
+// plugin. Plugin directories can contain modules imported by plugins, which are not
+// plugins themselves.
+func exportsJavaScriptPlugin(fs afero.Fs, pluginPath string) (bool, error) {
+	fi, err := fs.Stat(pluginPath)
+	if err != nil {
+		return false, err
+	}
+	if fi.IsDir() {
+		return false, nil
+	}
+
+	data, err := afero.ReadFile(fs, pluginPath)
+	if err != nil {
+		return false, err
+	}
+	return jsPluginExportRe.Match(data), nil
+}
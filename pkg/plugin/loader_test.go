@@ -197,6 +197,46 @@ func Test_AvailablePlugins(t *testing.T) {
 	}
 }
 
+func Test_AvailablePlugins_javascript(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	homePath := filepath.Join("/home", "user")
+	viper.Set("xdg-config-home", homePath)
+	viper.Set("plugin-path", "")
+	defer viper.Set("xdg-config-home", "")
+
+	c := &defaultConfig{
+		fs: fs,
+		os: "unix",
+		homeFn: func() string {
+			return homePath
+		},
+	}
+
+	configPath := filepath.Join(homePath, configDir, "plugins")
+	require.NoError(t, fs.MkdirAll(filepath.Join(configPath, "dir.js"), 0700))
+
+	files := map[string]string{
+		"esm-plugin.js":     "import lib from './lib';\nexport default class Plugin {}\n",
+		"cjs-plugin.js":     "Object.defineProperty(exports, \"__esModule\", { value: true });\nexports.default = Plugin;\n",
+		"webpack-plugin.js": "var _octantPlugin = (function () {})();\n",
+		"lib.js":            "export function helper() {}\nmodule.exports = {};\n",
+	}
+	for name, content := range files {
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(configPath, name), []byte(content), 0755))
+	}
+
+	got, err := AvailablePlugins(c)
+	require.NoError(t, err)
+
+	expected := []string{
+		filepath.Join(configPath, "cjs-plugin.js"),
+		filepath.Join(configPath, "esm-plugin.js"),
+		filepath.Join(configPath, "webpack-plugin.js"),
+	}
+	assert.Equal(t, expected, got)
+}
+
 func Test_AvailablePlugins_no_plugin_dir(t *testing.T) {
 	fs := afero.NewMemMapFs()
 
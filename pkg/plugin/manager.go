@@ -27,9 +27,12 @@ import (
 	"github.com/fsnotify/fsnotify"
 	"github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/metrics"
 	"github.com/vmware-tanzu/octant/internal/module"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -69,6 +72,9 @@ func (f *DefaultClientFactory) Init(ctx context.Context, cmd string) Client {
 			plugin.ProtocolGRPC,
 		},
 		Logger: loggerAdapter,
+		GRPCDialOptions: []grpc.DialOption{
+			grpc.WithUnaryInterceptor(metrics.PluginClientInterceptor(filepath.Base(cmd))),
+		},
 	})
 }
 
@@ -269,6 +275,9 @@ type ManagerInterface interface {
 	// ObjectStatus returns the object status
 	ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error)
 
+	// ListColumns returns the list table columns for a list of objects of the same kind.
+	ListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error)
+
 	// SetOctantClient sets the the Octant client.
 	SetOctantClient(octantClient javascript.OctantClient)
 }
@@ -287,6 +296,10 @@ type ActionRegistrar interface {
 	Register(actionPath string, pluginPath string, actionFunc action.DispatcherFunc) error
 	// Unregister unregisters an action.
 	Unregister(actionPath string, pluginPath string)
+	// RegisterCommand registers a command palette entry.
+	RegisterCommand(command action.Command, pluginPath string) error
+	// UnregisterCommand unregisters a command palette entry.
+	UnregisterCommand(commandName string, pluginPath string)
 }
 
 // ManagerOption is an option for configuring Manager.
@@ -495,7 +508,8 @@ func (m *Manager) unregisterGoPlugin(ctx context.Context, cmd string) {
 			if err != nil {
 				logger.Errorf("failed unregister service (go): %w", err)
 			}
-			if err := m.unregisterMetadata(ctx, cmd, metadata, moduleService); err != nil {
+			// actions and commands are registered using the plugin's name
+			if err := m.unregisterMetadata(ctx, name, metadata, moduleService); err != nil {
 				logger.Errorf("failed unregister metadata (go): %w", err)
 			}
 		}
@@ -533,13 +547,48 @@ func (m *Manager) unregisterMetadata(ctx context.Context, path string, metadata
 		actionPath := actionName
 		m.ActionRegistrar.Unregister(actionPath, path)
 	}
+
+	for _, command := range metadata.Capabilities.Commands {
+		m.ActionRegistrar.UnregisterCommand(command.Name, path)
+		if !metadata.Capabilities.HasAction(command.Name) {
+			m.ActionRegistrar.Unregister(command.Name, path)
+		}
+	}
+	return nil
+}
+
+// registerCommands adds a plugin's commands to the command palette. Commands with a name
+// that is not also in the plugin's action names are registered as actions.
+func (m *Manager) registerCommands(ctx context.Context, pluginPath string, capabilities Capabilities, handleAction func(context.Context, string, action.Payload) error) error {
+	logger := log.From(ctx).With("plugin-name", pluginPath)
+
+	for _, command := range capabilities.Commands {
+		actionPath := command.Name
+		logger.With("command", actionPath).Infof("registering plugin command")
+
+		if !capabilities.HasAction(actionPath) {
+			err := m.ActionRegistrar.Register(actionPath, pluginPath, func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+				return handleAction(ctx, actionPath, payload)
+			})
+			if err != nil {
+				return fmt.Errorf("configuring plugin command action: %w", err)
+			}
+		}
+
+		if err := m.ActionRegistrar.RegisterCommand(command, pluginPath); err != nil {
+			return fmt.Errorf("configuring plugin command: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string) error {
 	dashboardClientFactory := javascript.NewModularDashboardClientFactory(javascript.DefaultFunctions(m.octantClient, m.WSClient))
 
-	jsPlugin, err := NewJSPlugin(ctx, pluginPath, dashboardClientFactory)
+	jsPlugin, err := NewJSPlugin(ctx, pluginPath, dashboardClientFactory,
+		WithAllowList(viper.GetStringSlice("plugin-fetch-allow-list")),
+		WithStorageDir(StorageDir(DefaultConfig)))
 	if err != nil {
 		return err
 	}
@@ -567,6 +616,10 @@ func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string) error
 		}
 	}
 
+	if err := m.registerCommands(ctx, pluginPath, metadata.Capabilities, jsPlugin.HandleAction); err != nil {
+		return err
+	}
+
 	if metadata.Capabilities.IsModule {
 		pluginLogger.Infof("plugin supports navigation")
 
@@ -730,6 +783,10 @@ func (m *Manager) start(ctx context.Context, c PluginConfig) error {
 		}
 	}
 
+	if err := m.registerCommands(ctx, c.Name, metadata.Capabilities, service.HandleAction); err != nil {
+		return err
+	}
+
 	pluginLogger.With(
 		"cmd", c.Cmd,
 		"metadata", metadata,
@@ -846,6 +903,78 @@ func (m *Manager) Tabs(ctx context.Context, object runtime.Object) ([]component.
 	return tabs, nil
 }
 
+// ListColumns returns the list table columns plugins add for each of objects with a
+// single request to every plugin. Columns are ordered by plugin name. If plugins add
+// columns with the same name, the column belongs to the plugin named first.
+func (m *Manager) ListColumns(ctx context.Context, objects []runtime.Object) ([]ListColumnsResponse, error) {
+	if m.Runners == nil {
+		return nil, errors.New("runners is nil")
+	}
+
+	runner, ch := m.Runners.ListColumns(m.store)
+	done := make(chan bool)
+
+	var responses []PluginListColumnsResponse
+
+	go func() {
+		for resp := range ch {
+			responses = append(responses, resp)
+		}
+
+		done <- true
+	}()
+
+	if err := runner.Run(ctx, objects, m.store.ClientNames()); err != nil {
+		return nil, err
+	}
+	close(ch)
+
+	<-done
+
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Name < responses[j].Name
+	})
+
+	owners := map[string]string{}
+	for _, resp := range responses {
+		for _, objectResp := range resp.Responses {
+			for _, column := range objectResp.Columns {
+				if _, ok := owners[column]; !ok {
+					owners[column] = resp.Name
+				}
+			}
+		}
+	}
+
+	list := make([]ListColumnsResponse, len(objects))
+	for i := range list {
+		lcr := ListColumnsResponse{
+			Cells: map[string]component.Component{},
+		}
+		seen := map[string]bool{}
+		for _, resp := range responses {
+			if i >= len(resp.Responses) {
+				continue
+			}
+			objectResp := resp.Responses[i]
+			for _, column := range objectResp.Columns {
+				if owners[column] == resp.Name && !seen[column] {
+					seen[column] = true
+					lcr.Columns = append(lcr.Columns, column)
+				}
+			}
+			for column, cell := range objectResp.Cells {
+				if owners[column] == resp.Name {
+					lcr.Cells[column] = cell
+				}
+			}
+		}
+		list[i] = lcr
+	}
+
+	return list, nil
+}
+
 // ObjectStatus updates the object status of an object configured from a plugin
 func (m *Manager) ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error) {
 	if m.Runners == nil {
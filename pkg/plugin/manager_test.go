@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -204,6 +205,77 @@ func TestManager_Tabs(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestManager_ListColumns(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod1 := testutil.CreatePod("pod1")
+	pod2 := testutil.CreatePod("pod2")
+
+	var options []dashPlugin.ManagerOption
+
+	store := fake.NewMockManagerStore(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+	wsClient := fake2.NewMockWSClientGetter(controller)
+
+	store.EXPECT().ClientNames().Return([]string{"plugin2", "plugin1"})
+
+	ch := make(chan dashPlugin.PluginListColumnsResponse)
+	listColumnsRunner := dashPlugin.ListRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, objects []runtime.Object) error {
+			var responses []dashPlugin.ListColumnsResponse
+			for _, object := range objects {
+				responses = append(responses, dashPlugin.ListColumnsResponse{
+					Columns: []string{"Team", name},
+					Cells: map[string]component.Component{
+						"Team": component.NewText(name + "-" + object.(*corev1.Pod).Name),
+						name:   component.NewText(name),
+					},
+				})
+			}
+			ch <- dashPlugin.PluginListColumnsResponse{Name: name, Responses: responses}
+			return nil
+		},
+	}
+
+	runners := fake.NewMockRunners(controller)
+	runners.EXPECT().
+		ListColumns(gomock.Eq(store)).Return(listColumnsRunner, ch)
+
+	options = append(options, func(m *dashPlugin.Manager) {
+		m.Runners = runners
+	})
+
+	apiService := &stubAPIService{}
+	manager := dashPlugin.NewManager(apiService, moduleRegistrar, actionRegistrar, wsClient, options...)
+	manager.SetStore(store)
+
+	ctx := context.Background()
+	got, err := manager.ListColumns(ctx, []runtime.Object{pod1, pod2})
+	require.NoError(t, err)
+
+	expected := []dashPlugin.ListColumnsResponse{
+		{
+			Columns: []string{"Team", "plugin1", "plugin2"},
+			Cells: map[string]component.Component{
+				"Team":    component.NewText("plugin1-pod1"),
+				"plugin1": component.NewText("plugin1"),
+				"plugin2": component.NewText("plugin2"),
+			},
+		},
+		{
+			Columns: []string{"Team", "plugin1", "plugin2"},
+			Cells: map[string]component.Component{
+				"Team":    component.NewText("plugin1-pod2"),
+				"plugin1": component.NewText("plugin1"),
+				"plugin2": component.NewText("plugin2"),
+			},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
 type fakePluginClient struct {
 	clientProtocol *fake.MockClientProtocol
 	service        *fake.MockModuleService
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"sync"
+)
+
+// requestContext is the context a JavaScript plugin's dashboard client is created with. Its
+// values are looked up in the context of the request the plugin is handling first, so
+// objects a plugin reads while it handles a request for a signed-in user are read with the
+// user's cluster client.
+type requestContext struct {
+	context.Context
+
+	mu      sync.RWMutex
+	request context.Context
+}
+
+func newRequestContext(ctx context.Context) *requestContext {
+	return &requestContext{Context: ctx}
+}
+
+// Value returns the value for key in the current request's context, or in the plugin's
+// context if the request's context doesn't have it.
+func (c *requestContext) Value(key interface{}) interface{} {
+	c.mu.RLock()
+	request := c.request
+	c.mu.RUnlock()
+
+	if request != nil {
+		if v := request.Value(key); v != nil {
+			return v
+		}
+	}
+
+	return c.Context.Value(key)
+}
+
+// use makes ctx the current request's context until the returned function is called.
+func (c *requestContext) use(ctx context.Context) func() {
+	c.mu.Lock()
+	c.request = ctx
+	c.mu.Unlock()
+
+	return func() {
+		c.mu.Lock()
+		c.request = nil
+		c.mu.Unlock()
+	}
+}
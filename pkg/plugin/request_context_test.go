@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testContextKey string
+
+func Test_requestContext(t *testing.T) {
+	pluginCtx := context.WithValue(context.Background(), testContextKey("plugin"), "plugin")
+	ctx := newRequestContext(pluginCtx)
+
+	assert.Equal(t, "plugin", ctx.Value(testContextKey("plugin")))
+	assert.Nil(t, ctx.Value(testContextKey("request")))
+
+	requestCtx := context.WithValue(context.Background(), testContextKey("request"), "request")
+	reset := ctx.use(requestCtx)
+	assert.Equal(t, "request", ctx.Value(testContextKey("request")))
+	assert.Equal(t, "plugin", ctx.Value(testContextKey("plugin")))
+
+	reset()
+	assert.Nil(t, ctx.Value(testContextKey("request")))
+}
@@ -28,6 +28,9 @@ type Runners interface {
 	// ObjectStatus returns a runner for object status. The caller should
 	// close the channel when they are done with it.
 	ObjectStatus(ManagerStore) (DefaultRunner, chan ObjectStatusResponse)
+	// ListColumns returns a runner for list columns. The caller should
+	// close the channel when they are done with it.
+	ListColumns(ManagerStore) (ListRunner, chan PluginListColumnsResponse)
 }
 
 type defaultRunners struct{}
@@ -53,6 +56,11 @@ func (dr *defaultRunners) ObjectStatus(store ManagerStore) (DefaultRunner, chan
 	return ObjectStatusRunner(store, ch), ch
 }
 
+func (dr *defaultRunners) ListColumns(store ManagerStore) (ListRunner, chan PluginListColumnsResponse) {
+	ch := make(chan PluginListColumnsResponse)
+	return ListColumnsRunner(store, ch), ch
+}
+
 // DefaultRunner runs a function against all plugins
 type DefaultRunner struct {
 	RunFunc func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error
@@ -100,6 +108,63 @@ func (pr *DefaultRunner) validate(object runtime.Object) error {
 	return nil
 }
 
+// ListRunner runs a function against all plugins with a list of objects of the same kind.
+type ListRunner struct {
+	RunFunc func(ctx context.Context, name string, gvk schema.GroupVersionKind, objects []runtime.Object) error
+}
+
+// Run runs the runner for objects with the provided clients.
+func (lr *ListRunner) Run(ctx context.Context, objects []runtime.Object, clientNames []string) error {
+	if err := lr.validate(objects); err != nil {
+		return fmt.Errorf("plugin list runner validate: %w", err)
+	}
+
+	var g errgroup.Group
+
+	gvk := objects[0].GetObjectKind().GroupVersionKind()
+
+	for _, name := range clientNames {
+		fn := func(name string) func() error {
+			return func() error {
+				if err := lr.RunFunc(ctx, name, gvk, objects); err != nil {
+					return fmt.Errorf("running on %s: %w", name, err)
+				}
+
+				return nil
+			}
+		}
+		g.Go(fn(name))
+	}
+
+	if err := g.Wait(); err != nil {
+		return fmt.Errorf("handle objects: %w", err)
+	}
+
+	return nil
+}
+
+func (lr *ListRunner) validate(objects []runtime.Object) error {
+	if len(objects) == 0 {
+		return fmt.Errorf("objects are empty")
+	}
+
+	gvk := objects[0].GetObjectKind().GroupVersionKind()
+	for _, object := range objects {
+		if object == nil {
+			return fmt.Errorf("object is nil")
+		}
+		if object.GetObjectKind().GroupVersionKind() != gvk {
+			return fmt.Errorf("objects are not of the same kind")
+		}
+	}
+
+	if lr.RunFunc == nil {
+		return fmt.Errorf("requires a runFunc")
+	}
+
+	return nil
+}
+
 // PrintRunner is a runner for printing.
 func PrintRunner(store ManagerStore, ch chan<- PrintResponse) DefaultRunner {
 	return DefaultRunner{
@@ -271,3 +336,60 @@ func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) Defa
 		},
 	}
 }
+
+// PluginListColumnsResponse are the list columns responses for a list of objects with
+// the name of the plugin that generated them.
+type PluginListColumnsResponse struct {
+	// Responses are the list columns responses in the order of the objects.
+	Responses []ListColumnsResponse
+	// Name is the name of the plugin.
+	Name string
+}
+
+// ListColumnsRunner is a runner for list columns.
+func ListColumnsRunner(store ManagerStore, ch chan<- PluginListColumnsResponse) ListRunner {
+	return ListRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, objects []runtime.Object) error {
+			if IsJavaScriptPlugin(name) {
+				jsPlugin, ok := store.GetJS(name)
+				if !ok {
+					return fmt.Errorf("plugin %s not found", name)
+				}
+
+				if !jsPlugin.Metadata().Capabilities.HasListColumnsSupport(gvk) {
+					return nil
+				}
+
+				resp, err := jsPlugin.ListColumns(ctx, objects)
+				if err != nil {
+					return fmt.Errorf("printing list columns for plugin: %q: %w", name, err)
+				}
+
+				ch <- PluginListColumnsResponse{Responses: resp, Name: name}
+				return nil
+			}
+
+			metadata, err := store.GetMetadata(name)
+			if err != nil {
+				return err
+			}
+
+			if !metadata.Capabilities.HasListColumnsSupport(gvk) {
+				return nil
+			}
+
+			service, err := store.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			resp, err := service.ListColumns(ctx, objects)
+			if err != nil {
+				return fmt.Errorf("print list columns with plugin %q: %w", name, err)
+			}
+
+			ch <- PluginListColumnsResponse{Responses: resp, Name: name}
+			return nil
+		},
+	}
+}
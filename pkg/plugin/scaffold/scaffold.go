@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package scaffold generates the source for new Octant plugins.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates
+var templateFiles embed.FS
+
+// Language is the language of a plugin.
+type Language string
+
+const (
+	// Go generates a plugin built as a Go binary.
+	Go Language = "go"
+	// TypeScript generates a plugin compiled to JavaScript.
+	TypeScript Language = "typescript"
+)
+
+// Languages returns the supported plugin languages.
+func Languages() []Language {
+	return []Language{Go, TypeScript}
+}
+
+// ParseLanguage converts s to a Language. "ts" is accepted as an alias for TypeScript.
+func ParseLanguage(s string) (Language, error) {
+	switch strings.ToLower(s) {
+	case "go", "golang":
+		return Go, nil
+	case "ts", "typescript":
+		return TypeScript, nil
+	default:
+		return "", fmt.Errorf("unsupported plugin language %q", s)
+	}
+}
+
+var nameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Options are options for generating a plugin.
+type Options struct {
+	// Name is the name of the plugin. It is used as the module and binary name.
+	Name string
+	// Description is the description of the plugin.
+	Description string
+	// Language is the language of the plugin.
+	Language Language
+	// Dir is the directory the plugin is written to. It must not exist or be empty.
+	Dir string
+}
+
+type templateData struct {
+	Name        string
+	Description string
+	Title       string
+}
+
+// Generate writes a new plugin to options.Dir. It returns the paths of the files
+// that were created.
+func Generate(options Options) ([]string, error) {
+	if !nameRe.MatchString(options.Name) {
+		return nil, fmt.Errorf("plugin name %q must only contain lower case letters, numbers and '-'", options.Name)
+	}
+
+	root := path.Join("templates", string(options.Language))
+	if _, err := fs.Stat(templateFiles, root); err != nil {
+		return nil, fmt.Errorf("unsupported plugin language %q", options.Language)
+	}
+
+	if err := checkDir(options.Dir); err != nil {
+		return nil, err
+	}
+
+	data := templateData{
+		Name:        options.Name,
+		Description: options.Description,
+		Title:       title(options.Name),
+	}
+	if data.Description == "" {
+		data.Description = fmt.Sprintf("%s is an Octant plugin", options.Name)
+	}
+
+	var created []string
+	err := fs.WalkDir(templateFiles, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel := strings.TrimSuffix(strings.TrimPrefix(p, root+"/"), ".tmpl")
+		rel, err = render(rel, data)
+		if err != nil {
+			return err
+		}
+		// Files starting with a dot are not embedded, so they are stored without it.
+		if rel == "gitignore" {
+			rel = ".gitignore"
+		}
+
+		src, err := templateFiles.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		contents, err := render(string(src), data)
+		if err != nil {
+			return fmt.Errorf("rendering %s: %w", p, err)
+		}
+
+		dest := filepath.Join(options.Dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(dest, []byte(contents), 0644); err != nil {
+			return err
+		}
+		created = append(created, dest)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generating plugin: %w", err)
+	}
+
+	return created, nil
+}
+
+func checkDir(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("directory %s is not empty", dir)
+	}
+	return nil
+}
+
+func render(text string, data templateData) (string, error) {
+	tmpl, err := template.New("scaffold").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// title converts a plugin name like "my-plugin" to "My Plugin".
+func title(name string) string {
+	words := strings.Split(name, "-")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package scaffold
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/pkg/plugin/harness"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		language Language
+		expected []string
+	}{
+		{
+			language: Go,
+			expected: []string{"README.md", "go.mod", "main.go", "main_test.go"},
+		},
+		{
+			language: TypeScript,
+			expected: []string{".gitignore", "README.md", "package.json", "src/my-plugin.ts", "src/octant.d.ts", "tsconfig.json"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.language), func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "scaffold")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			files, err := Generate(Options{
+				Name:        "my-plugin",
+				Description: `a "quoted" description`,
+				Language:    test.language,
+				Dir:         dir,
+			})
+			require.NoError(t, err)
+
+			var got []string
+			for _, file := range files {
+				rel, err := filepath.Rel(dir, file)
+				require.NoError(t, err)
+				got = append(got, filepath.ToSlash(rel))
+			}
+			require.ElementsMatch(t, test.expected, got)
+
+			readme, err := ioutil.ReadFile(filepath.Join(dir, "README.md"))
+			require.NoError(t, err)
+			require.Contains(t, string(readme), "# my-plugin")
+
+			_, err = Generate(Options{Name: "my-plugin", Language: test.language, Dir: dir})
+			require.Error(t, err, "generating into a directory that is not empty")
+		})
+	}
+}
+
+func TestGenerate_typescript_build(t *testing.T) {
+	tsc, err := exec.LookPath("tsc")
+	if err != nil {
+		t.Skip("tsc is not installed")
+	}
+
+	dir, err := ioutil.TempDir("", "scaffold")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = Generate(Options{Name: "my-plugin", Language: TypeScript, Dir: dir})
+	require.NoError(t, err)
+
+	out, err := exec.Command(tsc, "-p", dir).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	// The plugin compiles to a single file which doesn't require other modules.
+	entries, err := ioutil.ReadDir(filepath.Join(dir, "dist"))
+	require.NoError(t, err)
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Name())
+	}
+	require.ElementsMatch(t, []string{"my-plugin.js", "my-plugin.js.map"}, got)
+
+	src, err := ioutil.ReadFile(filepath.Join(dir, "dist", "my-plugin.js"))
+	require.NoError(t, err)
+	require.NotContains(t, string(src), "require(")
+
+	// Load the plugin on its own, like it is loaded after being copied to the plugin directory.
+	pluginPath := filepath.Join(dir, "plugins", "my-plugin.js")
+	require.NoError(t, os.MkdirAll(filepath.Dir(pluginPath), 0755))
+	require.NoError(t, ioutil.WriteFile(pluginPath, src, 0644))
+
+	ctx := context.Background()
+	h, err := harness.Load(ctx, pluginPath)
+	require.NoError(t, err)
+	defer h.Close()
+
+	require.Equal(t, "my-plugin", h.Metadata().Name)
+	require.NoError(t, h.Validate(ctx))
+
+	nav, err := h.Navigation(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "my-plugin", nav.Path)
+}
+
+func TestGenerate_invalid(t *testing.T) {
+	_, err := Generate(Options{Name: "My Plugin", Language: Go, Dir: "unused"})
+	require.Error(t, err)
+
+	_, err = Generate(Options{Name: "plugin", Language: "rust", Dir: "unused"})
+	require.Error(t, err)
+}
+
+func TestParseLanguage(t *testing.T) {
+	for _, s := range []string{"ts", "TypeScript"} {
+		got, err := ParseLanguage(s)
+		require.NoError(t, err)
+		require.Equal(t, TypeScript, got)
+	}
+
+	got, err := ParseLanguage("go")
+	require.NoError(t, err)
+	require.Equal(t, Go, got)
+
+	_, err = ParseLanguage("rust")
+	require.Error(t, err)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/viper"
+
+	"github.com/vmware-tanzu/octant/internal/jsonschema"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	componentSchemaOnce sync.Once
+	componentSchema     *jsonschema.Schema
+	componentSchemaErr  error
+)
+
+func loadComponentSchema() (*jsonschema.Schema, error) {
+	componentSchemaOnce.Do(func() {
+		componentSchema, componentSchemaErr = jsonschema.Parse(component.Schema())
+	})
+	return componentSchema, componentSchemaErr
+}
+
+// validateOutput validates plugin output against a definition of the component schema.
+// In dev mode, output which doesn't match the schema is an error. Otherwise it is logged
+// as a warning and used anyway, since the frontend may still be able to show it.
+func validateOutput(ctx context.Context, source, definition string, data []byte) error {
+	schema, err := loadComponentSchema()
+	if err != nil {
+		return err
+	}
+
+	err = schema.Validate(definition, data)
+	if err == nil {
+		return nil
+	}
+
+	if viper.GetBool("dev-mode") {
+		return fmt.Errorf("%s doesn't match the component schema: %w", source, err)
+	}
+
+	log.From(ctx).WithErr(err).With("source", source).Warnf("plugin output doesn't match the component schema")
+	return nil
+}
+
+// validateOutputValue validates plugin output which was decoded from JSON, e.g. by the
+// JavaScript runtime.
+func validateOutputValue(ctx context.Context, source, definition string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", source, err)
+	}
+
+	return validateOutput(ctx, source, definition, data)
+}
+
+// validatePrintResponse validates the summary sections and items of a print response
+// from a Go plugin.
+func validatePrintResponse(ctx context.Context, resp *dashboard.PrintResponse) error {
+	for _, group := range []struct {
+		name     string
+		sections []*dashboard.PrintResponse_SummaryItem
+	}{
+		{name: "config", sections: resp.Config},
+		{name: "status", sections: resp.Status},
+	} {
+		for _, section := range group.sections {
+			source := fmt.Sprintf("print response %s section %q", group.name, section.Header)
+			if err := validateOutput(ctx, source, "component", section.Component); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resp.Items) > 0 {
+		var items []interface{}
+		if err := json.Unmarshal(resp.Items, &items); err != nil {
+			return fmt.Errorf("decode print response items: %w", err)
+		}
+		for i, item := range items {
+			source := fmt.Sprintf("print response item %d", i)
+			if err := validateOutputValue(ctx, source, "flexLayoutItem", item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
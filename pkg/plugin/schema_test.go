@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_validateOutput(t *testing.T) {
+	valid, err := json.Marshal(component.NewText("text"))
+	require.NoError(t, err)
+	invalid := []byte(`{"metadata":{"type":"text"},"config":{"value":1}}`)
+
+	tests := []struct {
+		name    string
+		devMode bool
+		data    []byte
+		wantErr bool
+	}{
+		{name: "valid", data: valid},
+		{name: "valid in dev mode", devMode: true, data: valid},
+		{name: "invalid", data: invalid},
+		{name: "invalid in dev mode", devMode: true, data: invalid, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			viper.Set("dev-mode", test.devMode)
+			defer viper.Set("dev-mode", false)
+
+			err := validateOutput(context.Background(), "test", "component", test.data)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validatePrintResponse(t *testing.T) {
+	viper.Set("dev-mode", true)
+	defer viper.Set("dev-mode", false)
+
+	text, err := json.Marshal(component.NewText("text"))
+	require.NoError(t, err)
+
+	items, err := json.Marshal([]component.FlexLayoutItem{
+		{Width: component.WidthFull, View: component.NewText("item")},
+	})
+	require.NoError(t, err)
+
+	resp := &dashboard.PrintResponse{
+		Config: []*dashboard.PrintResponse_SummaryItem{{Header: "config", Component: text}},
+		Items:  items,
+	}
+	require.NoError(t, validatePrintResponse(context.Background(), resp))
+
+	resp.Status = []*dashboard.PrintResponse_SummaryItem{{Header: "status", Component: []byte(`{"config":{}}`)}}
+	require.Error(t, validatePrintResponse(context.Background(), resp))
+
+	resp.Status = nil
+	resp.Items = []byte(`[{"width":24,"view":{"config":{}}}]`)
+	require.Error(t, validatePrintResponse(context.Background(), resp))
+}
@@ -114,6 +114,32 @@ func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plug
 	return p.HandlerFuncs.ObjectStatus(request)
 }
 
+// ListColumns creates list table columns for objects. The list columns handler is
+// called once for each object.
+func (p *Handler) ListColumns(ctx context.Context, objects []runtime.Object) ([]plugin.ListColumnsResponse, error) {
+	responses := make([]plugin.ListColumnsResponse, len(objects))
+	if p.HandlerFuncs.ListColumns == nil {
+		return responses, nil
+	}
+
+	for i, object := range objects {
+		request := &PrintRequest{
+			baseRequest:     newBaseRequest(ctx, p.name),
+			DashboardClient: p.dashboardClient,
+			Object:          object,
+			ClientState:     plugin.ClientStateFrom(ctx),
+		}
+
+		response, err := p.HandlerFuncs.ListColumns(request)
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = response
+	}
+
+	return responses, nil
+}
+
 // HandleAction handles actions given a payload.
 func (p *Handler) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
 	if p.HandlerFuncs.HandleAction == nil {
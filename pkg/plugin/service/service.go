@@ -46,6 +46,13 @@ func WithObjectStatus(fn HandlerObjectStatusFunc) PluginOption {
 	}
 }
 
+// WithListColumns configures the plugin to add columns to list tables.
+func WithListColumns(fn HandlerListColumnsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.HandlerFuncs.ListColumns = fn
+	}
+}
+
 // WithActionHandler configures the plugin to handle actions.
 func WithActionHandler(fn HandlerActionFunc) PluginOption {
 	return func(p *Plugin) {
@@ -179,6 +186,7 @@ type NavigationRequest struct {
 type HandlerPrinterFunc func(request *PrintRequest) (plugin.PrintResponse, error)
 type HandlerTabPrintFunc func(request *PrintRequest) (plugin.TabResponse, error)
 type HandlerObjectStatusFunc func(request *PrintRequest) (plugin.ObjectStatusResponse, error)
+type HandlerListColumnsFunc func(request *PrintRequest) (plugin.ListColumnsResponse, error)
 type HandlerActionFunc func(request *ActionRequest) error
 type HandlerNavigationFunc func(request *NavigationRequest) (navigation.Navigation, error)
 type HandlerInitRoutesFunc func(router *Router)
@@ -188,6 +196,7 @@ type HandlerFuncs struct {
 	Print        HandlerPrinterFunc
 	PrintTabs    []HandlerTabPrintFunc
 	ObjectStatus HandlerObjectStatusFunc
+	ListColumns  HandlerListColumnsFunc
 	HandleAction HandlerActionFunc
 	Navigation   HandlerNavigationFunc
 	InitRoutes   HandlerInitRoutesFunc
@@ -22,6 +22,8 @@ const (
 	TypeCode = "codeBlock"
 	// TypeContainers is a container component.
 	TypeContainers = "containers"
+	// TypeDiff is a diff component.
+	TypeDiff = "diff"
 	// TypeDonutChart is a donut chart component.
 	TypeDonutChart = "donutChart"
 	// TypeDropdown is a dropdown component.
@@ -44,6 +46,8 @@ const (
 	TypeGraphviz = "graphviz"
 	// TypeGridActions is a grid actions component.
 	TypeGridActions = "gridActions"
+	// TypeHeatmap is a heatmap component.
+	TypeHeatmap = "heatmap"
 	// TypeIcon is a Icon component.
 	TypeIcon = "icon"
 	// TypeIFrame is an iframe component.
@@ -62,6 +66,8 @@ const (
 	TypeLoading = "loading"
 	// TypeLogs is a logs component.
 	TypeLogs = "logs"
+	// TypeMarkdown is a Markdown component.
+	TypeMarkdown = "markdown"
 	// TypeModal is a modal component.
 	TypeModal = "modal"
 	// TypePodStatus is a pod status component.
@@ -96,6 +102,8 @@ const (
 	TypeText = "text"
 	// TypeTimeline is a timeline component.
 	TypeTimeline = "timeline"
+	// TypeTimeSeriesChart is a time series chart component.
+	TypeTimeSeriesChart = "timeSeriesChart"
 	// TypeTimestamp is a timestamp component.
 	TypeTimestamp = "timestamp"
 	// TypeYAML is a YAML component.
@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// DefaultDiffContext is the number of unchanged lines shown around changes. Longer runs
+// of unchanged lines are collapsed.
+const DefaultDiffContext = 3
+
+// DiffMode is how a diff is rendered.
+type DiffMode string
+
+const (
+	// DiffModeUnified renders changes in a single column.
+	DiffModeUnified DiffMode = "unified"
+	// DiffModeSplit renders the documents side by side.
+	DiffModeSplit DiffMode = "split"
+)
+
+// DiffLineType is the type of line in a diff.
+type DiffLineType string
+
+const (
+	// DiffLineEqual is a line found in both documents.
+	DiffLineEqual DiffLineType = "equal"
+	// DiffLineAdded is a line only found in the right document.
+	DiffLineAdded DiffLineType = "added"
+	// DiffLineRemoved is a line only found in the left document.
+	DiffLineRemoved DiffLineType = "removed"
+	// DiffLineCollapsed is a run of unchanged lines which are hidden until expanded.
+	DiffLineCollapsed DiffLineType = "collapsed"
+)
+
+// Diff is a line diff of two documents.
+// +octant:component
+type Diff struct {
+	Base
+	Config DiffConfig `json:"config"`
+}
+
+// DiffConfig is the contents of Diff.
+type DiffConfig struct {
+	LeftTitle  string     `json:"leftTitle"`
+	RightTitle string     `json:"rightTitle"`
+	Mode       DiffMode   `json:"mode"`
+	Lines      []DiffLine `json:"lines"`
+	Additions  int        `json:"additions"`
+	Deletions  int        `json:"deletions"`
+}
+
+// DiffLine is a line of a diff. Line numbers start at 1 and are zero if the line is not
+// in a document.
+type DiffLine struct {
+	Type  DiffLineType `json:"type"`
+	Left  int          `json:"left,omitempty"`
+	Right int          `json:"right,omitempty"`
+	Text  string       `json:"text,omitempty"`
+	// Lines are the hidden lines of a collapsed line.
+	Lines []DiffLine `json:"lines,omitempty"`
+}
+
+var _ Component = (*Diff)(nil)
+
+// NewDiff creates a diff of the lines of left and right.
+func NewDiff(leftTitle, left, rightTitle, right string) *Diff {
+	d := &Diff{
+		Base: newBase(TypeDiff, nil),
+		Config: DiffConfig{
+			LeftTitle:  leftTitle,
+			RightTitle: rightTitle,
+			Mode:       DiffModeSplit,
+		},
+	}
+
+	d.Config.Lines, d.Config.Additions, d.Config.Deletions = diffLines(splitLines(left), splitLines(right), DefaultDiffContext)
+	return d
+}
+
+// SetMode sets how the diff is rendered.
+func (d *Diff) SetMode(mode DiffMode) {
+	d.Config.Mode = mode
+}
+
+// IsEqual returns true if the documents are the same.
+func (d *Diff) IsEqual() bool {
+	return d.Config.Additions == 0 && d.Config.Deletions == 0
+}
+
+type diffMarshal Diff
+
+// MarshalJSON implements json.Marshaler.
+func (d *Diff) MarshalJSON() ([]byte, error) {
+	m := diffMarshal(*d)
+	m.Metadata.Type = TypeDiff
+	return json.Marshal(&m)
+}
+
+// diffLines compares lines of a and b. Unchanged lines further than context lines away
+// from a change are collapsed.
+func diffLines(a, b []string, context int) ([]DiffLine, int, int) {
+	matcher := difflib.NewMatcherWithJunk(a, b, false, nil)
+
+	var lines []DiffLine
+	additions, deletions := 0, 0
+
+	opCodes := matcher.GetOpCodes()
+	for i, op := range opCodes {
+		switch op.Tag {
+		case 'e':
+			var equal []DiffLine
+			for k := 0; k < op.I2-op.I1; k++ {
+				equal = append(equal, DiffLine{
+					Type:  DiffLineEqual,
+					Left:  op.I1 + k + 1,
+					Right: op.J1 + k + 1,
+					Text:  a[op.I1+k],
+				})
+			}
+			lines = append(lines, collapse(equal, context, i > 0, i < len(opCodes)-1)...)
+		case 'r', 'd', 'i':
+			for k := op.I1; k < op.I2; k++ {
+				lines = append(lines, DiffLine{Type: DiffLineRemoved, Left: k + 1, Text: a[k]})
+				deletions++
+			}
+			for k := op.J1; k < op.J2; k++ {
+				lines = append(lines, DiffLine{Type: DiffLineAdded, Right: k + 1, Text: b[k]})
+				additions++
+			}
+		}
+	}
+
+	return lines, additions, deletions
+}
+
+// collapse keeps context lines of a run of equal lines next to changes before and after
+// it, and collapses the rest.
+func collapse(equal []DiffLine, context int, changeBefore, changeAfter bool) []DiffLine {
+	head, tail := 0, 0
+	if changeBefore {
+		head = context
+	}
+	if changeAfter {
+		tail = context
+	}
+
+	if head+tail >= len(equal) {
+		return equal
+	}
+
+	list := append([]DiffLine{}, equal[:head]...)
+	list = append(list, DiffLine{Type: DiffLineCollapsed, Lines: equal[head : len(equal)-tail]})
+	return append(list, equal[len(equal)-tail:]...)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
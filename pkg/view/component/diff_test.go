@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+func Test_Diff_Marshal(t *testing.T) {
+	diff := NewDiff("staging", "kind: Deployment\nreplicas: 1\n", "production", "kind: Deployment\nreplicas: 3\n")
+
+	actual, err := json.Marshal(diff)
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(path.Join("testdata", "diff.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+func TestNewDiff(t *testing.T) {
+	var left []string
+	for i := 1; i <= 20; i++ {
+		left = append(left, fmt.Sprintf("line %d", i))
+	}
+	right := append([]string{}, left...)
+	right[9] = "changed"
+
+	diff := NewDiff("left", strings.Join(left, "\n"), "right", strings.Join(right, "\n"))
+
+	equal := func(n int) DiffLine {
+		return DiffLine{Type: DiffLineEqual, Left: n, Right: n, Text: fmt.Sprintf("line %d", n)}
+	}
+	equalRange := func(from, to int) []DiffLine {
+		var lines []DiffLine
+		for n := from; n <= to; n++ {
+			lines = append(lines, equal(n))
+		}
+		return lines
+	}
+
+	expected := []DiffLine{{Type: DiffLineCollapsed, Lines: equalRange(1, 6)}}
+	expected = append(expected, equalRange(7, 9)...)
+	expected = append(expected,
+		DiffLine{Type: DiffLineRemoved, Left: 10, Text: "line 10"},
+		DiffLine{Type: DiffLineAdded, Right: 10, Text: "changed"},
+	)
+	expected = append(expected, equalRange(11, 13)...)
+	expected = append(expected, DiffLine{Type: DiffLineCollapsed, Lines: equalRange(14, 20)})
+
+	assert.Equal(t, expected, diff.Config.Lines)
+	assert.Equal(t, 1, diff.Config.Additions)
+	assert.Equal(t, 1, diff.Config.Deletions)
+	assert.False(t, diff.IsEqual())
+}
+
+func TestNewDiff_equal(t *testing.T) {
+	diff := NewDiff("left", "a\nb\n", "right", "a\nb\n")
+
+	assert.True(t, diff.IsEqual())
+	assert.Equal(t, []DiffLine{{Type: DiffLineCollapsed, Lines: []DiffLine{
+		{Type: DiffLineEqual, Left: 1, Right: 1, Text: "a"},
+		{Type: DiffLineEqual, Left: 2, Right: 2, Text: "b"},
+	}}}, diff.Config.Lines)
+}
@@ -32,6 +32,9 @@ type EditorConfig struct {
 	Metadata     map[string]string `json:"metadata"`
 	SubmitAction string            `json:"submitAction,omitempty"`
 	SubmitLabel  string            `json:"submitLabel,omitempty"`
+	// FieldDocs are markdown docs shown when hovering over fields. They are keyed by
+	// the dotted path of the field, without list indexes.
+	FieldDocs map[string]string `json:"fieldDocs,omitempty"`
 }
 
 // NewEditor creates an instance of an editor component.
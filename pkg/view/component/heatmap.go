@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "github.com/vmware-tanzu/octant/internal/util/json"
+
+// Heatmap is a grid of cells colored by value.
+// +octant:component
+type Heatmap struct {
+	Base
+	Config HeatmapConfig `json:"config"`
+}
+
+// HeatmapConfig is the contents of Heatmap.
+type HeatmapConfig struct {
+	Cells []HeatmapCell `json:"cells"`
+}
+
+// HeatmapCell is a cell in a heatmap.
+type HeatmapCell struct {
+	Label string `json:"label"`
+	// Value is between 0 and 1. It sets the color of the cell.
+	Value float64 `json:"value"`
+	// Description is shown when hovering the cell.
+	Description string `json:"description,omitempty"`
+	// Ref is an optional link for the cell.
+	Ref string `json:"ref,omitempty"`
+}
+
+var _ Component = (*Heatmap)(nil)
+
+// NewHeatmap creates a heatmap.
+func NewHeatmap(title string, cells ...HeatmapCell) *Heatmap {
+	return &Heatmap{
+		Base: newBase(TypeHeatmap, TitleFromString(title)),
+		Config: HeatmapConfig{
+			Cells: cells,
+		},
+	}
+}
+
+// Add adds cells to the heatmap.
+func (h *Heatmap) Add(cells ...HeatmapCell) {
+	h.Config.Cells = append(h.Config.Cells, cells...)
+}
+
+type heatmapMarshal Heatmap
+
+// MarshalJSON implements json.Marshaler.
+func (h *Heatmap) MarshalJSON() ([]byte, error) {
+	m := heatmapMarshal(*h)
+	m.Metadata.Type = TypeHeatmap
+	return json.Marshal(&m)
+}
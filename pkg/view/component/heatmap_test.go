@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+func Test_Heatmap_Marshal(t *testing.T) {
+	heatmap := NewHeatmap("CPU")
+	heatmap.Add(HeatmapCell{
+		Label:       "node-1",
+		Value:       0.5,
+		Description: "usage 50%",
+		Ref:         "/cluster-overview/nodes/node-1",
+	})
+
+	actual, err := json.Marshal(heatmap)
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(path.Join("testdata", "heatmap.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/vmware-tanzu/octant/internal/util/json"
 )
 
+// Icon is a component for an icon
+// +octant:component
 type Icon struct {
 	Base
 	Config IconConfig `json:"config"`
@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"github.com/vmware-tanzu/octant/internal/markdown"
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// MarkdownLinkResolver converts the target of a relative link in Markdown to a content
+// path. It returns false if the target can't be resolved.
+type MarkdownLinkResolver func(target string) (string, bool)
+
+// Markdown is a component for Markdown text. Markdown is rendered to HTML on the server,
+// which escapes raw HTML and drops links with unsafe schemes, so it can show text that
+// isn't trusted.
+// +octant:component
+type Markdown struct {
+	Base
+	Config MarkdownConfig `json:"config"`
+
+	resolver MarkdownLinkResolver
+}
+
+// MarkdownConfig is the contents of Markdown.
+type MarkdownConfig struct {
+	// Value is the Markdown text.
+	Value string `json:"value"`
+	// HTML is the rendered text. It is set when the component is marshaled.
+	HTML string `json:"html,omitempty"`
+}
+
+var _ Component = (*Markdown)(nil)
+
+// NewMarkdown creates a Markdown component.
+func NewMarkdown(title []TitleComponent, value string) *Markdown {
+	return &Markdown{
+		Base: newBase(TypeMarkdown, title),
+		Config: MarkdownConfig{
+			Value: value,
+		},
+	}
+}
+
+// SetLinkResolver sets the resolver for relative links. Relative links are rendered as
+// text if there is no resolver.
+func (m *Markdown) SetLinkResolver(resolver MarkdownLinkResolver) {
+	m.resolver = resolver
+}
+
+// String returns the Markdown text.
+func (m *Markdown) String() string {
+	return m.Config.Value
+}
+
+type markdownMarshal Markdown
+
+// MarshalJSON implements json.Marshaler.
+func (m *Markdown) MarshalJSON() ([]byte, error) {
+	x := markdownMarshal(*m)
+	x.Metadata.Type = TypeMarkdown
+	x.Config.HTML = markdown.ToHTML(m.Config.Value, m.resolver)
+	return json.Marshal(&x)
+}
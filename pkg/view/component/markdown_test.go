@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+func Test_Markdown_Marshal(t *testing.T) {
+	m := NewMarkdown(TitleFromString("Runbook"), "Restart the **payments** pods. See [settings](v1/ConfigMap/settings).")
+	m.SetLinkResolver(func(target string) (string, bool) {
+		return "/overview/namespace/default/config-and-storage/config-maps/settings", target == "v1/ConfigMap/settings"
+	})
+
+	actual, err := json.Marshal(m)
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(path.Join("testdata", "markdown.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+func Test_Markdown_Marshal_replacesHTML(t *testing.T) {
+	m := NewMarkdown(nil, "text")
+	m.Config.HTML = `<img src=x onerror="alert(1)">`
+
+	actual, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"metadata":{"type":"markdown"},"config":{"value":"text","html":"<p>text</p>\n"}}`, string(actual))
+}
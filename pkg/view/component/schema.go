@@ -0,0 +1,22 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	_ "embed"
+)
+
+//go:embed schema.json
+var schema []byte
+
+// Schema returns the JSON Schema of the components. The "component" definition accepts
+// any component, and there are definitions for every component and component config by
+// name, and for content and print responses. The schema is generated from the components
+// marked with +octant:component by running
+// `go run ./cmd/ts-component-gen -schema pkg/view/component/schema.json`.
+func Schema() []byte {
+	return append([]byte(nil), schema...)
+}
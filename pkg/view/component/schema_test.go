@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/jsonschema"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+func TestSchema_components(t *testing.T) {
+	schema, err := jsonschema.Parse(Schema())
+	require.NoError(t, err)
+
+	now := time.Unix(1547211430, 0)
+
+	table := NewTableWithRows("table", "placeholder", NewTableCols("Name", "Age"), []TableRow{
+		{"Name": NewLink("", "pod", "/pod"), "Age": NewTimestamp(now)},
+	})
+	table.AddFilter("Name", TableFilter{Values: []string{"pod"}, Selected: []string{}})
+
+	flexLayout := NewFlexLayout("layout")
+	flexLayout.AddSections(FlexLayoutSection{
+		{Width: WidthHalf, View: NewText("text")},
+		{Width: WidthHalf, View: NewCodeBlock("code")},
+	})
+
+	card := NewCard(TitleFromString("card"))
+	card.SetBody(NewMarkdownText("**body**"))
+
+	buttonGroup := NewButtonGroup()
+	buttonGroup.AddButton(NewButton("delete", action.Payload{"name": "pod"}))
+
+	modal := NewModal(TitleFromString("modal"))
+	modal.SetBody(NewText("body"))
+	modal.AddForm(Form{Fields: []FormField{NewFormFieldText("Name", "name", "")}})
+
+	components := []Component{
+		NewText("text"),
+		NewLink("", "link", "/link"),
+		NewTimestamp(now),
+		NewLabels(map[string]string{"app": "web"}),
+		NewAnnotations(map[string]string{"note": "value"}),
+		NewList(TitleFromString("list"), []Component{NewText("item")}),
+		NewSummary("summary", SummarySection{Header: "header", Content: NewText("content")}),
+		NewDiff("left", "a\nb\nc\nd\ne\nf\ng\nh\n", "right", "a\nb\nc\nd\ne\nf\ng\ni\n"),
+		NewMarkdown(TitleFromString("markdown"), "# heading"),
+		NewYAML(TitleFromString("yaml"), "a: b"),
+		NewTimeline([]TimelineStep{{State: TimelineStepCurrent, Header: "header", Title: "title"}}, false),
+		NewSelectors([]Selector{NewLabelSelector("app", "web")}),
+		NewQuadrant("quadrant"),
+		NewSingleStat("stat", "1", "green"),
+		NewGraphviz("digraph {}"),
+		NewIcon("user"),
+		table,
+		flexLayout,
+		card,
+		buttonGroup,
+		modal,
+	}
+
+	for _, c := range components {
+		t.Run(c.GetMetadata().Type, func(t *testing.T) {
+			data, err := json.Marshal(c)
+			require.NoError(t, err)
+
+			require.NoError(t, schema.Validate("component", data))
+			require.NoError(t, schema.Validate(reflect.TypeOf(c).Elem().Name(), data))
+		})
+	}
+}
+
+func TestSchema_invalid(t *testing.T) {
+	schema, err := jsonschema.Parse(Schema())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		document string
+		expected string
+	}{
+		{
+			name:     "unknown type",
+			document: `{"metadata":{"type":"unknown"},"config":{}}`,
+			expected: `/metadata/type: unknown value "unknown"`,
+		},
+		{
+			name:     "missing config",
+			document: `{"metadata":{"type":"text"}}`,
+			expected: `/: missing property "config"`,
+		},
+		{
+			name:     "missing config property",
+			document: `{"metadata":{"type":"link"},"config":{"value":"link"}}`,
+			expected: `/config: missing property "ref"`,
+		},
+		{
+			name:     "config property with the wrong type",
+			document: `{"metadata":{"type":"text"},"config":{"value":1}}`,
+			expected: `/config/value: expected string, got number`,
+		},
+		{
+			name: "nested component",
+			document: `{"metadata":{"type":"flexlayout"},"config":{"sections":[[{"width":12,` +
+				`"view":{"metadata":{"type":"text"},"config":{}}}]]}}`,
+			expected: `/config/sections/0/0/view/config: missing property "value"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := schema.Validate("component", []byte(test.document))
+			require.Error(t, err)
+
+			var errs jsonschema.Errors
+			require.True(t, errors.As(err, &errs))
+			assert.Contains(t, errs.Error(), test.expected)
+		})
+	}
+}
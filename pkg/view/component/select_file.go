@@ -2,6 +2,8 @@ package component
 
 import "github.com/vmware-tanzu/octant/internal/util/json"
 
+// SelectFile is a component for selecting files
+// +octant:component
 type SelectFile struct {
 	Base
 	Config SelectFileConfig `json:"config"`
@@ -33,6 +33,7 @@ type TableConfig struct {
 	Loading      bool                   `json:"loading"`
 	Filters      map[string]TableFilter `json:"filters"`
 	ButtonGroup  *ButtonGroup           `json:"buttonGroup,omitempty"`
+	Paging       *TablePaging           `json:"paging,omitempty"`
 }
 
 func (t *TableConfig) UnmarshalJSON(data []byte) error {
@@ -43,6 +44,7 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 		Loading      bool                   `json:"loading"`
 		Filters      map[string]TableFilter `json:"filters"`
 		ButtonGroup  *TypedObject           `json:"buttonGroup,omitempty"`
+		Paging       *TablePaging           `json:"paging,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &x); err != nil {
@@ -67,6 +69,7 @@ func (t *TableConfig) UnmarshalJSON(data []byte) error {
 	t.EmptyContent = x.EmptyContent
 	t.Loading = x.Loading
 	t.Filters = x.Filters
+	t.Paging = x.Paging
 
 	return nil
 }
@@ -0,0 +1,211 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultTablePageSize is the number of rows in a page of a paged table.
+const DefaultTablePageSize = 20
+
+// TablePaging describes a table whose rows are paged, sorted and filtered by the server,
+// so only the rows of the current page are sent to clients.
+type TablePaging struct {
+	// Key identifies the table within its content.
+	Key string `json:"key"`
+	// Page is the zero based index of the page.
+	Page     int `json:"page"`
+	PageSize int `json:"pageSize"`
+	// TotalRows is the number of rows left after filtering.
+	TotalRows      int    `json:"totalRows"`
+	SortBy         string `json:"sortBy,omitempty"`
+	SortDescending bool   `json:"sortDescending,omitempty"`
+	// Filters are case insensitive substrings rows must contain, by column.
+	Filters map[string]string `json:"filters,omitempty"`
+}
+
+// EnablePaging makes the server page the rows of the table.
+func (t *Table) EnablePaging() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Config.Paging == nil {
+		t.Config.Paging = &TablePaging{PageSize: DefaultTablePageSize}
+	}
+}
+
+// IsPaged returns true if the server pages the rows of the table.
+func (t *Table) IsPaged() bool {
+	return t.Config.Paging != nil
+}
+
+// Page returns a copy of the table which only contains the rows of the page selected by
+// query, after filtering and sorting all rows. The table is not modified.
+func (t *Table) Page(query TablePaging) *Table {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rows := filterTableRows(t.Config.Rows, query.Filters)
+
+	if query.SortBy != "" {
+		sort.SliceStable(rows, func(i, j int) bool {
+			return lessTableCell(rows[i][query.SortBy], rows[j][query.SortBy], query.SortDescending)
+		})
+	}
+
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultTablePageSize
+	}
+
+	lastPage := 0
+	if len(rows) > 0 {
+		lastPage = (len(rows) - 1) / pageSize
+	}
+	page := query.Page
+	if page < 0 {
+		page = 0
+	} else if page > lastPage {
+		page = lastPage
+	}
+
+	start := page * pageSize
+	end := start + pageSize
+	if end > len(rows) {
+		end = len(rows)
+	}
+
+	config := t.Config
+	config.Rows = rows[start:end]
+	config.Paging = &TablePaging{
+		Key:            query.Key,
+		Page:           page,
+		PageSize:       pageSize,
+		TotalRows:      len(rows),
+		SortBy:         query.SortBy,
+		SortDescending: query.SortDescending,
+		Filters:        query.Filters,
+	}
+
+	return &Table{Base: t.Base, Config: config}
+}
+
+// lessTableCell returns true if cell a sorts before cell b. Empty cells sort last in
+// both directions.
+func lessTableCell(a, b Component, descending bool) bool {
+	switch {
+	case a == nil:
+		return false
+	case b == nil:
+		return true
+	case descending:
+		return b.LessThan(a)
+	default:
+		return a.LessThan(b)
+	}
+}
+
+// filterTableRows returns a new slice of the rows which contain all filters.
+func filterTableRows(rows []TableRow, filters map[string]string) []TableRow {
+	list := make([]TableRow, 0, len(rows))
+
+	for _, row := range rows {
+		matches := true
+		for column, value := range filters {
+			c, ok := row[column]
+			if !ok || !strings.Contains(strings.ToLower(c.String()), strings.ToLower(value)) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			list = append(list, row)
+		}
+	}
+
+	return list
+}
+
+// PageTables returns a copy of components in which each paged table only contains the
+// rows of one page. Paged tables without a key are keyed by their order. query returns
+// the query for a table key, and the first page is used if it returns false.
+func PageTables(components []Component, query func(key string) (TablePaging, bool)) []Component {
+	p := &tablePager{query: query}
+	return p.components(components)
+}
+
+type tablePager struct {
+	query func(key string) (TablePaging, bool)
+	count int
+}
+
+func (p *tablePager) components(components []Component) []Component {
+	if components == nil {
+		return nil
+	}
+
+	list := make([]Component, len(components))
+	for i := range components {
+		list[i] = p.component(components[i])
+	}
+	return list
+}
+
+func (p *tablePager) component(c Component) Component {
+	switch t := c.(type) {
+	case *Table:
+		if !t.IsPaged() {
+			return t
+		}
+
+		key := t.Config.Paging.Key
+		if key == "" {
+			key = fmt.Sprintf("table-%d", p.count)
+		}
+		p.count++
+
+		q, ok := p.query(key)
+		if !ok {
+			q = TablePaging{PageSize: t.Config.Paging.PageSize}
+		}
+		q.Key = key
+
+		return t.Page(q)
+	case *List:
+		list := &List{Base: t.Base, Config: t.Config}
+		list.Config.Items = p.components(t.Config.Items)
+		return list
+	case *FlexLayout:
+		return p.flexLayout(t)
+	case *TabsView:
+		tabsView := &TabsView{Base: t.Base, Config: t.Config}
+		tabsView.Config.Tabs = make([]SingleTab, len(t.Config.Tabs))
+		for i, tab := range t.Config.Tabs {
+			tabsView.Config.Tabs[i] = SingleTab{Name: tab.Name, Contents: *p.flexLayout(&tab.Contents)}
+		}
+		return tabsView
+	default:
+		return c
+	}
+}
+
+func (p *tablePager) flexLayout(f *FlexLayout) *FlexLayout {
+	layout := &FlexLayout{Base: f.Base, Config: f.Config}
+	layout.Config.Sections = make([]FlexLayoutSection, len(f.Config.Sections))
+	for i, section := range f.Config.Sections {
+		s := make(FlexLayoutSection, len(section))
+		for j, item := range section {
+			item.View = p.component(item.View)
+			s[j] = item
+		}
+		layout.Config.Sections[i] = s
+	}
+	return layout
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pagingTestTable(n int) *Table {
+	table := NewTable("Pods", "none", NewTableCols("Name", "Status"))
+	for i := 0; i < n; i++ {
+		status := "Running"
+		if i%2 == 1 {
+			status = "Pending"
+		}
+		table.Add(TableRow{
+			"Name":   NewText(fmt.Sprintf("pod-%02d", i)),
+			"Status": NewText(status),
+		})
+	}
+	return table
+}
+
+func rowNames(table *Table) []string {
+	var names []string
+	for _, row := range table.Rows() {
+		names = append(names, row["Name"].String())
+	}
+	return names
+}
+
+func TestTable_Page(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         TablePaging
+		expectedNames []string
+		expectedPage  int
+		expectedTotal int
+	}{
+		{
+			name:          "first page",
+			query:         TablePaging{PageSize: 3},
+			expectedNames: []string{"pod-00", "pod-01", "pod-02"},
+			expectedTotal: 10,
+		},
+		{
+			name:          "last page",
+			query:         TablePaging{Page: 3, PageSize: 3},
+			expectedNames: []string{"pod-09"},
+			expectedPage:  3,
+			expectedTotal: 10,
+		},
+		{
+			name:          "page out of range",
+			query:         TablePaging{Page: 12, PageSize: 3},
+			expectedNames: []string{"pod-09"},
+			expectedPage:  3,
+			expectedTotal: 10,
+		},
+		{
+			name:          "sort descending",
+			query:         TablePaging{PageSize: 2, SortBy: "Name", SortDescending: true},
+			expectedNames: []string{"pod-09", "pod-08"},
+			expectedTotal: 10,
+		},
+		{
+			name:          "filter",
+			query:         TablePaging{Page: 1, PageSize: 2, Filters: map[string]string{"Status": "pend"}},
+			expectedNames: []string{"pod-05", "pod-07"},
+			expectedPage:  1,
+			expectedTotal: 5,
+		},
+		{
+			name:          "filter without matches",
+			query:         TablePaging{PageSize: 2, Filters: map[string]string{"Status": "Failed"}},
+			expectedTotal: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table := pagingTestTable(10)
+			table.EnablePaging()
+
+			got := table.Page(test.query)
+
+			assert.Equal(t, test.expectedNames, rowNames(got))
+			require.NotNil(t, got.Config.Paging)
+			assert.Equal(t, test.expectedPage, got.Config.Paging.Page)
+			assert.Equal(t, test.expectedTotal, got.Config.Paging.TotalRows)
+			assert.Len(t, table.Rows(), 10, "the paged table is not modified")
+			assert.Equal(t, "pod-00", table.Rows()[0]["Name"].String())
+		})
+	}
+}
+
+func TestTable_Page_emptyCells(t *testing.T) {
+	table := NewTable("Pods", "none", NewTableCols("Name", "Node"))
+	for i, node := range []string{"node-b", "", "node-a", "", "node-c"} {
+		row := TableRow{"Name": NewText(fmt.Sprintf("pod-%02d", i))}
+		if node != "" {
+			row["Node"] = NewText(node)
+		}
+		table.Add(row)
+	}
+	table.EnablePaging()
+
+	got := table.Page(TablePaging{PageSize: 5, SortBy: "Node"})
+	assert.Equal(t, []string{"pod-02", "pod-00", "pod-04", "pod-01", "pod-03"}, rowNames(got))
+
+	got = table.Page(TablePaging{PageSize: 5, SortBy: "Node", SortDescending: true})
+	assert.Equal(t, []string{"pod-04", "pod-00", "pod-02", "pod-01", "pod-03"}, rowNames(got),
+		"empty cells sort last in both directions")
+}
+
+func TestPageTables(t *testing.T) {
+	paged := pagingTestTable(30)
+	paged.EnablePaging()
+	unpaged := pagingTestTable(30)
+
+	layout := NewFlexLayout("layout")
+	layout.AddSections(FlexLayoutSection{{Width: WidthFull, View: paged}})
+
+	list := NewList(TitleFromString("list"), []Component{paged})
+
+	components := []Component{layout, unpaged, list}
+	got := PageTables(components, func(key string) (TablePaging, bool) {
+		if key == "table-1" {
+			return TablePaging{Page: 1, PageSize: 5}, true
+		}
+		return TablePaging{}, false
+	})
+	require.Len(t, got, 3)
+
+	first := got[0].(*FlexLayout).Config.Sections[0][0].View.(*Table)
+	assert.Equal(t, "table-0", first.Config.Paging.Key)
+	assert.Len(t, first.Rows(), DefaultTablePageSize)
+
+	assert.Same(t, unpaged, got[1])
+
+	second := got[2].(*List).Config.Items[0].(*Table)
+	assert.Equal(t, "table-1", second.Config.Paging.Key)
+	assert.Equal(t, []string{"pod-05", "pod-06", "pod-07", "pod-08", "pod-09"}, rowNames(second))
+
+	assert.Same(t, paged, layout.Config.Sections[0][0].View, "the layout is not modified")
+	assert.Len(t, paged.Rows(), 30)
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/vmware-tanzu/octant/internal/util/json"
 )
 
+// TabsView is a component for showing components in tabs
+// +octant:component
 type TabsView struct {
 	Base
 	Config TabsViewConfig `json:"config"`
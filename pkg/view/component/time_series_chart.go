@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+// TimeSeriesChart is a line or area chart of values over time.
+// +octant:component
+type TimeSeriesChart struct {
+	Base
+	Config TimeSeriesChartConfig `json:"config"`
+}
+
+// TimeSeriesChartConfig is the contents of TimeSeriesChart.
+type TimeSeriesChartConfig struct {
+	Series []TimeSeries `json:"series"`
+	// Unit is shown after values, e.g. "MiB".
+	Unit string `json:"unit,omitempty"`
+	// Area fills the area below the lines.
+	Area bool `json:"area,omitempty"`
+	// Placeholder is shown if no series has points.
+	Placeholder string `json:"placeholder,omitempty"`
+}
+
+// TimeSeries is a named series of points.
+type TimeSeries struct {
+	Name   string            `json:"name"`
+	Points []TimeSeriesPoint `json:"points"`
+}
+
+// TimeSeriesPoint is a value at a point in time.
+type TimeSeriesPoint struct {
+	// Timestamp is the time of the value in seconds since the epoch.
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// NewTimeSeriesPoint creates a point for a value at a time.
+func NewTimeSeriesPoint(t time.Time, value float64) TimeSeriesPoint {
+	return TimeSeriesPoint{Timestamp: t.Unix(), Value: value}
+}
+
+var _ Component = (*TimeSeriesChart)(nil)
+
+// NewTimeSeriesChart creates a time series chart.
+func NewTimeSeriesChart(title string, series ...TimeSeries) *TimeSeriesChart {
+	return &TimeSeriesChart{
+		Base: newBase(TypeTimeSeriesChart, TitleFromString(title)),
+		Config: TimeSeriesChartConfig{
+			Series: series,
+		},
+	}
+}
+
+// AddSeries adds series to the chart.
+func (c *TimeSeriesChart) AddSeries(series ...TimeSeries) {
+	c.Config.Series = append(c.Config.Series, series...)
+}
+
+// SetUnit sets the unit of values.
+func (c *TimeSeriesChart) SetUnit(unit string) {
+	c.Config.Unit = unit
+}
+
+// SetArea sets whether the area below lines is filled.
+func (c *TimeSeriesChart) SetArea(area bool) {
+	c.Config.Area = area
+}
+
+// SetPlaceholder sets the text shown if no series has points.
+func (c *TimeSeriesChart) SetPlaceholder(placeholder string) {
+	c.Config.Placeholder = placeholder
+}
+
+type timeSeriesChartMarshal TimeSeriesChart
+
+// MarshalJSON implements json.Marshaler.
+func (c *TimeSeriesChart) MarshalJSON() ([]byte, error) {
+	m := timeSeriesChartMarshal(*c)
+	m.Metadata.Type = TypeTimeSeriesChart
+	return json.Marshal(&m)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/util/json"
+)
+
+func Test_TimeSeriesChart_Marshal(t *testing.T) {
+	chart := NewTimeSeriesChart("CPU", TimeSeries{
+		Name:   "CPU",
+		Points: []TimeSeriesPoint{NewTimeSeriesPoint(time.Unix(1633089600, 0), 250)},
+	})
+	chart.SetUnit("m")
+	chart.SetPlaceholder("No samples")
+
+	actual, err := json.Marshal(chart)
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(path.Join("testdata", "time_series_chart.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package component
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -26,6 +27,11 @@ type Timeline struct {
 type TimelineConfig struct {
 	Steps    []TimelineStep `json:"steps"`
 	Vertical bool           `json:"vertical"`
+	// Filters filter steps by the values of their attributes. They are keyed by
+	// attribute name.
+	Filters map[string]TableFilter `json:"filters,omitempty"`
+	// GroupBy is the name of an attribute steps can be grouped by.
+	GroupBy string `json:"groupBy,omitempty"`
 }
 
 // TimelineStep is the data for each timeline step
@@ -35,15 +41,18 @@ type TimelineStep struct {
 	Title       string        `json:"title"`
 	Description string        `json:"description"`
 	ButtonGroup *ButtonGroup  `json:"buttonGroup,omitempty"`
+	// Attributes are values steps can be filtered and grouped by.
+	Attributes map[string]string `json:"attributes,omitempty"`
 }
 
 func (t *TimelineStep) UnmarshalJSON(data []byte) error {
 	x := struct {
-		State       TimelineState `json:"state"`
-		Header      string        `json:"header"`
-		Title       string        `json:"title"`
-		Description string        `json:"description"`
-		ButtonGroup *TypedObject  `json:"buttonGroup,omitempty"`
+		State       TimelineState     `json:"state"`
+		Header      string            `json:"header"`
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		ButtonGroup *TypedObject      `json:"buttonGroup,omitempty"`
+		Attributes  map[string]string `json:"attributes,omitempty"`
 	}{}
 	if err := json.Unmarshal(data, &x); err != nil {
 		return err
@@ -63,6 +72,7 @@ func (t *TimelineStep) UnmarshalJSON(data []byte) error {
 	t.Title = x.Title
 	t.Header = x.Header
 	t.Description = x.Description
+	t.Attributes = x.Attributes
 
 	return nil
 }
@@ -96,6 +106,37 @@ func (t *Timeline) Add(steps ...TimelineStep) {
 	t.Config.Steps = append(t.Config.Steps, steps...)
 }
 
+// AddFilter adds a filter for an attribute of steps. The filter values are the values of
+// the attribute in the current steps.
+func (t *Timeline) AddFilter(attribute string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	seen := make(map[string]bool)
+	values := []string{}
+	for _, step := range t.Config.Steps {
+		value, ok := step.Attributes[attribute]
+		if !ok || seen[value] {
+			continue
+		}
+		seen[value] = true
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	if t.Config.Filters == nil {
+		t.Config.Filters = make(map[string]TableFilter)
+	}
+	t.Config.Filters[attribute] = TableFilter{Values: values, Selected: []string{}}
+}
+
+// SetGroupBy sets the attribute steps can be grouped by.
+func (t *Timeline) SetGroupBy(attribute string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Config.GroupBy = attribute
+}
+
 type timelineMarshal Timeline
 
 func (t *Timeline) MarshalJSON() ([]byte, error) {
@@ -95,3 +95,21 @@ func Test_Timeline_Add(t *testing.T) {
 	}
 	assert.Equal(t, expected, timeline.Config.Steps)
 }
+
+func Test_Timeline_AddFilter(t *testing.T) {
+	timeline := NewTimeline([]TimelineStep{
+		{Title: "BackOff", Attributes: map[string]string{"Type": "Warning", "Reason": "BackOff"}},
+		{Title: "Pulled", Attributes: map[string]string{"Type": "Normal", "Reason": "Pulled"}},
+		{Title: "Started", Attributes: map[string]string{"Type": "Normal", "Reason": "Started"}},
+		{Title: "Note"},
+	}, true)
+
+	timeline.AddFilter("Type")
+	timeline.SetGroupBy("Reason")
+
+	expected := map[string]TableFilter{
+		"Type": {Values: []string{"Normal", "Warning"}, Selected: []string{}},
+	}
+	assert.Equal(t, expected, timeline.Config.Filters)
+	assert.Equal(t, "Reason", timeline.Config.GroupBy)
+}
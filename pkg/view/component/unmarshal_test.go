@@ -379,6 +379,17 @@ func Test_unmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "markdown",
+			configFile: "config_markdown.json",
+			objectType: TypeMarkdown,
+			expected: &Markdown{
+				Config: MarkdownConfig{
+					Value: "# Runbook\n\nRestart the **payments** pods.",
+				},
+				Base: newBase(TypeMarkdown, nil),
+			},
+		},
 		{
 			name:       "modal",
 			configFile: "config_modal.json",
@@ -741,13 +752,69 @@ func Test_unmarshal(t *testing.T) {
 							Header:      "Header",
 							Title:       "Title",
 							Description: "Description",
+							Attributes:  map[string]string{"Type": "Normal"},
 						},
 					},
 					Vertical: true,
+					Filters: map[string]TableFilter{
+						"Type": {Values: []string{"Normal"}, Selected: []string{}},
+					},
+					GroupBy: "Type",
 				},
 				Base: newBase(TypeTimeline, nil),
 			},
 		},
+		{
+			name:       "diff",
+			configFile: "config_diff.json",
+			objectType: "diff",
+			expected: &Diff{
+				Config: DiffConfig{
+					LeftTitle:  "staging",
+					RightTitle: "production",
+					Mode:       DiffModeUnified,
+					Lines: []DiffLine{
+						{Type: DiffLineRemoved, Left: 1, Text: "replicas: 1"},
+						{Type: DiffLineAdded, Right: 1, Text: "replicas: 3"},
+					},
+					Additions: 1,
+					Deletions: 1,
+				},
+				Base: newBase(TypeDiff, nil),
+			},
+		},
+		{
+			name:       "heatmap",
+			configFile: "config_heatmap.json",
+			objectType: "heatmap",
+			expected: &Heatmap{
+				Config: HeatmapConfig{
+					Cells: []HeatmapCell{{Label: "node-1", Value: 0.25}},
+				},
+				Base: newBase(TypeHeatmap, nil),
+			},
+		},
+		{
+			name:       "time series chart",
+			configFile: "config_time_series_chart.json",
+			objectType: "timeSeriesChart",
+			expected: &TimeSeriesChart{
+				Config: TimeSeriesChartConfig{
+					Series: []TimeSeries{
+						{
+							Name: "Memory",
+							Points: []TimeSeriesPoint{
+								{Timestamp: 1633089600, Value: 12.5},
+								{Timestamp: 1633089615, Value: 13},
+							},
+						},
+					},
+					Unit: "MiB",
+					Area: true,
+				},
+				Base: newBase(TypeTimeSeriesChart, nil),
+			},
+		},
 		{
 			name:       "button",
 			configFile: "config_button.json",
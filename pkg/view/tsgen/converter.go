@@ -16,12 +16,16 @@ import (
 // Converter converts types to typescript.
 type Converter struct {
 	componentNames []string
+	// visiting are the structs being visited. They are typed as any where they are
+	// nested in themselves.
+	visiting map[reflect.Type]bool
 }
 
 // NewConverter creates an instance of Converter.
 func NewConverter(componentNames []string) *Converter {
 	c := &Converter{
 		componentNames: componentNames,
+		visiting:       make(map[reflect.Type]bool),
 	}
 	return c
 }
@@ -92,6 +96,13 @@ func (c *Converter) visit(w *gostrings.Builder, t reflect.Type, depth int) ([]st
 			return nil, nil
 		}
 
+		if c.visiting[t] {
+			w.WriteString("any")
+			return nil, nil
+		}
+		c.visiting[t] = true
+		defer delete(c.visiting, t)
+
 		w.WriteString("{\n")
 		for i := 0; i < t.NumField(); i++ {
 			f := t.Field(i)
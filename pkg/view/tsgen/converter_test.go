@@ -18,6 +18,11 @@ func TestConverter_Convert(t *testing.T) {
 
 	componentNames := []string{"Text"}
 
+	type node struct {
+		Name     string `json:"name"`
+		Children []node `json:"children,omitempty"`
+	}
+
 	type args struct {
 		in reflect.Type
 	}
@@ -48,6 +53,11 @@ func TestConverter_Convert(t *testing.T) {
 			args: args{in: reflect.TypeOf([]string{"foo"})},
 			want: "string[]",
 		},
+		{
+			name: "recursive struct",
+			args: args{in: reflect.TypeOf(node{})},
+			want: "{\n  name: string;\n  children?: any[];\n}",
+		},
 	}
 
 	for _, tt := range tests {
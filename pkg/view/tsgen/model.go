@@ -46,6 +46,8 @@ func ConvertField(xType reflect.Type, i int, componentNames []string) (Field, bo
 type Model struct {
 	Components     []Component
 	ComponentNames []string
+	// Schemas are JSON Schemas of types which contain components, by definition name.
+	Schemas map[string][]byte
 }
 
 // Component is a component in the model.
@@ -53,6 +55,8 @@ type Component struct {
 	Name   string
 	TSName string
 	Fields []Field
+	// ConfigSchema is the JSON Schema of the component config.
+	ConfigSchema []byte
 }
 
 // ImportReference is a reference for an import that is used to build typescript imports.
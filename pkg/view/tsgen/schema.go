@@ -0,0 +1,318 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tsgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	gostrings "strings"
+
+	"github.com/vmware-tanzu/octant/internal/util/strings"
+)
+
+// jsonSchemaDraft is the JSON Schema draft the generated schema uses.
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// Schema is a JSON Schema.
+type Schema map[string]interface{}
+
+// ConvertSchema converts a component config to a JSON Schema. It returns the schema
+// as JSON so it can be included in the model.
+func ConvertSchema(xType reflect.Type, componentNames []string) ([]byte, error) {
+	c := NewSchemaConverter(componentNames)
+	s, err := c.Convert(xType)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(s)
+}
+
+// SchemaConverter converts types to JSON Schema. Components are referenced by name, and
+// other types are described in place. Types with their own JSON encoding are allowed to
+// have any value.
+type SchemaConverter struct {
+	componentNames []string
+	// visiting are the structs being visited. They are allowed to have any value where
+	// they are nested in themselves.
+	visiting map[reflect.Type]bool
+}
+
+// NewSchemaConverter creates an instance of SchemaConverter.
+func NewSchemaConverter(componentNames []string) *SchemaConverter {
+	return &SchemaConverter{
+		componentNames: componentNames,
+		visiting:       make(map[reflect.Type]bool),
+	}
+}
+
+// Convert converts a type to a JSON Schema.
+func (c *SchemaConverter) Convert(t reflect.Type) (Schema, error) {
+	return c.visit(t, 0)
+}
+
+func (c *SchemaConverter) visit(t reflect.Type, depth int) (Schema, error) {
+	switch t.Kind() {
+	case reflect.Ptr:
+		s, err := c.visit(t.Elem(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return nullable(s), nil
+	case reflect.Map:
+		s, err := c.visit(t.Elem(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return Schema{"type": []string{"object", "null"}, "additionalProperties": s}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// byte slices are encoded as base64 strings.
+			return Schema{"type": []string{"string", "null"}}, nil
+		}
+		s, err := c.visit(t.Elem(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return Schema{"type": []string{"array", "null"}, "items": s}, nil
+	case reflect.Array:
+		s, err := c.visit(t.Elem(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return Schema{"type": "array", "items": s}, nil
+	case reflect.Struct:
+		if depth > 0 && strings.Contains(t.String(), c.componentNames) {
+			return componentRef(t.Name()), nil
+		}
+
+		if t.String() == "time.Time" {
+			return Schema{"type": "string", "format": "date-time"}, nil
+		}
+
+		if depth > 0 && (t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType)) {
+			return Schema{}, nil
+		}
+
+		return c.visitStruct(t, depth)
+	case reflect.String:
+		return Schema{"type": "string"}, nil
+	case reflect.Bool:
+		return Schema{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}, nil
+	case reflect.Interface:
+		switch t.String() {
+		case "component.Component", "component.TitleComponent":
+			// interfaces are encoded as null when they aren't set.
+			return nullable(componentRef("component")), nil
+		default:
+			return Schema{}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unable to handle %s", t.String())
+	}
+}
+
+// visitStruct describes the fields of a struct the way encoding/json encodes them.
+func (c *SchemaConverter) visitStruct(t reflect.Type, depth int) (Schema, error) {
+	if c.visiting[t] {
+		return Schema{}, nil
+	}
+	c.visiting[t] = true
+	defer delete(c.visiting, t)
+
+	properties := Schema{}
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+
+		parts := gostrings.Split(f.Tag.Get("json"), ",")
+		name := parts[0]
+		if name == "-" && len(parts) == 1 {
+			continue
+		}
+
+		if name == "" && f.Anonymous && f.Type.Kind() == reflect.Struct {
+			// the fields of untagged embedded structs are encoded as if they were in
+			// the outer struct.
+			embedded, err := c.visitStruct(f.Type, depth)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range embedded["properties"].(Schema) {
+				properties[k] = v
+			}
+			if list, ok := embedded["required"].([]string); ok {
+				required = append(required, list...)
+			}
+			continue
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		s, err := c.visit(f.Type, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		properties[name] = s
+
+		if !strings.Contains("omitempty", parts[1:]) {
+			required = append(required, name)
+		}
+	}
+
+	s := Schema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		sort.Strings(required)
+		s["required"] = required
+	}
+
+	return s, nil
+}
+
+// nullable allows a schema to be null as well.
+func nullable(s Schema) Schema {
+	switch typ := s["type"].(type) {
+	case string:
+		out := copySchema(s)
+		out["type"] = []string{typ, "null"}
+		return out
+	case []string:
+		if strings.Contains("null", typ) {
+			return s
+		}
+		out := copySchema(s)
+		out["type"] = append(append([]string{}, typ...), "null")
+		return out
+	}
+
+	if len(s) == 0 {
+		return s
+	}
+
+	return Schema{"anyOf": []Schema{{"type": "null"}, s}}
+}
+
+func copySchema(s Schema) Schema {
+	out := Schema{}
+	for k, v := range s {
+		out[k] = v
+	}
+	return out
+}
+
+func componentRef(name string) Schema {
+	return Schema{"$ref": "#/definitions/" + name}
+}
+
+// Schema generates a JSON Schema document for all components in the model. Every
+// component config is a definition named after the component with a "Config" suffix,
+// and every component is a definition named after the component. The "component"
+// definition accepts any component, and checks its config against the config of its
+// type. The model's schemas, e.g. for content responses, are definitions as well.
+func (tg *TSGen) Schema(model *Model) ([]byte, error) {
+	if model == nil {
+		return nil, fmt.Errorf("model is nil")
+	}
+
+	components := append([]Component(nil), model.Components...)
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+
+	definitions := Schema{}
+	for name, s := range model.Schemas {
+		definitions[name] = json.RawMessage(s)
+	}
+
+	var types []string
+	var configs []Schema
+	for _, c := range components {
+		if len(c.ConfigSchema) == 0 {
+			return nil, fmt.Errorf("component %s has no config schema", c.Name)
+		}
+
+		types = append(types, c.TSName)
+		definitions[c.Name+"Config"] = json.RawMessage(c.ConfigSchema)
+		definitions[c.Name] = Schema{
+			"allOf": []Schema{componentRef("component")},
+			"properties": Schema{
+				"metadata": Schema{
+					"properties": Schema{"type": Schema{"const": c.TSName}},
+				},
+			},
+		}
+
+		configs = append(configs, Schema{
+			"if": Schema{
+				"required": []string{"metadata"},
+				"properties": Schema{
+					"metadata": Schema{
+						"required":   []string{"type"},
+						"properties": Schema{"type": Schema{"const": c.TSName}},
+					},
+				},
+			},
+			"then": Schema{
+				"properties": Schema{"config": componentRef(c.Name + "Config")},
+			},
+		})
+	}
+	sort.Strings(types)
+
+	definitions["component"] = Schema{
+		"type":     "object",
+		"required": []string{"config", "metadata"},
+		"properties": Schema{
+			"metadata": Schema{
+				"allOf":      []Schema{componentRef("metadata")},
+				"properties": Schema{"type": Schema{"enum": types}},
+			},
+		},
+		"allOf": configs,
+	}
+
+	sectionList := Schema{"type": []string{"array", "null"}, "items": componentRef("summarySection")}
+	definitions["printResponse"] = Schema{
+		"type": "object",
+		"properties": Schema{
+			"config": sectionList,
+			"status": sectionList,
+			"items":  Schema{"type": []string{"array", "null"}, "items": componentRef("flexLayoutItem")},
+		},
+	}
+
+	doc := Schema{
+		"$schema":     jsonSchemaDraft,
+		"title":       "Octant components",
+		"$ref":        "#/definitions/component",
+		"definitions": definitions,
+	}
+
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tsgen
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaText struct {
+	Value string `json:"value"`
+}
+
+type schemaNode struct {
+	Name     string       `json:"name"`
+	Children []schemaNode `json:"children,omitempty"`
+}
+
+type schemaEmbedded struct {
+	Kind string `json:"kind"`
+}
+
+type schemaConfig struct {
+	schemaEmbedded
+	Value    string            `json:"value"`
+	Count    int               `json:"count,omitempty"`
+	Ratio    float64           `json:"ratio"`
+	Enabled  *bool             `json:"enabled,omitempty"`
+	Labels   map[string]string `json:"labels"`
+	Data     []byte            `json:"data,omitempty"`
+	Created  time.Time         `json:"created"`
+	Text     *schemaText       `json:"text,omitempty"`
+	Node     schemaNode        `json:"node"`
+	Ignored  string            `json:"-"`
+	internal string
+}
+
+func TestSchemaConverter_Convert(t *testing.T) {
+	c := NewSchemaConverter([]string{"tsgen.schemaText"})
+	got, err := c.Convert(reflect.TypeOf(schemaConfig{}))
+	require.NoError(t, err)
+
+	expected := Schema{
+		"type": "object",
+		"properties": Schema{
+			"kind":    Schema{"type": "string"},
+			"value":   Schema{"type": "string"},
+			"count":   Schema{"type": "integer"},
+			"ratio":   Schema{"type": "number"},
+			"enabled": Schema{"type": []string{"boolean", "null"}},
+			"labels": Schema{
+				"type":                 []string{"object", "null"},
+				"additionalProperties": Schema{"type": "string"},
+			},
+			"data":    Schema{"type": []string{"string", "null"}},
+			"created": Schema{"type": "string", "format": "date-time"},
+			"text": Schema{"anyOf": []Schema{
+				{"type": "null"},
+				{"$ref": "#/definitions/schemaText"},
+			}},
+			"node": Schema{
+				"type": "object",
+				"properties": Schema{
+					"name":     Schema{"type": "string"},
+					"children": Schema{"type": []string{"array", "null"}, "items": Schema{}},
+				},
+				"required": []string{"name"},
+			},
+		},
+		"required": []string{"created", "kind", "labels", "node", "ratio", "value"},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func TestTSGen_Schema(t *testing.T) {
+	configSchema, err := ConvertSchema(reflect.TypeOf(schemaText{}), []string{"tsgen.schemaText"})
+	require.NoError(t, err)
+
+	model := &Model{
+		Components: []Component{
+			{Name: "Text", TSName: "text", ConfigSchema: configSchema},
+		},
+		Schemas: map[string][]byte{
+			"metadata": []byte(`{"type":"object"}`),
+		},
+	}
+
+	tg, err := NewTSGen()
+	require.NoError(t, err)
+
+	data, err := tg.Schema(model)
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+
+	assert.Equal(t, jsonSchemaDraft, doc["$schema"])
+	assert.Equal(t, "#/definitions/component", doc["$ref"])
+
+	definitions := doc["definitions"].(map[string]interface{})
+	for _, name := range []string{"component", "metadata", "printResponse", "Text", "TextConfig"} {
+		assert.Contains(t, definitions, name)
+	}
+
+	component := definitions["component"].(map[string]interface{})
+	metadata := component["properties"].(map[string]interface{})["metadata"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"text"},
+		metadata["properties"].(map[string]interface{})["type"].(map[string]interface{})["enum"])
+}
+
+func TestTSGen_Schema_missing_config_schema(t *testing.T) {
+	tg, err := NewTSGen()
+	require.NoError(t, err)
+
+	_, err = tg.Schema(&Model{Components: []Component{{Name: "Text", TSName: "text"}}})
+	require.Error(t, err)
+}
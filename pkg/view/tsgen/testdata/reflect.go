@@ -53,9 +53,29 @@ func main() {
 			c.Fields = append(c.Fields, f)
 		}
 
+		configSchema, err := tsgen.ConvertSchema(xType, m.ComponentNames)
+		if err != nil {
+			log.Fatalf("convert %s to JSON schema: %v", job.Name, err)
+		}
+		c.ConfigSchema = configSchema
+
 		m.Components = append(m.Components, c)
 	}
 
+	m.Schemas = map[string][]byte{}
+	for name, t := range map[string]reflect.Type{
+		"metadata":        reflect.TypeOf(component.Metadata{}),
+		"contentResponse": reflect.TypeOf(component.ContentResponse{}),
+		"summarySection":  reflect.TypeOf(component.SummarySection{}),
+		"flexLayoutItem":  reflect.TypeOf(component.FlexLayoutItem{}),
+	} {
+		schema, err := tsgen.ConvertSchema(t, m.ComponentNames)
+		if err != nil {
+			log.Fatalf("convert %s to JSON schema: %v", name, err)
+		}
+		m.Schemas[name] = schema
+	}
+
 	enc := gob.NewEncoder(os.Stdout)
 	if err := enc.Encode(m); err != nil {
 		log.Fatal("encode error:", err)
@@ -20,6 +20,7 @@ import { webSocket, WebSocketSubject } from 'rxjs/webSocket';
 import { delay, retryWhen, take, tap } from 'rxjs/operators';
 import { WindowToken } from '../../../window';
 import { ElectronService } from 'src/app/modules/shared/services/electron/electron.service';
+import { ButtonGroupView } from '../../../modules/shared/models/content';
 
 interface WebsocketPayload {
   type: string;
@@ -40,6 +41,7 @@ interface Alert {
   type: NotifierSignalType;
   message: string;
   expiration?: string;
+  buttonGroup?: ButtonGroupView;
 }
 
 @Injectable({
@@ -67,7 +69,11 @@ export class WebsocketService implements BackendService {
 
     this.registerHandler('event.octant.dev/alert', data => {
       const alert = data as Alert;
-      const id = this.notifierSession.pushSignal(alert.type, alert.message);
+      const id = this.notifierSession.pushSignal(
+        alert.type,
+        alert.message,
+        alert.buttonGroup
+      );
       if (alert.expiration) {
         const expiration = new Date(alert.expiration);
         const diff = expiration.getTime() - Date.now();
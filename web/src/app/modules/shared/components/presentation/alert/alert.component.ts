@@ -2,6 +2,7 @@ import {
   ChangeDetectionStrategy,
   Component,
   Input,
+  OnChanges,
   OnInit,
 } from '@angular/core';
 
@@ -21,7 +22,7 @@ const alertLookup = {
   styleUrls: ['./alert.component.scss'],
   changeDetection: ChangeDetectionStrategy.OnPush,
 })
-export class AlertComponent implements OnInit {
+export class AlertComponent implements OnInit, OnChanges {
   @Input() alert: Alert;
   message = '';
   status = '';
@@ -33,6 +34,14 @@ export class AlertComponent implements OnInit {
   constructor() {}
 
   ngOnInit(): void {
+    this.update();
+  }
+
+  ngOnChanges(): void {
+    this.update();
+  }
+
+  update(): void {
     if (this.alert) {
       this.type = this.alert.type;
       this.message = this.alert.message;
@@ -17,6 +17,7 @@ import { parse } from 'marked';
 import { DomSanitizer } from '@angular/platform-browser';
 import { ActionService } from '../../../services/action/action.service';
 import { ModalService } from '../../../services/modal/modal.service';
+import { ReadOnlyService } from '../../../services/read-only/read-only.service';
 
 @Component({
   selector: 'app-button',
@@ -40,11 +41,18 @@ export class ButtonComponent extends AbstractViewComponent<ButtonView> {
   constructor(
     private actionService: ActionService,
     private modalService: ModalService,
-    private sanitize: DomSanitizer
+    private sanitize: DomSanitizer,
+    private readOnlyService: ReadOnlyService
   ) {
     super();
   }
 
+  // hidden is true if the button performs an action refused in read-only mode.
+  get hidden(): boolean {
+    const payload = this.v?.config?.payload as { action?: string };
+    return this.readOnlyService.refuses(payload?.action);
+  }
+
   update() {
     const button = this.v?.config;
     if (button) {
@@ -3,9 +3,10 @@
 //
 
 import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
-import { DatagridComponent } from './datagrid.component';
+import { DatagridComponent, SetTablePageAction } from './datagrid.component';
 import { SharedModule } from '../../../shared.module';
 import { windowProvider, WindowToken } from '../../../../../window';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
 
 describe('DatagridComponent', () => {
   let component: DatagridComponent;
@@ -29,4 +30,45 @@ describe('DatagridComponent', () => {
   it('should create', () => {
     expect(component).toBeTruthy();
   });
+
+  describe('refresh', () => {
+    let websocketService: WebsocketService;
+
+    beforeEach(() => {
+      websocketService = TestBed.inject(WebsocketService);
+      spyOn(websocketService, 'sendMessage');
+    });
+
+    it('ignores tables which are not paged', () => {
+      component.paging = undefined;
+      component.refresh({ page: { current: 2, size: 20 } });
+      expect(websocketService.sendMessage).not.toHaveBeenCalled();
+    });
+
+    it('requests a page of a paged table', () => {
+      component.paging = { key: 'table-0', page: 0, pageSize: 20, totalRows: 300 };
+      component.refresh({
+        page: { current: 3, size: 50 },
+        sort: { by: 'Name', reverse: true },
+        filters: [{ column: 'Name', text: 'nginx' }],
+      });
+      expect(websocketService.sendMessage).toHaveBeenCalledWith(
+        SetTablePageAction,
+        {
+          key: 'table-0',
+          page: 2,
+          pageSize: 50,
+          sortBy: 'Name',
+          sortDescending: true,
+          filters: { Name: 'nginx' },
+        }
+      );
+    });
+
+    it('does not request the current page', () => {
+      component.paging = { key: 'table-0', page: 1, pageSize: 20, totalRows: 300 };
+      component.refresh({ page: { current: 2, size: 20 } });
+      expect(websocketService.sendMessage).not.toHaveBeenCalled();
+    });
+  });
 });
@@ -2,7 +2,10 @@
 // SPDX-License-Identifier: Apache-2.0
 //
 
-import { ClrDatagridSortOrder } from '@clr/angular';
+import {
+  ClrDatagridSortOrder,
+  ClrDatagridStateInterface,
+} from '@clr/angular';
 import {
   ChangeDetectionStrategy,
   ChangeDetectorRef,
@@ -16,6 +19,7 @@ import {
   GridAction,
   GridActionsView,
   TableFilters,
+  TablePaging,
   TableRow,
   TableRowWithMetadata,
   TableView,
@@ -28,6 +32,7 @@ import trackByIdentity from 'src/app/util/trackBy/trackByIdentity';
 import { TimestampComparator } from '../../../../../util/timestamp-comparator';
 import { ViewService } from '../../../services/view/view.service';
 import { ActionService } from '../../../services/action/action.service';
+import { ReadOnlyService } from '../../../services/read-only/read-only.service';
 import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
 import { BehaviorSubject, Observable } from 'rxjs';
 import { LoadingService } from '../../../services/loading/loading.service';
@@ -36,6 +41,9 @@ import { DomSanitizer } from '@angular/platform-browser';
 import { parse } from 'marked';
 import { PreferencesService } from '../../../services/preferences/preferences.service';
 import { Subscription } from 'rxjs';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+
+export const SetTablePageAction = 'action.octant.dev/setTablePage';
 
 @Component({
   selector: 'app-view-datagrid',
@@ -59,6 +67,7 @@ export class DatagridComponent
   buttonGroup?: ButtonGroupView;
   isModalOpen = false;
   defaultPageSize: number;
+  paging?: TablePaging;
 
   actionDialogOptions: ActionDialogOptions = undefined;
 
@@ -76,7 +85,9 @@ export class DatagridComponent
     private loadingService: LoadingService,
     private preferencesService: PreferencesService,
     private cdr: ChangeDetectorRef,
-    private readonly sanitizer: DomSanitizer
+    private readonly sanitizer: DomSanitizer,
+    private readOnlyService: ReadOnlyService,
+    private websocketService: WebsocketService
   ) {
     super();
     this.sub = this.preferencesService.preferences
@@ -107,6 +118,52 @@ export class DatagridComponent
     this.columns = this.v.config.columns.map(column => column.name);
     this.filters = this.v.config.filters;
     this.buttonGroup = this.v.config.buttonGroup;
+    this.paging = this.v.config.paging;
+  }
+
+  // refresh requests the page, sort order and filters of a table which is paged
+  // by the server. Tables which are not paged are paged by the datagrid.
+  refresh(state: ClrDatagridStateInterface) {
+    if (!this.paging) {
+      return;
+    }
+
+    const filters: { [column: string]: string } = {};
+    (state.filters || []).forEach(filter => {
+      if (filter.column && filter.text) {
+        filters[filter.column] = filter.text;
+      } else if (filter.property && filter.value) {
+        filters[filter.property] = filter.value;
+      }
+    });
+
+    const query: TablePaging = {
+      key: this.paging.key,
+      page: state.page ? state.page.current - 1 : this.paging.page,
+      pageSize: state.page ? state.page.size : this.paging.pageSize,
+      totalRows: this.paging.totalRows,
+      sortBy: typeof state.sort?.by === 'string' ? state.sort.by : '',
+      sortDescending: !!state.sort?.reverse,
+      filters,
+    };
+
+    if (this.isCurrentPage(query)) {
+      return;
+    }
+
+    const { totalRows, ...payload } = query;
+    this.websocketService.sendMessage(SetTablePageAction, payload);
+  }
+
+  private isCurrentPage(query: TablePaging): boolean {
+    const current = this.paging;
+    return (
+      current.page === query.page &&
+      current.pageSize === query.pageSize &&
+      (current.sortBy || '') === query.sortBy &&
+      !!current.sortDescending === query.sortDescending &&
+      sameFilters(current.filters || {}, query.filters)
+    );
   }
 
   private getRowsWithMetadata(rows: TableRow[]): TableRowWithMetadata[] {
@@ -120,7 +177,9 @@ export class DatagridComponent
       let replace: boolean;
 
       if (row.hasOwnProperty('_action')) {
-        actions = (row._action as GridActionsView).config.actions;
+        actions = (row._action as GridActionsView).config.actions.filter(
+          action => !this.readOnlyService.refuses(action.actionPath)
+        );
       }
 
       if (row.hasOwnProperty('_expand')) {
@@ -193,6 +252,17 @@ export class DatagridComponent
   }
 }
 
+const sameFilters = (
+  a: { [column: string]: string },
+  b: { [column: string]: string }
+): boolean => {
+  const keys = Object.keys(a);
+  return (
+    keys.length === Object.keys(b).length &&
+    keys.every(key => a[key] === b[key])
+  );
+};
+
 interface ActionDialogOptions {
   action: GridAction;
   text: string;
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+
+import { DiffComponent } from './diff.component';
+import { DiffView } from '../../../models/content';
+
+describe('DiffComponent', () => {
+  let component: DiffComponent;
+  let fixture: ComponentFixture<DiffComponent>;
+
+  const view: DiffView = {
+    metadata: { type: 'diff' },
+    config: {
+      leftTitle: 'staging',
+      rightTitle: 'production',
+      mode: 'split',
+      additions: 1,
+      deletions: 1,
+      lines: [
+        {
+          type: 'collapsed',
+          lines: [
+            { type: 'equal', left: 1, right: 1, text: 'kind: Deployment' },
+            { type: 'equal', left: 2, right: 2, text: 'spec:' },
+          ],
+        },
+        { type: 'removed', left: 3, text: '  replicas: 1' },
+        { type: 'added', right: 3, text: '  replicas: 3' },
+      ],
+    },
+  };
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [DiffComponent],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(DiffComponent);
+    component = fixture.componentInstance;
+    component.view = view;
+    fixture.detectChanges();
+  });
+
+  it('should pair removed and added lines in split mode', () => {
+    expect(component.rows).toEqual([
+      { collapsed: 0, hidden: 2 },
+      { left: view.config.lines[1], right: view.config.lines[2] },
+    ]);
+  });
+
+  it('should list removed and added lines in unified mode', () => {
+    component.setMode('unified');
+    expect(component.rows).toEqual([
+      { collapsed: 0, hidden: 2 },
+      { left: view.config.lines[1] },
+      { right: view.config.lines[2] },
+    ]);
+  });
+
+  it('should expand collapsed lines', () => {
+    component.expand(0);
+    fixture.detectChanges();
+
+    const root: HTMLElement = fixture.nativeElement;
+    expect(root.querySelectorAll('tbody tr').length).toEqual(3);
+    expect(root.querySelector('.collapsed')).toBeNull();
+  });
+});
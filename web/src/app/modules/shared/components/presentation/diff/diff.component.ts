@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { Component } from '@angular/core';
+import { DiffLine, DiffView } from '../../../models/content';
+import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
+
+export interface DiffRow {
+  left?: DiffLine;
+  right?: DiffLine;
+  // collapsed is the index of a collapsed line which can be expanded.
+  collapsed?: number;
+  hidden?: number;
+}
+
+@Component({
+  selector: 'app-view-diff',
+  templateUrl: './diff.component.html',
+  styleUrls: ['./diff.component.scss'],
+})
+export class DiffComponent extends AbstractViewComponent<DiffView> {
+  mode: 'unified' | 'split' = 'split';
+  rows: DiffRow[] = [];
+  private expanded = new Set<number>();
+
+  constructor() {
+    super();
+  }
+
+  update() {
+    this.mode = this.v?.config?.mode || 'split';
+    this.expanded.clear();
+    this.rows = this.buildRows();
+  }
+
+  setMode(mode: 'unified' | 'split') {
+    this.mode = mode;
+    this.rows = this.buildRows();
+  }
+
+  expand(index: number) {
+    this.expanded.add(index);
+    this.rows = this.buildRows();
+  }
+
+  isEqual(): boolean {
+    return this.v?.config?.additions === 0 && this.v?.config?.deletions === 0;
+  }
+
+  trackByIndex(index: number) {
+    return index;
+  }
+
+  // buildRows lists the rows for the current mode. In split mode, removed lines are
+  // paired with the added lines following them.
+  private buildRows(): DiffRow[] {
+    const lines = this.v?.config?.lines || [];
+    const rows: DiffRow[] = [];
+
+    let removed: DiffLine[] = [];
+    let added: DiffLine[] = [];
+    const flush = () => {
+      for (let i = 0; i < Math.max(removed.length, added.length); i++) {
+        rows.push({ left: removed[i], right: added[i] });
+      }
+      removed = [];
+      added = [];
+    };
+
+    lines.forEach((line, index) => {
+      if (line.type === 'collapsed' && this.expanded.has(index)) {
+        flush();
+        (line.lines || []).forEach(l => rows.push({ left: l, right: l }));
+        return;
+      }
+
+      if (this.mode === 'unified') {
+        rows.push(this.unifiedRow(line, index));
+        return;
+      }
+
+      switch (line.type) {
+        case 'removed':
+          if (added.length > 0) {
+            flush();
+          }
+          removed.push(line);
+          break;
+        case 'added':
+          added.push(line);
+          break;
+        default:
+          flush();
+          rows.push(this.unifiedRow(line, index));
+      }
+    });
+    flush();
+
+    return rows;
+  }
+
+  private unifiedRow(line: DiffLine, index: number): DiffRow {
+    switch (line.type) {
+      case 'collapsed':
+        return { collapsed: index, hidden: (line.lines || []).length };
+      case 'removed':
+        return { left: line };
+      case 'added':
+        return { right: line };
+      default:
+        return { left: line, right: line };
+    }
+  }
+}
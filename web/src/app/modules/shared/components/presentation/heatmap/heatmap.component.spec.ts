@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+import { RouterTestingModule } from '@angular/router/testing';
+
+import { HeatmapComponent } from './heatmap.component';
+import { HeatmapView } from '../../../models/content';
+
+describe('HeatmapComponent', () => {
+  let component: HeatmapComponent;
+  let fixture: ComponentFixture<HeatmapComponent>;
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [HeatmapComponent],
+        imports: [RouterTestingModule],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(HeatmapComponent);
+    component = fixture.componentInstance;
+    const view: HeatmapView = {
+      metadata: { type: 'heatmap' },
+      config: {
+        cells: [
+          { label: 'node-1', value: 0, ref: '/cluster-overview/nodes/node-1' },
+          { label: 'node-2', value: 1, description: 'node-2: usage 100%' },
+        ],
+      },
+    };
+    component.view = view;
+    fixture.detectChanges();
+  });
+
+  it('should render a cell for each node', () => {
+    const root: HTMLElement = fixture.nativeElement;
+    const cells = root.querySelectorAll('.cell');
+    expect(cells.length).toEqual(2);
+    expect(cells[0].tagName).toEqual('A');
+    expect(cells[1].getAttribute('title')).toEqual('node-2: usage 100%');
+    expect(cells[1].querySelector('.value').textContent).toEqual('100%');
+  });
+
+  it('should color cells from green to red', () => {
+    expect(component.cellColor(component.cells[0])).toEqual('hsl(120, 70%, 45%)');
+    expect(component.cellColor(component.cells[1])).toEqual('hsl(0, 70%, 45%)');
+  });
+});
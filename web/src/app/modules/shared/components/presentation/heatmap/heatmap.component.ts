@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { Component } from '@angular/core';
+import { HeatmapCell, HeatmapView } from '../../../models/content';
+import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
+
+@Component({
+  selector: 'app-view-heatmap',
+  templateUrl: './heatmap.component.html',
+  styleUrls: ['./heatmap.component.scss'],
+})
+export class HeatmapComponent extends AbstractViewComponent<HeatmapView> {
+  cells: HeatmapCell[] = [];
+
+  constructor() {
+    super();
+  }
+
+  update() {
+    this.cells = this.v?.config?.cells || [];
+  }
+
+  trackByLabel(index: number, cell: HeatmapCell) {
+    return cell.label;
+  }
+
+  // cellColor interpolates from green at 0 through yellow to red at 1.
+  cellColor(cell: HeatmapCell): string {
+    const value = Math.min(1, Math.max(0, cell.value || 0));
+    const hue = Math.round(120 * (1 - value));
+    return `hsl(${hue}, 70%, 45%)`;
+  }
+
+  percent(cell: HeatmapCell): string {
+    return `${Math.round((cell.value || 0) * 100)}%`;
+  }
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+import { Router } from '@angular/router';
+import { RouterTestingModule } from '@angular/router/testing';
+
+import { MarkdownComponent } from './markdown.component';
+import { MarkdownView } from '../../../models/content';
+
+describe('MarkdownComponent', () => {
+  let component: MarkdownComponent;
+  let fixture: ComponentFixture<MarkdownComponent>;
+
+  const view: MarkdownView = {
+    metadata: { type: 'markdown' },
+    config: {
+      value: '## Restart\n\nSee [settings](v1/ConfigMap/settings).',
+      html:
+        '<h2>Restart</h2>\n' +
+        '<p>See <a href="/overview/namespace/default/config-and-storage/config-maps/settings">settings</a>' +
+        ' and <a href="https://example.com" target="_blank">docs</a>.</p>\n',
+    },
+  };
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [MarkdownComponent],
+        imports: [RouterTestingModule],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(MarkdownComponent);
+    component = fixture.componentInstance;
+    component.view = view;
+    fixture.detectChanges();
+  });
+
+  it('should render the HTML', () => {
+    const root: HTMLElement = fixture.nativeElement;
+    expect(root.querySelector('h2').textContent).toEqual('Restart');
+    expect(root.querySelectorAll('a').length).toEqual(2);
+  });
+
+  it('should open content paths in the app', () => {
+    const router = TestBed.inject(Router);
+    spyOn(router, 'navigateByUrl');
+
+    const root: HTMLElement = fixture.nativeElement;
+    const link: HTMLAnchorElement = root.querySelector('a');
+    const event = new MouseEvent('click', { bubbles: true, cancelable: true });
+    link.dispatchEvent(event);
+
+    expect(event.defaultPrevented).toBeTrue();
+    expect(router.navigateByUrl).toHaveBeenCalledWith(
+      '/overview/namespace/default/config-and-storage/config-maps/settings'
+    );
+  });
+
+  it('should not handle external links', () => {
+    const router = TestBed.inject(Router);
+    spyOn(router, 'navigateByUrl');
+
+    const root: HTMLElement = fixture.nativeElement;
+    const link: HTMLAnchorElement = root.querySelectorAll('a')[1];
+    link.addEventListener('click', e => e.preventDefault());
+    link.dispatchEvent(
+      new MouseEvent('click', { bubbles: true, cancelable: true })
+    );
+
+    expect(router.navigateByUrl).not.toHaveBeenCalled();
+  });
+});
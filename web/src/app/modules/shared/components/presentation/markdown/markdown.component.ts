@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { Component, SecurityContext } from '@angular/core';
+import { DomSanitizer } from '@angular/platform-browser';
+import { Router } from '@angular/router';
+import { MarkdownView } from '../../../models/content';
+import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
+
+@Component({
+  selector: 'app-view-markdown',
+  templateUrl: './markdown.component.html',
+  styleUrls: ['./markdown.component.scss'],
+})
+export class MarkdownComponent extends AbstractViewComponent<MarkdownView> {
+  html: string;
+
+  constructor(
+    private readonly sanitizer: DomSanitizer,
+    private router: Router
+  ) {
+    super();
+  }
+
+  update() {
+    // The server renders and sanitizes the HTML. It is sanitized again in case the
+    // view didn't come from the server.
+    this.html = this.sanitizer.sanitize(
+      SecurityContext.HTML,
+      this.v?.config?.html || ''
+    );
+  }
+
+  // navigate opens links to content paths in the app instead of reloading the page.
+  navigate(event: MouseEvent) {
+    if (event.button !== 0 || event.ctrlKey || event.metaKey || event.shiftKey) {
+      return;
+    }
+
+    const anchor = (event.target as HTMLElement)?.closest('a');
+    const href = anchor?.getAttribute('href');
+    if (href?.startsWith('/') && !href.startsWith('//')) {
+      event.preventDefault();
+      this.router.navigateByUrl(href);
+    }
+  }
+}
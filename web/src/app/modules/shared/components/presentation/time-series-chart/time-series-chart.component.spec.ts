@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+
+import { TimeSeriesChartComponent } from './time-series-chart.component';
+import { TimeSeriesChartView } from '../../../models/content';
+
+describe('TimeSeriesChartComponent', () => {
+  let component: TimeSeriesChartComponent;
+  let fixture: ComponentFixture<TimeSeriesChartComponent>;
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [TimeSeriesChartComponent],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(TimeSeriesChartComponent);
+    component = fixture.componentInstance;
+  });
+
+  it('should draw a line and area for each series', () => {
+    const view: TimeSeriesChartView = {
+      metadata: { type: 'timeSeriesChart' },
+      config: {
+        series: [
+          {
+            name: 'Memory',
+            points: [
+              { timestamp: 100, value: 0 },
+              { timestamp: 115, value: 10 },
+            ],
+          },
+        ],
+        unit: 'MiB',
+        area: true,
+      },
+    };
+    component.view = view;
+    fixture.detectChanges();
+
+    const root: HTMLElement = fixture.nativeElement;
+    expect(root.querySelectorAll('path.line').length).toEqual(1);
+    expect(root.querySelectorAll('path.area').length).toEqual(1);
+    expect(component.series[0].line).toEqual('M 4 116 L 396 4');
+    expect(component.series[0].latest).toEqual('10 MiB');
+  });
+
+  it('should show the placeholder without points', () => {
+    const view: TimeSeriesChartView = {
+      metadata: { type: 'timeSeriesChart' },
+      config: {
+        series: [{ name: 'CPU', points: [] }],
+        placeholder: 'Collecting samples...',
+      },
+    };
+    component.view = view;
+    fixture.detectChanges();
+
+    const root: HTMLElement = fixture.nativeElement;
+    expect(root.querySelector('svg')).toBeNull();
+    expect(root.querySelector('.placeholder').textContent).toContain(
+      'Collecting samples...'
+    );
+  });
+});
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+import { Component } from '@angular/core';
+import { TimeSeries, TimeSeriesChartView } from '../../../models/content';
+import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
+
+export interface SeriesDescriptor {
+  name: string;
+  color: string;
+  line: string;
+  area: string;
+  latest: string;
+}
+
+const colors = ['#0072a3', '#60b515', '#f57600', '#9460b8', '#00bfa9'];
+
+@Component({
+  selector: 'app-view-time-series-chart',
+  templateUrl: './time-series-chart.component.html',
+  styleUrls: ['./time-series-chart.component.scss'],
+})
+export class TimeSeriesChartComponent extends AbstractViewComponent<TimeSeriesChartView> {
+  width = 400;
+  height = 120;
+  padding = 4;
+
+  series: SeriesDescriptor[] = [];
+  maxLabel = '';
+  startLabel = '';
+  endLabel = '';
+
+  constructor() {
+    super();
+  }
+
+  update() {
+    const series = (this.v?.config?.series || []).filter(
+      s => s.points?.length > 0
+    );
+    this.series = [];
+    if (series.length === 0) {
+      return;
+    }
+
+    const points = series.reduce((all, s) => all.concat(s.points), []);
+    const start = Math.min(...points.map(p => p.timestamp));
+    const end = Math.max(...points.map(p => p.timestamp));
+    const max = Math.max(...points.map(p => p.value)) || 1;
+
+    this.maxLabel = this.formatValue(max);
+    this.startLabel = this.formatTime(start);
+    this.endLabel = this.formatTime(end);
+
+    this.series = series.map((s, index) => ({
+      name: s.name,
+      color: colors[index % colors.length],
+      line: this.linePath(s, start, end, max),
+      area: this.v.config.area ? this.areaPath(s, start, end, max) : '',
+      latest: this.formatValue(s.points[s.points.length - 1].value),
+    }));
+  }
+
+  viewBox(): string {
+    return `0 0 ${this.width} ${this.height}`;
+  }
+
+  trackByName(index: number, item: SeriesDescriptor) {
+    return item.name;
+  }
+
+  linePath(series: TimeSeries, start: number, end: number, max: number) {
+    return series.points
+      .map((p, i) => {
+        const x = this.x(p.timestamp, start, end);
+        const y = this.y(p.value, max);
+        return `${i === 0 ? 'M' : 'L'} ${x} ${y}`;
+      })
+      .join(' ');
+  }
+
+  areaPath(series: TimeSeries, start: number, end: number, max: number) {
+    const first = series.points[0];
+    const last = series.points[series.points.length - 1];
+    const bottom = this.height - this.padding;
+    return (
+      `${this.linePath(series, start, end, max)} ` +
+      `L ${this.x(last.timestamp, start, end)} ${bottom} ` +
+      `L ${this.x(first.timestamp, start, end)} ${bottom} Z`
+    );
+  }
+
+  formatValue(value: number): string {
+    const rounded = Math.round(value * 10) / 10;
+    const unit = this.v?.config?.unit;
+    return unit ? `${rounded} ${unit}` : `${rounded}`;
+  }
+
+  formatTime(timestamp: number): string {
+    return new Date(timestamp * 1000).toLocaleTimeString();
+  }
+
+  private x(timestamp: number, start: number, end: number): number {
+    const range = end - start;
+    const width = this.width - 2 * this.padding;
+    if (range === 0) {
+      return this.width - this.padding;
+    }
+    return this.padding + ((timestamp - start) / range) * width;
+  }
+
+  private y(value: number, max: number): number {
+    const height = this.height - 2 * this.padding;
+    return this.height - this.padding - (value / max) * height;
+  }
+}
@@ -5,6 +5,7 @@ import { Component } from '@angular/core';
 import { TimelineView } from '../../../models/content';
 import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
 import { TimelineComponent } from './timeline.component';
+import { By } from '@angular/platform-browser';
 @Component({
   template: '<app-view-timeline [view]="view"></app-view-timeline>',
 })
@@ -53,5 +54,47 @@ describe('TimelineComponent', () => {
         'description'
       );
     });
+
+    it('should filter and group steps', () => {
+      const element: HTMLDivElement = fixture.nativeElement;
+      const step = (title: string, type: string, object: string) => ({
+        state: type === 'Warning' ? 'error' : 'success',
+        header: 'header',
+        title,
+        description: 'description',
+        attributes: { Type: type, Object: object },
+      });
+      component.view = {
+        config: {
+          steps: [
+            step('BackOff', 'Warning', 'Pod pod-a'),
+            step('Pulled', 'Normal', 'Pod pod-b'),
+            step('Killing', 'Warning', 'Pod pod-b'),
+          ],
+          vertical: true,
+          filters: {
+            Type: { values: ['Normal', 'Warning'], selected: [] },
+          },
+          groupBy: 'Object',
+        },
+        metadata: { type: 'timeline', title: [], accessor: 'accessor' },
+      };
+      fixture.detectChanges();
+
+      const timeline: TimelineComponent = fixture.debugElement.query(
+        By.directive(TimelineComponent)
+      ).componentInstance;
+      expect(element.querySelectorAll('clr-timeline-step').length).toEqual(3);
+
+      timeline.setFilter('Type', 'Warning');
+      timeline.toggleGrouped();
+      expect(timeline.groups).toEqual([
+        { name: 'Pod pod-a', steps: [component.view.config.steps[0]] },
+        { name: 'Pod pod-b', steps: [component.view.config.steps[2]] },
+      ]);
+
+      timeline.setFilter('Type', '');
+      expect(timeline.groups.map(group => group.steps.length)).toEqual([1, 2]);
+    });
   });
 });
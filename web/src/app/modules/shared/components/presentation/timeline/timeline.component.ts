@@ -6,6 +6,11 @@ import { ChangeDetectionStrategy, Component, OnInit } from '@angular/core';
 import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
 import { TimelineStep, TimelineView } from '../../../models/content';
 
+export interface TimelineGroup {
+  name: string;
+  steps: TimelineStep[];
+}
+
 @Component({
   selector: 'app-view-timeline',
   templateUrl: './timeline.component.html',
@@ -18,15 +23,88 @@ export class TimelineComponent
 {
   vertical: boolean;
   steps: TimelineStep[];
+  groups: TimelineGroup[] = [];
+  filterNames: string[] = [];
+  filterValues: { [name: string]: string[] } = {};
+  // selected is the selected value of each filter. Steps are not filtered by
+  // filters without a selected value.
+  selected: { [name: string]: string } = {};
+  groupBy: string;
+  grouped = false;
+
   constructor() {
     super();
   }
+
   update() {
     const view = this.v;
     this.vertical = view.config.vertical;
-    this.steps = view.config.steps;
+    this.steps = view.config.steps || [];
+    this.groupBy = view.config.groupBy;
+
+    const filters = view.config.filters || {};
+    this.filterNames = Object.keys(filters).sort();
+    this.filterValues = {};
+    const selected: { [name: string]: string } = {};
+    this.filterNames.forEach(name => {
+      const values = filters[name].values || [];
+      this.filterValues[name] = values;
+
+      // keep selections when the view is updated
+      const current = this.selected[name] || filters[name].selected?.[0];
+      if (current && values.includes(current)) {
+        selected[name] = current;
+      }
+    });
+    this.selected = selected;
+
+    this.groups = this.buildGroups();
+  }
+
+  setFilter(name: string, value: string) {
+    this.selected = { ...this.selected, [name]: value };
+    this.groups = this.buildGroups();
   }
+
+  toggleGrouped() {
+    this.grouped = !this.grouped;
+    this.groups = this.buildGroups();
+  }
+
   trackByFn(index, _) {
     return index;
   }
+
+  trackByName(_, group: TimelineGroup) {
+    return group.name;
+  }
+
+  // buildGroups filters the steps and groups them by the group by attribute if
+  // grouping is enabled. Groups are ordered by their first step.
+  private buildGroups(): TimelineGroup[] {
+    const steps = this.steps.filter(step =>
+      this.filterNames.every(
+        name =>
+          !this.selected[name] || step.attributes?.[name] === this.selected[name]
+      )
+    );
+
+    if (!this.grouped || !this.groupBy) {
+      return steps.length > 0 ? [{ name: '', steps }] : [];
+    }
+
+    const groups: TimelineGroup[] = [];
+    const byName = new Map<string, TimelineGroup>();
+    steps.forEach(step => {
+      const name = step.attributes?.[this.groupBy] || '';
+      let group = byName.get(name);
+      if (!group) {
+        group = { name, steps: [] };
+        byName.set(name, group);
+        groups.push(group);
+      }
+      group.steps.push(step);
+    });
+    return groups;
+  }
 }
@@ -4,7 +4,7 @@
 
 import { Component, OnDestroy, OnInit } from '@angular/core';
 import '@cds/core/icon/register.js';
-import { ClarityIcons, clusterIcon } from '@cds/core/icon';
+import { ClarityIcons, clusterIcon, starIcon } from '@cds/core/icon';
 import {
   ContextDescription,
   KubeContextService,
@@ -23,7 +23,7 @@ export class ContextSelectorComponent implements OnInit, OnDestroy {
   private kubeContextSubscription: Subscription;
 
   constructor(private kubeContext: KubeContextService) {
-    ClarityIcons.addIcons(clusterIcon);
+    ClarityIcons.addIcons(clusterIcon, starIcon);
   }
 
   ngOnInit() {
@@ -6,6 +6,12 @@ import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
 import { EditorComponent } from './editor.component';
 import { MonacoEditorModule } from '@materia-ui/ngx-monaco-editor';
 import { windowProvider, WindowToken } from '../../../../../window';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../../data/services/websocket/mock';
+import { ValidationMessage } from '../../../services/validation/validation.service';
 
 describe('EditorComponent', () => {
   let component: EditorComponent;
@@ -14,7 +20,10 @@ describe('EditorComponent', () => {
   beforeEach(
     waitForAsync(() => {
       TestBed.configureTestingModule({
-        providers: [{ provide: WindowToken, useFactory: windowProvider }],
+        providers: [
+          { provide: WindowToken, useFactory: windowProvider },
+          { provide: WebsocketService, useClass: WebsocketServiceMock },
+        ],
         imports: [MonacoEditorModule],
         declarations: [EditorComponent],
       }).compileComponents();
@@ -38,4 +47,55 @@ describe('EditorComponent', () => {
     expect(editorElement).not.toBeNull();
     expect(editorElement.classList.contains('editor')).toBeTruthy();
   });
+
+  it('should document fields on hover', () => {
+    component.view = {
+      config: {
+        value: 'spec:\n  replicas: 1\n',
+        language: 'yaml',
+        readOnly: false,
+        metadata: {},
+        submitAction: '',
+        submitLabel: '',
+        fieldDocs: { 'spec.replicas': '**replicas** `integer`' },
+      },
+      metadata: { type: 'editor' },
+    };
+    fixture.detectChanges();
+
+    const lines = ['spec:', '  replicas: 1'];
+    expect(component.fieldHover(lines, 2)).toEqual({
+      contents: [{ value: '**replicas** `integer`' }],
+    });
+    expect(component.fieldHover(lines, 1)).toBeNull();
+  });
+
+  it('should keep validation errors of the edited object', () => {
+    const metadata = {
+      namespace: 'default',
+      apiVersion: 'apps/v1',
+      kind: 'Deployment',
+      name: 'app',
+    };
+    component.view = {
+      config: {
+        value: 'spec:\n  replica: 1\n',
+        language: 'yaml',
+        readOnly: false,
+        metadata,
+        submitAction: '',
+        submitLabel: '',
+      },
+      metadata: { type: 'editor' },
+    };
+    fixture.detectChanges();
+
+    const errors = [{ line: 2, column: 3, message: 'unknown field "replica"' }];
+    const backendService: BackendService = TestBed.inject(WebsocketService);
+    backendService.triggerHandler(ValidationMessage, { ...metadata, errors });
+    expect(component.validationErrors).toEqual(errors);
+
+    component.reset();
+    expect(component.validationErrors).toEqual([]);
+  });
 });
@@ -7,11 +7,20 @@ import { Component, OnDestroy, OnInit, ViewChild } from '@angular/core';
 import { EditorView, SelectFileView } from '../../../models/content';
 import { NamespaceService } from '../../../services/namespace/namespace.service';
 import { ActionService } from '../../../services/action/action.service';
+import { ReadOnlyService } from '../../../services/read-only/read-only.service';
+import {
+  ValidationError,
+  ValidationService,
+} from '../../../services/validation/validation.service';
 import { AbstractViewComponent } from '../../abstract-view/abstract-view.component';
 import { ThemeService } from '../../../services/theme/theme.service';
 import { Subscription } from 'rxjs';
 import { SelectFileComponent } from '../../presentation/select-file/select-file.component';
 import '@cds/core/button/register.js';
+import { yamlKeyPath } from '../../../../../util/yamlKeyPath';
+
+// monaco is the global namespace of the Monaco editor, which is loaded at runtime.
+declare const monaco: any;
 
 interface Options {
   readOnly: boolean;
@@ -42,6 +51,10 @@ export class EditorComponent
   private subscriptionTheme: Subscription;
   private syncMonacoTheme: () => void;
   private editorValue: string;
+  private hoverProvider: { dispose: () => void };
+  private editor: any;
+  private subscriptionValidation: Subscription;
+  validationErrors: ValidationError[] = [];
   private pristineValue: string;
   uri: string;
   metadata: { [p: string]: string };
@@ -70,7 +83,9 @@ export class EditorComponent
   constructor(
     private namespaceService: NamespaceService,
     private themeService: ThemeService,
-    private actionService: ActionService
+    private actionService: ActionService,
+    private readOnlyService: ReadOnlyService,
+    private validationService: ValidationService
   ) {
     super();
 
@@ -91,6 +106,36 @@ export class EditorComponent
     );
   }
 
+  editorInit(editor: any) {
+    this.editor = editor;
+    this.showValidationErrors();
+
+    this.hoverProvider?.dispose();
+    this.hoverProvider = monaco.languages.registerHoverProvider('yaml', {
+      provideHover: (model, position) => {
+        if (model !== editor.getModel()) {
+          return null;
+        }
+        return this.fieldHover(model.getLinesContent(), position.lineNumber);
+      },
+    });
+  }
+
+  // fieldHover returns the documentation of the field on a line, if there is any.
+  fieldHover(lines: string[], lineNumber: number) {
+    const docs = this.v?.config.fieldDocs;
+    if (!docs) {
+      return null;
+    }
+
+    const path = yamlKeyPath(lines, lineNumber);
+    if (!path || !docs[path]) {
+      return null;
+    }
+
+    return { contents: [{ value: docs[path] }] };
+  }
+
   inputFileChanged(files: any) {
     if (files && files[0]) {
       const reader = new FileReader();
@@ -116,6 +161,35 @@ export class EditorComponent
 
     this.submitAction = view.config.submitAction || this.submitAction;
     this.submitLabel = view.config.submitLabel || this.submitLabel;
+
+    if (this.metadata && !this.subscriptionValidation) {
+      this.subscriptionValidation = this.validationService
+        .validationsFor(this.metadata)
+        .subscribe(validation => {
+          this.validationErrors = validation.errors;
+          this.showValidationErrors();
+        });
+    }
+  }
+
+  // showValidationErrors marks the schema errors of the last submission in the editor.
+  private showValidationErrors() {
+    const model = this.editor?.getModel();
+    if (!model) {
+      return;
+    }
+
+    const markers = this.validationErrors.map(e => ({
+      severity: monaco.MarkerSeverity.Error,
+      message: e.field ? `${e.field}: ${e.message}` : e.message,
+      startLineNumber: e.line,
+      startColumn: e.column,
+      endLineNumber: e.line,
+      endColumn: model.getLineMaxColumn(
+        Math.min(e.line, model.getLineCount())
+      ),
+    }));
+    monaco.editor.setModelMarkers(model, 'schema', markers);
   }
 
   submit() {
@@ -129,6 +203,11 @@ export class EditorComponent
     this.actionService.perform(payload);
   }
 
+  // submitRefused is true if submitting is refused in read-only mode.
+  get submitRefused(): boolean {
+    return this.readOnlyService.refuses(this.submitAction);
+  }
+
   isUpdateEnabled() {
     return !this.isModified || this.editorValue.length === 0;
   }
@@ -136,9 +215,13 @@ export class EditorComponent
   reset() {
     this.selectFileComponent?.reset();
     this.value = this.pristineValue;
+    this.validationErrors = [];
+    this.showValidationErrors();
   }
 
   ngOnDestroy() {
     this.subscriptionTheme?.unsubscribe();
+    this.hoverProvider?.dispose();
+    this.subscriptionValidation?.unsubscribe();
   }
 }
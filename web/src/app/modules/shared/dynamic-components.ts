@@ -14,6 +14,7 @@ import { CardListComponent } from './components/presentation/card-list/card-list
 import { CodeComponent } from './components/presentation/code/code.component';
 import { ContainersComponent } from './components/presentation/containers/containers.component';
 import { DatagridComponent } from './components/presentation/datagrid/datagrid.component';
+import { DiffComponent } from './components/presentation/diff/diff.component';
 import { DonutChartComponent } from './components/presentation/donut-chart/donut-chart.component';
 import { DropdownComponent } from './components/presentation/dropdown/dropdown.component';
 import { EditorComponent } from './components/smart/editor/editor.component';
@@ -21,6 +22,7 @@ import { ErrorComponent } from './components/presentation/error/error.component'
 import { ExpressionSelectorComponent } from './components/presentation/expression-selector/expression-selector.component';
 import { FlexlayoutComponent } from './components/presentation/flexlayout/flexlayout.component';
 import { GraphvizComponent } from './components/presentation/graphviz/graphviz.component';
+import { HeatmapComponent } from './components/presentation/heatmap/heatmap.component';
 import { IconComponent } from './components/presentation/icon/icon.component';
 import { IFrameComponent } from './components/presentation/iframe/iframe.component';
 import { JSONEditorComponent } from './components/presentation/json-editor/json-editor.component';
@@ -30,6 +32,7 @@ import { LinkComponent } from './components/presentation/link/link.component';
 import { ListComponent } from './components/presentation/list/list.component';
 import { LoadingComponent } from './components/presentation/loading/loading.component';
 import { LogsComponent } from './components/smart/logs/logs.component';
+import { MarkdownComponent } from './components/presentation/markdown/markdown.component';
 import { ModalComponent } from './components/presentation/modal/modal.component';
 import { PortsComponent } from './components/presentation/ports/ports.component';
 import { PortForwardComponent } from './components/presentation/port-forward/port-forward.component';
@@ -44,6 +47,7 @@ import { SummaryComponent } from './components/presentation/summary/summary.comp
 import { TerminalComponent } from './components/smart/terminal/terminal.component';
 import { TextComponent } from './components/presentation/text/text.component';
 import { TimelineComponent } from './components/presentation/timeline/timeline.component';
+import { TimeSeriesChartComponent } from './components/presentation/time-series-chart/time-series-chart.component';
 import { TimestampComponent } from './components/presentation/timestamp/timestamp.component';
 import { YamlComponent } from './components/presentation/yaml/yaml.component';
 import { TabsViewComponent } from './components/presentation/tabs-view/tabs-view.component';
@@ -61,6 +65,7 @@ const DynamicComponentMapping: ComponentMapping = {
   cardList: CardListComponent,
   codeBlock: CodeComponent,
   containers: ContainersComponent,
+  diff: DiffComponent,
   donutChart: DonutChartComponent,
   dropdown: DropdownComponent,
   editor: EditorComponent,
@@ -71,12 +76,14 @@ const DynamicComponentMapping: ComponentMapping = {
   labelSelector: LabelSelectorComponent,
   loading: LoadingComponent,
   error: ErrorComponent,
+  heatmap: HeatmapComponent,
   icon: IconComponent,
   iframe: IFrameComponent,
   jsonEditor: JSONEditorComponent,
   link: LinkComponent,
   list: ListComponent,
   logs: LogsComponent,
+  markdown: MarkdownComponent,
   modal: ModalComponent,
   podStatus: PodStatusComponent,
   portforward: PortForwardComponent,
@@ -92,6 +99,7 @@ const DynamicComponentMapping: ComponentMapping = {
   terminal: TerminalComponent,
   text: TextComponent,
   timeline: TimelineComponent,
+  timeSeriesChart: TimeSeriesChartComponent,
   timestamp: TimestampComponent,
   yaml: YamlComponent,
   signpost: SignpostComponent,
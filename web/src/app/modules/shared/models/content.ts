@@ -365,9 +365,20 @@ export interface TableView extends View {
     loading: boolean;
     filters: TableFilters;
     buttonGroup?: ButtonGroupView;
+    paging?: TablePaging;
   };
 }
 
+export interface TablePaging {
+  key: string;
+  page: number;
+  pageSize: number;
+  totalRows: number;
+  sortBy?: string;
+  sortDescending?: boolean;
+  filters?: { [column: string]: string };
+}
+
 export interface TableFilters {
   [key: string]: TableFilter;
 }
@@ -408,6 +419,8 @@ export interface TimelineView extends View {
   config: {
     steps: TimelineStep[];
     vertical: boolean;
+    filters?: TableFilters;
+    groupBy?: string;
   };
 }
 
@@ -417,6 +430,65 @@ export interface TimelineStep {
   title: string;
   description: string;
   buttonGroup?: ButtonGroupView;
+  attributes?: { [key: string]: string };
+}
+
+export interface HeatmapCell {
+  label: string;
+  value: number;
+  description?: string;
+  ref?: string;
+}
+
+export interface DiffLine {
+  type: 'equal' | 'added' | 'removed' | 'collapsed';
+  left?: number;
+  right?: number;
+  text?: string;
+  lines?: DiffLine[];
+}
+
+export interface DiffView extends View {
+  config: {
+    leftTitle: string;
+    rightTitle: string;
+    mode: 'unified' | 'split';
+    lines: DiffLine[];
+    additions: number;
+    deletions: number;
+  };
+}
+
+export interface MarkdownView extends View {
+  config: {
+    value: string;
+    html?: string;
+  };
+}
+
+export interface HeatmapView extends View {
+  config: {
+    cells: HeatmapCell[];
+  };
+}
+
+export interface TimeSeriesPoint {
+  timestamp: number;
+  value: number;
+}
+
+export interface TimeSeries {
+  name: string;
+  points: TimeSeriesPoint[];
+}
+
+export interface TimeSeriesChartView extends View {
+  config: {
+    series: TimeSeries[];
+    unit?: string;
+    area?: boolean;
+    placeholder?: string;
+  };
 }
 
 export interface TimestampView extends View {
@@ -530,6 +602,7 @@ export interface EditorView extends View {
     metadata: { [key: string]: string };
     submitAction: string;
     submitLabel: string;
+    fieldDocs?: { [path: string]: string };
   };
 }
 
@@ -9,6 +9,7 @@ import pullAt from 'lodash/pullAt';
 import remove from 'lodash/remove';
 import uniqueId from 'lodash/uniqueId';
 import { BehaviorSubject } from 'rxjs';
+import { ButtonGroupView } from '../models/content';
 
 export enum NotifierSignalType {
   LOADING = 'LOADING',
@@ -23,6 +24,7 @@ export interface NotifierSignal {
   sessionID: string;
   type: NotifierSignalType;
   data: boolean | string;
+  buttonGroup?: ButtonGroupView;
 }
 
 export class NotifierSession {
@@ -35,15 +37,22 @@ export class NotifierSession {
     this.id = uniqueIDPrefix;
   }
 
-  pushSignal(type: NotifierSignalType, data: boolean | string): string {
+  pushSignal(
+    type: NotifierSignalType,
+    data: boolean | string,
+    buttonGroup?: ButtonGroupView
+  ): string {
     const currentSignals = this.globalSignalsStream.getValue();
     const newSignalID = uniqueId(this.uniqueIDPrefix);
-    const newSignal = {
+    const newSignal: NotifierSignal = {
       id: newSignalID,
       sessionID: this.uniqueIDPrefix,
       type,
       data,
     };
+    if (buttonGroup) {
+      newSignal.buttonGroup = buttonGroup;
+    }
     this.globalSignalsStream.next([...currentSignals, newSignal]);
     return newSignalID;
   }
@@ -0,0 +1,119 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { inject, TestBed } from '@angular/core/testing';
+import {
+  Command,
+  CommandService,
+  shortcutFromEvent,
+  visibleFields,
+} from './command.service';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../data/services/websocket/mock';
+
+const deploy: Command = {
+  name: 'plugin.example.com/deploy',
+  title: 'Deploy',
+  shortcut: 'ctrl+shift+d',
+  fields: [
+    { name: 'kind', label: '', type: 'hidden', value: 'Deployment' },
+    { name: 'namespace', label: 'Namespace' },
+    { name: 'replicas', label: 'Replicas', type: 'number' },
+    { name: 'force', label: 'Force', type: 'checkbox' },
+  ],
+};
+
+describe('CommandService', () => {
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      providers: [
+        CommandService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+  });
+
+  it('updates commands from the backend', inject(
+    [CommandService, WebsocketService],
+    (svc: CommandService, backendService: BackendService) => {
+      backendService.triggerHandler('event.octant.dev/commands', {
+        commands: [deploy],
+      });
+      expect(svc.commands.value).toEqual([deploy]);
+    }
+  ));
+
+  it('finds commands by shortcut', inject(
+    [CommandService, WebsocketService],
+    (svc: CommandService, backendService: BackendService) => {
+      backendService.triggerHandler('event.octant.dev/commands', {
+        commands: [deploy],
+      });
+
+      const event = new KeyboardEvent('keydown', {
+        key: 'D',
+        code: 'KeyD',
+        ctrlKey: true,
+        shiftKey: true,
+      });
+      expect(svc.findByShortcut(event)).toEqual(deploy);
+
+      const other = new KeyboardEvent('keydown', { key: 'd', code: 'KeyD' });
+      expect(svc.findByShortcut(other)).toBeUndefined();
+    }
+  ));
+
+  it('performs the command action with field values', inject(
+    [CommandService, WebsocketService],
+    (svc: CommandService, backendService: BackendService) => {
+      spyOn(backendService, 'sendMessage');
+
+      svc.run(deploy, { namespace: 'default', replicas: '3', force: true });
+
+      expect(backendService.sendMessage).toHaveBeenCalledWith(
+        'action.octant.dev/performAction',
+        {
+          kind: 'Deployment',
+          namespace: 'default',
+          replicas: 3,
+          force: true,
+          action: 'plugin.example.com/deploy',
+        }
+      );
+    }
+  ));
+});
+
+describe('shortcutFromEvent', () => {
+  it('orders modifiers', () => {
+    const event = new KeyboardEvent('keydown', {
+      key: 'Å',
+      code: 'KeyA',
+      altKey: true,
+      shiftKey: true,
+    });
+    expect(shortcutFromEvent(event)).toEqual('alt+shift+a');
+  });
+
+  it('ignores keys without modifiers', () => {
+    const event = new KeyboardEvent('keydown', { key: 'a', code: 'KeyA' });
+    expect(shortcutFromEvent(event)).toEqual('');
+  });
+});
+
+describe('visibleFields', () => {
+  it('skips hidden fields', () => {
+    expect(visibleFields(deploy).map(field => field.name)).toEqual([
+      'namespace',
+      'replicas',
+      'force',
+    ]);
+  });
+});
@@ -0,0 +1,133 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { BehaviorSubject } from 'rxjs';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+import { ActionService } from '../action/action.service';
+
+export type CommandFieldType =
+  | 'text'
+  | 'textarea'
+  | 'number'
+  | 'select'
+  | 'checkbox'
+  | 'hidden';
+
+export interface CommandField {
+  name: string;
+  label: string;
+  type?: CommandFieldType;
+  choices?: string[];
+  required?: boolean;
+  value?: string;
+}
+
+export interface Command {
+  name: string;
+  title: string;
+  description?: string;
+  shortcut?: string;
+  fields?: CommandField[];
+  source?: string;
+}
+
+export interface CommandsMessage {
+  commands: Command[];
+}
+
+const modifiers = ['ctrl', 'alt', 'shift', 'meta'];
+
+// shortcutFromEvent converts a keyboard event to a shortcut in the same form the
+// backend uses, e.g. `ctrl+shift+y`. Letters and digits use the physical key so
+// shortcuts with alt work with any keyboard layout.
+export function shortcutFromEvent(event: KeyboardEvent): string {
+  let key = (event.key || '').toLowerCase();
+  if (event.code && event.code.startsWith('Key')) {
+    key = event.code.substring(3).toLowerCase();
+  } else if (event.code && event.code.startsWith('Digit')) {
+    key = event.code.substring(5);
+  }
+
+  if (modifiers.includes(key) || key === 'control') {
+    return '';
+  }
+
+  const parts = [];
+  if (event.ctrlKey) {
+    parts.push('ctrl');
+  }
+  if (event.altKey) {
+    parts.push('alt');
+  }
+  if (event.shiftKey) {
+    parts.push('shift');
+  }
+  if (event.metaKey) {
+    parts.push('meta');
+  }
+  if (parts.length === 0) {
+    return '';
+  }
+
+  parts.push(key);
+  return parts.join('+');
+}
+
+// visibleFields returns the fields the command palette asks for.
+export function visibleFields(command: Command): CommandField[] {
+  return (command.fields || []).filter(field => field.type !== 'hidden');
+}
+
+@Injectable({
+  providedIn: 'root',
+})
+export class CommandService {
+  commands = new BehaviorSubject<Command[]>([]);
+
+  constructor(
+    private websocketService: WebsocketService,
+    private actionService: ActionService
+  ) {
+    websocketService.registerHandler('event.octant.dev/commands', data => {
+      const update = data as CommandsMessage;
+      this.commands.next(update.commands || []);
+    });
+  }
+
+  // findByShortcut returns the command with a shortcut matching the keyboard event.
+  findByShortcut(event: KeyboardEvent): Command | undefined {
+    const shortcut = shortcutFromEvent(event);
+    if (shortcut === '') {
+      return undefined;
+    }
+    return this.commands.value.find(command => command.shortcut === shortcut);
+  }
+
+  // run dispatches a command with the values of its fields. Hidden fields are
+  // always sent with their value.
+  run(command: Command, values: { [name: string]: any } = {}) {
+    const payload: { [name: string]: any } = {};
+
+    (command.fields || []).forEach(field => {
+      const value =
+        field.type === 'hidden' ? field.value : values[field.name] ?? '';
+
+      switch (field.type) {
+        case 'number':
+          if (value !== '') {
+            payload[field.name] = Number(value);
+          }
+          break;
+        case 'checkbox':
+          payload[field.name] = value === true || value === 'true';
+          break;
+        default:
+          payload[field.name] = value;
+      }
+    });
+
+    this.actionService.perform({ ...payload, action: command.name });
+  }
+}
@@ -6,9 +6,11 @@
 import { TestBed } from '@angular/core/testing';
 
 import {
+  ContentPatchMessage,
   ContentService,
   ContentUpdate,
   ContentUpdateMessage,
+  RefreshContentAction,
 } from './content.service';
 import { WebsocketServiceMock } from '../../../../data/services/websocket/mock';
 import {
@@ -76,6 +78,72 @@ describe('ContentService', () => {
     });
   });
 
+  describe('content patch', () => {
+    const text = { metadata: { type: 'text' }, config: { value: 'text' } };
+    const table = {
+      metadata: { type: 'table', accessor: 'pods' },
+      config: { rows: [{ Name: 'a' }] },
+    };
+    const update: ContentUpdate = {
+      content: {
+        extensionComponent: null,
+        title: [],
+        viewComponents: [text, table],
+      },
+      namespace: 'default',
+      contentPath: '/path',
+      queryParams: {},
+    };
+
+    let backendService: BackendService;
+
+    beforeEach(() => {
+      backendService = TestBed.inject(WebsocketService);
+      spyOn(backendService, 'sendMessage');
+      backendService.triggerHandler(ContentUpdateMessage, update);
+    });
+
+    it('patches the views of the current content', () => {
+      backendService.triggerHandler(ContentPatchMessage, {
+        contentPath: '/path',
+        queryParams: {},
+        namespace: 'default',
+        patches: [
+          {
+            index: 1,
+            accessor: 'pods',
+            operations: [
+              { op: 'add', path: '/config/rows/1', value: { Name: 'b' } },
+            ],
+          },
+        ],
+      });
+
+      const current = service.current.getValue();
+      expect(current.content.viewComponents[0]).toBe(text);
+      expect(current.content.viewComponents[1]).toEqual({
+        metadata: { type: 'table', accessor: 'pods' },
+        config: { rows: [{ Name: 'a' }, { Name: 'b' }] },
+      } as any);
+      expect(backendService.sendMessage).not.toHaveBeenCalled();
+    });
+
+    it('requests the full content if a patch does not apply', () => {
+      backendService.triggerHandler(ContentPatchMessage, {
+        contentPath: '/path',
+        queryParams: {},
+        namespace: 'default',
+        patches: [{ index: 1, accessor: 'other', operations: [] }],
+      });
+
+      expect(service.current.getValue().content).toEqual(update.content);
+      expect(backendService.sendMessage).toHaveBeenCalledWith(
+        RefreshContentAction,
+        {}
+      );
+    });
+  });
+
   describe('label filters updated', () => {
     let labelFilterService: LabelFilterService;
 
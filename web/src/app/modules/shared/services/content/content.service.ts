@@ -22,8 +22,11 @@ import { NamespaceService } from '../namespace/namespace.service';
 import { LoadingService } from '../loading/loading.service';
 import { debounceTime, delay, distinctUntilChanged } from 'rxjs/operators';
 import { Title } from '@angular/platform-browser';
+import { applyPatch, PatchOperation } from '../../../../util/jsonPatch';
 
 export const ContentUpdateMessage = 'event.octant.dev/content';
+export const ContentPatchMessage = 'event.octant.dev/contentPatch';
+export const RefreshContentAction = 'action.octant.dev/refreshContent';
 
 export interface ContentUpdate {
   content: Content;
@@ -32,6 +35,19 @@ export interface ContentUpdate {
   queryParams: { [key: string]: string[] };
 }
 
+export interface ContentPatch {
+  patches: ComponentPatch[];
+  namespace: string;
+  contentPath: string;
+  queryParams: { [key: string]: string[] };
+}
+
+export interface ComponentPatch {
+  index: number;
+  accessor: string;
+  operations: PatchOperation[];
+}
+
 const emptyContentResponse: ContentResponse = {
   content: { extensionComponent: null, viewComponents: [], title: [] },
   currentPath: '',
@@ -110,6 +126,10 @@ export class ContentService {
       this.previousContentPath = response.contentPath;
     });
 
+    websocketService.registerHandler(ContentPatchMessage, data => {
+      this.patchContent(data as ContentPatch);
+    });
+
     labelFilterService.filters.subscribe(filters => {
       this.filters = filters;
     });
@@ -155,6 +175,36 @@ export class ContentService {
     this.current.next(contentResponse);
   }
 
+  // patchContent applies patches of view components to the current content. Only the
+  // patched views are replaced. If the patches don't apply to the current content, the
+  // full content is requested.
+  private patchContent(update: ContentPatch) {
+    const current = this.current.getValue();
+    if (update.contentPath !== current.currentPath) {
+      return;
+    }
+
+    try {
+      const viewComponents = [...current.content.viewComponents];
+      update.patches.forEach(patch => {
+        const view = viewComponents[patch.index];
+        if (!view || (view.metadata.accessor || '') !== patch.accessor) {
+          throw new Error(`view ${patch.index} does not match the patch`);
+        }
+        viewComponents[patch.index] = applyPatch(view, patch.operations);
+      });
+
+      this.lastReceived = '';
+      this.current.next({
+        ...current,
+        content: { ...current.content, viewComponents },
+      });
+    } catch (e) {
+      console.error('unable to patch content', e);
+      this.websocketService.sendMessage(RefreshContentAction, {});
+    }
+  }
+
   private setTitle(response: ContentUpdate) {
     const title = response?.content?.title;
 
@@ -11,6 +11,7 @@ export const KubeContextMessage = 'event.octant.dev/kubeConfig';
 
 export interface ContextDescription {
   name: string;
+  favorite?: boolean;
 }
 
 export interface KubeContextResponse {
@@ -0,0 +1,72 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { inject, TestBed } from '@angular/core/testing';
+import { RouterTestingModule } from '@angular/router/testing';
+import {
+  NotificationMessage,
+  NotificationService,
+  WatchNotification,
+} from './notification.service';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../data/services/websocket/mock';
+import {
+  NotifierService,
+  NotifierSignalType,
+} from '../../notifier/notifier.service';
+
+describe('NotificationService', () => {
+  const notification: WatchNotification = {
+    time: '2021-01-01T00:00:00Z',
+    level: 'error',
+    title: 'Pod web status is error',
+    message: 'CrashLoopBackOff',
+    ref: '/overview/namespace/default/workloads/pods/web',
+    context: 'dev',
+    watchID: 'watch-1',
+  };
+
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      imports: [RouterTestingModule],
+      providers: [
+        NotificationService,
+        NotifierService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+  });
+
+  it('shows notifications from the backend', inject(
+    [NotificationService, WebsocketService],
+    (svc: NotificationService, backendService: BackendService) => {
+      spyOn(svc, 'show');
+
+      backendService.triggerHandler(NotificationMessage, notification);
+
+      expect(svc.show).toHaveBeenCalledWith(notification);
+    }
+  ));
+
+  it('falls back to alerts when browser notifications are denied', inject(
+    [NotificationService, NotifierService],
+    (svc: NotificationService, notifierService: NotifierService) => {
+      spyOnProperty(Notification, 'permission').and.returnValue('denied');
+      spyOn(notifierService, 'pushSignal');
+
+      svc.show(notification);
+
+      expect(notifierService.pushSignal).toHaveBeenCalledWith(
+        NotifierSignalType.ERROR,
+        'Pod web status is error: CrashLoopBackOff'
+      );
+    }
+  ));
+});
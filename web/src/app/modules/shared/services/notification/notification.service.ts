@@ -0,0 +1,100 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { Router } from '@angular/router';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+import {
+  NotifierService,
+  NotifierSignalType,
+} from '../../notifier/notifier.service';
+
+export const NotificationMessage = 'event.octant.dev/notification';
+
+export interface WatchNotification {
+  time: string;
+  level: 'info' | 'success' | 'warning' | 'error';
+  title: string;
+  message: string;
+  ref?: string;
+  context: string;
+  watchID: string;
+}
+
+const signalTypes: { [level: string]: NotifierSignalType } = {
+  info: NotifierSignalType.INFO,
+  success: NotifierSignalType.SUCCESS,
+  warning: NotifierSignalType.WARNING,
+  error: NotifierSignalType.ERROR,
+};
+
+// NotificationService shows notifications about watched objects. Browser
+// notifications are used when they are permitted, and Octant's own alerts
+// otherwise.
+@Injectable({
+  providedIn: 'root',
+})
+export class NotificationService {
+  constructor(
+    private websocketService: WebsocketService,
+    private notifierService: NotifierService,
+    private router: Router
+  ) {
+    websocketService.registerHandler(NotificationMessage, data => {
+      this.show(data as WatchNotification);
+    });
+  }
+
+  show(notification: WatchNotification) {
+    if (!this.browserNotificationsSupported()) {
+      this.showAlert(notification);
+      return;
+    }
+
+    switch (Notification.permission) {
+      case 'granted':
+        this.showBrowserNotification(notification);
+        break;
+      case 'default':
+        Notification.requestPermission().then(permission => {
+          if (permission === 'granted') {
+            this.showBrowserNotification(notification);
+          } else {
+            this.showAlert(notification);
+          }
+        });
+        break;
+      default:
+        this.showAlert(notification);
+    }
+  }
+
+  private browserNotificationsSupported(): boolean {
+    return typeof window !== 'undefined' && 'Notification' in window;
+  }
+
+  private showBrowserNotification(notification: WatchNotification) {
+    const browserNotification = new Notification(notification.title, {
+      body: notification.message,
+      tag: `${notification.watchID}/${notification.title}`,
+    });
+    browserNotification.onclick = () => {
+      window.focus();
+      if (notification.ref) {
+        this.router.navigateByUrl(notification.ref);
+      }
+      browserNotification.close();
+    };
+  }
+
+  private showAlert(notification: WatchNotification) {
+    const text = notification.message
+      ? `${notification.title}: ${notification.message}`
+      : notification.title;
+    this.notifierService.pushSignal(
+      signalTypes[notification.level] || NotifierSignalType.INFO,
+      text
+    );
+  }
+}
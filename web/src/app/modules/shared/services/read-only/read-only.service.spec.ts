@@ -0,0 +1,48 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { inject, TestBed } from '@angular/core/testing';
+import { ReadOnlyMessage, ReadOnlyService } from './read-only.service';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../data/services/websocket/mock';
+
+describe('ReadOnlyService', () => {
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      providers: [
+        ReadOnlyService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+  });
+
+  it('allows every action by default', inject(
+    [ReadOnlyService],
+    (svc: ReadOnlyService) => {
+      expect(svc.readOnly).toBeFalse();
+      expect(svc.refuses('action.octant.dev/deleteObject')).toBeFalse();
+    }
+  ));
+
+  it('refuses mutating actions in read-only mode', inject(
+    [ReadOnlyService, WebsocketService],
+    (svc: ReadOnlyService, backendService: BackendService) => {
+      backendService.triggerHandler(ReadOnlyMessage, {
+        readOnly: true,
+        mutatingActions: ['action.octant.dev/deleteObject'],
+      });
+
+      expect(svc.readOnly).toBeTrue();
+      expect(svc.refuses('action.octant.dev/deleteObject')).toBeTrue();
+      expect(svc.refuses('action.octant.dev/manifest')).toBeFalse();
+      expect(svc.refuses(undefined)).toBeFalse();
+    }
+  ));
+});
@@ -0,0 +1,49 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { BehaviorSubject } from 'rxjs';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+
+export const ReadOnlyMessage = 'event.octant.dev/readOnly';
+
+export interface ReadOnlyState {
+  readOnly: boolean;
+  mutatingActions: string[];
+}
+
+const writable: ReadOnlyState = {
+  readOnly: false,
+  mutatingActions: [],
+};
+
+@Injectable({
+  providedIn: 'root',
+})
+export class ReadOnlyService {
+  state = new BehaviorSubject<ReadOnlyState>(writable);
+
+  constructor(private websocketService: WebsocketService) {
+    websocketService.registerHandler(ReadOnlyMessage, data => {
+      const update = data as ReadOnlyState;
+      this.state.next({
+        readOnly: update.readOnly,
+        mutatingActions: update.mutatingActions || [],
+      });
+    });
+  }
+
+  get readOnly(): boolean {
+    return this.state.value.readOnly;
+  }
+
+  // refuses returns true if the backend would refuse the action, so controls
+  // for it should be hidden.
+  refuses(action: string | undefined): boolean {
+    const state = this.state.value;
+    return (
+      state.readOnly && !!action && state.mutatingActions.includes(action)
+    );
+  }
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { inject, TestBed } from '@angular/core/testing';
+import {
+  Validation,
+  ValidationMessage,
+  ValidationService,
+} from './validation.service';
+import {
+  BackendService,
+  WebsocketService,
+} from '../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../data/services/websocket/mock';
+
+describe('ValidationService', () => {
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      providers: [
+        ValidationService,
+        {
+          provide: WebsocketService,
+          useClass: WebsocketServiceMock,
+        },
+      ],
+    });
+  });
+
+  it('emits validations of the matching object', inject(
+    [ValidationService, WebsocketService],
+    (svc: ValidationService, backendService: BackendService) => {
+      const metadata = {
+        namespace: 'default',
+        apiVersion: 'apps/v1',
+        kind: 'Deployment',
+        name: 'app',
+      };
+
+      const received: Validation[] = [];
+      svc.validationsFor(metadata).subscribe(v => received.push(v));
+
+      backendService.triggerHandler(ValidationMessage, {
+        ...metadata,
+        name: 'other',
+        errors: [],
+      });
+      backendService.triggerHandler(ValidationMessage, {
+        ...metadata,
+        errors: [{ line: 3, column: 5, message: 'unknown field "replica"' }],
+      });
+      backendService.triggerHandler(ValidationMessage, metadata);
+
+      expect(received.length).toBe(2);
+      expect(received[0].errors).toEqual([
+        { line: 3, column: 5, message: 'unknown field "replica"' },
+      ]);
+      expect(received[1].errors).toEqual([]);
+    }
+  ));
+});
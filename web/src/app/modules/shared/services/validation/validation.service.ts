@@ -0,0 +1,64 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { Observable, Subject } from 'rxjs';
+import { filter } from 'rxjs/operators';
+import { WebsocketService } from '../../../../data/services/websocket/websocket.service';
+
+export const ValidationMessage = 'event.octant.dev/validation';
+
+// ValidationError is a schema violation in edited YAML. Lines and columns start at 1.
+export interface ValidationError {
+  line: number;
+  column: number;
+  field?: string;
+  message: string;
+}
+
+// Validation is the result of validating an edited object.
+export interface Validation {
+  namespace?: string;
+  apiVersion?: string;
+  kind?: string;
+  name?: string;
+  errors: ValidationError[];
+}
+
+const keyFields = ['namespace', 'apiVersion', 'kind', 'name'];
+
+// sameObject returns true if a validation is for the object with the given metadata.
+function sameObject(
+  validation: Validation,
+  metadata: { [key: string]: string }
+): boolean {
+  const key = validation as { [key: string]: any };
+  return keyFields.every(
+    field => (key[field] || '') === ((metadata || {})[field] || '')
+  );
+}
+
+@Injectable({
+  providedIn: 'root',
+})
+export class ValidationService {
+  private validations = new Subject<Validation>();
+
+  constructor(private websocketService: WebsocketService) {
+    websocketService.registerHandler(ValidationMessage, data => {
+      const validation = data as Validation;
+      this.validations.next({
+        ...validation,
+        errors: validation.errors || [],
+      });
+    });
+  }
+
+  // validationsFor returns validations of the object with the given metadata.
+  validationsFor(metadata: { [key: string]: string }): Observable<Validation> {
+    return this.validations.pipe(
+      filter(validation => sameObject(validation, metadata))
+    );
+  }
+}
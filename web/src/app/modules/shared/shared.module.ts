@@ -19,6 +19,7 @@ import { TabsComponent } from './components/presentation/tabs/tabs.component';
 import { TabsViewComponent } from './components/presentation/tabs-view/tabs-view.component';
 import { ContainersComponent } from './components/presentation/containers/containers.component';
 import { DatagridComponent } from './components/presentation/datagrid/datagrid.component';
+import { DiffComponent } from './components/presentation/diff/diff.component';
 import { DonutChartComponent } from './components/presentation/donut-chart/donut-chart.component';
 import { FlexlayoutComponent } from './components/presentation/flexlayout/flexlayout.component';
 import { SingleStatComponent } from './components/presentation/single-stat/single-stat.component';
@@ -34,10 +35,12 @@ import { ButtonGroupComponent } from './components/presentation/button-group/but
 import { YamlComponent } from './components/presentation/yaml/yaml.component';
 import { TableComponent } from './components/presentation/table/table.component';
 import { TimelineComponent } from './components/presentation/timeline/timeline.component';
+import { TimeSeriesChartComponent } from './components/presentation/time-series-chart/time-series-chart.component';
 import { TimestampComponent } from './components/presentation/timestamp/timestamp.component';
 import { LoadingComponent } from './components/presentation/loading/loading.component';
 import { HighlightModule } from 'ngx-highlightjs';
 import { LabelSelectorComponent } from './components/presentation/label-selector/label-selector.component';
+import { MarkdownComponent } from './components/presentation/markdown/markdown.component';
 import { ModalComponent } from './components/presentation/modal/modal.component';
 import { CytoscapeComponent } from './components/presentation/cytoscape/cytoscape.component';
 import { Cytoscape2Component } from './components/presentation/cytoscape2/cytoscape2.component';
@@ -88,6 +91,7 @@ import { BottomPanelComponent } from './components/smart/bottom-panel/bottom-pan
 import { DataModule } from '../../data/data.module';
 import { OverlayscrollbarsModule } from 'overlayscrollbars-ngx';
 import { StringEscapePipe } from './pipes/stringEscape/string.escape.pipe';
+import { HeatmapComponent } from './components/presentation/heatmap/heatmap.component';
 import { IconComponent } from './components/presentation/icon/icon.component';
 import { FormViewContainerComponent } from './components/form-view-container/form-view-container.component';
 import { SignpostComponent } from './components/presentation/signpost/signpost.component';
@@ -114,6 +118,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     Cytoscape2Component,
     DatagridComponent,
     DefaultPipe,
+    DiffComponent,
     DonutChartComponent,
     EditorComponent,
     ErrorComponent,
@@ -130,6 +135,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     HeptagonLabelComponent,
     IFrameComponent,
     IndicatorComponent,
+    HeatmapComponent,
     IconComponent,
     JSONEditorComponent,
     LabelsComponent,
@@ -138,6 +144,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     ListComponent,
     LoadingComponent,
     LogsComponent,
+    MarkdownComponent,
     ModalComponent,
     ObjectStatusComponent,
     PodStatusComponent,
@@ -163,6 +170,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     TerminalComponent,
     TextComponent,
     TimelineComponent,
+    TimeSeriesChartComponent,
     TimestampComponent,
     TitleComponent,
     YamlComponent,
@@ -195,6 +203,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     CytoscapeComponent,
     Cytoscape2Component,
     DatagridComponent,
+    DiffComponent,
     DonutChartComponent,
     EditorComponent,
     ErrorComponent,
@@ -207,6 +216,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     HeptagonGridComponent,
     HeptagonGridRowComponent,
     HeptagonLabelComponent,
+    HeatmapComponent,
     IconComponent,
     IFrameComponent,
     IndicatorComponent,
@@ -216,6 +226,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     ListComponent,
     LoadingComponent,
     LogsComponent,
+    MarkdownComponent,
     ModalComponent,
     ObjectStatusComponent,
     PodStatusComponent,
@@ -235,6 +246,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     TerminalComponent,
     TextComponent,
     TimelineComponent,
+    TimeSeriesChartComponent,
     TimestampComponent,
     TitleComponent,
     YamlComponent,
@@ -280,6 +292,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     Cytoscape2Component,
     DatagridComponent,
     DefaultPipe,
+    DiffComponent,
     DonutChartComponent,
     EditorComponent,
     ErrorComponent,
@@ -293,6 +306,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     HeptagonGridComponent,
     HeptagonGridRowComponent,
     HeptagonLabelComponent,
+    HeatmapComponent,
     IconComponent,
     IFrameComponent,
     IndicatorComponent,
@@ -303,6 +317,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     ListComponent,
     LoadingComponent,
     LogsComponent,
+    MarkdownComponent,
     ModalComponent,
     ObjectStatusComponent,
     PodStatusComponent,
@@ -322,6 +337,7 @@ import { CUSTOM_ELEMENTS_SCHEMA } from '@angular/core';
     TerminalComponent,
     TextComponent,
     TimelineComponent,
+    TimeSeriesChartComponent,
     TimestampComponent,
     TitleComponent,
     TruncatePipe,
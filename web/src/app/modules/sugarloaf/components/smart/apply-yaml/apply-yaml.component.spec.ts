@@ -3,6 +3,8 @@
 //
 import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
 import { ApplyYAMLComponent } from './apply-yaml.component';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../../data/services/websocket/mock';
 
 describe('ApplyYAMLComponent', () => {
   let component: ApplyYAMLComponent;
@@ -12,6 +14,9 @@ describe('ApplyYAMLComponent', () => {
     waitForAsync(() => {
       TestBed.configureTestingModule({
         declarations: [ApplyYAMLComponent],
+        providers: [
+          { provide: WebsocketService, useClass: WebsocketServiceMock },
+        ],
       }).compileComponents();
     })
   );
@@ -5,8 +5,9 @@ SPDX-License-Identifier: Apache-2.0
 
 import { Component, HostListener, OnInit } from '@angular/core';
 import '@cds/core/modal/register';
-import { ClarityIcons, uploadIcon } from '@cds/core/icon';
+import { ClarityIcons, lockIcon, uploadIcon } from '@cds/core/icon';
 import { EditorView } from 'src/app/modules/shared/models/content';
+import { ReadOnlyService } from 'src/app/modules/shared/services/read-only/read-only.service';
 
 @Component({
   selector: 'app-apply-yaml',
@@ -29,15 +30,20 @@ export class ApplyYAMLComponent implements OnInit {
     },
   };
 
-  constructor() {
-    ClarityIcons.addIcons(uploadIcon);
+  constructor(private readOnlyService: ReadOnlyService) {
+    ClarityIcons.addIcons(uploadIcon, lockIcon);
+  }
+
+  // readOnly is true if applying YAML is refused in read-only mode.
+  get readOnly(): boolean {
+    return this.readOnlyService.refuses(this.editorView.config.submitAction);
   }
 
   ngOnInit() {}
 
   @HostListener('window:keydown', ['$event'])
   keyEvent(event: KeyboardEvent) {
-    if (event.ctrlKey && event.key === 'y') {
+    if (event.ctrlKey && event.key === 'y' && !this.readOnly) {
       event.preventDefault();
       event.cancelBubble = true;
       this.toggleModal();
@@ -0,0 +1,83 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { ComponentFixture, TestBed, waitForAsync } from '@angular/core/testing';
+
+import { CommandPaletteComponent } from './command-palette.component';
+import { windowProvider, WindowToken } from '../../../../../window';
+import {
+  Command,
+  CommandService,
+} from '../../../../shared/services/command/command.service';
+import { WebsocketService } from '../../../../../data/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../../data/services/websocket/mock';
+import { NamespaceService } from '../../../../shared/services/namespace/namespace.service';
+
+describe('CommandPaletteComponent', () => {
+  let component: CommandPaletteComponent;
+  let fixture: ComponentFixture<CommandPaletteComponent>;
+  let commandService: CommandService;
+
+  const refresh: Command = { name: 'refresh', title: 'Refresh' };
+  const scale: Command = {
+    name: 'scale',
+    title: 'Scale Deployment',
+    fields: [
+      { name: 'namespace', label: 'Namespace' },
+      { name: 'replicas', label: 'Replicas', type: 'number', required: true },
+    ],
+  };
+
+  beforeEach(
+    waitForAsync(() => {
+      TestBed.configureTestingModule({
+        declarations: [CommandPaletteComponent],
+        providers: [
+          { provide: WindowToken, useFactory: windowProvider },
+          { provide: WebsocketService, useClass: WebsocketServiceMock },
+        ],
+      }).compileComponents();
+    })
+  );
+
+  beforeEach(() => {
+    commandService = TestBed.inject(CommandService);
+    commandService.commands.next([refresh, scale]);
+    TestBed.inject(NamespaceService).activeNamespace.next('default');
+
+    fixture = TestBed.createComponent(CommandPaletteComponent);
+    component = fixture.componentInstance;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('filters commands', () => {
+    component.updateFilteredCommands('scale');
+    expect(component.filteredCommands).toEqual([scale]);
+  });
+
+  it('runs commands without fields right away', () => {
+    spyOn(commandService, 'run');
+    component.select(refresh);
+    expect(commandService.run).toHaveBeenCalledWith(refresh);
+  });
+
+  it('asks for field values', () => {
+    spyOn(commandService, 'run');
+    component.select(scale);
+
+    expect(component.selected).toEqual(scale);
+    expect(component.values.namespace).toEqual('default');
+    expect(component.canRun()).toBeFalse();
+
+    component.values.replicas = '2';
+    component.run();
+    expect(commandService.run).toHaveBeenCalledWith(scale, {
+      namespace: 'default',
+      replicas: '2',
+    });
+  });
+});
@@ -0,0 +1,209 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import {
+  Component,
+  ElementRef,
+  HostListener,
+  OnDestroy,
+  OnInit,
+} from '@angular/core';
+import '@cds/core/modal/register.js';
+import { Subscription } from 'rxjs';
+import {
+  Command,
+  CommandField,
+  CommandService,
+  visibleFields,
+} from '../../../../shared/services/command/command.service';
+import { NamespaceService } from '../../../../shared/services/namespace/namespace.service';
+
+@Component({
+  selector: 'app-command-palette',
+  templateUrl: './command-palette.component.html',
+  styleUrls: ['./command-palette.component.scss'],
+})
+export class CommandPaletteComponent implements OnInit, OnDestroy {
+  commands: Command[] = [];
+  filteredCommands: Command[] = [];
+
+  // selected is the command the palette is asking field values for.
+  selected: Command;
+  fields: CommandField[] = [];
+  values: { [name: string]: any } = {};
+
+  input = '';
+  activeIndex = 0;
+  opened = false;
+
+  private commandSubscription: Subscription;
+
+  constructor(
+    private commandService: CommandService,
+    private namespaceService: NamespaceService,
+    private el: ElementRef
+  ) {}
+
+  ngOnInit() {
+    this.commandSubscription = this.commandService.commands.subscribe(
+      commands => {
+        this.commands = commands;
+        this.updateFilteredCommands(this.input);
+      }
+    );
+  }
+
+  ngOnDestroy(): void {
+    if (this.commandSubscription) {
+      this.commandSubscription.unsubscribe();
+    }
+  }
+
+  @HostListener('window:keydown', ['$event'])
+  keyEvent(event: KeyboardEvent) {
+    if ((event.ctrlKey || event.metaKey) && event.key.toLowerCase() === 'k') {
+      event.preventDefault();
+      this.open();
+      return;
+    }
+
+    const command = this.commandService.findByShortcut(event);
+    if (command) {
+      event.preventDefault();
+      this.select(command);
+    }
+  }
+
+  identifyCommand(_: number, item: Command): string {
+    return item.name;
+  }
+
+  onInputChange(input: string) {
+    this.updateFilteredCommands(input);
+  }
+
+  onKeyDown(event: KeyboardEvent) {
+    if (this.selected) {
+      return;
+    }
+
+    if (event.key === 'ArrowDown') {
+      event.preventDefault();
+      this.activeIndex = Math.min(
+        this.activeIndex + 1,
+        this.filteredCommands.length - 1
+      );
+    } else if (event.key === 'ArrowUp') {
+      event.preventDefault();
+      this.activeIndex = Math.max(this.activeIndex - 1, 0);
+    } else if (event.key === 'Enter') {
+      event.preventDefault();
+      const command = this.filteredCommands[this.activeIndex];
+      if (command) {
+        this.select(command);
+      }
+    }
+  }
+
+  updateFilteredCommands(filter: string) {
+    this.activeIndex = 0;
+    const f = (filter || '').toLowerCase();
+    this.filteredCommands = this.commands.filter(
+      command =>
+        f === '' ||
+        command.title.toLowerCase().includes(f) ||
+        (command.description || '').toLowerCase().includes(f) ||
+        (command.source || '').toLowerCase().includes(f)
+    );
+  }
+
+  // select runs a command without visible fields right away. Otherwise the
+  // palette asks for the values of the command's fields.
+  select(command: Command) {
+    const fields = visibleFields(command);
+    if (fields.length === 0) {
+      this.commandService.run(command);
+      this.close();
+      return;
+    }
+
+    this.selected = command;
+    this.fields = fields;
+    this.values = {};
+    fields.forEach(field => {
+      let value: any = field.value ?? '';
+      if (field.name === 'namespace' && value === '') {
+        value = this.namespaceService.activeNamespace.value;
+      }
+      if (field.type === 'checkbox') {
+        value = value === 'true';
+      }
+      this.values[field.name] = value;
+    });
+
+    if (!this.opened) {
+      this.setOpen(true);
+    }
+  }
+
+  canRun(): boolean {
+    return this.fields.every(
+      field =>
+        !field.required ||
+        field.type === 'checkbox' ||
+        `${this.values[field.name] ?? ''}`.trim() !== ''
+    );
+  }
+
+  run() {
+    if (!this.selected || !this.canRun()) {
+      return;
+    }
+    this.commandService.run(this.selected, this.values);
+    this.close();
+  }
+
+  back() {
+    this.selected = undefined;
+    this.fields = [];
+    this.values = {};
+    this.focusInput();
+  }
+
+  open() {
+    this.reset();
+    this.setOpen(true);
+    this.focusInput();
+  }
+
+  close() {
+    this.reset();
+    this.setOpen(false);
+  }
+
+  private reset() {
+    this.input = '';
+    this.selected = undefined;
+    this.fields = [];
+    this.values = {};
+    this.updateFilteredCommands('');
+  }
+
+  private setOpen(opened: boolean) {
+    this.opened = opened;
+    const modal = document.getElementById('command-palette-modal');
+    if (modal) {
+      modal.hidden = !opened;
+    }
+  }
+
+  private focusInput() {
+    const el = this.el;
+    setTimeout(() => {
+      const input = el.nativeElement.querySelector('.filter-input');
+      if (input) {
+        input.focus();
+      }
+    }, 250);
+  }
+}
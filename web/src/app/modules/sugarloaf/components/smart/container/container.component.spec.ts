@@ -32,6 +32,7 @@ import { WebsocketServiceMock } from '../../../../../data/services/websocket/moc
 import { ClarityIcons } from '@clr/icons';
 import { ThemeSwitchButtonComponent } from '../theme-switch/theme-switch-button.component';
 import { QuickSwitcherComponent } from '../quick-switcher/quick-switcher.component';
+import { CommandPaletteComponent } from '../command-palette/command-palette.component';
 
 import { UploaderComponent } from '../uploader/uploader.component';
 import { BrowserAnimationsModule } from '@angular/platform-browser/animations';
@@ -79,6 +80,7 @@ describe('AppComponent', () => {
           FilterTextPipe,
           ThemeSwitchButtonComponent,
           QuickSwitcherComponent,
+          CommandPaletteComponent,
           UploaderComponent,
           OverlayScrollbarsComponent,
         ],
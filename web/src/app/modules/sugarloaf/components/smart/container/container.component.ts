@@ -19,6 +19,7 @@ import {
   ContentRoute,
   HistoryService,
 } from '../../../../shared/services/history/history.service';
+import { NotificationService } from '../../../../shared/services/notification/notification.service';
 import {
   DropdownItem,
   DropdownView,
@@ -56,7 +57,9 @@ export class ContainerComponent implements OnInit, OnDestroy {
     private electronService: ElectronService,
     private iconService: IconService,
     private helperService: HelperService,
-    private historyService: HistoryService
+    private historyService: HistoryService,
+    // injected so notifications about watched objects are shown on every page
+    private notificationService: NotificationService
   ) {
     iconService.load({
       iconName: 'octant-logo',
@@ -5,7 +5,10 @@
 import { Component, OnDestroy, OnInit } from '@angular/core';
 import findLast from 'lodash/findLast';
 import { Subscription } from 'rxjs';
-import { Alert } from 'src/app/modules/shared/models/content';
+import {
+  Alert,
+  ButtonGroupView,
+} from 'src/app/modules/shared/models/content';
 import {
   NotifierService,
   NotifierSignalType,
@@ -20,6 +23,7 @@ export class NotifierComponent implements OnInit, OnDestroy {
   private signalSubscription: Subscription;
   loading = false;
   error: string;
+  errorButtonGroup: ButtonGroupView;
   warning: string;
   info: string;
   success: string;
@@ -46,6 +50,7 @@ export class NotifierComponent implements OnInit, OnDestroy {
           type: NotifierSignalType.ERROR,
         });
         this.error = lastErrorSignal ? (lastErrorSignal.data as string) : '';
+        this.errorButtonGroup = lastErrorSignal?.buttonGroup;
 
         const lastInfoSignal = findLast(currentSignals, {
           type: NotifierSignalType.INFO,
@@ -76,6 +81,7 @@ export class NotifierComponent implements OnInit, OnDestroy {
   setAlert(): void {
     let status: string, message: string;
     let closable: boolean;
+    let buttonGroup: ButtonGroupView;
 
     if (this.warning) {
       status = 'warning';
@@ -83,6 +89,7 @@ export class NotifierComponent implements OnInit, OnDestroy {
     } else if (this.error) {
       status = 'error';
       message = this.error;
+      buttonGroup = this.errorButtonGroup;
     } else if (this.success) {
       status = 'success';
       message = this.success;
@@ -97,6 +104,7 @@ export class NotifierComponent implements OnInit, OnDestroy {
       type: 'banner',
       message: message,
       closable: !!closable,
+      buttonGroup,
     };
   }
 }
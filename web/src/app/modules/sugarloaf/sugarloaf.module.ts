@@ -7,6 +7,7 @@ import { InputFilterComponent } from './components/smart/input-filter/input-filt
 import { NotifierComponent } from './components/smart/notifier/notifier.component';
 import { NavigationComponent } from './components/smart/navigation/navigation.component';
 import { QuickSwitcherComponent } from './components/smart/quick-switcher/quick-switcher.component';
+import { CommandPaletteComponent } from './components/smart/command-palette/command-palette.component';
 import { ApplyYAMLComponent } from './components/smart/apply-yaml/apply-yaml.component';
 import { ThemeSwitchButtonComponent } from './components/smart/theme-switch/theme-switch-button.component';
 import { UploaderComponent } from './components/smart/uploader/uploader.component';
@@ -47,6 +48,7 @@ export class UnstripTrailingSlashLocation extends Location {
     NavigationComponent,
     ContentComponent,
     QuickSwitcherComponent,
+    CommandPaletteComponent,
     ThemeSwitchButtonComponent,
     UploaderComponent,
     FilterTextPipe,
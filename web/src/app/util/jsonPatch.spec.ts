@@ -0,0 +1,59 @@
+import { applyPatch } from './jsonPatch';
+
+describe('applyPatch', () => {
+  const document = {
+    config: {
+      rows: [{ name: 'a' }, { name: 'b' }, { name: 'c' }],
+      title: 'Pods',
+    },
+    metadata: { type: 'table' },
+  };
+
+  it('should replace values without modifying the document', () => {
+    const patched = applyPatch(document, [
+      { op: 'replace', path: '/config/rows/1/name', value: 'renamed' },
+    ]);
+
+    expect(patched.config.rows[1].name).toBe('renamed');
+    expect(document.config.rows[1].name).toBe('b');
+  });
+
+  it('should share unchanged values with the document', () => {
+    const patched = applyPatch(document, [
+      { op: 'replace', path: '/config/title', value: 'Deployments' },
+    ]);
+
+    expect(patched.metadata).toBe(document.metadata);
+    expect(patched.config.rows).toBe(document.config.rows);
+  });
+
+  it('should add and remove array items', () => {
+    const patched = applyPatch(document, [
+      { op: 'remove', path: '/config/rows/2' },
+      { op: 'remove', path: '/config/rows/1' },
+      { op: 'add', path: '/config/rows/1', value: { name: 'd' } },
+    ]);
+
+    expect(patched.config.rows).toEqual([{ name: 'a' }, { name: 'd' }]);
+  });
+
+  it('should add and remove object keys', () => {
+    const patched = applyPatch(document, [
+      { op: 'add', path: '/config/a~1b', value: true },
+      { op: 'remove', path: '/config/title' },
+    ]);
+
+    expect(patched.config).toEqual({
+      rows: document.config.rows,
+      'a/b': true,
+    } as any);
+  });
+
+  it('should throw if a path does not exist', () => {
+    expect(() =>
+      applyPatch(document, [
+        { op: 'replace', path: '/missing/value', value: 1 },
+      ])
+    ).toThrow();
+  });
+});
@@ -0,0 +1,82 @@
+// Copyright (c) 2021 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+export interface PatchOperation {
+  op: 'add' | 'remove' | 'replace';
+  path: string;
+  value?: any;
+}
+
+// applyPatch applies JSON Patch (RFC 6902) operations to a document. The document is
+// not modified: objects on the paths of operations are copied, and everything else is
+// shared with the document, so unchanged views keep their identity.
+export function applyPatch<T>(document: T, operations: PatchOperation[]): T {
+  return operations.reduce(
+    (doc, operation) => applyOperation(doc, operation),
+    document
+  );
+}
+
+function applyOperation(document: any, operation: PatchOperation): any {
+  const keys = parsePointer(operation.path);
+  if (keys.length === 0) {
+    if (operation.op === 'remove') {
+      throw new Error('unable to remove the document');
+    }
+    return operation.value;
+  }
+
+  return update(document, keys, operation);
+}
+
+function update(node: any, keys: string[], operation: PatchOperation): any {
+  if (node === null || typeof node !== 'object') {
+    throw new Error(`path ${operation.path} does not exist`);
+  }
+
+  const [key, ...rest] = keys;
+  const copy = Array.isArray(node) ? [...node] : { ...node };
+
+  if (rest.length > 0) {
+    copy[key] = update(node[key], rest, operation);
+    return copy;
+  }
+
+  if (Array.isArray(copy)) {
+    const index = key === '-' ? copy.length : Number(key);
+    if (!Number.isInteger(index) || index < 0 || index > copy.length) {
+      throw new Error(`invalid array index in path ${operation.path}`);
+    }
+
+    switch (operation.op) {
+      case 'add':
+        copy.splice(index, 0, operation.value);
+        break;
+      case 'remove':
+        copy.splice(index, 1);
+        break;
+      case 'replace':
+        copy[index] = operation.value;
+        break;
+    }
+    return copy;
+  }
+
+  if (operation.op === 'remove') {
+    delete copy[key];
+  } else {
+    copy[key] = operation.value;
+  }
+  return copy;
+}
+
+function parsePointer(path: string): string[] {
+  if (path === '') {
+    return [];
+  }
+  return path
+    .split('/')
+    .slice(1)
+    .map(key => key.replace(/~1/g, '/').replace(/~0/g, '~'));
+}
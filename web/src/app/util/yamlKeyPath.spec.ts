@@ -0,0 +1,49 @@
+import { yamlKeyPath } from './yamlKeyPath';
+
+describe('yamlKeyPath', () => {
+  const lines = [
+    'apiVersion: apps/v1',
+    'kind: Deployment',
+    'metadata:',
+    '  labels:',
+    '    "app.kubernetes.io/name": web',
+    'spec:',
+    '  template:',
+    '    spec:',
+    '      containers:',
+    '      - name: web',
+    '        image: nginx',
+    '        args:',
+    '        - --port=80',
+    '',
+    '  # replicas is set by the autoscaler',
+    '  replicas: 3',
+  ];
+
+  it('should return top level keys', () => {
+    expect(yamlKeyPath(lines, 2)).toBe('kind');
+  });
+
+  it('should return nested keys', () => {
+    expect(yamlKeyPath(lines, 9)).toBe('spec.template.spec.containers');
+  });
+
+  it('should leave out list indexes', () => {
+    expect(yamlKeyPath(lines, 10)).toBe('spec.template.spec.containers.name');
+    expect(yamlKeyPath(lines, 11)).toBe('spec.template.spec.containers.image');
+  });
+
+  it('should unquote keys', () => {
+    expect(yamlKeyPath(lines, 5)).toBe('metadata.labels.app.kubernetes.io/name');
+  });
+
+  it('should skip comments and blank lines', () => {
+    expect(yamlKeyPath(lines, 16)).toBe('spec.replicas');
+  });
+
+  it('should return undefined for lines without keys', () => {
+    expect(yamlKeyPath(lines, 13)).toBeUndefined();
+    expect(yamlKeyPath(lines, 14)).toBeUndefined();
+    expect(yamlKeyPath(lines, 15)).toBeUndefined();
+  });
+});
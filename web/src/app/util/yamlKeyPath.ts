@@ -0,0 +1,50 @@
+// Matches a line with a mapping key, including keys of list items such as "- name: a".
+// The first group is the indentation of the key, including list item markers.
+const keyLine = /^(\s*(?:-\s+)*)("[^"]*"|'[^']*'|[^\s#:][^:#]*?)\s*:(\s|$)/;
+
+interface YAMLKey {
+  indent: number;
+  name: string;
+}
+
+function parseKey(line: string): YAMLKey | undefined {
+  const match = keyLine.exec(line);
+  if (!match) {
+    return undefined;
+  }
+
+  let name = match[2];
+  if (
+    name.length > 1 &&
+    (name[0] === '"' || name[0] === "'") &&
+    name[name.length - 1] === name[0]
+  ) {
+    name = name.substring(1, name.length - 1);
+  }
+
+  return { indent: match[1].length, name };
+}
+
+// yamlKeyPath returns the dotted path of the key on a line of a YAML document, without
+// list indexes. lineNumber starts at 1. It returns undefined if the line has no key.
+export function yamlKeyPath(
+  lines: string[],
+  lineNumber: number
+): string | undefined {
+  const key = parseKey(lines[lineNumber - 1] || '');
+  if (!key) {
+    return undefined;
+  }
+
+  const path = [key.name];
+  let indent = key.indent;
+  for (let i = lineNumber - 2; i >= 0 && indent > 0; i--) {
+    const parent = parseKey(lines[i]);
+    if (parent && parent.indent < indent) {
+      path.unshift(parent.name);
+      indent = parent.indent;
+    }
+  }
+
+  return path.join('.');
+}